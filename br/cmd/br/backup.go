@@ -100,6 +100,21 @@ func runBackupTxnCommand(command *cobra.Command, cmdName string) error {
 	return nil
 }
 
+func runCompactBackupCommand(command *cobra.Command, cmdName string) error {
+	cfg := task.CompactBackupConfig{Config: task.Config{LogProgress: HasLogFile()}}
+	if err := cfg.ParseFromFlags(command.Flags()); err != nil {
+		command.SilenceUsage = false
+		return errors.Trace(err)
+	}
+
+	ctx := GetDefaultContext()
+	if err := task.RunCompactBackup(ctx, gluetikv.Glue{}, cmdName, &cfg); err != nil {
+		log.Error("failed to compact backups", zap.Error(err))
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 // NewBackupCommand return a full backup subcommand.
 func NewBackupCommand() *cobra.Command {
 	command := &cobra.Command{
@@ -129,6 +144,7 @@ func NewBackupCommand() *cobra.Command {
 		newTableBackupCommand(),
 		newRawBackupCommand(),
 		newTxnBackupCommand(),
+		newCompactBackupCommand(),
 	)
 
 	task.DefineBackupFlags(command.PersistentFlags())
@@ -211,3 +227,22 @@ func newTxnBackupCommand() *cobra.Command {
 	task.DefineTxnBackupFlags(command)
 	return command
 }
+
+// newCompactBackupCommand return a subcommand which compacts a full backup and the incremental
+// backups based on it into a new full backup.
+func newCompactBackupCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "compact",
+		Short: "merge a full backup and the chain of incremental backups based on it into a new full backup",
+		Long: "merge a full backup and the chain of incremental backups based on it into a new full backup.\n" +
+			"The compacted backup doesn't have the checksums of the tables, so restoring it doesn't check " +
+			"the checksums of the restored tables. The chain whose incremental backups have the DDLs needing " +
+			"reorg, e.g. ADD INDEX, can't be compacted, since the data backfilled by them isn't backed up.",
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			return runCompactBackupCommand(command, task.CompactBackupCmd)
+		},
+	}
+	task.DefineCompactBackupFlags(command.Flags())
+	return command
+}
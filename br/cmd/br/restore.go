@@ -249,7 +249,7 @@ func newStreamRestoreCommand() *cobra.Command {
 			return runRestoreCommand(command, task.PointRestoreCmd)
 		},
 	}
-	task.DefineFilterFlags(command, filterOutSysAndMemTables, true)
+	task.DefineFilterFlags(command, filterOutSysAndMemTables, false)
 	task.DefineStreamRestoreFlags(command)
 	return command
 }
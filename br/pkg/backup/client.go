@@ -100,6 +100,8 @@ type Client struct {
 	checkpointRunner *checkpoint.CheckpointRunner[checkpoint.BackupKeyType, checkpoint.BackupValueType]
 
 	gcTTL int64
+
+	throttler *AdaptiveThrottler
 }
 
 // NewBackupClient returns a new backup client.
@@ -117,6 +119,12 @@ func NewBackupClient(ctx context.Context, mgr ClientMgr) *Client {
 	}
 }
 
+// SetThrottler sets the throttler which adjusts the rate limit and the concurrency of
+// the backup requests sent to each store.
+func (bc *Client) SetThrottler(throttler *AdaptiveThrottler) {
+	bc.throttler = throttler
+}
+
 // SetCipher for checkpoint to encrypt sst file's metadata
 func (bc *Client) SetCipher(cipher *backuppb.CipherInfo) {
 	bc.cipher = cipher
@@ -907,7 +915,7 @@ func (bc *Client) BackupRange(
 			req.EndKey = progressRange.Incomplete[0].EndKey
 		}
 
-		push := newPushDown(bc.mgr, len(targetStores))
+		push := newPushDown(bc.mgr, len(targetStores), bc.throttler)
 		err = push.pushBackup(ctx, req, progressRange, targetStores, bc.checkpointRunner, progressCallBack)
 		if err != nil {
 			return errors.Trace(err)
@@ -23,9 +23,10 @@ import (
 
 // pushDown wraps a backup task.
 type pushDown struct {
-	mgr    ClientMgr
-	respCh chan responseAndStore
-	errCh  chan error
+	mgr       ClientMgr
+	respCh    chan responseAndStore
+	errCh     chan error
+	throttler *AdaptiveThrottler
 }
 
 type responseAndStore struct {
@@ -42,11 +43,12 @@ func (r responseAndStore) GetStore() *metapb.Store {
 }
 
 // newPushDown creates a push down backup.
-func newPushDown(mgr ClientMgr, capacity int) *pushDown {
+func newPushDown(mgr ClientMgr, capacity int, throttler *AdaptiveThrottler) *pushDown {
 	return &pushDown{
-		mgr:    mgr,
-		respCh: make(chan responseAndStore, capacity),
-		errCh:  make(chan error, capacity),
+		mgr:       mgr,
+		respCh:    make(chan responseAndStore, capacity),
+		errCh:     make(chan error, capacity),
+		throttler: throttler,
 	}
 }
 
@@ -88,11 +90,15 @@ func (push *pushDown) pushBackup(
 			logutil.CL(lctx).Warn("fail to connect store, skipping", zap.Error(err))
 			continue
 		}
+		storeReq := req
+		if push.throttler != nil {
+			push.throttler.apply(&storeReq, storeID)
+		}
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			err := SendBackup(
-				lctx, storeID, client, req,
+				lctx, storeID, client, storeReq,
 				func(resp *backuppb.BackupResponse) error {
 					// Forward all responses (including error).
 					push.respCh <- responseAndStore{
@@ -0,0 +1,296 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"context"
+	"crypto/tls"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/conn"
+	connutil "github.com/pingcap/tidb/br/pkg/conn/util"
+	"github.com/pingcap/tidb/br/pkg/httputil"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	pd "github.com/tikv/pd/client"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultAdaptiveMaxRateLimit is the upper bound of the rate limit of the adaptive
+	// throttling when `--ratelimit` isn't specified.
+	DefaultAdaptiveMaxRateLimit = 1 * units.GiB
+	// minAdaptiveRateLimit is the lower bound of the rate limit of the adaptive throttling,
+	// so that the backup always makes progress.
+	minAdaptiveRateLimit = 1 * units.MiB
+	// the rate limit is increased by 1/adaptiveRateLimitSteps of the upper bound each time.
+	adaptiveRateLimitSteps = 10
+	// the backup is sped up only when the latency is lower than this ratio of the target,
+	// to avoid oscillating around the target.
+	adaptiveSpeedUpLatencyRatio = 0.8
+
+	grpcMsgDurationMetric = "tikv_grpc_msg_duration_seconds"
+)
+
+// foregroundRequestTypes are the gRPC message types of TiKV which are regarded as
+// the foreground requests whose latency should be protected.
+var foregroundRequestTypes = map[string]struct{}{
+	"kv_get":              {},
+	"kv_batch_get":        {},
+	"kv_scan":             {},
+	"kv_prewrite":         {},
+	"kv_commit":           {},
+	"kv_pessimistic_lock": {},
+	"coprocessor":         {},
+	"raw_get":             {},
+	"raw_batch_get":       {},
+	"raw_put":             {},
+	"raw_batch_put":       {},
+}
+
+// StoreLatencyFetcher fetches the P99 latency of the foreground requests of a store.
+type StoreLatencyFetcher interface {
+	// FetchP99Latency returns the P99 latency of the foreground requests of the store
+	// since the last call, 0 is returned if there is no request.
+	FetchP99Latency(ctx context.Context, store *metapb.Store) (time.Duration, error)
+}
+
+type storeThrottle struct {
+	rateLimit   uint64
+	concurrency uint32
+}
+
+// AdaptiveThrottler adjusts the rate limit and the concurrency of the backup requests
+// sent to each store by the feedback of the store, to keep the P99 latency of the
+// foreground requests under the target. They're halved when the latency exceeds the
+// target, and increased step by step when the latency is well under the target.
+type AdaptiveThrottler struct {
+	targetLatency  time.Duration
+	maxRateLimit   uint64
+	maxConcurrency uint32
+	fetcher        StoreLatencyFetcher
+
+	mu     sync.Mutex
+	stores map[uint64]*storeThrottle
+}
+
+// NewAdaptiveThrottler creates an AdaptiveThrottler. The rate limit and the concurrency
+// of each store start from the upper bounds.
+func NewAdaptiveThrottler(
+	targetLatency time.Duration,
+	maxRateLimit uint64,
+	maxConcurrency uint32,
+	fetcher StoreLatencyFetcher,
+) *AdaptiveThrottler {
+	return &AdaptiveThrottler{
+		targetLatency:  targetLatency,
+		maxRateLimit:   max(maxRateLimit, minAdaptiveRateLimit),
+		maxConcurrency: max(maxConcurrency, 1),
+		fetcher:        fetcher,
+		stores:         make(map[uint64]*storeThrottle),
+	}
+}
+
+func (t *AdaptiveThrottler) getStoreLocked(storeID uint64) *storeThrottle {
+	s, ok := t.stores[storeID]
+	if !ok {
+		s = &storeThrottle{rateLimit: t.maxRateLimit, concurrency: t.maxConcurrency}
+		t.stores[storeID] = s
+	}
+	return s
+}
+
+// apply sets the current rate limit and concurrency of the store to the request.
+func (t *AdaptiveThrottler) apply(req *backuppb.BackupRequest, storeID uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := t.getStoreLocked(storeID)
+	req.RateLimit = s.rateLimit
+	req.Concurrency = s.concurrency
+}
+
+// adjust updates the rate limit and the concurrency of the store by its latency.
+func (t *AdaptiveThrottler) adjust(storeID uint64, latency time.Duration) storeThrottle {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := t.getStoreLocked(storeID)
+	if latency > t.targetLatency {
+		s.rateLimit = max(s.rateLimit/2, minAdaptiveRateLimit)
+		s.concurrency = max(s.concurrency/2, 1)
+	} else if float64(latency) < float64(t.targetLatency)*adaptiveSpeedUpLatencyRatio {
+		s.rateLimit = min(s.rateLimit+t.maxRateLimit/adaptiveRateLimitSteps, t.maxRateLimit)
+		s.concurrency = min(s.concurrency+1, t.maxConcurrency)
+	}
+	return *s
+}
+
+// Run fetches the latency of all the TiKV stores and adjusts the throttling of them
+// every interval until the context is done. The new throttling takes effect on the
+// backup requests sent afterwards.
+func (t *AdaptiveThrottler) Run(ctx context.Context, pdClient pd.Client, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		stores, err := conn.GetAllTiKVStoresWithRetry(ctx, pdClient, connutil.SkipTiFlash)
+		if err != nil {
+			log.Warn("failed to get stores for adaptive throttling", logutil.ShortError(err))
+			continue
+		}
+		for _, store := range stores {
+			if store.GetState() != metapb.StoreState_Up {
+				continue
+			}
+			latency, err := t.fetcher.FetchP99Latency(ctx, store)
+			if err != nil {
+				log.Warn("failed to fetch the latency of store, keep its throttling",
+					zap.Uint64("store-id", store.GetId()), logutil.ShortError(err))
+				continue
+			}
+			s := t.adjust(store.GetId(), latency)
+			log.Info("adjust backup throttling",
+				zap.Uint64("store-id", store.GetId()),
+				zap.Duration("p99-latency", latency),
+				zap.Duration("target-latency", t.targetLatency),
+				zap.String("rate-limit", units.HumanSize(float64(s.rateLimit))+"/s"),
+				zap.Uint32("concurrency", s.concurrency))
+		}
+	}
+}
+
+// tikvMetricsLatencyFetcher fetches the latency from the `tikv_grpc_msg_duration_seconds`
+// histogram exposed by the status server of TiKV.
+type tikvMetricsLatencyFetcher struct {
+	cli        *http.Client
+	httpPrefix string
+
+	// the cumulative buckets of the last fetch of each store, the upper bound -> count,
+	// the count of all the requests is in the bucket of +Inf.
+	lastBuckets map[uint64]map[float64]uint64
+}
+
+// NewTiKVMetricsLatencyFetcher creates a StoreLatencyFetcher which reads the metrics
+// of TiKV.
+func NewTiKVMetricsLatencyFetcher(tlsConf *tls.Config) StoreLatencyFetcher {
+	httpPrefix := "http://"
+	if tlsConf != nil {
+		httpPrefix = "https://"
+	}
+	return &tikvMetricsLatencyFetcher{
+		cli:         httputil.NewClient(tlsConf),
+		httpPrefix:  httpPrefix,
+		lastBuckets: make(map[uint64]map[float64]uint64),
+	}
+}
+
+// FetchP99Latency implements the StoreLatencyFetcher interface.
+func (f *tikvMetricsLatencyFetcher) FetchP99Latency(ctx context.Context, store *metapb.Store) (time.Duration, error) {
+	statusAddr := store.GetStatusAddress()
+	if !strings.HasPrefix(statusAddr, "http") {
+		statusAddr = f.httpPrefix + statusAddr
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, statusAddr+"/metrics", nil)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	resp, err := f.cli.Do(req)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.Errorf("unexpected status %s when fetching metrics from %s", resp.Status, statusAddr)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	buckets := foregroundBuckets(families[grpcMsgDurationMetric])
+	last := f.lastBuckets[store.GetId()]
+	f.lastBuckets[store.GetId()] = buckets
+	if last == nil {
+		// the buckets are cumulative since TiKV starts, only the delta
+		// between two fetches reflects the current latency.
+		return 0, nil
+	}
+	delta := make(map[float64]uint64, len(buckets))
+	for upperBound, count := range buckets {
+		if count >= last[upperBound] {
+			delta[upperBound] = count - last[upperBound]
+		} else {
+			// TiKV is restarted.
+			delta[upperBound] = count
+		}
+	}
+	return p99OfBuckets(delta), nil
+}
+
+// foregroundBuckets merges the cumulative buckets of the foreground request types.
+func foregroundBuckets(family *dto.MetricFamily) map[float64]uint64 {
+	buckets := make(map[float64]uint64)
+	if family == nil {
+		return buckets
+	}
+	for _, m := range family.GetMetric() {
+		isForeground := false
+		for _, label := range m.GetLabel() {
+			if label.GetName() == "type" {
+				_, isForeground = foregroundRequestTypes[label.GetValue()]
+				break
+			}
+		}
+		if !isForeground || m.GetHistogram() == nil {
+			continue
+		}
+		for _, b := range m.GetHistogram().GetBucket() {
+			if !math.IsInf(b.GetUpperBound(), 1) {
+				buckets[b.GetUpperBound()] += b.GetCumulativeCount()
+			}
+		}
+		buckets[math.Inf(1)] += m.GetHistogram().GetSampleCount()
+	}
+	return buckets
+}
+
+// p99OfBuckets returns the upper bound of the bucket which the P99 falls into. If it
+// falls into the bucket of +Inf, the largest finite upper bound is returned.
+func p99OfBuckets(buckets map[float64]uint64) time.Duration {
+	total := buckets[math.Inf(1)]
+	if total == 0 {
+		return 0
+	}
+	upperBounds := make([]float64, 0, len(buckets))
+	for upperBound := range buckets {
+		if !math.IsInf(upperBound, 1) {
+			upperBounds = append(upperBounds, upperBound)
+		}
+	}
+	if len(upperBounds) == 0 {
+		return 0
+	}
+	sort.Float64s(upperBounds)
+	threshold := uint64(math.Ceil(float64(total) * 0.99))
+	for _, upperBound := range upperBounds {
+		if buckets[upperBound] >= threshold {
+			return time.Duration(upperBound * float64(time.Second))
+		}
+	}
+	return time.Duration(upperBounds[len(upperBounds)-1] * float64(time.Second))
+}
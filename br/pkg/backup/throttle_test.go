@@ -0,0 +1,120 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/go-units"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveThrottler(t *testing.T) {
+	throttler := NewAdaptiveThrottler(100*time.Millisecond, 100*units.MiB, 4, nil)
+
+	check := func(storeID uint64, rateLimit uint64, concurrency uint32) {
+		req := backuppb.BackupRequest{}
+		throttler.apply(&req, storeID)
+		require.Equal(t, rateLimit, req.RateLimit)
+		require.Equal(t, concurrency, req.Concurrency)
+	}
+	// start from the upper bounds.
+	check(1, 100*units.MiB, 4)
+
+	// slow down when the latency exceeds the target.
+	throttler.adjust(1, 200*time.Millisecond)
+	check(1, 50*units.MiB, 2)
+	throttler.adjust(1, 200*time.Millisecond)
+	check(1, 25*units.MiB, 1)
+	// the other stores are not affected.
+	check(2, 100*units.MiB, 4)
+
+	// keep it when the latency is close to the target.
+	throttler.adjust(1, 90*time.Millisecond)
+	check(1, 25*units.MiB, 1)
+	// speed up step by step when the latency is well under the target.
+	throttler.adjust(1, 10*time.Millisecond)
+	check(1, 35*units.MiB, 2)
+	for i := 0; i < 20; i++ {
+		throttler.adjust(1, 0)
+	}
+	check(1, 100*units.MiB, 4)
+
+	// never lower than the lower bounds.
+	for i := 0; i < 20; i++ {
+		throttler.adjust(1, time.Second)
+	}
+	check(1, minAdaptiveRateLimit, 1)
+}
+
+func TestTiKVMetricsLatencyFetcher(t *testing.T) {
+	// the cumulative counts of the buckets 1ms, 10ms, 100ms and the total count.
+	var (
+		mu    sync.Mutex
+		kvGet = []int{10, 20, 30, 30}
+	)
+	setKVGet := func(counts ...int) {
+		mu.Lock()
+		kvGet = counts
+		mu.Unlock()
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintln(w, "# HELP tikv_grpc_msg_duration_seconds Bucketed histogram of grpc server messages")
+		fmt.Fprintln(w, "# TYPE tikv_grpc_msg_duration_seconds histogram")
+		for i, le := range []string{"0.001", "0.01", "0.1", "+Inf"} {
+			fmt.Fprintf(w, "tikv_grpc_msg_duration_seconds_bucket{type=\"kv_get\",le=\"%s\"} %d\n", le, kvGet[i])
+		}
+		fmt.Fprintln(w, "tikv_grpc_msg_duration_seconds_sum{type=\"kv_get\"} 1")
+		fmt.Fprintf(w, "tikv_grpc_msg_duration_seconds_count{type=\"kv_get\"} %d\n", kvGet[3])
+		// the background requests are ignored.
+		fmt.Fprintln(w, "tikv_grpc_msg_duration_seconds_bucket{type=\"split_region\",le=\"0.001\"} 0")
+		fmt.Fprintln(w, "tikv_grpc_msg_duration_seconds_bucket{type=\"split_region\",le=\"0.01\"} 0")
+		fmt.Fprintln(w, "tikv_grpc_msg_duration_seconds_bucket{type=\"split_region\",le=\"0.1\"} 0")
+		fmt.Fprintln(w, "tikv_grpc_msg_duration_seconds_bucket{type=\"split_region\",le=\"+Inf\"} 1000")
+		fmt.Fprintln(w, "tikv_grpc_msg_duration_seconds_sum{type=\"split_region\"} 10000")
+		fmt.Fprintln(w, "tikv_grpc_msg_duration_seconds_count{type=\"split_region\"} 1000")
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	store := &metapb.Store{Id: 1, StatusAddress: server.URL}
+	fetcher := NewTiKVMetricsLatencyFetcher(nil)
+
+	// there is no baseline at the first fetch.
+	latency, err := fetcher.FetchP99Latency(ctx, store)
+	require.NoError(t, err)
+	require.Zero(t, latency)
+
+	// no request since the last fetch.
+	latency, err = fetcher.FetchP99Latency(ctx, store)
+	require.NoError(t, err)
+	require.Zero(t, latency)
+
+	// 98 of the 100 new requests are between 1ms and 10ms.
+	setKVGet(10, 118, 130, 130)
+	latency, err = fetcher.FetchP99Latency(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, latency)
+
+	// 99 of the 100 new requests are under 1ms.
+	setKVGet(109, 218, 230, 230)
+	latency, err = fetcher.FetchP99Latency(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, time.Millisecond, latency)
+
+	// the requests slower than all the buckets.
+	setKVGet(109, 218, 230, 330)
+	latency, err = fetcher.FetchP99Latency(ctx, store)
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, latency)
+}
@@ -367,11 +367,26 @@ func (exec *Executor) Execute(
 	ctx context.Context,
 	client kv.Client,
 	updateFn func(),
+) (*tipb.ChecksumResponse, error) {
+	return exec.ExecuteFrom(ctx, client, 0, func(*tipb.ChecksumResponse) {
+		updateFn()
+	})
+}
+
+// ExecuteFrom executes the checksum requests from the `start`th one, the requests before it
+// are skipped, e.g. they're finished before the checksum is interrupted. onRequestDone is
+// called with the response of each finished request, and the merged response of the executed
+// requests is returned.
+func (exec *Executor) ExecuteFrom(
+	ctx context.Context,
+	client kv.Client,
+	start int,
+	onRequestDone func(resp *tipb.ChecksumResponse),
 ) (*tipb.ChecksumResponse, error) {
 	checksumResp := &tipb.ChecksumResponse{}
 	checksumBackoffer := utils.InitialRetryState(utils.ChecksumRetryTime,
 		utils.ChecksumWaitInterval, utils.ChecksumMaxWaitInterval)
-	for _, req := range exec.reqs {
+	for _, req := range exec.reqs[min(start, len(exec.reqs)):] {
 		// Pointer to SessionVars.Killed
 		// Killed is a flag to indicate that this query is killed.
 		//
@@ -401,8 +416,12 @@ func (exec *Executor) Execute(
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		// the response may be incomplete if the context is done.
+		if err = checkContextDone(ctx); err != nil {
+			return nil, err
+		}
 		updateChecksumResponse(checksumResp, resp)
-		updateFn()
+		onRequestDone(resp)
 	}
 	return checksumResp, checkContextDone(ctx)
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tipb/go-tipb"
 	"github.com/stretchr/testify/require"
 )
 
@@ -102,6 +103,21 @@ func TestChecksum(t *testing.T) {
 	require.Equalf(t, uint64(2), resp2.TotalKvs, "%v", resp2)
 	require.Equalf(t, uint64(2), resp2.TotalBytes, "%v", resp2)
 
+	// Test skipping the finished requests
+	var finished []*tipb.ChecksumResponse
+	resp2, err = exe2.ExecuteFrom(context.TODO(), mock.Storage.GetClient(), 1, func(resp *tipb.ChecksumResponse) {
+		finished = append(finished, resp)
+	})
+	require.NoError(t, err)
+	require.Len(t, finished, 1)
+	require.Equal(t, finished[0], resp2)
+	require.Equalf(t, uint64(1), resp2.TotalKvs, "%v", resp2)
+	resp2, err = exe2.ExecuteFrom(context.TODO(), mock.Storage.GetClient(), exe2.Len(), func(*tipb.ChecksumResponse) {
+		require.FailNow(t, "all requests are finished")
+	})
+	require.NoError(t, err)
+	require.Equalf(t, uint64(0), resp2.TotalKvs, "%v", resp2)
+
 	// Test rewrite rules
 	tk.MustExec("alter table t1 add index i2(a);")
 	tableInfo1 = getTableInfo(t, mock, "test", "t1")
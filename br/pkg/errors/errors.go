@@ -70,6 +70,11 @@ var (
 	ErrRestoreIncompatibleSys  = errors.Normalize("incompatible system table", errors.RFCCodeText("BR:Restore:ErrRestoreIncompatibleSys"))
 	ErrUnsupportedSystemTable  = errors.Normalize("the system table isn't supported for restoring yet", errors.RFCCodeText("BR:Restore:ErrUnsupportedSysTable"))
 	ErrDatabasesAlreadyExisted = errors.Normalize("databases already existed in restored cluster", errors.RFCCodeText("BR:Restore:ErrDatabasesAlreadyExisted"))
+	// ErrTablesAlreadyExisted is the error when the tables to restore as a copy already exist.
+	ErrTablesAlreadyExisted = errors.Normalize("tables already existed in restored cluster", errors.RFCCodeText("BR:Restore:ErrTablesAlreadyExisted"))
+	// ErrRestoreNewCollationMismatch is the error when the new_collation_enabled of the backup cluster differs
+	// from the one of the restore cluster.
+	ErrRestoreNewCollationMismatch = errors.Normalize("new collation setting mismatch", errors.RFCCodeText("BR:Restore:ErrRestoreNewCollationMismatch"))
 
 	// ErrStreamLogTaskExist is the error when stream log task already exists, because of supporting single task currently.
 	ErrStreamLogTaskExist = errors.Normalize("stream task already exists", errors.RFCCodeText("BR:Stream:ErrStreamLogTaskExist"))
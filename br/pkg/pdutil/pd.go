@@ -252,6 +252,18 @@ func (p *PdController) GetRegionCount(ctx context.Context, startKey, endKey []by
 	return status.Count, nil
 }
 
+// GetRegionStats returns the region count and the approximate size and keys of the regions in the specified range.
+func (p *PdController) GetRegionStats(ctx context.Context, startKey, endKey []byte) (*pdhttp.RegionStats, error) {
+	// TiKV reports region start/end keys to PD in memcomparable-format.
+	var start, end []byte
+	start = codec.EncodeBytes(nil, startKey)
+	if len(endKey) != 0 { // Empty end key means the max.
+		end = codec.EncodeBytes(nil, endKey)
+	}
+	stats, err := p.pdHTTPCli.GetRegionStatusByKeyRange(ctx, pdhttp.NewKeyRange(start, end), false)
+	return stats, errors.Trace(err)
+}
+
 // GetStoreInfo returns the info of store with the specified id.
 func (p *PdController) GetStoreInfo(ctx context.Context, storeID uint64) (*pdhttp.StoreInfo, error) {
 	info, err := p.pdHTTPCli.GetStore(ctx, storeID)
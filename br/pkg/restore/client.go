@@ -75,9 +75,9 @@ import (
 	"google.golang.org/grpc/keepalive"
 )
 
-// defaultChecksumConcurrency is the default number of the concurrent
+// DefaultChecksumTableConcurrency is the default number of the concurrent
 // checksum tasks.
-const defaultChecksumConcurrency = 64
+const DefaultChecksumTableConcurrency = 64
 const defaultDDLConcurrency = 16
 const minBatchDdlSize = 1
 
@@ -1200,6 +1200,34 @@ outer:
 	return errors.Annotate(berrors.ErrRestoreNotFreshCluster, "user db/tables: "+strings.Join(userTableOrDBNames, ", "))
 }
 
+// CheckTablesNotExist checks that none of the tables to restore exists in the
+// target cluster, it's used when restoring as a copy into a non-empty cluster,
+// where the tables are always created with new table IDs.
+func (rc *Client) CheckTablesNotExist(tables []*metautil.Table) error {
+	log.Info("checking whether the tables to restore exist in target cluster")
+	is := rc.dom.InfoSchema()
+	const maxPrintCount = 10
+	existedTables := make([]string, 0, maxPrintCount+1)
+	for _, table := range tables {
+		if table.Info == nil || utils.IsTemplateSysDB(table.DB.Name) {
+			continue
+		}
+		if !is.TableExists(table.DB.Name, table.Info.Name) {
+			continue
+		}
+		if len(existedTables) == maxPrintCount {
+			existedTables = append(existedTables, "...")
+			break
+		}
+		existedTables = append(existedTables, utils.EncloseDBAndTable(table.DB.Name.O, table.Info.Name.O))
+	}
+	if len(existedTables) == 0 {
+		return nil
+	}
+	log.Error("tables to restore already exist", zap.Strings("tables", existedTables))
+	return errors.Annotate(berrors.ErrTablesAlreadyExisted, "tables: "+strings.Join(existedTables, ", "))
+}
+
 func (rc *Client) CheckSysTableCompatibility(dom *domain.Domain, tables []*metautil.Table) error {
 	log.Info("checking target cluster system table compatibility with backed up data")
 	privilegeTablesInBackup := make([]*metautil.Table, 0)
@@ -1766,6 +1794,10 @@ func concurrentHandleTablesCh(
 
 // GoValidateChecksum forks a goroutine to validate checksum after restore.
 // it returns a channel fires a struct{} when all things get done.
+// tables are verified in parallel, a table whose checksum mismatches doesn't
+// stop verifying the others, and isn't sent to the output channel, all the
+// failed tables are reported at last, and they would be re-verified when
+// restoring with checkpoint again.
 func (rc *Client) GoValidateChecksum(
 	ctx context.Context,
 	inCh <-chan *CreatedTable,
@@ -1773,17 +1805,33 @@ func (rc *Client) GoValidateChecksum(
 	errCh chan<- error,
 	updateCh glue.Progress,
 	concurrency uint,
+	tableConcurrency uint,
 ) chan *CreatedTable {
-	log.Info("Start to validate checksum")
+	log.Info("Start to validate checksum", zap.Uint("table-concurrency", tableConcurrency))
+	if tableConcurrency == 0 {
+		tableConcurrency = DefaultChecksumTableConcurrency
+	}
 	outCh := DefaultOutputTableChan()
-	workers := tidbutil.NewWorkerPool(defaultChecksumConcurrency, "RestoreChecksum")
-	go concurrentHandleTablesCh(ctx, inCh, outCh, errCh, workers, func(c context.Context, tbl *CreatedTable) error {
+	verifiedCh := DefaultOutputTableChan()
+	var (
+		failedMu     sync.Mutex
+		failedTables = make(map[*CreatedTable]string)
+	)
+	workers := tidbutil.NewWorkerPool(tableConcurrency, "RestoreChecksum")
+	go concurrentHandleTablesCh(ctx, inCh, verifiedCh, errCh, workers, func(c context.Context, tbl *CreatedTable) error {
 		start := time.Now()
 		defer func() {
 			elapsed := time.Since(start)
 			summary.CollectSuccessUnit("table checksum", 1, elapsed)
 		}()
 		err := rc.execChecksum(c, tbl, kvClient, concurrency)
+		if berrors.ErrRestoreChecksumMismatch.Equal(err) {
+			failedMu.Lock()
+			failedTables[tbl] = utils.EncloseDBAndTable(tbl.OldTable.DB.Name.O, tbl.OldTable.Info.Name.O)
+			failedMu.Unlock()
+			updateCh.Inc()
+			return nil
+		}
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -1792,9 +1840,37 @@ func (rc *Client) GoValidateChecksum(
 	}, func() {
 		log.Info("all checksum ended")
 	})
+	go func() {
+		defer close(outCh)
+		for tbl := range verifiedCh {
+			failedMu.Lock()
+			_, failed := failedTables[tbl]
+			failedMu.Unlock()
+			if failed {
+				continue
+			}
+			outCh <- tbl
+		}
+		// verifiedCh is closed after all the workers exit.
+		if len(failedTables) > 0 {
+			names := make([]string, 0, len(failedTables))
+			for _, name := range failedTables {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			errCh <- errors.Annotatef(berrors.ErrRestoreChecksumMismatch,
+				"failed to validate checksum of %d tables: %s", len(names), strings.Join(names, ", "))
+		}
+	}()
 	return outCh
 }
 
+func checksumMatched(item *checkpoint.ChecksumItem, table *metautil.Table) bool {
+	return item.Crc64xor == table.Crc64Xor &&
+		item.TotalKvs == table.TotalKvs &&
+		item.TotalBytes == table.TotalBytes
+}
+
 func (rc *Client) execChecksum(
 	ctx context.Context,
 	tbl *CreatedTable,
@@ -1817,7 +1893,14 @@ func (rc *Client) execChecksum(
 		ctx = opentracing.ContextWithSpan(ctx, span1)
 	}
 
+	table := tbl.OldTable
 	item, exists := rc.checkpointChecksum[tbl.Table.ID]
+	if exists && !checksumMatched(item, table) {
+		// the checksum of a failed table might be saved by the older version,
+		// verify it again.
+		logger.Info("re-verify the checksum of table failed before")
+		exists = false
+	}
 	if !exists {
 		startTS, err := rc.GetTSWithRetry(ctx)
 		if err != nil {
@@ -1845,17 +1928,16 @@ func (rc *Client) execChecksum(
 			TotalKvs:   checksumResp.TotalKvs,
 			TotalBytes: checksumResp.TotalBytes,
 		}
-		if rc.checkpointRunner != nil {
+		// only the verified tables are saved, so the failed ones would be
+		// verified again when resuming.
+		if rc.checkpointRunner != nil && checksumMatched(item, table) {
 			err = rc.checkpointRunner.FlushChecksumItem(ctx, item)
 			if err != nil {
 				return errors.Trace(err)
 			}
 		}
 	}
-	table := tbl.OldTable
-	if item.Crc64xor != table.Crc64Xor ||
-		item.TotalKvs != table.TotalKvs ||
-		item.TotalBytes != table.TotalBytes {
+	if !checksumMatched(item, table) {
 		logger.Error("failed in validate checksum",
 			zap.Uint64("origin tidb crc64", table.Crc64Xor),
 			zap.Uint64("calculated crc64", item.Crc64xor),
@@ -3412,6 +3494,27 @@ func (rc *Client) generateRepairIngestIndexSQLs(
 		}
 	}
 
+	sqls, err := generateRepairIndexSQLs(ingestRecorder, allSchema)
+	if err != nil {
+		return sqls, false, errors.Trace(err)
+	}
+
+	if rc.useCheckpoint && len(sqls) > 0 {
+		if err := checkpoint.SaveCheckpointIngestIndexRepairSQLs(ctx, rc.storage, &checkpoint.CheckpointIngestIndexRepairSQLs{
+			SQLs: sqls,
+		}, taskName); err != nil {
+			return sqls, false, errors.Trace(err)
+		}
+	}
+	return sqls, false, nil
+}
+
+// generateRepairIndexSQLs generates the SQLs to re-add the indexes in the IngestRecorder.
+func generateRepairIndexSQLs(
+	ingestRecorder *ingestrec.IngestRecorder,
+	allSchema []*model.DBInfo,
+) ([]checkpoint.CheckpointIngestIndexRepairSQL, error) {
+	var sqls []checkpoint.CheckpointIngestIndexRepairSQL
 	ingestRecorder.UpdateIndexInfo(allSchema)
 	if err := ingestRecorder.Iterate(func(_, indexID int64, info *ingestrec.IngestIndexInfo) error {
 		var (
@@ -3461,17 +3564,9 @@ func (rc *Client) generateRepairIngestIndexSQLs(
 
 		return nil
 	}); err != nil {
-		return sqls, false, errors.Trace(err)
-	}
-
-	if rc.useCheckpoint && len(sqls) > 0 {
-		if err := checkpoint.SaveCheckpointIngestIndexRepairSQLs(ctx, rc.storage, &checkpoint.CheckpointIngestIndexRepairSQLs{
-			SQLs: sqls,
-		}, taskName); err != nil {
-			return sqls, false, errors.Trace(err)
-		}
+		return nil, errors.Trace(err)
 	}
-	return sqls, false, nil
+	return sqls, nil
 }
 
 // RepairIngestIndex drops the indexes from IngestRecorder and re-add them.
@@ -3517,44 +3612,70 @@ NEXTSQL:
 			}
 		}
 
-		if err := func(sql checkpoint.CheckpointIngestIndexRepairSQL) error {
-			w := console.StartProgressBar(progressTitle, glue.OnlyOneTask)
-			defer w.Close()
+		// only drop the index when first execution or old index id is not dropped
+		if err := rc.repairIndex(ctx, console, progressTitle, sql, !fromCheckpoint || oldIndexIDFound); err != nil {
+			return errors.Trace(err)
+		}
+	}
 
-			// TODO: When the TiDB supports the DROP and CREATE the same name index in one SQL,
-			//   the checkpoint for ingest recorder can be removed and directly use the SQL:
-			//      ALTER TABLE db.tbl DROP INDEX `i_1`, ADD IDNEX `i_1` ...
-			//
-			// This SQL is compatible with checkpoint: If one ingest index has been recreated by
-			// the SQL, the index's id would be another one. In the next retry execution, BR can
-			// not find the ingest index's dropped id so that BR regards it as a dropped index by
-			// restored metakv and then skips repairing it.
+	return nil
+}
 
-			// only when first execution or old index id is not dropped
-			if !fromCheckpoint || oldIndexIDFound {
-				if err := rc.db.se.ExecuteInternal(ctx, alterTableDropIndexSQL, sql.SchemaName.O, sql.TableName.O, sql.IndexName); err != nil {
-					return errors.Trace(err)
-				}
-			}
-			failpoint.Inject("failed-before-create-ingest-index", func(v failpoint.Value) {
-				if v != nil && v.(bool) {
-					failpoint.Return(errors.New("failed before create ingest index"))
-				}
-			})
-			// create the repaired index when first execution or not found it
-			if err := rc.db.se.ExecuteInternal(ctx, sql.AddSQL, sql.AddArgs...); err != nil {
-				return errors.Trace(err)
-			}
-			w.Inc()
-			if err := w.Wait(ctx); err != nil {
-				return errors.Trace(err)
-			}
-			return nil
-		}(sql); err != nil {
+// repairIndex re-adds the index by the SQL, the index is dropped first if dropIndex is true.
+func (rc *Client) repairIndex(
+	ctx context.Context,
+	console glue.ConsoleOperations,
+	progressTitle string,
+	sql checkpoint.CheckpointIngestIndexRepairSQL,
+	dropIndex bool,
+) error {
+	w := console.StartProgressBar(progressTitle, glue.OnlyOneTask)
+	defer w.Close()
+
+	// TODO: When the TiDB supports the DROP and CREATE the same name index in one SQL,
+	//   the checkpoint for ingest recorder can be removed and directly use the SQL:
+	//      ALTER TABLE db.tbl DROP INDEX `i_1`, ADD IDNEX `i_1` ...
+	//
+	// This SQL is compatible with checkpoint: If one ingest index has been recreated by
+	// the SQL, the index's id would be another one. In the next retry execution, BR can
+	// not find the ingest index's dropped id so that BR regards it as a dropped index by
+	// restored metakv and then skips repairing it.
+	if dropIndex {
+		if err := rc.db.se.ExecuteInternal(ctx, alterTableDropIndexSQL, sql.SchemaName.O, sql.TableName.O, sql.IndexName); err != nil {
 			return errors.Trace(err)
 		}
 	}
+	failpoint.Inject("failed-before-create-ingest-index", func(v failpoint.Value) {
+		if v != nil && v.(bool) {
+			failpoint.Return(errors.New("failed before create ingest index"))
+		}
+	})
+	// create the repaired index when first execution or not found it
+	if err := rc.db.se.ExecuteInternal(ctx, sql.AddSQL, sql.AddArgs...); err != nil {
+		return errors.Trace(err)
+	}
+	w.Inc()
+	return errors.Trace(w.Wait(ctx))
+}
 
+// RebuildIndexes drops the indexes from IngestRecorder and re-add them, it's used by the
+// snapshot restore to rebuild the indexes whose KVs aren't restored.
+func (rc *Client) RebuildIndexes(ctx context.Context, recorder *ingestrec.IngestRecorder, g glue.Glue, storage kv.Storage) error {
+	dom, err := g.GetDomain(storage)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	sqls, err := generateRepairIndexSQLs(recorder, dom.InfoSchema().AllSchemas())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	console := glue.GetConsole(g)
+	for _, sql := range sqls {
+		progressTitle := fmt.Sprintf("rebuild index %s for table %s.%s", sql.IndexName, sql.SchemaName, sql.TableName)
+		if err := rc.repairIndex(ctx, console, progressTitle, sql, true); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return nil
 }
 
@@ -3829,7 +3950,7 @@ func CheckNewCollationEnable(
 	}
 
 	if !strings.EqualFold(backupNewCollationEnable, newCollationEnable) {
-		return enabled, errors.Annotatef(berrors.ErrUnknown,
+		return enabled, errors.Annotatef(berrors.ErrRestoreNewCollationMismatch,
 			"the config '%s' not match, upstream:%v, downstream: %v",
 			utils.TidbNewCollationEnabled, backupNewCollationEnable, newCollationEnable)
 	}
@@ -209,6 +209,49 @@ func TestCheckTargetClusterFreshWithTable(t *testing.T) {
 	require.True(t, berrors.ErrRestoreNotFreshCluster.Equal(client.CheckTargetClusterFresh(ctx)))
 }
 
+func TestCheckTablesNotExist(t *testing.T) {
+	// cannot use shared `mc`, other parallel case may change it.
+	cluster := getStartedMockedCluster(t)
+	defer cluster.Stop()
+
+	g := gluetidb.New()
+	client := restore.NewRestoreClient(cluster.PDClient, cluster.PDHTTPCli, nil, defaultKeepaliveCfg, false)
+	err := client.Init(g, cluster.Storage)
+	require.NoError(t, err)
+
+	info, err := cluster.Domain.GetSnapshotInfoSchema(math.MaxUint64)
+	require.NoError(t, err)
+	dbSchema, isExist := info.SchemaByName(model.NewCIStr("test"))
+	require.True(t, isExist)
+	intField := types.NewFieldType(mysql.TypeLong)
+	intField.SetCharset("binary")
+	newTable := func(name string) *metautil.Table {
+		return &metautil.Table{
+			DB: dbSchema,
+			Info: &model.TableInfo{
+				ID:   int64(1),
+				Name: model.NewCIStr(name),
+				Columns: []*model.ColumnInfo{{
+					ID:        1,
+					Name:      model.NewCIStr("id"),
+					FieldType: *intField,
+					State:     model.StatePublic,
+				}},
+				Charset: "utf8mb4",
+				Collate: "utf8mb4_bin",
+			},
+		}
+	}
+	_, _, err = client.CreateTables(cluster.Domain, []*metautil.Table{newTable("t1")}, 0)
+	require.NoError(t, err)
+
+	// the cluster is not fresh, but the tables to restore don't exist.
+	require.NoError(t, client.CheckTablesNotExist([]*metautil.Table{newTable("t2"), newTable("t3")}))
+	err = client.CheckTablesNotExist([]*metautil.Table{newTable("t2"), newTable("T1")})
+	require.True(t, berrors.ErrTablesAlreadyExisted.Equal(err))
+	require.ErrorContains(t, err, "`test`.`T1`")
+}
+
 func TestCheckSysTableCompatibility(t *testing.T) {
 	cluster := mc
 	g := gluetidb.New()
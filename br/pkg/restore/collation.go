@@ -0,0 +1,136 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"bytes"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/restore/ingestrec"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"go.uber.org/zap"
+)
+
+// CollationIndexRebuilder restores a backup into a cluster whose new_collation_enabled differs
+// from the backup cluster. The keys of the indexes on the string columns are encoded with the
+// collation keys, which differ between the two settings, so the KVs of these indexes are left
+// out of the restore, and the indexes are rebuilt after the data is restored.
+type CollationIndexRebuilder struct {
+	// indexes is the names of the indexes to rebuild, keyed by the old table ID.
+	indexes  map[int64][]model.CIStr
+	recorder *ingestrec.IngestRecorder
+}
+
+// NewCollationIndexRebuilder leaves the files of the indexes affected by the collations out of
+// the tables, and disables the checksum of these tables, because the checksum of the backup is
+// calculated with the keys of the other encoding.
+func NewCollationIndexRebuilder(tables []*metautil.Table) (*CollationIndexRebuilder, error) {
+	r := &CollationIndexRebuilder{
+		indexes:  make(map[int64][]model.CIStr),
+		recorder: ingestrec.New(),
+	}
+	for _, table := range tables {
+		if table.Info == nil {
+			continue
+		}
+		indexes, err := collationAffectedIndexes(table.DB.Name, table.Info)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if len(indexes) == 0 {
+			continue
+		}
+		prefixes := make([][]byte, 0, len(indexes))
+		for _, physicalID := range physicalIDsOf(table.Info) {
+			for _, idx := range indexes {
+				prefixes = append(prefixes, tablecodec.EncodeTableIndexPrefix(physicalID, idx.ID))
+			}
+		}
+		for _, idx := range indexes {
+			r.indexes[table.Info.ID] = append(r.indexes[table.Info.ID], idx.Name)
+		}
+		table.Files = filterOutFiles(table.Files, prefixes)
+		table.Crc64Xor, table.TotalKvs, table.TotalBytes = 0, 0, 0
+		log.Warn("the indexes are rebuilt after restore because of the different collations",
+			zap.Stringer("db", table.DB.Name), zap.Stringer("table", table.Info.Name),
+			zap.Any("indexes", r.indexes[table.Info.ID]))
+	}
+	return r, nil
+}
+
+// AddCreatedTable records the indexes to rebuild of the created table.
+func (r *CollationIndexRebuilder) AddCreatedTable(oldTableID int64, newTable *model.TableInfo) {
+	for _, name := range r.indexes[oldTableID] {
+		if idx := newTable.FindIndexByName(name.L); idx != nil {
+			r.recorder.AddIndex(newTable.ID, idx.ID, idx.Primary)
+		}
+	}
+}
+
+// Recorder returns the IngestRecorder of the indexes to rebuild.
+func (r *CollationIndexRebuilder) Recorder() *ingestrec.IngestRecorder {
+	return r.recorder
+}
+
+// collationAffectedIndexes returns the indexes whose keys depend on new_collation_enabled.
+func collationAffectedIndexes(dbName model.CIStr, tblInfo *model.TableInfo) ([]*model.IndexInfo, error) {
+	var indexes []*model.IndexInfo
+	for _, idx := range tblInfo.Indices {
+		if idx.State != model.StatePublic || !isCollationAffectedIndex(tblInfo, idx) {
+			continue
+		}
+		if idx.Primary && tblInfo.IsCommonHandle {
+			// the record keys are encoded with the clustered index, they can't be rebuilt.
+			return nil, errors.Annotatef(berrors.ErrRestoreNewCollationMismatch,
+				"the clustered index of table %s.%s contains the columns whose collation keys differ, "+
+					"the table can't be restored into the cluster with the different new_collation_enabled",
+				dbName, tblInfo.Name)
+		}
+		indexes = append(indexes, idx)
+	}
+	return indexes, nil
+}
+
+func isCollationAffectedIndex(tblInfo *model.TableInfo, idx *model.IndexInfo) bool {
+	for _, idxCol := range idx.Columns {
+		col := tblInfo.Columns[idxCol.Offset]
+		// the binary strings are encoded as they are with both settings.
+		if types.IsString(col.GetType()) && col.GetCollate() != charset.CollationBin {
+			return true
+		}
+	}
+	return false
+}
+
+func physicalIDsOf(tblInfo *model.TableInfo) []int64 {
+	ids := []int64{tblInfo.ID}
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			ids = append(ids, def.ID)
+		}
+	}
+	return ids
+}
+
+// filterOutFiles filters out the files in the ranges of the prefixes. The backup ranges are
+// split by the indexes, so a file never spans more than one index.
+func filterOutFiles(files []*backuppb.File, prefixes [][]byte) []*backuppb.File {
+	kept := make([]*backuppb.File, 0, len(files))
+NEXTFILE:
+	for _, file := range files {
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(file.GetStartKey(), prefix) {
+				continue NEXTFILE
+			}
+		}
+		kept = append(kept, file)
+	}
+	return kept
+}
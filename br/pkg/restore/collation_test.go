@@ -0,0 +1,89 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newCollationTestTable(id int64) *model.TableInfo {
+	newColumn := func(name string, offset int, tp byte, collate string) *model.ColumnInfo {
+		col := &model.ColumnInfo{Name: model.NewCIStr(name), Offset: offset, FieldType: *types.NewFieldType(tp)}
+		col.SetCollate(collate)
+		return col
+	}
+	newIndex := func(id int64, name string, col *model.ColumnInfo) *model.IndexInfo {
+		return &model.IndexInfo{
+			ID:      id,
+			Name:    model.NewCIStr(name),
+			Columns: []*model.IndexColumn{{Name: col.Name, Offset: col.Offset, Length: types.UnspecifiedLength}},
+			State:   model.StatePublic,
+		}
+	}
+	idCol := newColumn("id", 0, mysql.TypeLonglong, charset.CollationBin)
+	name := newColumn("name", 1, mysql.TypeVarchar, "utf8mb4_bin")
+	b := newColumn("b", 2, mysql.TypeVarchar, charset.CollationBin)
+	return &model.TableInfo{
+		ID:      id,
+		Name:    model.NewCIStr("t"),
+		Columns: []*model.ColumnInfo{idCol, name, b},
+		Indices: []*model.IndexInfo{
+			newIndex(1, "idx_id", idCol),
+			newIndex(2, "idx_name", name),
+			newIndex(3, "idx_b", b),
+		},
+	}
+}
+
+func TestCollationIndexRebuilder(t *testing.T) {
+	dbInfo := &model.DBInfo{Name: model.NewCIStr("test")}
+	files := []*backuppb.File{
+		{Name: "record", StartKey: tablecodec.GenTableRecordPrefix(100)},
+		{Name: "idx_id", StartKey: tablecodec.EncodeTableIndexPrefix(100, 1)},
+		{Name: "idx_name", StartKey: tablecodec.EncodeTableIndexPrefix(100, 2)},
+		{Name: "idx_b", StartKey: tablecodec.EncodeTableIndexPrefix(100, 3)},
+	}
+	table := &metautil.Table{
+		DB:       dbInfo,
+		Info:     newCollationTestTable(100),
+		Files:    files,
+		Crc64Xor: 1,
+		TotalKvs: 1,
+	}
+	rebuilder, err := NewCollationIndexRebuilder([]*metautil.Table{table})
+	require.NoError(t, err)
+	// only the files of the index on the non-binary string column are left out.
+	names := make([]string, 0, len(table.Files))
+	for _, f := range table.Files {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"record", "idx_id", "idx_b"}, names)
+	require.True(t, table.NoChecksum())
+
+	// the index is rebuilt on the created table.
+	newTable := newCollationTestTable(200)
+	rebuilder.AddCreatedTable(100, newTable)
+	dbInfo.Tables = []*model.TableInfo{newTable}
+	sqls, err := generateRepairIndexSQLs(rebuilder.Recorder(), []*model.DBInfo{dbInfo})
+	require.NoError(t, err)
+	require.Len(t, sqls, 1)
+	require.Equal(t, "idx_name", sqls[0].IndexName)
+	require.Equal(t, "ALTER TABLE %n.%n ADD INDEX %n(%n) VISIBLE", sqls[0].AddSQL)
+
+	// the clustered index on the string column can't be rebuilt.
+	clustered := newCollationTestTable(300)
+	clustered.IsCommonHandle = true
+	clustered.Indices[1].Primary = true
+	_, err = NewCollationIndexRebuilder([]*metautil.Table{{DB: dbInfo, Info: clustered}})
+	require.True(t, berrors.Is(err, berrors.ErrRestoreNewCollationMismatch))
+}
@@ -118,6 +118,20 @@ func (i *IngestRecorder) TryAddJob(job *model.Job, isSubJob bool) error {
 	return nil
 }
 
+// AddIndex records an index to re-add, whose KVs aren't restored, e.g. the index keys
+// encoded with the collations of another cluster.
+func (i *IngestRecorder) AddIndex(tableID, indexID int64, isPrimary bool) {
+	tableindexes, exists := i.items[tableID]
+	if !exists {
+		tableindexes = make(map[int64]*IngestIndexInfo)
+		i.items[tableID] = tableindexes
+	}
+	tableindexes[indexID] = &IngestIndexInfo{
+		IsPrimary: isPrimary,
+		Updated:   false,
+	}
+}
+
 // RewriteTableID rewrites the table id of the items in the IngestRecorder
 func (i *IngestRecorder) RewriteTableID(rewriteFunc func(tableID int64) (int64, bool, error)) error {
 	newItems := make(map[int64]map[int64]*IngestIndexInfo)
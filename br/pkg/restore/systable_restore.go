@@ -53,6 +53,17 @@ var unRecoverableTable = map[string]map[string]struct{}{
 
 		// replace into view is not supported now
 		"tidb_mdl_view": {},
+
+		// the placement violations, the index conflicts of the DDL jobs, the backup history and
+		// the cold partitions belong to the cluster where they're found.
+		"tidb_placement_violations": {},
+		"add_index_conflicts":       {},
+		"tidb_backup_history":       {},
+		"tidb_cold_partitions":      {},
+
+		// the variable profiles are bound to the resource groups and validated against the
+		// system variables of the cluster where they're created.
+		"tidb_variable_profiles": {},
 	},
 	"sys": {
 		// replace into view is not supported now
@@ -3,14 +3,19 @@
 package restore
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"testing"
 
 	backuppb "github.com/pingcap/kvproto/pkg/brpb"
 	"github.com/pingcap/kvproto/pkg/import_sstpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
 	recover_data "github.com/pingcap/kvproto/pkg/recoverdatapb"
+	"github.com/pingcap/tidb/br/pkg/checkpoint"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/util/codec"
 	"github.com/stretchr/testify/require"
@@ -456,3 +461,50 @@ func TestLogFilesSkipMap(t *testing.T) {
 		ratio = ratio * 2
 	}
 }
+
+type noopProgress struct{}
+
+func (noopProgress) Inc()              {}
+func (noopProgress) IncBy(int64)       {}
+func (noopProgress) GetCurrent() int64 { return 0 }
+func (noopProgress) Close()            {}
+
+func TestGoValidateChecksumByCheckpoint(t *testing.T) {
+	newTable := func(id int64, crc uint64) *CreatedTable {
+		return &CreatedTable{
+			RewriteRule: EmptyRewriteRule(),
+			Table:       &model.TableInfo{ID: id, Name: model.NewCIStr(fmt.Sprintf("t%d", id))},
+			OldTable: &metautil.Table{
+				DB:       &model.DBInfo{Name: model.NewCIStr("test")},
+				Info:     &model.TableInfo{ID: id, Name: model.NewCIStr(fmt.Sprintf("t%d", id))},
+				Crc64Xor: crc, TotalKvs: 1, TotalBytes: 1,
+			},
+		}
+	}
+	item := &checkpoint.ChecksumItem{TableID: 1, Crc64xor: 1, TotalKvs: 1, TotalBytes: 1}
+	require.True(t, checksumMatched(item, newTable(1, 1).OldTable))
+	require.False(t, checksumMatched(item, newTable(1, 2).OldTable))
+
+	// the tables verified before are skipped, and no checksum request is sent.
+	client := &Client{checkpointChecksum: map[int64]*checkpoint.ChecksumItem{
+		1: item,
+		2: {TableID: 2, Crc64xor: 2, TotalKvs: 1, TotalBytes: 1},
+	}}
+	ctx := context.Background()
+	inCh := DefaultOutputTableChan()
+	errCh := make(chan error, 1)
+	outCh := client.GoValidateChecksum(ctx, inCh, nil, errCh, noopProgress{}, 1, 2)
+	inCh <- newTable(1, 1)
+	inCh <- newTable(2, 2)
+	noChecksum := newTable(3, 0)
+	noChecksum.OldTable.TotalKvs, noChecksum.OldTable.TotalBytes = 0, 0
+	inCh <- noChecksum
+	close(inCh)
+	ids := make([]int64, 0, 3)
+	for tbl := range outCh {
+		ids = append(ids, tbl.Table.ID)
+	}
+	slices.Sort(ids)
+	require.Equal(t, []int64{1, 2, 3}, ids)
+	require.Len(t, errCh, 0)
+}
@@ -25,6 +25,7 @@ import (
 	berrors "github.com/pingcap/tidb/br/pkg/errors"
 	"github.com/pingcap/tidb/br/pkg/restore/ingestrec"
 	"github.com/pingcap/tidb/br/pkg/restore/tiflashrec"
+	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/pkg/ddl"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta"
@@ -658,22 +659,82 @@ func (sr *SchemasReplace) RewriteKvEntry(e *kv.Entry, cf string) (*kv.Entry, err
 	}
 
 	if meta.IsDBkey(rawKey.Field) {
+		dbID, err := meta.ParseDBKey(rawKey.Field)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if sr.isDBFilteredOut(dbID) {
+			return nil, nil
+		}
 		return sr.rewriteEntryForDB(e, cf)
 	} else if !meta.IsDBkey(rawKey.Key) {
 		return nil, nil
 	}
+
+	var (
+		rewrite      func(e *kv.Entry, cf string) (*kv.Entry, error)
+		parseTableID func([]byte) (int64, error)
+	)
 	if meta.IsTableKey(rawKey.Field) {
-		return sr.rewriteEntryForTable(e, cf)
+		rewrite, parseTableID = sr.rewriteEntryForTable, meta.ParseTableKey
 	} else if meta.IsAutoIncrementIDKey(rawKey.Field) {
-		return sr.rewriteEntryForAutoIncrementIDKey(e, cf)
+		rewrite, parseTableID = sr.rewriteEntryForAutoIncrementIDKey, meta.ParseAutoIncrementIDKey
 	} else if meta.IsAutoTableIDKey(rawKey.Field) {
-		return sr.rewriteEntryForAutoTableIDKey(e, cf)
+		rewrite, parseTableID = sr.rewriteEntryForAutoTableIDKey, meta.ParseAutoTableIDKey
 	} else if meta.IsSequenceKey(rawKey.Field) {
-		return sr.rewriteEntryForSequenceKey(e, cf)
+		rewrite, parseTableID = sr.rewriteEntryForSequenceKey, meta.ParseSequenceKey
 	} else if meta.IsAutoRandomTableIDKey(rawKey.Field) {
-		return sr.rewriteEntryForAutoRandomTableIDKey(e, cf)
+		rewrite, parseTableID = sr.rewriteEntryForAutoRandomTableIDKey, meta.ParseAutoRandomTableIDKey
+	} else {
+		return nil, nil
+	}
+
+	if sr.IsRestoreKVStatus() {
+		dbID, err := meta.ParseDBKey(rawKey.Key)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		tableID, err := parseTableID(rawKey.Field)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if sr.isTableFilteredOut(dbID, tableID) {
+			return nil, nil
+		}
+	}
+	return rewrite(e, cf)
+}
+
+// isDBFilteredOut checks whether the meta kvs of the database are excluded by the table filter.
+// The names are collected when constructing the id maps, so it only takes effect in the
+// RestoreKV status. The system databases are always kept, the same as the rewrite rules of
+// their data kvs.
+func (sr *SchemasReplace) isDBFilteredOut(dbID UpstreamID) bool {
+	if !sr.IsRestoreKVStatus() || sr.TableFilter == nil {
+		return false
+	}
+	dbReplace, exist := sr.DbMap[dbID]
+	if !exist {
+		// let the rewrite report the missing id.
+		return false
+	}
+	return !utils.IsSysDB(dbReplace.Name) && !sr.TableFilter.MatchSchema(dbReplace.Name)
+}
+
+// isTableFilteredOut checks whether the meta kvs of the table are excluded by the table filter.
+func (sr *SchemasReplace) isTableFilteredOut(dbID, tableID UpstreamID) bool {
+	if !sr.IsRestoreKVStatus() || sr.TableFilter == nil {
+		return false
+	}
+	dbReplace, exist := sr.DbMap[dbID]
+	if !exist {
+		return false
+	}
+	tableReplace, exist := dbReplace.TableMap[tableID]
+	if !exist {
+		return false
 	}
-	return nil, nil
+	return !utils.IsSysDB(dbReplace.Name) && !sr.TableFilter.MatchTable(dbReplace.Name, tableReplace.Name)
 }
 
 func (sr *SchemasReplace) tryRecordIngestIndex(job *model.Job) error {
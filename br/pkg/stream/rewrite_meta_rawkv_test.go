@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/ddl"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -284,6 +285,75 @@ func TestRewriteKeyForTable(t *testing.T) {
 	}
 }
 
+func TestRewriteKvEntryWithTableFilter(t *testing.T) {
+	var (
+		ts   uint64 = 400036290571534337
+		mDbs        = []byte("DBs")
+	)
+	tableFilter, err := filter.Parse([]string{"db1.t1"})
+	require.NoError(t, err)
+
+	dbMap := map[UpstreamID]*DBReplace{
+		1: {
+			Name: "db1",
+			DbID: 101,
+			TableMap: map[UpstreamID]*TableReplace{
+				10: NewTableReplace("t1", 110),
+				11: NewTableReplace("t2", 111),
+			},
+		},
+		2: {
+			Name:     "db2",
+			DbID:     102,
+			TableMap: map[UpstreamID]*TableReplace{},
+		},
+	}
+	sr := NewSchemasReplace(dbMap, false, nil, 9527, tableFilter, mockGenGenGlobalID, nil, nil)
+	sr.SetRestoreKVStatus()
+
+	rewriteDB := func(dbID int64, dbName string) *kv.Entry {
+		value, err := produceDBInfoValue(dbName, dbID)
+		require.NoError(t, err)
+		e, err := sr.RewriteKvEntry(&kv.Entry{Key: encodeTxnMetaKey(mDbs, meta.DBkey(dbID), ts), Value: value}, DefaultCF)
+		require.NoError(t, err)
+		return e
+	}
+	rewriteTable := func(dbID, tableID int64, tableName string) *kv.Entry {
+		value, err := produceTableInfoValue(tableName, tableID)
+		require.NoError(t, err)
+		e, err := sr.RewriteKvEntry(&kv.Entry{Key: encodeTxnMetaKey(meta.DBkey(dbID), meta.TableKey(tableID), ts), Value: value}, DefaultCF)
+		require.NoError(t, err)
+		return e
+	}
+	rewriteAutoID := func(dbID, tableID int64) *kv.Entry {
+		e, err := sr.RewriteKvEntry(&kv.Entry{Key: encodeTxnMetaKey(meta.DBkey(dbID), meta.AutoIncrementIDKey(tableID), ts), Value: []byte("1")}, DefaultCF)
+		require.NoError(t, err)
+		return e
+	}
+
+	// the matched database and table are rewritten.
+	e := rewriteDB(1, "db1")
+	require.NotNil(t, e)
+	decodedKey, err := ParseTxnMetaKeyFrom(e.Key)
+	require.NoError(t, err)
+	newDBID, err := meta.ParseDBKey(decodedKey.Field)
+	require.NoError(t, err)
+	require.Equal(t, int64(101), newDBID)
+	e = rewriteTable(1, 10, "t1")
+	require.NotNil(t, e)
+	decodedKey, err = ParseTxnMetaKeyFrom(e.Key)
+	require.NoError(t, err)
+	newTableID, err := meta.ParseTableKey(decodedKey.Field)
+	require.NoError(t, err)
+	require.Equal(t, int64(110), newTableID)
+	require.NotNil(t, rewriteAutoID(1, 10))
+
+	// the others are filtered out.
+	require.Nil(t, rewriteDB(2, "db2"))
+	require.Nil(t, rewriteTable(1, 11, "t2"))
+	require.Nil(t, rewriteAutoID(1, 11))
+}
+
 func TestRewriteTableInfo(t *testing.T) {
 	var (
 		dbId      int64 = 40
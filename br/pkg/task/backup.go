@@ -58,11 +58,17 @@ const (
 	flagKeyspaceName     = "keyspace-name"
 	flagReplicaReadLabel = "replica-read-label"
 	flagTableConcurrency = "table-concurrency"
+	flagTargetP99Latency = "target-p99-latency"
 
 	flagGCTTL = "gcttl"
 
+	flagEstimateOnly = "estimate-only"
+	flagStoragePrice = "storage-price"
+
 	defaultBackupConcurrency = 4
 	maxBackupConcurrency     = 256
+
+	adaptiveThrottleInterval = 10 * time.Second
 )
 
 const (
@@ -94,6 +100,9 @@ type BackupConfig struct {
 	UseCheckpoint    bool              `json:"use-checkpoint" toml:"use-checkpoint"`
 	ReplicaReadLabel map[string]string `json:"replica-read-label" toml:"replica-read-label"`
 	TableConcurrency uint              `json:"table-concurrency" toml:"table-concurrency"`
+	EstimateOnly     bool              `json:"estimate-only" toml:"estimate-only"`
+	StoragePrice     float64           `json:"storage-price" toml:"storage-price"`
+	TargetP99Latency time.Duration     `json:"target-p99-latency" toml:"target-p99-latency"`
 	CompressionConfig
 
 	// for ebs-based backup
@@ -156,6 +165,14 @@ func DefineBackupFlags(flags *pflag.FlagSet) {
 	_ = flags.MarkHidden(flagUseCheckpoint)
 
 	flags.String(flagReplicaReadLabel, "", "specify the label of the stores to be used for backup, e.g. 'label_key:label_value'")
+
+	flags.Bool(flagEstimateOnly, false, "only estimate the size, duration and storage cost of the backup without running it")
+	flags.Float64(flagStoragePrice, defaultStoragePrice, "the monthly price of 1 GiB in the external storage, "+
+		"used to estimate the storage cost of the backup")
+
+	flags.Duration(flagTargetP99Latency, 0, "enable the adaptive throttling, which adjusts the rate limit and "+
+		"the concurrency of the backup on each TiKV to keep the P99 latency of its foreground requests under this target, "+
+		"e.g. 50ms. The `--ratelimit` is used as the upper bound of the rate limit if specified. 0 means disabled")
 }
 
 // ParseFromFlags parses the backup-related flags from the flag set.
@@ -227,6 +244,24 @@ func (cfg *BackupConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	cfg.EstimateOnly, err = flags.GetBool(flagEstimateOnly)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cfg.StoragePrice, err = flags.GetFloat64(flagStoragePrice)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.StoragePrice < 0 {
+		return errors.Annotate(berrors.ErrInvalidArgument, "negative storage price is not allowed")
+	}
+	cfg.TargetP99Latency, err = flags.GetDuration(flagTargetP99Latency)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.TargetP99Latency < 0 {
+		return errors.Annotate(berrors.ErrInvalidArgument, "negative target P99 latency is not allowed")
+	}
 
 	if flags.Lookup(flagFullBackupType) != nil {
 		// for backup full
@@ -302,14 +337,14 @@ func (cfg *BackupConfig) Adjust() {
 	if cfg.Config.Concurrency > maxBackupConcurrency {
 		cfg.Config.Concurrency = maxBackupConcurrency
 	}
-	if cfg.RateLimit != unlimited {
+	if cfg.RateLimit != unlimited || cfg.TargetP99Latency > 0 {
 		// TiKV limits the upload rate by each backup request.
 		// When the backup requests are sent concurrently,
 		// the ratelimit couldn't work as intended.
 		// Degenerating to sequentially sending backup requests to avoid this.
 		if !usingDefaultConcurrency {
-			logutil.WarnTerm("setting `--ratelimit` and `--concurrency` at the same time, "+
-				"ignoring `--concurrency`: `--ratelimit` forces sequential (i.e. concurrency = 1) backup",
+			logutil.WarnTerm("setting `--ratelimit` or `--target-p99-latency` and `--concurrency` at the same time, "+
+				"ignoring `--concurrency`: the rate limit forces sequential (i.e. concurrency = 1) backup",
 				zap.String("ratelimit", units.HumanSize(float64(cfg.RateLimit))+"/s"),
 				zap.Uint32("concurrency-specified", cfg.Config.Concurrency))
 		}
@@ -382,6 +417,7 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 	defer summary.Summary(cmdName)
 	ctx, cancel := context.WithCancel(c)
 	defer cancel()
+	startTime := time.Now()
 
 	if span := opentracing.SpanFromContext(ctx); span != nil && span.Tracer() != nil {
 		span1 := span.Tracer().StartSpan("task.RunBackup", opentracing.ChildOf(span.Context()))
@@ -436,6 +472,9 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 	}
 
 	client := backup.NewBackupClient(ctx, mgr)
+	if cfg.EstimateOnly {
+		return estimateBackup(ctx, g, mgr, client, cmdName, cfg)
+	}
 
 	// set cipher only for checkpoint
 	client.SetCipher(&cfg.CipherInfo)
@@ -549,6 +588,18 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 			RequestSource: kvutil.BuildRequestSource(true, kv.InternalTxnBR, kvutil.ExplicitTypeBR),
 		},
 	}
+	if cfg.TargetP99Latency > 0 {
+		maxRateLimit := cfg.RateLimit
+		if maxRateLimit == unlimited {
+			maxRateLimit = backup.DefaultAdaptiveMaxRateLimit
+		}
+		throttler := backup.NewAdaptiveThrottler(cfg.TargetP99Latency, maxRateLimit, req.Concurrency,
+			backup.NewTiKVMetricsLatencyFetcher(mgr.GetTLSConfig()))
+		client.SetThrottler(throttler)
+		go throttler.Run(ctx, mgr.GetPDClient(), adaptiveThrottleInterval)
+		log.Info("adaptive throttling enabled", zap.Duration("target-p99-latency", cfg.TargetP99Latency),
+			zap.String("max-ratelimit", units.HumanSize(float64(maxRateLimit))+"/s"))
+	}
 	brVersion := g.GetVersion()
 	clusterVersion, err := mgr.GetClusterVersion(ctx)
 	if err != nil {
@@ -620,18 +671,17 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 
 	var updateCh glue.Progress
 	var unit backup.ProgressUnit
+	// The size of the regions is only collected for the backup with few ranges, it's recorded
+	// into the backup history to estimate the compression ratio of the later backups.
+	regionStats := &backupEstimate{}
 	if len(ranges) < 100 {
 		unit = backup.RegionUnit
 		// The number of regions need to backup
-		approximateRegions := 0
-		for _, r := range ranges {
-			var regionCount int
-			regionCount, err = mgr.GetRegionCount(ctx, r.StartKey, r.EndKey)
-			if err != nil {
-				return errors.Trace(err)
-			}
-			approximateRegions += regionCount
+		regionStats, err = collectBackupRegionStats(ctx, mgr, ranges)
+		if err != nil {
+			return errors.Trace(err)
 		}
+		approximateRegions := regionStats.RegionCount
 		// Redirect to log if there is no log file to avoid unreadable output.
 		updateCh = g.StartProgress(
 			ctx, cmdName, int64(approximateRegions), !cfg.LogProgress)
@@ -756,6 +806,8 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 	g.Record(summary.BackupDataSize, archiveSize)
 	//backup from tidb will fetch a general Size issue https://github.com/pingcap/tidb/issues/27247
 	g.Record("Size", archiveSize)
+	recordFinishedBackup(ctx, g, mgr, cmdName, cfg, backupTS, regionStats.RegionCount, regionStats.KVSize,
+		archiveSize, time.Since(startTime))
 	// Set task summary to success status.
 	summary.SetSuccessStatus(true)
 	return nil
@@ -0,0 +1,433 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/summary"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	flagCompactBase        = "base"
+	flagCompactIncremental = "incremental"
+
+	// CompactBackupCmd is the name of the command which compacts an incremental backup chain.
+	CompactBackupCmd = "Compact Backup"
+
+	compactCopyBufferSize = 8 * 1024 * 1024
+)
+
+// CompactBackupConfig is the configuration of compacting a full backup and the chain of
+// the incremental backups based on it into a synthetic full backup.
+type CompactBackupConfig struct {
+	Config
+
+	// BaseStorage is the full backup which the chain starts from.
+	BaseStorage string `json:"base" toml:"base"`
+	// IncrementalStorages are the incremental backups in the order they're taken, each
+	// one is taken with `--lastbackupts` set to the end version of the previous one.
+	IncrementalStorages []string `json:"incremental" toml:"incremental"`
+	UseBackupMetaV2     bool     `json:"use-backupmeta-v2"`
+}
+
+// DefineCompactBackupFlags defines the flags for compacting backups.
+func DefineCompactBackupFlags(flags *pflag.FlagSet) {
+	flags.String(flagCompactBase, "", "the url of the full backup which the incremental backups are based on")
+	flags.StringSlice(flagCompactIncremental, nil,
+		"the urls of the incremental backups, in the order they're taken, separated by comma")
+}
+
+// ParseFromFlags parses the compact backup config from the flag set.
+func (cfg *CompactBackupConfig) ParseFromFlags(flags *pflag.FlagSet) error {
+	var err error
+	if cfg.BaseStorage, err = flags.GetString(flagCompactBase); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.IncrementalStorages, err = flags.GetStringSlice(flagCompactIncremental); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.UseBackupMetaV2, err = flags.GetBool(flagUseBackupMetaV2); err != nil {
+		return errors.Trace(err)
+	}
+	if err = cfg.Config.ParseFromFlags(flags); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.Concurrency, err = flags.GetUint32(flagConcurrency); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.BaseStorage == "" {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "--%s is required", flagCompactBase)
+	}
+	if len(cfg.IncrementalStorages) == 0 {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "--%s is required", flagCompactIncremental)
+	}
+	return nil
+}
+
+// backupInChain is a backup of the chain to compact.
+type backupInChain struct {
+	storage storage.ExternalStorage
+	meta    *backuppb.BackupMeta
+	tables  []*metautil.Table
+}
+
+// compactedFile is a data file copied from a backup in the chain into the compacted backup.
+type compactedFile struct {
+	// from is the index of the backup in the chain.
+	from    int
+	srcName string
+	// file is the meta of the file in the compacted backup.
+	file *backuppb.File
+}
+
+// RunCompactBackup merges the full backup and the chain of the incremental backups based on it
+// into a new full backup in the storage specified by `--storage`. It only reads and writes the
+// external storages, so it can run without the cluster. Restoring the compacted backup gets the
+// same data as restoring the whole chain, while the restore doesn't go through the chain one by one.
+func RunCompactBackup(c context.Context, g glue.Glue, cmdName string, cfg *CompactBackupConfig) error {
+	defer summary.Summary(cmdName)
+	ctx, cancel := context.WithCancel(c)
+	defer cancel()
+
+	_, output, err := GetStorage(ctx, cfg.Storage, &cfg.Config)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	exists, err := output.FileExists(ctx, metautil.MetaFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if exists {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"backup meta file exists in %s, there may be some backup files in the path already, "+
+				"please specify a correct backup directory!", cfg.Storage)
+	}
+
+	storages := append([]string{cfg.BaseStorage}, cfg.IncrementalStorages...)
+	chain := make([]*backupInChain, 0, len(storages))
+	for _, s := range storages {
+		backupCfg := cfg.Config
+		backupCfg.Storage = s
+		_, st, meta, err := ReadBackupMeta(ctx, metautil.MetaFile, &backupCfg)
+		if err != nil {
+			return errors.Annotatef(err, "failed to read the backup meta of %s", s)
+		}
+		chain = append(chain, &backupInChain{storage: st, meta: meta})
+	}
+	metas := make([]*backuppb.BackupMeta, 0, len(chain))
+	for _, b := range chain {
+		metas = append(metas, b.meta)
+	}
+	if err = checkBackupChain(metas); err != nil {
+		return errors.Trace(err)
+	}
+	for i, b := range chain {
+		b.tables, err = readBackupTables(ctx, b, &cfg.CipherInfo)
+		if err != nil {
+			return errors.Annotatef(err, "failed to read the tables of %s", storages[i])
+		}
+		if i > 0 {
+			if err = checkNoReorgDDLs(ctx, b, &cfg.CipherInfo); err != nil {
+				return errors.Annotatef(err, "the incremental backup %s can't be compacted", storages[i])
+			}
+		}
+	}
+
+	last := chain[len(chain)-1].meta
+	log.Info("compact backups", zap.Int("incremental-count", len(cfg.IncrementalStorages)),
+		zap.Uint64("end-version", last.EndVersion))
+	log.Warn("the compacted backup doesn't have the checksums of the tables, " +
+		"restoring it skips checking the checksums of the restored tables")
+	tables, files := planCompactBackup(chain)
+	updateCh := g.StartProgress(ctx, cmdName, int64(len(files)), !cfg.LogProgress)
+	defer updateCh.Close()
+	if err = copyCompactedFiles(ctx, chain, output, files, cfg.Concurrency, updateCh); err != nil {
+		return errors.Trace(err)
+	}
+
+	metaWriter := metautil.NewMetaWriter(output, metautil.MetaFileSize, cfg.UseBackupMetaV2, "", &cfg.CipherInfo)
+	if err = writeCompactedBackupMeta(ctx, metaWriter, chain, tables, files); err != nil {
+		return errors.Trace(err)
+	}
+	summary.CollectInt("compacted files", len(files))
+	g.Record(summary.BackupDataSize, metaWriter.ArchiveSize())
+	summary.SetSuccessStatus(true)
+	return nil
+}
+
+// checkBackupChain checks the backups are a full backup followed by the incremental backups
+// taken one after another from the same cluster.
+func checkBackupChain(metas []*backuppb.BackupMeta) error {
+	base := metas[0]
+	if base.IsRawKv || base.StartVersion != 0 {
+		return errors.Annotate(berrors.ErrInvalidArgument, "the base backup must be a full backup")
+	}
+	prev := base
+	for i, m := range metas[1:] {
+		switch {
+		case m.IsRawKv:
+			return errors.Annotatef(berrors.ErrInvalidArgument, "the incremental backup %d is a raw kv backup", i+1)
+		case m.ClusterId != base.ClusterId:
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"the incremental backup %d is taken from cluster %d, but the base backup is from cluster %d",
+				i+1, m.ClusterId, base.ClusterId)
+		case m.ApiVersion != base.ApiVersion:
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"the api version of the incremental backup %d doesn't match the base backup", i+1)
+		case m.StartVersion == 0:
+			return errors.Annotatef(berrors.ErrInvalidArgument, "the backup %d isn't an incremental backup", i+1)
+		case m.StartVersion > prev.EndVersion:
+			// the changes between the two backups are in neither of them.
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"the incremental backup %d starts from %d, which is after the end version %d of the previous backup",
+				i+1, m.StartVersion, prev.EndVersion)
+		case m.EndVersion <= prev.EndVersion:
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"the incremental backup %d ends at %d, which isn't after the end version %d of the previous backup",
+				i+1, m.EndVersion, prev.EndVersion)
+		}
+		prev = m
+	}
+	return nil
+}
+
+func readBackupTables(ctx context.Context, b *backupInChain, cipher *backuppb.CipherInfo) ([]*metautil.Table, error) {
+	reader := metautil.NewMetaReader(b.meta, b.storage, cipher)
+	outCh := make(chan *metautil.Table)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(outCh)
+		// the stats are outdated after compaction, skip them.
+		if err := reader.ReadSchemasFiles(ctx, outCh, metautil.SkipStats); err != nil {
+			errCh <- errors.Trace(err)
+		}
+	}()
+	var tables []*metautil.Table
+	for table := range outCh {
+		tables = append(tables, table)
+	}
+	select {
+	case err := <-errCh:
+		return nil, err
+	default:
+		return tables, nil
+	}
+}
+
+// checkNoReorgDDLs refuses the incremental backup which has the DDL jobs needing reorg, e.g. ADD INDEX.
+// The data backfilled by them isn't in the incremental backup, and it's restored by executing the DDL
+// jobs again when the chain is restored one by one, while the compacted backup only has the schemas
+// after the DDL jobs.
+func checkNoReorgDDLs(ctx context.Context, b *backupInChain, cipher *backuppb.CipherInfo) error {
+	reader := metautil.NewMetaReader(b.meta, b.storage, cipher)
+	ddls, err := reader.ReadDDLs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(ddls) == 0 {
+		return nil
+	}
+	var jobs []*model.Job
+	if err = json.Unmarshal(ddls, &jobs); err != nil {
+		return errors.Trace(err)
+	}
+	for _, job := range jobs {
+		if ddlJobNeedsReorg(job) {
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"the DDL job %d (%s) on %s.%s needs reorg, restore the backups one by one instead",
+				job.ID, job.Type, job.SchemaName, job.TableName)
+		}
+	}
+	return nil
+}
+
+// ddlJobNeedsReorg is like model.Job.MayNeedReorg, but the MODIFY COLUMN jobs are taken as needing
+// reorg, since whether they need reorg isn't recorded in the jobs backed up.
+func ddlJobNeedsReorg(job *model.Job) bool {
+	switch job.Type {
+	case model.ActionAddIndex, model.ActionAddPrimaryKey, model.ActionModifyColumn, model.ActionReorganizePartition,
+		model.ActionRemovePartitioning, model.ActionAlterTablePartitioning, model.ActionReorganizeIndex:
+		return true
+	case model.ActionMultiSchemaChange:
+		if job.MultiSchemaInfo == nil {
+			return false
+		}
+		for _, sub := range job.MultiSchemaInfo.SubJobs {
+			if ddlJobNeedsReorg(&model.Job{Type: sub.Type}) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// planCompactBackup returns the schemas and the data files of the compacted backup. The schemas
+// are the ones of the last backup in the chain, and the data files are the files of the tables
+// and partitions in them from all the backups, the files of the tables and partitions which are
+// dropped or truncated in the chain are left out.
+func planCompactBackup(chain []*backupInChain) ([]*metautil.Table, []compactedFile) {
+	tables := chain[len(chain)-1].tables
+	physicalIDs := make(map[int64]struct{})
+	for _, t := range tables {
+		if t.Info == nil {
+			continue
+		}
+		physicalIDs[t.Info.ID] = struct{}{}
+		if t.Info.Partition != nil {
+			for _, def := range t.Info.Partition.Definitions {
+				physicalIDs[def.ID] = struct{}{}
+			}
+		}
+	}
+
+	var files []compactedFile
+	for i, b := range chain {
+		for _, t := range b.tables {
+			for _, f := range t.Files {
+				if _, ok := physicalIDs[tablecodec.DecodeTableID(f.GetStartKey())]; !ok {
+					continue
+				}
+				file := *f
+				// the files of different backups may have the same name.
+				file.Name = fmt.Sprintf("%d_%s", i, f.Name)
+				files = append(files, compactedFile{from: i, srcName: f.Name, file: &file})
+			}
+		}
+	}
+	return tables, files
+}
+
+func copyCompactedFiles(
+	ctx context.Context,
+	chain []*backupInChain,
+	output storage.ExternalStorage,
+	files []compactedFile,
+	concurrency uint32,
+	updateCh glue.Progress,
+) error {
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.SetLimit(int(max(concurrency, 1)))
+	for _, f := range files {
+		f := f
+		eg.Go(func() error {
+			if err := copyBackupFile(ectx, chain[f.from].storage, output, f.srcName, f.file.Name); err != nil {
+				return errors.Annotatef(err, "failed to copy file %s", f.srcName)
+			}
+			updateCh.Inc()
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+func copyBackupFile(ctx context.Context, src, dst storage.ExternalStorage, srcName, dstName string) error {
+	reader, err := src.Open(ctx, srcName, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer reader.Close()
+	writer, err := dst.Create(ctx, dstName, &storage.WriterOption{Concurrency: 1})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	buf := make([]byte, compactCopyBufferSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if _, werr := writer.Write(ctx, buf[:n]); werr != nil {
+				_ = writer.Close(ctx)
+				return errors.Trace(werr)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = writer.Close(ctx)
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(writer.Close(ctx))
+}
+
+func writeCompactedBackupMeta(
+	ctx context.Context,
+	metaWriter *metautil.MetaWriter,
+	chain []*backupInChain,
+	tables []*metautil.Table,
+	files []compactedFile,
+) error {
+	base, last := chain[0].meta, chain[len(chain)-1].meta
+	metaWriter.Update(func(m *backuppb.BackupMeta) {
+		m.StartVersion = 0
+		m.EndVersion = last.EndVersion
+		m.ClusterId = last.ClusterId
+		m.ClusterVersion = last.ClusterVersion
+		m.BrVersion = last.BrVersion
+		m.NewCollationsEnabled = last.NewCollationsEnabled
+		m.ApiVersion = last.ApiVersion
+		// the placement policies are only backed up by the full backup.
+		m.Policies = base.Policies
+	})
+
+	metaWriter.StartWriteMetasAsync(ctx, metautil.AppendDataFile)
+	for _, f := range files {
+		if err := metaWriter.Send([]*backuppb.File{f.file}, metautil.AppendDataFile); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if err := metaWriter.FinishWriteMetas(ctx, metautil.AppendDataFile); err != nil {
+		return errors.Trace(err)
+	}
+
+	metaWriter.StartWriteMetasAsync(ctx, metautil.AppendSchema)
+	for _, t := range tables {
+		s, err := encodeCompactedSchema(t)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err = metaWriter.Send(s, metautil.AppendSchema); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if err := metaWriter.FinishWriteMetas(ctx, metautil.AppendSchema); err != nil {
+		return errors.Trace(err)
+	}
+	return metaWriter.FlushBackupMeta(ctx)
+}
+
+// encodeCompactedSchema encodes the schema of the table without the checksum, the checksum of
+// the last backup only covers the changes in it, restore skips checking the tables without it.
+func encodeCompactedSchema(t *metautil.Table) (*backuppb.Schema, error) {
+	dbBytes, err := json.Marshal(t.DB)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var tableBytes []byte
+	if t.Info != nil {
+		if tableBytes, err = json.Marshal(t.Info); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return &backuppb.Schema{
+		Db:              dbBytes,
+		Table:           tableBytes,
+		TiflashReplicas: uint32(t.TiFlashReplicas),
+	}, nil
+}
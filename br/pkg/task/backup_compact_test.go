@@ -0,0 +1,176 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/kvproto/pkg/encryptionpb"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBackupChain(t *testing.T) {
+	base := &backuppb.BackupMeta{ClusterId: 1, EndVersion: 10}
+	inc1 := &backuppb.BackupMeta{ClusterId: 1, StartVersion: 10, EndVersion: 20}
+	inc2 := &backuppb.BackupMeta{ClusterId: 1, StartVersion: 15, EndVersion: 30}
+	require.NoError(t, checkBackupChain([]*backuppb.BackupMeta{base, inc1, inc2}))
+
+	// the base isn't a full backup.
+	require.ErrorContains(t, checkBackupChain([]*backuppb.BackupMeta{inc1, inc2}), "must be a full backup")
+	// a full backup in the chain.
+	require.ErrorContains(t, checkBackupChain([]*backuppb.BackupMeta{base, base}), "isn't an incremental backup")
+	// the changes between 20 and 25 are missing.
+	gap := &backuppb.BackupMeta{ClusterId: 1, StartVersion: 25, EndVersion: 30}
+	require.ErrorContains(t, checkBackupChain([]*backuppb.BackupMeta{base, inc1, gap}), "starts from 25")
+	// the same incremental backup twice.
+	require.ErrorContains(t, checkBackupChain([]*backuppb.BackupMeta{base, inc1, inc1}), "ends at 20")
+	// from another cluster.
+	other := &backuppb.BackupMeta{ClusterId: 2, StartVersion: 10, EndVersion: 20}
+	require.ErrorContains(t, checkBackupChain([]*backuppb.BackupMeta{base, other}), "taken from cluster 2")
+}
+
+func writeTestBackup(
+	ctx context.Context,
+	t *testing.T,
+	s storage.ExternalStorage,
+	cipher *backuppb.CipherInfo,
+	startVersion, endVersion uint64,
+	tables []*model.TableInfo,
+	files map[string]int64,
+) *backuppb.BackupMeta {
+	db := &model.DBInfo{ID: 1, Name: model.NewCIStr("test")}
+	metaWriter := metautil.NewMetaWriter(s, metautil.MetaFileSize, true, "", cipher)
+	metaWriter.Update(func(m *backuppb.BackupMeta) {
+		m.ClusterId = 1
+		m.StartVersion = startVersion
+		m.EndVersion = endVersion
+	})
+	metaWriter.StartWriteMetasAsync(ctx, metautil.AppendDataFile)
+	for name, tableID := range files {
+		require.NoError(t, s.WriteFile(ctx, name, []byte(name)))
+		prefix := tablecodec.GenTableRecordPrefix(tableID)
+		file := &backuppb.File{Name: name, StartKey: prefix, EndKey: prefix.PrefixNext(), Size_: uint64(len(name))}
+		require.NoError(t, metaWriter.Send([]*backuppb.File{file}, metautil.AppendDataFile))
+	}
+	require.NoError(t, metaWriter.FinishWriteMetas(ctx, metautil.AppendDataFile))
+	metaWriter.StartWriteMetasAsync(ctx, metautil.AppendSchema)
+	for _, tbl := range tables {
+		dbBytes, err := json.Marshal(db)
+		require.NoError(t, err)
+		tableBytes, err := json.Marshal(tbl)
+		require.NoError(t, err)
+		schema := &backuppb.Schema{Db: dbBytes, Table: tableBytes, Crc64Xor: 1, TotalKvs: 1, TotalBytes: 1}
+		require.NoError(t, metaWriter.Send(schema, metautil.AppendSchema))
+	}
+	require.NoError(t, metaWriter.FinishWriteMetas(ctx, metautil.AppendSchema))
+	require.NoError(t, metaWriter.FlushBackupMeta(ctx))
+	return metaWriter.Backupmeta()
+}
+
+type fakeProgress struct{}
+
+func (fakeProgress) Inc()              {}
+func (fakeProgress) IncBy(int64)       {}
+func (fakeProgress) GetCurrent() int64 { return 0 }
+func (fakeProgress) Close()            {}
+
+func TestCompactBackup(t *testing.T) {
+	ctx := context.Background()
+	cipher := &backuppb.CipherInfo{CipherType: encryptionpb.EncryptionMethod_PLAINTEXT}
+	newStorage := func() storage.ExternalStorage {
+		s, err := storage.NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+		return s
+	}
+	t1 := &model.TableInfo{ID: 100, Name: model.NewCIStr("t1")}
+	t2 := &model.TableInfo{ID: 200, Name: model.NewCIStr("t2")}
+	// t2 is truncated in the incremental backup, and gets the new ID 300.
+	t2Truncated := &model.TableInfo{ID: 300, Name: model.NewCIStr("t2")}
+
+	baseStorage, incStorage := newStorage(), newStorage()
+	baseMeta := writeTestBackup(ctx, t, baseStorage, cipher, 0, 10,
+		[]*model.TableInfo{t1, t2}, map[string]int64{"t1.sst": 100, "t2.sst": 200})
+	// the incremental backup has a file with the same name as the base backup.
+	incMeta := writeTestBackup(ctx, t, incStorage, cipher, 10, 20,
+		[]*model.TableInfo{t1, t2Truncated}, map[string]int64{"t1.sst": 100, "t2_new.sst": 300})
+
+	chain := []*backupInChain{
+		{storage: baseStorage, meta: baseMeta},
+		{storage: incStorage, meta: incMeta},
+	}
+	var err error
+	for _, b := range chain {
+		b.tables, err = readBackupTables(ctx, b, cipher)
+		require.NoError(t, err)
+	}
+	tables, files := planCompactBackup(chain)
+	output := newStorage()
+	require.NoError(t, copyCompactedFiles(ctx, chain, output, files, 2, fakeProgress{}))
+	metaWriter := metautil.NewMetaWriter(output, metautil.MetaFileSize, true, "", cipher)
+	require.NoError(t, writeCompactedBackupMeta(ctx, metaWriter, chain, tables, files))
+
+	// read the compacted backup back.
+	compacted := metaWriter.Backupmeta()
+	require.Zero(t, compacted.StartVersion)
+	require.Equal(t, uint64(20), compacted.EndVersion)
+	require.Equal(t, uint64(1), compacted.ClusterId)
+	result, err := readBackupTables(ctx, &backupInChain{storage: output, meta: compacted}, cipher)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	names := make(map[int64][]string)
+	for _, tbl := range result {
+		require.True(t, tbl.NoChecksum())
+		for _, f := range tbl.Files {
+			content, err := output.ReadFile(ctx, f.Name)
+			require.NoError(t, err)
+			require.Equal(t, f.Name[2:], string(content))
+			names[tbl.Info.ID] = append(names[tbl.Info.ID], f.Name)
+		}
+		sort.Strings(names[tbl.Info.ID])
+	}
+	// the files of the truncated table are left out.
+	require.Equal(t, map[int64][]string{
+		100: {"0_t1.sst", "1_t1.sst"},
+		300: {"1_t2_new.sst"},
+	}, names)
+}
+
+func TestCheckNoReorgDDLs(t *testing.T) {
+	ctx := context.Background()
+	cipher := &backuppb.CipherInfo{CipherType: encryptionpb.EncryptionMethod_PLAINTEXT}
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	check := func(jobs ...*model.Job) error {
+		ddls, err := json.Marshal(jobs)
+		require.NoError(t, err)
+		meta := &backuppb.BackupMeta{Version: metautil.MetaV1, Ddls: ddls}
+		return checkNoReorgDDLs(ctx, &backupInChain{storage: s, meta: meta}, cipher)
+	}
+
+	require.NoError(t, checkNoReorgDDLs(ctx, &backupInChain{storage: s, meta: &backuppb.BackupMeta{}}, cipher))
+	require.NoError(t, check(
+		&model.Job{ID: 1, Type: model.ActionCreateTable, SchemaName: "test", TableName: "t"},
+		&model.Job{ID: 2, Type: model.ActionTruncateTable, SchemaName: "test", TableName: "t"},
+	))
+	require.ErrorContains(t, check(
+		&model.Job{ID: 1, Type: model.ActionCreateTable, SchemaName: "test", TableName: "t"},
+		&model.Job{ID: 2, Type: model.ActionAddIndex, SchemaName: "test", TableName: "t"},
+	), "the DDL job 2 (add index) on test.t needs reorg")
+	require.ErrorContains(t, check(&model.Job{ID: 3, Type: model.ActionModifyColumn}), "needs reorg")
+	require.ErrorContains(t, check(&model.Job{
+		ID:   4,
+		Type: model.ActionMultiSchemaChange,
+		MultiSchemaInfo: &model.MultiSchemaInfo{SubJobs: []*model.SubJob{
+			{Type: model.ActionAddColumn},
+			{Type: model.ActionAddPrimaryKey},
+		}},
+	}), "needs reorg")
+}
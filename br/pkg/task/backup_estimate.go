@@ -0,0 +1,221 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/backup"
+	"github.com/pingcap/tidb/br/pkg/conn"
+	"github.com/pingcap/tidb/br/pkg/conn/util"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/rtree"
+	"github.com/pingcap/tidb/br/pkg/summary"
+	"github.com/pingcap/tidb/pkg/kv"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultBackupCompressionRatio is the ratio of the backup size to the size of the regions,
+	// it's used when there is no finished backup in the history.
+	defaultBackupCompressionRatio = 0.5
+	// defaultBackupThroughputPerStore is the backup throughput of each TiKV, it's used when there is
+	// no finished backup in the history.
+	defaultBackupThroughputPerStore = 50 * units.MiB
+	// backupHistoryLimit is the number of the latest finished backups used to estimate the backup.
+	backupHistoryLimit = 10
+	// defaultStoragePrice is the monthly price of 1 GiB in the external storage, in USD.
+	defaultStoragePrice = 0.023
+)
+
+// backupHistory is a finished backup recorded in mysql.tidb_backup_history.
+type backupHistory struct {
+	kvSize     uint64
+	backupSize uint64
+	// duration is in seconds.
+	duration float64
+}
+
+// backupEstimate is the predicted size, duration and storage cost of a backup.
+type backupEstimate struct {
+	BackupTS    uint64
+	RegionCount int
+	// KVSize is the approximate size of the regions to back up.
+	KVSize uint64
+	// BackupSize is the predicted size of the backup files in the external storage.
+	BackupSize uint64
+	// Throughput is the predicted size of the backup files written per second.
+	Throughput  float64
+	Duration    time.Duration
+	StorageCost float64
+	// FromHistory is whether the estimate is based on the finished backups.
+	FromHistory bool
+}
+
+// estimateBackup predicts the size, duration and storage cost of the backup without running it.
+// The size of the regions to back up is got from PD, the compression ratio and throughput are got
+// from the latest finished backups in mysql.tidb_backup_history if there are any.
+func estimateBackup(ctx context.Context, g glue.Glue, mgr *conn.Mgr, client *backup.Client, cmdName string, cfg *BackupConfig) error {
+	if cfg.LastBackupTS > 0 {
+		return errors.Annotate(berrors.ErrInvalidArgument, "--estimate-only doesn't support incremental backup")
+	}
+	backupTS, err := client.GetTS(ctx, cfg.TimeAgo, cfg.BackupTS)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ranges, _, _, err := client.BuildBackupRangeAndSchema(mgr.GetStorage(), cfg.TableFilter, backupTS, isFullBackup(cmdName))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	est, err := collectBackupRegionStats(ctx, mgr, ranges)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	est.BackupTS = backupTS
+	stores, err := util.GetAllTiKVStores(ctx, mgr.GetPDClient(), util.SkipTiFlash)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	history, err := loadBackupHistory(ctx, g, mgr.GetStorage())
+	if err != nil {
+		log.Warn("failed to load the backup history, estimate the backup by the default throughput",
+			logutil.ShortError(err))
+	}
+	est.calculate(history, len(stores), cfg.RateLimit, cfg.StoragePrice)
+
+	printBackupEstimate(glue.GetConsole(g), cmdName, est)
+	// the predicted size is the result of BACKUP ... ESTIMATE_ONLY = 1 in SQL.
+	g.Record("BackupTS", est.BackupTS)
+	g.Record("Size", est.BackupSize)
+	if err = recordBackupHistory(ctx, g, mgr.GetStorage(), cmdName, true, est); err != nil {
+		log.Warn("failed to record the backup estimate", logutil.ShortError(err))
+	}
+	summary.SetSuccessStatus(true)
+	return nil
+}
+
+// collectBackupRegionStats collects the count and approximate size of the regions in the ranges.
+func collectBackupRegionStats(ctx context.Context, mgr *conn.Mgr, ranges []rtree.Range) (*backupEstimate, error) {
+	est := &backupEstimate{}
+	for _, r := range ranges {
+		stats, err := mgr.GetRegionStats(ctx, r.StartKey, r.EndKey)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		est.RegionCount += stats.Count
+		// The storage size reported by PD is in MiB.
+		est.KVSize += uint64(stats.StorageSize) * units.MiB
+	}
+	return est, nil
+}
+
+// calculate predicts the backup size, duration and storage cost by the size of the regions.
+func (est *backupEstimate) calculate(history []backupHistory, storeCount int, rateLimit uint64, storagePrice float64) {
+	var kvSize, sizeWithKVSize, backupSize uint64
+	var duration float64
+	for _, h := range history {
+		// The size of the regions isn't recorded for the backup with too many ranges.
+		if h.kvSize > 0 {
+			kvSize += h.kvSize
+			sizeWithKVSize += h.backupSize
+		}
+		backupSize += h.backupSize
+		duration += h.duration
+	}
+	ratio := defaultBackupCompressionRatio
+	if kvSize > 0 && sizeWithKVSize > 0 {
+		ratio = float64(sizeWithKVSize) / float64(kvSize)
+	}
+	est.BackupSize = uint64(float64(est.KVSize) * ratio)
+
+	storeCount = max(storeCount, 1)
+	est.Throughput = float64(defaultBackupThroughputPerStore * storeCount)
+	if duration > 0 && backupSize > 0 {
+		est.Throughput = float64(backupSize) / duration
+		est.FromHistory = true
+	}
+	if rateLimit != unlimited {
+		est.Throughput = min(est.Throughput, float64(rateLimit)*float64(storeCount))
+	}
+	est.Duration = time.Duration(float64(est.BackupSize) / est.Throughput * float64(time.Second))
+	est.StorageCost = float64(est.BackupSize) / units.GiB * storagePrice
+}
+
+func printBackupEstimate(console glue.ConsoleOperations, cmdName string, est *backupEstimate) {
+	basis := "default throughput"
+	if est.FromHistory {
+		basis = "finished backups"
+	}
+	console.Printf("%s estimate (based on the %s):\n", cmdName, basis)
+	table := console.CreateTable()
+	table.Add("backup-ts", fmt.Sprint(est.BackupTS))
+	table.Add("region-count", fmt.Sprint(est.RegionCount))
+	table.Add("kv-size", units.BytesSize(float64(est.KVSize)))
+	table.Add("backup-size", units.BytesSize(float64(est.BackupSize)))
+	table.Add("throughput", units.BytesSize(est.Throughput)+"/s")
+	table.Add("duration", est.Duration.Round(time.Second).String())
+	table.Add("storage-cost", fmt.Sprintf("$%.2f per month", est.StorageCost))
+	table.Print()
+}
+
+// loadBackupHistory loads the latest finished backups from mysql.tidb_backup_history.
+func loadBackupHistory(ctx context.Context, g glue.Glue, store kv.Storage) ([]backupHistory, error) {
+	var history []backupHistory
+	err := g.UseOneShotSession(store, false, func(se glue.Session) error {
+		exec := se.GetSessionCtx().GetRestrictedSQLExecutor()
+		rows, _, err := exec.ExecRestrictedSQL(kv.WithInternalSourceType(ctx, kv.InternalTxnBR), nil,
+			"SELECT kv_size, backup_size, duration FROM mysql.tidb_backup_history "+
+				"WHERE estimate_only = 0 AND duration > 0 ORDER BY id DESC LIMIT %?", backupHistoryLimit)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, row := range rows {
+			history = append(history, backupHistory{
+				kvSize:     uint64(row.GetInt64(0)),
+				backupSize: uint64(row.GetInt64(1)),
+				duration:   row.GetFloat64(2),
+			})
+		}
+		return nil
+	})
+	return history, errors.Trace(err)
+}
+
+// recordBackupHistory records the finished backup or the backup estimate into mysql.tidb_backup_history.
+func recordBackupHistory(ctx context.Context, g glue.Glue, store kv.Storage, cmdName string, estimateOnly bool, est *backupEstimate) error {
+	return g.UseOneShotSession(store, false, func(se glue.Session) error {
+		return errors.Trace(se.ExecuteInternal(kv.WithInternalSourceType(ctx, kv.InternalTxnBR),
+			"INSERT INTO mysql.tidb_backup_history (cmd, estimate_only, backup_ts, region_count, kv_size, "+
+				"backup_size, duration, storage_cost) VALUES (%?, %?, %?, %?, %?, %?, %?, %?)",
+			cmdName, estimateOnly, est.BackupTS, est.RegionCount, est.KVSize, est.BackupSize,
+			est.Duration.Seconds(), est.StorageCost))
+	})
+}
+
+// recordFinishedBackup records the finished backup, whose throughput and compression ratio
+// are used to estimate the later backups. kvSize is 0 if it isn't collected.
+func recordFinishedBackup(ctx context.Context, g glue.Glue, mgr *conn.Mgr, cmdName string, cfg *BackupConfig,
+	backupTS uint64, regionCount int, kvSize, backupSize uint64, duration time.Duration) {
+	// The throughput of the incremental backup doesn't represent the full backup.
+	if cfg.LastBackupTS > 0 {
+		return
+	}
+	est := &backupEstimate{
+		BackupTS:    backupTS,
+		RegionCount: regionCount,
+		KVSize:      kvSize,
+		BackupSize:  backupSize,
+		Duration:    duration,
+		StorageCost: float64(backupSize) / units.GiB * cfg.StoragePrice,
+	}
+	if err := recordBackupHistory(ctx, g, mgr.GetStorage(), cmdName, false, est); err != nil {
+		log.Warn("failed to record the backup history", zap.Uint64("backup-ts", backupTS), logutil.ShortError(err))
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/docker/go-units"
 	backup "github.com/pingcap/kvproto/pkg/brpb"
 	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/stretchr/testify/require"
@@ -222,3 +223,34 @@ func TestBackupConfigHash(t *testing.T) {
 		hashCheck(t, &testCfg, originalHash, true)
 	}
 }
+
+func TestCalculateBackupEstimate(t *testing.T) {
+	// Without history, the default compression ratio and throughput are used.
+	est := &backupEstimate{KVSize: 100 * units.GiB}
+	est.calculate(nil, 2, unlimited, 0.02)
+	require.False(t, est.FromHistory)
+	require.Equal(t, uint64(50*units.GiB), est.BackupSize)
+	require.Equal(t, float64(100*units.MiB), est.Throughput)
+	require.Equal(t, 512*time.Second, est.Duration)
+	require.InDelta(t, 1.0, est.StorageCost, 1e-9)
+
+	// The rate limit caps the throughput of each store.
+	est = &backupEstimate{KVSize: 100 * units.GiB}
+	est.calculate(nil, 2, 10*units.MiB, 0.02)
+	require.Equal(t, float64(20*units.MiB), est.Throughput)
+	require.Equal(t, 2560*time.Second, est.Duration)
+
+	// The compression ratio is only got from the backups with the size of the regions,
+	// while the throughput is got from all the backups.
+	history := []backupHistory{
+		{kvSize: 40 * units.GiB, backupSize: 10 * units.GiB, duration: 102.4},
+		{kvSize: 0, backupSize: 20 * units.GiB, duration: 204.8},
+	}
+	est = &backupEstimate{KVSize: 100 * units.GiB}
+	est.calculate(history, 3, unlimited, 0.02)
+	require.True(t, est.FromHistory)
+	require.Equal(t, uint64(25*units.GiB), est.BackupSize)
+	require.InDelta(t, float64(100*units.MiB), est.Throughput, 1)
+	require.InDelta(t, 256, est.Duration.Seconds(), 1e-3)
+	require.InDelta(t, 0.5, est.StorageCost, 1e-9)
+}
@@ -80,6 +80,15 @@ const (
 
 	FlagResetSysUsers = "reset-sys-users"
 
+	// FlagChecksumTableConcurrency controls how many tables are checksummed concurrently after restore.
+	FlagChecksumTableConcurrency = "checksum-table-concurrency"
+
+	// FlagRestoreAsCopy represents whether restore the tables with new table IDs into a non-empty cluster.
+	FlagRestoreAsCopy = "restore-as-copy"
+	// FlagRebuildCollationIndexes represents whether restore the backup into a cluster with the different
+	// new_collation_enabled, by rebuilding the indexes whose keys depend on the collations.
+	FlagRebuildCollationIndexes = "rebuild-collation-indexes"
+
 	defaultPiTRBatchCount     = 8
 	defaultPiTRBatchSize      = 16 * 1024 * 1024
 	defaultRestoreConcurrency = 128
@@ -222,6 +231,8 @@ type RestoreConfig struct {
 	BatchFlushInterval time.Duration `json:"batch-flush-interval" toml:"batch-flush-interval"`
 	// DdlBatchSize use to define the size of batch ddl to create tables
 	DdlBatchSize uint `json:"ddl-batch-size" toml:"ddl-batch-size"`
+	// ChecksumTableConcurrency is the number of tables checksummed concurrently
+	ChecksumTableConcurrency uint `json:"checksum-table-concurrency" toml:"checksum-table-concurrency"`
 
 	WithPlacementPolicy string `json:"with-tidb-placement-mode" toml:"with-tidb-placement-mode"`
 
@@ -242,6 +253,14 @@ type RestoreConfig struct {
 	checkpointLogRestoreTaskName      string `json:"-" toml:"-"`
 	checkpointTaskInfoClusterID       uint64 `json:"-" toml:"-"`
 	WaitTiflashReady                  bool   `json:"wait-tiflash-ready" toml:"wait-tiflash-ready"`
+	// RestoreAsCopy allocates new table IDs for all tables and rewrites keys
+	// when downloading, so that the backup can be restored into a cluster
+	// with existing data, as long as the tables to restore don't exist.
+	RestoreAsCopy bool `json:"restore-as-copy" toml:"restore-as-copy"`
+	// RebuildCollationIndexes leaves the KVs of the indexes on the string columns out of the
+	// restore and rebuilds them afterwards, when new_collation_enabled of the backup cluster
+	// differs from the one of the restore cluster.
+	RebuildCollationIndexes bool `json:"rebuild-collation-indexes" toml:"rebuild-collation-indexes"`
 
 	// for ebs-based restore
 	FullBackupType      FullBackupType        `json:"full-backup-type" toml:"full-backup-type"`
@@ -271,6 +290,13 @@ func DefineRestoreFlags(flags *pflag.FlagSet) {
 	_ = flags.MarkHidden(flagUseCheckpoint)
 
 	flags.Bool(FlagWaitTiFlashReady, false, "whether wait tiflash replica ready if tiflash exists")
+	flags.Uint(FlagChecksumTableConcurrency, restore.DefaultChecksumTableConcurrency,
+		"the number of tables checksummed concurrently after restore, the verified tables are "+
+			"recorded in checkpoint, so only the unverified or failed ones are checksummed when restoring again")
+	flags.Bool(FlagRestoreAsCopy, false, "allocate new table IDs for all restored tables, so that the backup can be "+
+		"restored into a cluster with existing data, the tables to restore must not exist in the cluster")
+	flags.Bool(FlagRebuildCollationIndexes, false, "restore the backup into a cluster whose new_collation_enabled "+
+		"differs from the backup cluster, the indexes on the string columns are rebuilt after restoring the data")
 
 	DefineRestoreCommonFlags(flags)
 }
@@ -386,6 +412,24 @@ func (cfg *RestoreConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Annotatef(err, "failed to get flag %s", FlagWaitTiFlashReady)
 	}
+	cfg.ChecksumTableConcurrency, err = flags.GetUint(FlagChecksumTableConcurrency)
+	if err != nil {
+		return errors.Annotatef(err, "failed to get flag %s", FlagChecksumTableConcurrency)
+	}
+	cfg.RestoreAsCopy, err = flags.GetBool(FlagRestoreAsCopy)
+	if err != nil {
+		return errors.Annotatef(err, "failed to get flag %s", FlagRestoreAsCopy)
+	}
+	cfg.RebuildCollationIndexes, err = flags.GetBool(FlagRebuildCollationIndexes)
+	if err != nil {
+		return errors.Annotatef(err, "failed to get flag %s", FlagRebuildCollationIndexes)
+	}
+	if cfg.RestoreAsCopy && cfg.WithSysTable {
+		// the system tables are restored in place, which conflicts with the
+		// existing ones of a non-empty cluster.
+		log.Info("system tables are not restored when restoring as copy")
+		cfg.WithSysTable = false
+	}
 
 	if flags.Lookup(flagFullBackupType) != nil {
 		// for restore full only
@@ -665,6 +709,10 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 
 	var restoreError error
 	if IsStreamRestore(cmdName) {
+		if cfg.RestoreAsCopy {
+			// the table IDs in the log backup can't be rewritten to the new ones yet.
+			return errors.Annotatef(berrors.ErrInvalidArgument, "%s is not supported by point-in-time restore", FlagRestoreAsCopy)
+		}
 		restoreError = RunStreamRestore(c, g, cmdName, cfg)
 	} else {
 		restoreError = runRestore(c, g, cmdName, cfg)
@@ -765,8 +813,13 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 			return errors.Trace(versionErr)
 		}
 	}
+	newCollationMismatch := false
 	if _, err = restore.CheckNewCollationEnable(backupMeta.GetNewCollationsEnabled(), g, mgr.GetStorage(), cfg.CheckRequirements); err != nil {
-		return errors.Trace(err)
+		if !cfg.RebuildCollationIndexes || !berrors.Is(err, berrors.ErrRestoreNewCollationMismatch) {
+			return errors.Trace(err)
+		}
+		log.Warn("new_collation_enabled mismatches, the indexes on the string columns will be rebuilt", zap.Error(err))
+		newCollationMismatch = true
 	}
 
 	reader := metautil.NewMetaReader(backupMeta, s, &cfg.CipherInfo)
@@ -784,6 +837,17 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	if len(dbs) == 0 && len(tables) != 0 {
 		return errors.Annotate(berrors.ErrRestoreInvalidBackup, "contain tables but no databases")
 	}
+	var collationIndexRebuilder *restore.CollationIndexRebuilder
+	if newCollationMismatch {
+		collationIndexRebuilder, err = restore.NewCollationIndexRebuilder(tables)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		files = files[:0]
+		for _, table := range tables {
+			files = append(files, table.Files...)
+		}
+	}
 
 	archiveSize := reader.ArchiveSize(ctx, files)
 	g.Record(summary.RestoreDataSize, archiveSize)
@@ -832,7 +896,14 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		checkpointFirstRun = !existsCheckpointMetadata
 	}
 
-	if isFullRestore(cmdName) {
+	if cfg.RestoreAsCopy {
+		// the tables created by the previous run are expected to exist.
+		if checkpointFirstRun {
+			if err = client.CheckTablesNotExist(tables); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	} else if isFullRestore(cmdName) {
 		if client.NeedCheckFreshCluster(cfg.ExplicitFilter, checkpointFirstRun) {
 			if err = client.CheckTargetClusterFresh(ctx); err != nil {
 				return errors.Trace(err)
@@ -941,9 +1012,11 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	}
 
 	// preallocate the table id, because any ddl job or database creation also allocates the global ID
-	err = client.AllocTableIDs(ctx, tables)
-	if err != nil {
-		return errors.Trace(err)
+	if !cfg.RestoreAsCopy {
+		err = client.AllocTableIDs(ctx, tables)
+		if err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	// execute DDL first
@@ -1000,6 +1073,13 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		})
 	}
 
+	if collationIndexRebuilder != nil {
+		tableStream = util.ChanMap(tableStream, func(t restore.CreatedTable) restore.CreatedTable {
+			collationIndexRebuilder.AddCreatedTable(t.OldTable.Info.ID, t.Table)
+			return t
+		})
+	}
+
 	if cfg.tiflashRecorder != nil {
 		tableStream = util.ChanMap(tableStream, func(t restore.CreatedTable) restore.CreatedTable {
 			if cfg.tiflashRecorder != nil {
@@ -1073,7 +1153,7 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	// pipeline checksum
 	if cfg.Checksum {
 		postHandleCh = client.GoValidateChecksum(
-			ctx, postHandleCh, mgr.GetStorage().GetClient(), errCh, updateCh, cfg.ChecksumConcurrency)
+			ctx, postHandleCh, mgr.GetStorage().GetClient(), errCh, updateCh, cfg.ChecksumConcurrency, cfg.ChecksumTableConcurrency)
 	}
 
 	// pipeline update meta and load stats
@@ -1123,6 +1203,12 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		return errors.Trace(err)
 	}
 
+	if collationIndexRebuilder != nil {
+		if err = client.RebuildIndexes(ctx, collationIndexRebuilder.Recorder(), g, mgr.GetStorage()); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	schedulersRemovable = true
 
 	// Set task summary to success status.
@@ -54,6 +54,7 @@ import (
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/util/cdcutil"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
 	"github.com/spf13/pflag"
 	"github.com/tikv/client-go/v2/config"
 	"github.com/tikv/client-go/v2/oracle"
@@ -1746,6 +1747,11 @@ func buildPauseSafePointName(taskName string) string {
 
 func checkPiTRRequirements(ctx context.Context, g glue.Glue, cfg *RestoreConfig, mgr *conn.Mgr) error {
 	userDBs := restore.GetExistedUserDBs(mgr.GetDomain())
+	if cfg.ExplicitFilter {
+		// only the filtered tables are restored, so the cluster doesn't need
+		// to be empty, as long as none of them exists.
+		return checkPiTRFilteredTablesNotExist(userDBs, cfg.TableFilter)
+	}
 	if len(userDBs) > 0 {
 		userDBNames := make([]string, 0, len(userDBs))
 		for _, db := range userDBs {
@@ -1759,6 +1765,23 @@ func checkPiTRRequirements(ctx context.Context, g glue.Glue, cfg *RestoreConfig,
 	return nil
 }
 
+func checkPiTRFilteredTablesNotExist(userDBs []*model.DBInfo, tableFilter filter.Filter) error {
+	existedTables := make([]string, 0)
+	for _, db := range userDBs {
+		for _, tbl := range db.Tables {
+			if tableFilter.MatchTable(db.Name.O, tbl.Name.O) {
+				existedTables = append(existedTables, utils.EncloseDBAndTable(db.Name.O, tbl.Name.O))
+			}
+		}
+	}
+	if len(existedTables) > 0 {
+		return errors.Annotatef(berrors.ErrTablesAlreadyExisted,
+			"tables %s existed in restored cluster, please drop them before execute PiTR",
+			strings.Join(existedTables, ","))
+	}
+	return nil
+}
+
 func checkPiTRTaskInfo(
 	ctx context.Context,
 	g glue.Glue,
@@ -28,6 +28,8 @@ import (
 	"github.com/pingcap/tidb/br/pkg/metautil"
 	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/oracle"
 )
@@ -261,3 +263,32 @@ func TestGetExternalStorageOptions(t *testing.T) {
 	options = getExternalStorageOptions(&cfg, u)
 	require.Nil(t, options.HTTPClient)
 }
+
+func TestCheckPiTRFilteredTablesNotExist(t *testing.T) {
+	userDBs := []*model.DBInfo{
+		{
+			Name: model.NewCIStr("db1"),
+			Tables: []*model.TableInfo{
+				{Name: model.NewCIStr("t1")},
+				{Name: model.NewCIStr("t2")},
+			},
+		},
+		{Name: model.NewCIStr("db2")},
+	}
+	newFilter := func(rules ...string) filter.Filter {
+		f, err := filter.Parse(rules)
+		require.NoError(t, err)
+		return f
+	}
+
+	require.NoError(t, checkPiTRFilteredTablesNotExist(userDBs, newFilter("db1.t3")))
+	require.NoError(t, checkPiTRFilteredTablesNotExist(userDBs, newFilter("db2.*")))
+	require.NoError(t, checkPiTRFilteredTablesNotExist(nil, newFilter("*.*")))
+
+	err := checkPiTRFilteredTablesNotExist(userDBs, newFilter("db1.t2"))
+	require.True(t, berrors.ErrTablesAlreadyExisted.Equal(err))
+	require.ErrorContains(t, err, "`db1`.`t2`")
+	err = checkPiTRFilteredTablesNotExist(userDBs, newFilter("db1.*", "!db1.t1"))
+	require.ErrorContains(t, err, "`db1`.`t2`")
+	require.NotContains(t, err.Error(), "`db1`.`t1`")
+}
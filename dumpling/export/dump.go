@@ -389,6 +389,7 @@ func (d *Dumper) dumpDatabases(tctx *tcontext.Context, metaConn *BaseConn, taskC
 	conf := d.conf
 	allTables := conf.Tables
 
+	var cachedTables map[string]map[string]struct{}
 	// policy should be created before database
 	// placement policy in other server type can be different, so we only handle the tidb server
 	if conf.ServerInfo.ServerType == version.ServerTypeTiDB {
@@ -414,6 +415,17 @@ func (d *Dumper) dumpDatabases(tctx *tcontext.Context, metaConn *BaseConn, taskC
 				return tctx.Err()
 			}
 		}
+		// the placement policy, TTL and AUTO_RANDOM attributes of the tables are
+		// kept in SHOW CREATE TABLE as TiDB special comments, but the cached table
+		// attribute isn't shown there, so we need to find out the cached tables
+		// separately.
+		if !conf.NoSchemas {
+			var err error
+			cachedTables, err = ListAllCachedTables(tctx, metaConn)
+			if err != nil {
+				tctx.L().Warn("fail to list cached tables", log.ShortError(err))
+			}
+		}
 	}
 
 	parser1 := parser.New()
@@ -465,6 +477,9 @@ func (d *Dumper) dumpDatabases(tctx *tcontext.Context, metaConn *BaseConn, taskC
 					if err != nil {
 						return errors.Trace(err)
 					}
+					if _, ok := cachedTables[dbName][table.Name]; ok {
+						newCreateSQL = appendCacheTableSQL(newCreateSQL, table.Name)
+					}
 					meta.(*tableMeta).showCreateTable = newCreateSQL
 
 					task := NewTaskTableMeta(dbName, table.Name, meta.ShowCreateTable())
@@ -750,6 +765,19 @@ func (d *Dumper) concurrentDumpTable(tctx *tcontext.Context, conn *BaseConn, met
 	}
 
 	field, err := pickupPossibleField(tctx, meta, conn)
+	if err == nil && field == "" {
+		// no integer field, try to split the table by sampling its primary key
+		err = d.concurrentDumpTableByIndexSample(tctx, conn, meta, taskChan)
+		err2 := errors.Cause(err)
+		if err2 == nil || err2 == context.DeadlineExceeded || err2 == context.Canceled {
+			return err
+		}
+		if err2 != errEmptyHandleVals {
+			tctx.L().Info("fallback to sequential dump due to failed to sample primary key. This won't influence the whole dump process",
+				zap.String("database", db), zap.String("table", tbl), log.ShortError(err))
+		}
+		err = nil
+	}
 	if err != nil || field == "" {
 		// skip split chunk logic if not found proper field
 		tctx.L().Info("fallback to sequential dump due to no proper field. This won't influence the whole dump process",
@@ -865,6 +893,95 @@ func (d *Dumper) selectMinAndMaxIntValue(tctx *tcontext.Context, conn *BaseConn,
 	return min, max, nil
 }
 
+// concurrentDumpTableByIndexSample splits the table into chunks by the values
+// of every conf.Rows-th row ordered by the primary key, it's used for the tables
+// whose primary key is not a single integer column. Every sampling query seeks
+// from the last boundary, so the whole sampling only scans the primary key once.
+func (d *Dumper) concurrentDumpTableByIndexSample(tctx *tcontext.Context, conn *BaseConn, meta TableMeta, taskChan chan<- Task) error {
+	conf := d.conf
+	db, tbl := meta.DatabaseName(), meta.TableName()
+	pkFields, pkColTypes, err := GetPrimaryKeyAndColumnTypes(tctx, conn, meta)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(pkFields) == 0 {
+		return errors.Annotatef(errEmptyHandleVals, "table: `%s`.`%s` has no primary key", escapeString(db), escapeString(tbl))
+	}
+	count := estimateCount(d.tctx, db, tbl, conn, "", conf)
+	if count < conf.Rows {
+		return errors.Annotatef(errEmptyHandleVals, "table: `%s`.`%s` estimate count %d < rows %d",
+			escapeString(db), escapeString(tbl), count, conf.Rows)
+	}
+	handleVals, err := selectIndexSampleBoundaries(tctx, conn, meta, pkFields, pkColTypes, conf.Rows)
+	if err != nil {
+		return err
+	}
+	tctx.L().Debug("get primary key sample boundaries",
+		zap.String("database", db), zap.String("table", tbl),
+		zap.Strings("fields", pkFields), zap.Int("boundaries", len(handleVals)))
+	return d.sendConcurrentDumpTiDBTasks(tctx, meta, taskChan, pkFields, handleVals, "", 0, len(handleVals)+1)
+}
+
+// selectIndexSampleBoundaries returns the values of pkFields of every step-th
+// row ordered by pkFields, each value is formatted as a SQL literal.
+func selectIndexSampleBoundaries(tctx *tcontext.Context, conn *BaseConn, meta TableMeta,
+	pkFields, pkColTypes []string, step uint64) ([][]string, error) {
+	var (
+		handleVals [][]string
+		buf        = new(bytes.Buffer)
+	)
+	for {
+		var lastVals []string
+		if len(handleVals) > 0 {
+			lastVals = handleVals[len(handleVals)-1]
+		}
+		query := buildIndexSampleQuery(meta.DatabaseName(), meta.TableName(), pkFields, lastVals, step)
+		var vals []string
+		rowRec := MakeRowReceiver(pkColTypes)
+		err := conn.QuerySQL(tctx, func(rows *sql.Rows) error {
+			iter := &rowIter{rows: rows, args: make([]any, len(pkFields))}
+			if err := iter.Decode(rowRec); err != nil {
+				return errors.Trace(err)
+			}
+			vals = make([]string, 0, len(pkFields))
+			for _, rec := range rowRec.receivers {
+				rec.WriteToBuffer(buf, true)
+				vals = append(vals, buf.String())
+				buf.Reset()
+			}
+			return nil
+		}, func() {
+			vals = nil
+			rowRec = MakeRowReceiver(pkColTypes)
+			buf.Reset()
+		}, query)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if vals == nil {
+			return handleVals, nil
+		}
+		handleVals = append(handleVals, vals)
+	}
+}
+
+// buildIndexSampleQuery builds the query to get the step-th row after lastVals
+// ordered by pkFields, lastVals is nil for the first boundary.
+func buildIndexSampleQuery(db, tbl string, pkFields, lastVals []string, step uint64) string {
+	quotaCols := make([]string, len(pkFields))
+	for i, col := range pkFields {
+		quotaCols[i] = wrapBackTicks(escapeString(col))
+	}
+	var where string
+	if len(lastVals) > 0 {
+		buf := new(bytes.Buffer)
+		buildCompareClause(buf, quotaCols, lastVals, greater, true)
+		where = "WHERE " + buf.String()
+	}
+	query := buildSelectQuery(db, tbl, strings.Join(quotaCols, ","), "", where, buildOrderByClauseString(pkFields))
+	return fmt.Sprintf("%s LIMIT %d,1", query, step)
+}
+
 func (d *Dumper) concurrentDumpTiDBTables(tctx *tcontext.Context, conn *BaseConn, meta TableMeta, taskChan chan<- Task) error {
 	db, tbl := meta.DatabaseName(), meta.TableName()
 
@@ -210,6 +210,17 @@ func TestAdjustTableCollation(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, expectedSQLs[i], newSQL)
 	}
+
+	// the TiDB specific attributes are kept when the sql is rewritten.
+	originSQL := "CREATE TABLE `t` (`id` bigint(20) NOT NULL /*T![auto_rand] AUTO_RANDOM(5) */, `c` datetime DEFAULT NULL, " +
+		"PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 " +
+		"/*T![placement] PLACEMENT POLICY=`p1` */ /*T![ttl] TTL=`c` + INTERVAL 1 DAY */ /*T![ttl] TTL_ENABLE='ON' */ /*T![ttl] TTL_JOB_INTERVAL='1h' */"
+	newSQL, err := adjustTableCollation(tctx, StrictCollationCompatible, parser1, originSQL, charsetAndDefaultCollationMap)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `t` (`id` BIGINT(20) NOT NULL /*T![auto_rand] AUTO_RANDOM(5) */,`c` DATETIME DEFAULT NULL,"+
+		"PRIMARY KEY(`id`) /*T![clustered_index] CLUSTERED */) ENGINE = InnoDB DEFAULT CHARACTER SET = UTF8MB4 "+
+		"/*T![placement] PLACEMENT POLICY = `p1` */ /*T![ttl] TTL = `c` + INTERVAL 1 DAY */ /*T![ttl] TTL_ENABLE = 'ON' */ "+
+		"/*T![ttl] TTL_JOB_INTERVAL = '1h' */ DEFAULT COLLATE = UTF8MB4_GENERAL_CI", newSQL)
 }
 
 func TestUnregisterMetrics(t *testing.T) {
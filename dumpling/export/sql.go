@@ -379,6 +379,34 @@ func ListAllPlacementPolicyNames(tctx *tcontext.Context, db *BaseConn) ([]string
 	return policyList, errors.Annotatef(err, "sql: %s", query)
 }
 
+// ListAllCachedTables returns all cached tables of TiDB, grouped by database name.
+func ListAllCachedTables(tctx *tcontext.Context, db *BaseConn) (map[string]map[string]struct{}, error) {
+	cachedTables := make(map[string]map[string]struct{})
+	var schema, table string
+	const query = "SELECT TABLE_SCHEMA,TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE CREATE_OPTIONS = 'cached=on'"
+	err := db.QuerySQL(tctx, func(rows *sql.Rows) error {
+		err := rows.Scan(&schema, &table)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if _, ok := cachedTables[schema]; !ok {
+			cachedTables[schema] = make(map[string]struct{})
+		}
+		cachedTables[schema][table] = struct{}{}
+		return nil
+	}, func() {
+		cachedTables = make(map[string]map[string]struct{})
+	}, query)
+	return cachedTables, errors.Annotatef(err, "sql: %s", query)
+}
+
+// appendCacheTableSQL appends the statement which alters the table to a cached
+// table to the create table SQL, it's guarded by the TiDB special comment.
+func appendCacheTableSQL(createTableSQL, table string) string {
+	createTableSQL = strings.TrimRight(createTableSQL, "; \n")
+	return fmt.Sprintf("%s;\n/*T! ALTER TABLE `%s` CACHE */", createTableSQL, escapeString(table))
+}
+
 // SelectVersion gets the version information from the database server
 func SelectVersion(db *sql.DB) (string, error) {
 	var versionInfo string
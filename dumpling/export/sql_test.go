@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -457,6 +458,35 @@ func TestListPolicyNames(t *testing.T) {
 	}
 }
 
+func TestListAllCachedTables(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	tctx := tcontext.Background().WithLogger(appLogger)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	baseConn := newBaseConn(conn, true, nil)
+
+	mock.ExpectQuery("SELECT TABLE_SCHEMA,TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE CREATE_OPTIONS = 'cached=on'").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME"}).
+			AddRow("db1", "t1").AddRow("db1", "t2").AddRow("db2", "t1"))
+	cachedTables, err := ListAllCachedTables(tctx, baseConn)
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[string]struct{}{
+		"db1": {"t1": {}, "t2": {}},
+		"db2": {"t1": {}},
+	}, cachedTables)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	require.Equal(t, "CREATE TABLE `t` (`a` int);\n/*T! ALTER TABLE `t` CACHE */",
+		appendCacheTableSQL("CREATE TABLE `t` (`a` int)", "t"))
+	require.Equal(t, "CREATE TABLE `t``1` (`a` int);\n/*T! ALTER TABLE `t``1` CACHE */",
+		appendCacheTableSQL("CREATE TABLE `t``1` (`a` int);\n", "t`1"))
+}
+
 func TestGetSuitableRows(t *testing.T) {
 	testCases := []struct {
 		avgRowLength uint64
@@ -803,6 +833,7 @@ func TestBuildTableSampleQueries(t *testing.T) {
 					}
 					mock.ExpectQuery(fmt.Sprintf("SHOW INDEX FROM `%s`.`%s`", database, table)).WillReturnRows(rows)
 					mock.ExpectQuery("SHOW INDEX FROM").WillReturnRows(sqlmock.NewRows(showIndexHeaders))
+					mock.ExpectQuery("SHOW INDEX FROM").WillReturnRows(sqlmock.NewRows(showIndexHeaders))
 				} else {
 					d.conf.Rows = 200000
 					mock.ExpectQuery("EXPLAIN SELECT `_tidb_rowid`").
@@ -1078,6 +1109,7 @@ func TestBuildRegionQueriesWithoutPartition(t *testing.T) {
 			}
 			mock.ExpectQuery(fmt.Sprintf("SHOW INDEX FROM `%s`.`%s`", database, table)).WillReturnRows(rows)
 			mock.ExpectQuery("SHOW INDEX FROM").WillReturnRows(sqlmock.NewRows(showIndexHeaders))
+			mock.ExpectQuery("SHOW INDEX FROM").WillReturnRows(sqlmock.NewRows(showIndexHeaders))
 		}
 		require.NoError(t, d.concurrentDumpTable(tctx, baseConn, meta, taskChan))
 		require.NoError(t, mock.ExpectationsWereMet())
@@ -1930,3 +1962,82 @@ func TestGetSpecifiedColumnValuesAndClose(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	require.NoError(t, err)
 }
+
+func TestConcurrentDumpTableByIndexSample(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	baseConn := newBaseConn(conn, true, nil)
+	tctx, cancel := tcontext.Background().WithLogger(appLogger).WithCancel()
+
+	d := &Dumper{
+		tctx:      tctx,
+		conf:      DefaultConfig(),
+		cancelCtx: cancel,
+		metrics:   newMetrics(promutil.NewDefaultFactory(), nil),
+	}
+	d.conf.Rows = 100
+	d.conf.ServerInfo = version.ServerInfo{
+		ServerType: version.ServerTypeMySQL,
+	}
+	meta := &mockTableIR{
+		dbName:        database,
+		tblName:       table,
+		selectedField: "*",
+		selectedLen:   2,
+		colNames:      []string{"a", "b"},
+		colTypes:      []string{"VARCHAR", "INT"},
+		specCmt: []string{
+			"/*!40101 SET NAMES binary*/;",
+		},
+	}
+
+	// the primary key is (a, b), whose first column is not an integer
+	showIndexRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(showIndexHeaders).
+			AddRow(table, 0, "PRIMARY", 1, "a", "A", 0, nil, nil, "", "BTREE", "", "").
+			AddRow(table, 0, "PRIMARY", 2, "b", "A", 0, nil, nil, "", "BTREE", "", "")
+	}
+	// for order by clause, numeric index and primary key sampling
+	for i := 0; i < 3; i++ {
+		mock.ExpectQuery(fmt.Sprintf("SHOW INDEX FROM `%s`.`%s`", database, table)).WillReturnRows(showIndexRows())
+	}
+	mock.ExpectQuery("EXPLAIN SELECT \\* FROM").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "select_type", "table", "type", "rows"}).
+			AddRow(1, "SIMPLE", table, "index", 300))
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(
+		"SELECT `a`,`b` FROM `%s`.`%s` ORDER BY `a`,`b` LIMIT 100,1", database, table))).
+		WillReturnRows(sqlmock.NewRows([]string{"a", "b"}).AddRow("x", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(
+		"SELECT `a`,`b` FROM `%s`.`%s` WHERE `a`>'x' or(`a`='x' and `b`>=1) ORDER BY `a`,`b` LIMIT 100,1", database, table))).
+		WillReturnRows(sqlmock.NewRows([]string{"a", "b"}).AddRow("y", 2))
+	mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf(
+		"SELECT `a`,`b` FROM `%s`.`%s` WHERE `a`>'y' or(`a`='y' and `b`>=2) ORDER BY `a`,`b` LIMIT 100,1", database, table))).
+		WillReturnRows(sqlmock.NewRows([]string{"a", "b"}))
+
+	taskChan := make(chan Task, 128)
+	require.NoError(t, d.concurrentDumpTable(tctx, baseConn, meta, taskChan))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	expectedWhereClauses := []string{
+		"`a`<'x' or(`a`='x' and `b`<1)",
+		"(`a`>'x' and `a`<'y')or(`a`='x' and(`b`>=1))or(`a`='y' and(`b`<2))",
+		"`a`>'y' or(`a`='y' and `b`>=2)",
+	}
+	require.Len(t, taskChan, len(expectedWhereClauses))
+	for i, w := range expectedWhereClauses {
+		task := <-taskChan
+		taskTableData, ok := task.(*TaskTableData)
+		require.True(t, ok)
+		require.Equal(t, i, taskTableData.ChunkIndex)
+		require.Equal(t, len(expectedWhereClauses), taskTableData.TotalChunks)
+		data, ok := taskTableData.Data.(*tableData)
+		require.True(t, ok)
+		require.Equal(t, buildSelectQuery(database, table, "*", "", buildWhereCondition(d.conf, w), "ORDER BY `a`,`b`"), data.query)
+	}
+}
@@ -16,12 +16,15 @@ package importer
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/docker/go-units"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/lightning/pkg/precheck"
+	"github.com/pingcap/tidb/pkg/lightning/common"
 	"github.com/pingcap/tidb/pkg/lightning/config"
 )
 
@@ -48,7 +51,7 @@ func (rc *Controller) doPreCheckOnItem(ctx context.Context, checkItemID precheck
 	if err != nil {
 		return errors.Trace(err)
 	}
-	result, err := theChecker.Check(ctx)
+	result, err := precheck.RunChecker(ctx, theChecker, rc.cfg.App.PrecheckAutoFix)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -153,9 +156,70 @@ func (rc *Controller) checkSourceSchema(ctx context.Context) error {
 	return rc.doPreCheckOnItem(ctx, precheck.CheckSourceSchemaValid)
 }
 
+// checkCustomItems checks the items registered by RegisterPrecheckItem.
+func (rc *Controller) checkCustomItems(ctx context.Context) error {
+	for _, checkItemID := range customPrecheckItems {
+		if err := rc.doPreCheckOnItem(ctx, checkItemID); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 func (rc *Controller) checkCDCPiTR(ctx context.Context) error {
 	if rc.cfg.TikvImporter.Backend == config.BackendTiDB {
 		return nil
 	}
 	return rc.doPreCheckOnItem(ctx, precheck.CheckTargetUsingCDCPITR)
 }
+
+// checkOnly runs all the precheck items without importing anything, and prints
+// the result as a JSON report, for the tools which drive lightning.
+func (rc *Controller) checkOnly(ctx context.Context) error {
+	checkItemIDs := []precheck.CheckItemID{
+		precheck.CheckLargeDataFile,
+		precheck.CheckTargetClusterVersion,
+	}
+	if rc.ownStore {
+		checkItemIDs = append(checkItemIDs, precheck.CheckSourcePermission)
+	}
+	if rc.cfg.Checkpoint.Enable {
+		checkItemIDs = append(checkItemIDs, precheck.CheckCheckpoints)
+	}
+	checkItemIDs = append(checkItemIDs, precheck.CheckSourceSchemaValid, precheck.CheckTargetTableEmpty, precheck.CheckCSVHeader)
+	if isLocalBackend(rc.cfg) {
+		if rc.isSourceInLocal() {
+			checkItemIDs = append(checkItemIDs, precheck.CheckLocalDiskPlacement)
+		}
+		checkItemIDs = append(checkItemIDs,
+			precheck.CheckLocalTempKVDir,
+			precheck.CheckTargetClusterSize,
+			precheck.CheckTargetClusterEmptyRegion,
+			precheck.CheckTargetClusterRegionDist,
+			precheck.CheckTargetUsingCDCPITR,
+		)
+	}
+	checkItemIDs = append(checkItemIDs, customPrecheckItems...)
+
+	checkers := make([]precheck.Checker, 0, len(checkItemIDs))
+	for _, checkItemID := range checkItemIDs {
+		checker, err := rc.precheckItemBuilder.BuildPrecheckItem(checkItemID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		checkers = append(checkers, checker)
+	}
+	report, err := precheck.Run(ctx, checkers, rc.cfg.App.PrecheckAutoFix)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	fmt.Println(string(output))
+	if !report.Passed {
+		return common.ErrPreCheckFailed.GenWithStackByArgs("some precheck items failed, see the report for details")
+	}
+	return nil
+}
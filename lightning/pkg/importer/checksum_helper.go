@@ -64,6 +64,18 @@ func NewChecksumManager(ctx context.Context, rc *Controller, store kv.Storage) (
 // DoChecksum do checksum for tables.
 // table should be in <db>.<table>, format.  e.g. foo.bar
 func DoChecksum(ctx context.Context, table *checkpoints.TidbTableInfo) (*local.RemoteChecksum, error) {
+	return DoChecksumFrom(ctx, table, checkpoints.ChecksumProgress{}, nil)
+}
+
+// DoChecksumFrom is like DoChecksum, but it resumes the checksum from the given
+// progress and reports the progress by onProgress after each finished range if the
+// checksum manager supports it. Otherwise, the whole table is checksummed again.
+func DoChecksumFrom(
+	ctx context.Context,
+	table *checkpoints.TidbTableInfo,
+	progress checkpoints.ChecksumProgress,
+	onProgress func(checkpoints.ChecksumProgress),
+) (*local.RemoteChecksum, error) {
 	var err error
 	manager, ok := ctx.Value(&checksumManagerKey).(local.ChecksumManager)
 	if !ok {
@@ -72,7 +84,12 @@ func DoChecksum(ctx context.Context, table *checkpoints.TidbTableInfo) (*local.R
 
 	task := log.FromContext(ctx).With(zap.String("table", table.Name)).Begin(zap.InfoLevel, "remote checksum")
 
-	cs, err := manager.Checksum(ctx, table)
+	var cs *local.RemoteChecksum
+	if resumable, ok := manager.(local.ResumableChecksumManager); ok {
+		cs, err = resumable.ChecksumFrom(ctx, table, progress, onProgress)
+	} else {
+		cs, err = manager.Checksum(ctx, table)
+	}
 	dur := task.End(zap.ErrorLevel, err)
 	if m, ok := metric.FromContext(ctx); ok {
 		m.ChecksumSecondsHistogram.Observe(dur.Seconds())
@@ -407,7 +407,7 @@ get_struct_from_src:
 			resultInfos[i] = curTblInfo
 			continue
 		}
-		createTblSQL, err := tableFileMeta.GetSchema(ctx, p.srcStorage)
+		createTblSQL, err := getTableSchema(ctx, p.srcStorage, tableFileMeta, p.cfg)
 		if err != nil {
 			return nil, errors.Annotatef(err, "get create table statement from schema file error: %s", tableFileMeta.Name)
 		}
@@ -425,15 +425,23 @@ get_struct_from_src:
 
 func newTableInfo(createTblSQL string, tableID int64) (*model.TableInfo, error) {
 	parser := parser.New()
-	astNode, err := parser.ParseOneStmt(createTblSQL, "", "")
+	astNodes, _, err := parser.ParseSQL(createTblSQL)
 	if err != nil {
 		errMsg := "parse sql statement error"
 		log.L().Error(errMsg, zap.Error(err), zap.String("sql", createTblSQL))
 		return nil, errors.Trace(err)
 	}
 	sctx := mock.NewContext()
-	createTableStmt, ok := astNode.(*ast.CreateTableStmt)
-	if !ok {
+	// the schema file may contain other statements after CREATE TABLE, such as
+	// `ALTER TABLE ... CACHE`, they don't affect the table structure.
+	var createTableStmt *ast.CreateTableStmt
+	for _, astNode := range astNodes {
+		if stmt, ok := astNode.(*ast.CreateTableStmt); ok {
+			createTableStmt = stmt
+			break
+		}
+	}
+	if createTableStmt == nil {
 		return nil, errors.New("cannot transfer the parsed SQL as an CREATE TABLE statement")
 	}
 	info, err := ddl.MockTableInfo(sctx, createTableStmt, tableID)
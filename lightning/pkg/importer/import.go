@@ -547,6 +547,9 @@ func (rc *Controller) Run(ctx context.Context) error {
 		rc.fullCompact,
 		rc.cleanCheckpoints,
 	}
+	if rc.cfg.App.CheckOnly {
+		opts = []func(context.Context) error{rc.checkOnly}
+	}
 
 	task := log.FromContext(ctx).Begin(zap.InfoLevel, "the whole procedure")
 
@@ -621,6 +624,7 @@ type restoreSchemaWorker struct {
 	db     *sql.DB
 	parser *parser.Parser
 	store  storage.ExternalStorage
+	cfg    *config.Config
 }
 
 func (worker *restoreSchemaWorker) addJob(sqlStr string, job *schemaJob) error {
@@ -632,6 +636,21 @@ func (worker *restoreSchemaWorker) addJob(sqlStr string, job *schemaJob) error {
 	return worker.appendJob(job)
 }
 
+// getTableSchema returns the CREATE TABLE statement of the table from its
+// schema file, or infers it from the CSV data files if the schema file is
+// missing and `mydumper.infer-schema` is enabled.
+func getTableSchema(
+	ctx context.Context,
+	store storage.ExternalStorage,
+	tblMeta *mydump.MDTableMeta,
+	cfg *config.Config,
+) (string, error) {
+	if tblMeta.SchemaFile.FileMeta.Path == "" && cfg.Mydumper.InferSchema.Enable {
+		return mydump.InferCSVTableSchema(ctx, store, tblMeta, &cfg.Mydumper)
+	}
+	return tblMeta.GetSchema(ctx, store)
+}
+
 func (worker *restoreSchemaWorker) makeJobs(
 	dbMetas []*mydump.MDDatabaseMeta,
 	getDBs func(context.Context) ([]*model.DBInfo, error),
@@ -704,10 +723,10 @@ func (worker *restoreSchemaWorker) makeJobs(
 					zap.String("table", tblMeta.Name),
 				)
 				continue
-			} else if tblMeta.SchemaFile.FileMeta.Path == "" {
+			} else if tblMeta.SchemaFile.FileMeta.Path == "" && !worker.cfg.Mydumper.InferSchema.Enable {
 				return common.ErrSchemaNotExists.GenWithStackByArgs(dbMeta.Name, tblMeta.Name)
 			}
-			sql, err := tblMeta.GetSchema(worker.ctx, worker.store)
+			sql, err := getTableSchema(worker.ctx, worker.store, tblMeta, worker.cfg)
 			if err != nil {
 				return err
 			}
@@ -895,6 +914,7 @@ func (rc *Controller) restoreSchema(ctx context.Context) error {
 		db:     rc.db,
 		parser: p,
 		store:  rc.store,
+		cfg:    rc.cfg,
 	}
 	for i := 0; i < concurrency; i++ {
 		go worker.doJob()
@@ -2226,6 +2246,9 @@ func (rc *Controller) preCheckRequirements(ctx context.Context) error {
 	}
 
 	if rc.cfg.App.CheckRequirements {
+		if err := rc.checkCustomItems(ctx); err != nil {
+			return errors.Trace(err)
+		}
 		fmt.Println(rc.checkTemplate.Output())
 	}
 	if !rc.checkTemplate.Success() {
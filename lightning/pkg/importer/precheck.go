@@ -128,38 +128,85 @@ func NewPrecheckItemBuilder(
 	}
 }
 
+// PrecheckItemBuildFunc builds a precheck item from the builder.
+type PrecheckItemBuildFunc func(b *PrecheckItemBuilder) precheck.Checker
+
+var (
+	precheckItemBuildFuncs = map[precheck.CheckItemID]PrecheckItemBuildFunc{
+		precheck.CheckLargeDataFile: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewLargeFileCheckItem(b.cfg, b.dbMetas)
+		},
+		precheck.CheckSourcePermission: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewStoragePermissionCheckItem(b.cfg)
+		},
+		precheck.CheckTargetTableEmpty: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewTableEmptyCheckItem(b.cfg, b.preInfoGetter, b.dbMetas, b.checkpointsDB)
+		},
+		precheck.CheckSourceSchemaValid: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewSchemaCheckItem(b.cfg, b.preInfoGetter, b.dbMetas, b.checkpointsDB)
+		},
+		precheck.CheckCheckpoints: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewCheckpointCheckItem(b.cfg, b.preInfoGetter, b.dbMetas, b.checkpointsDB)
+		},
+		precheck.CheckCSVHeader: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewCSVHeaderCheckItem(b.cfg, b.preInfoGetter, b.dbMetas)
+		},
+		precheck.CheckTargetClusterSize: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewClusterResourceCheckItem(b.preInfoGetter)
+		},
+		precheck.CheckTargetClusterEmptyRegion: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewEmptyRegionCheckItem(b.preInfoGetter, b.dbMetas)
+		},
+		precheck.CheckTargetClusterRegionDist: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewRegionDistributionCheckItem(b.preInfoGetter, b.dbMetas)
+		},
+		precheck.CheckTargetClusterVersion: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewClusterVersionCheckItem(b.preInfoGetter, b.dbMetas)
+		},
+		precheck.CheckLocalDiskPlacement: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewLocalDiskPlacementCheckItem(b.cfg)
+		},
+		precheck.CheckLocalTempKVDir: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewLocalTempKVDirCheckItem(b.cfg, b.preInfoGetter, b.dbMetas)
+		},
+		precheck.CheckTargetUsingCDCPITR: func(b *PrecheckItemBuilder) precheck.Checker {
+			return NewCDCPITRCheckItem(b.cfg, b.pdAddrsGetter)
+		},
+	}
+	// customPrecheckItems are the items registered by RegisterPrecheckItem, in the
+	// order they're registered.
+	customPrecheckItems []precheck.CheckItemID
+)
+
+// RegisterPrecheckItem registers a custom precheck item, which is checked after the
+// built-in items when `check-requirements` is enabled. It must be called before
+// lightning starts, usually in an init function.
+func RegisterPrecheckItem(checkID precheck.CheckItemID, displayName string, build PrecheckItemBuildFunc) error {
+	if !precheck.RegisterCheckItem(checkID, displayName) {
+		return errors.Errorf("check item %s is registered already", checkID)
+	}
+	precheckItemBuildFuncs[checkID] = build
+	customPrecheckItems = append(customPrecheckItems, checkID)
+	return nil
+}
+
 // BuildPrecheckItem builds a Checker by the given checkID
 func (b *PrecheckItemBuilder) BuildPrecheckItem(checkID precheck.CheckItemID) (precheck.Checker, error) {
-	switch checkID {
-	case precheck.CheckLargeDataFile:
-		return NewLargeFileCheckItem(b.cfg, b.dbMetas), nil
-	case precheck.CheckSourcePermission:
-		return NewStoragePermissionCheckItem(b.cfg), nil
-	case precheck.CheckTargetTableEmpty:
-		return NewTableEmptyCheckItem(b.cfg, b.preInfoGetter, b.dbMetas, b.checkpointsDB), nil
-	case precheck.CheckSourceSchemaValid:
-		return NewSchemaCheckItem(b.cfg, b.preInfoGetter, b.dbMetas, b.checkpointsDB), nil
-	case precheck.CheckCheckpoints:
-		return NewCheckpointCheckItem(b.cfg, b.preInfoGetter, b.dbMetas, b.checkpointsDB), nil
-	case precheck.CheckCSVHeader:
-		return NewCSVHeaderCheckItem(b.cfg, b.preInfoGetter, b.dbMetas), nil
-	case precheck.CheckTargetClusterSize:
-		return NewClusterResourceCheckItem(b.preInfoGetter), nil
-	case precheck.CheckTargetClusterEmptyRegion:
-		return NewEmptyRegionCheckItem(b.preInfoGetter, b.dbMetas), nil
-	case precheck.CheckTargetClusterRegionDist:
-		return NewRegionDistributionCheckItem(b.preInfoGetter, b.dbMetas), nil
-	case precheck.CheckTargetClusterVersion:
-		return NewClusterVersionCheckItem(b.preInfoGetter, b.dbMetas), nil
-	case precheck.CheckLocalDiskPlacement:
-		return NewLocalDiskPlacementCheckItem(b.cfg), nil
-	case precheck.CheckLocalTempKVDir:
-		return NewLocalTempKVDirCheckItem(b.cfg, b.preInfoGetter, b.dbMetas), nil
-	case precheck.CheckTargetUsingCDCPITR:
-		return NewCDCPITRCheckItem(b.cfg, b.pdAddrsGetter), nil
-	default:
+	build, ok := precheckItemBuildFuncs[checkID]
+	if !ok {
 		return nil, errors.Errorf("unsupported check item: %v", checkID)
 	}
+	return build(b), nil
+}
+
+// GetConfig gets the config of the import task from the builder.
+func (b *PrecheckItemBuilder) GetConfig() *config.Config {
+	return b.cfg
+}
+
+// GetDBMetas gets the metas of the source databases from the builder.
+func (b *PrecheckItemBuilder) GetDBMetas() []*mydump.MDDatabaseMeta {
+	return b.dbMetas
 }
 
 // GetPreInfoGetter gets the pre restore info getter from the builder.
@@ -607,6 +607,23 @@ func (ci *localTempKVDirCheckItem) Check(ctx context.Context) (*precheck.CheckRe
 	return theResult, nil
 }
 
+// Fix implements precheck.Fixer. It lowers the disk quota to 90% of the local
+// available space, so the import slows down instead of running out of disk.
+func (ci *localTempKVDirCheckItem) Fix(ctx context.Context, _ *precheck.CheckResult) error {
+	storageSize, err := common.GetStorageSize(ci.cfg.TikvImporter.SortedKVDir)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	diskQuota := config.ByteSize(storageSize.Available / 10 * 9)
+	if diskQuota >= ci.cfg.TikvImporter.DiskQuota {
+		return nil
+	}
+	log.FromContext(ctx).Warn("lower the disk quota to fit the local disk",
+		zap.Int64("old", int64(ci.cfg.TikvImporter.DiskQuota)), zap.Int64("new", int64(diskQuota)))
+	ci.cfg.TikvImporter.DiskQuota = diskQuota
+	return nil
+}
+
 type checkpointCheckItem struct {
 	cfg           *config.Config
 	preInfoGetter PreImportInfoGetter
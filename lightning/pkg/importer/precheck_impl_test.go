@@ -26,6 +26,7 @@ import (
 	ropts "github.com/pingcap/tidb/lightning/pkg/importer/opts"
 	"github.com/pingcap/tidb/lightning/pkg/precheck"
 	"github.com/pingcap/tidb/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/pkg/lightning/common"
 	"github.com/pingcap/tidb/pkg/lightning/config"
 	"github.com/pingcap/tidb/pkg/lightning/log"
 	"github.com/stretchr/testify/suite"
@@ -411,6 +412,15 @@ func (s *precheckImplSuite) TestLocalTempKVDirCheckBasic() {
 	s.Require().Equal(precheck.Critical, result.Severity)
 	s.T().Logf("check result message: %s", result.Message)
 	s.Require().False(result.Passed)
+
+	// the disk quota is lowered to fit the local disk.
+	result, err = precheck.RunChecker(ctx, ci, true)
+	s.Require().NoError(err)
+	s.Require().True(result.Passed)
+	s.Require().True(result.Fixed)
+	storageSize, err := common.GetStorageSize(s.cfg.TikvImporter.SortedKVDir)
+	s.Require().NoError(err)
+	s.Require().Less(uint64(s.cfg.TikvImporter.DiskQuota), storageSize.Available)
 }
 
 func (s *precheckImplSuite) TestCheckpointCheckBasic() {
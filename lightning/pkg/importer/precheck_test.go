@@ -15,6 +15,7 @@
 package importer
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pingcap/tidb/lightning/pkg/importer/mock"
@@ -53,3 +54,45 @@ func TestPrecheckBuilderBasic(t *testing.T) {
 		require.Equal(t, checkItemID, theChecker.GetCheckItemID())
 	}
 }
+
+type customCheckItem struct {
+	cfg *config.Config
+}
+
+func (*customCheckItem) GetCheckItemID() precheck.CheckItemID {
+	return "custom"
+}
+
+func (ci *customCheckItem) Check(context.Context) (*precheck.CheckResult, error) {
+	return &precheck.CheckResult{
+		Item:     ci.GetCheckItemID(),
+		Severity: precheck.Warn,
+		Passed:   ci.cfg.App.CheckRequirements,
+	}, nil
+}
+
+func TestRegisterPrecheckItem(t *testing.T) {
+	build := func(b *PrecheckItemBuilder) precheck.Checker {
+		return &customCheckItem{cfg: b.GetConfig()}
+	}
+	require.NoError(t, RegisterPrecheckItem("custom", "Custom check", build))
+	t.Cleanup(func() {
+		delete(precheckItemBuildFuncs, "custom")
+		customPrecheckItems = nil
+	})
+	require.Error(t, RegisterPrecheckItem("custom", "Custom check", build))
+	require.Error(t, RegisterPrecheckItem(precheck.CheckLargeDataFile, "Large data file", build))
+	require.Equal(t, []precheck.CheckItemID{"custom"}, customPrecheckItems)
+	require.Equal(t, "Custom check", precheck.CheckItemID("custom").DisplayName())
+
+	cfg := config.NewConfig()
+	theCheckBuilder := NewPrecheckItemBuilder(cfg, nil, nil, nil, nil)
+	theChecker, err := theCheckBuilder.BuildPrecheckItem("custom")
+	require.NoError(t, err)
+	result, err := theChecker.Check(context.Background())
+	require.NoError(t, err)
+	require.True(t, result.Passed)
+
+	_, err = theCheckBuilder.BuildPrecheckItem("unknown")
+	require.ErrorContains(t, err, "unsupported check item")
+}
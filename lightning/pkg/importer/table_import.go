@@ -975,6 +975,9 @@ func (tr *TableImporter) postProcess(
 				err = common.RebaseGlobalAutoID(ctx, adjustIDBase(newBase), tr, tr.dbInfo.ID, tr.tableInfo.Core)
 			}
 		}
+		if err == nil {
+			err = RebaseSequences(ctx, rc.db, tr.dbInfo.Name, tblInfo)
+		}
 		saveCpErr := rc.saveStatusCheckpoint(ctx, tr.tableName, checkpoints.WholeTableEngineID, err, checkpoints.CheckpointStatusAlteredAutoInc)
 		if err = firstErr(err, saveCpErr); err != nil {
 			return false, errors.Trace(err)
@@ -1080,8 +1083,20 @@ func (tr *TableImporter) postProcess(
 				tr.logger.Info("merged local checksum", zap.Object("checksum", &localChecksum))
 			}
 
+			if cp.ChecksumProgress.FinishedRanges > 0 {
+				tr.logger.Info("resume remote checksum from checkpoint",
+					zap.Int64("finishedRanges", cp.ChecksumProgress.FinishedRanges))
+			}
 			var remoteChecksum *local.RemoteChecksum
-			remoteChecksum, err = DoChecksum(ctx, tr.tableInfo)
+			remoteChecksum, err = DoChecksumFrom(ctx, tr.tableInfo, cp.ChecksumProgress,
+				func(progress checkpoints.ChecksumProgress) {
+					rc.saveCpCh <- saveCp{
+						tableName: tr.tableName,
+						merger: &checkpoints.ChecksumProgressMerger{
+							Progress: progress,
+						},
+					}
+				})
 			failpoint.Inject("checksum-error", func() {
 				tr.logger.Info("failpoint checksum-error injected.")
 				remoteChecksum = nil
@@ -156,6 +156,10 @@ func createIfNotExistsStmt(p *parser.Parser, createTable, dbName, tblName string
 		case *ast.CreateViewStmt:
 			node.ViewName.Schema = model.NewCIStr(dbName)
 			node.ViewName.Name = model.NewCIStr(tblName)
+		case *ast.AlterTableStmt:
+			// such as the `ALTER TABLE ... CACHE` exported by dumpling for cached tables.
+			node.Table.Schema = model.NewCIStr(dbName)
+			node.Table.Name = model.NewCIStr(tblName)
 		case *ast.DropTableStmt:
 			node.Tables[0].Schema = model.NewCIStr(dbName)
 			node.Tables[0].Name = model.NewCIStr(tblName)
@@ -362,3 +366,32 @@ func AlterAutoRandom(ctx context.Context, db *sql.DB, tableName string, randomBa
 	}
 	return errors.Annotatef(err, "%s", query)
 }
+
+// RebaseSequences moves the sequences used by the default values of the table past the imported values,
+// so the values generated by them later won't conflict with the imported ones.
+func RebaseSequences(ctx context.Context, db *sql.DB, schema string, tblInfo *model.TableInfo) error {
+	tableName := common.UniqueTable(schema, tblInfo.Name.O)
+	logger := log.FromContext(ctx).With(zap.String("table", tableName))
+	exec := common.SQLWithRetry{DB: db, Logger: logger}
+	for _, seqCol := range common.GetSequenceColumns(tblInfo) {
+		colName := common.EscapeIdentifier(seqCol.Column.Name.O)
+		query := fmt.Sprintf("SELECT MIN(%s), MAX(%s) FROM %s", colName, colName, tableName)
+		var minVal, maxVal sql.NullInt64
+		if err := exec.QueryRow(ctx, "fetch imported range of sequence column", query, &minVal, &maxVal); err != nil {
+			return errors.Annotatef(err, "%s", query)
+		}
+		if !maxVal.Valid {
+			continue
+		}
+		// SETVAL never moves a sequence backward, so only one of them takes effect, the max value for the
+		// ascending sequence, and the min value for the descending one.
+		for _, val := range []int64{maxVal.Int64, minVal.Int64} {
+			query = fmt.Sprintf("SELECT SETVAL(%s, %d)", seqCol.SequenceTable(schema), val)
+			if err := exec.Exec(ctx, "rebase sequence", query); err != nil {
+				logger.Error("rebase sequence failed, please perform the query manually", zap.String("query", query))
+				return errors.Annotatef(err, "%s", query)
+			}
+		}
+	}
+	return nil
+}
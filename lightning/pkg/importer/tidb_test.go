@@ -162,6 +162,13 @@ func TestCreateTableIfNotExistsStmt(t *testing.T) {
 			SET character_set_results = @PREV_CHARACTER_SET_RESULTS;
 			SET collation_connection = @PREV_COLLATION_CONNECTION;
 		`, "m"))
+
+	// the cached table attribute exported by dumpling.
+	require.Equal(t, []string{
+		"CREATE TABLE IF NOT EXISTS `testdb`.`foo` (`bar` TINYINT(1));",
+		"ALTER TABLE `testdb`.`foo` CACHE;",
+	},
+		createSQLIfNotExistsStmt("CREATE TABLE `foo`(`bar` TINYINT(1));\n/*T! ALTER TABLE `foo` CACHE */;\n", "foo"))
 }
 
 func TestDropTable(t *testing.T) {
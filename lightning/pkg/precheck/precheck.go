@@ -16,6 +16,7 @@ package precheck
 
 import (
 	"context"
+	"sync"
 )
 
 // CheckType represents the check type.
@@ -48,7 +49,8 @@ const (
 )
 
 var (
-	// CheckItemIDToDisplayName is a map from CheckItemID to its display name
+	checkItemsMu sync.RWMutex
+	// checkItemIDToDisplayName is a map from CheckItemID to its display name
 	checkItemIDToDisplayName = map[CheckItemID]string{
 		CheckLargeDataFile:            "Large data file",
 		CheckSourcePermission:         "Source permission",
@@ -68,15 +70,31 @@ var (
 
 // DisplayName returns display name for it.
 func (c CheckItemID) DisplayName() string {
+	checkItemsMu.RLock()
+	defer checkItemsMu.RUnlock()
 	return checkItemIDToDisplayName[c]
 }
 
+// RegisterCheckItem registers the display name of a check item which isn't built in,
+// it returns false if the ID is registered already.
+func RegisterCheckItem(id CheckItemID, displayName string) bool {
+	checkItemsMu.Lock()
+	defer checkItemsMu.Unlock()
+	if _, ok := checkItemIDToDisplayName[id]; ok {
+		return false
+	}
+	checkItemIDToDisplayName[id] = displayName
+	return true
+}
+
 // CheckResult is the result of a precheck item
 type CheckResult struct {
-	Item     CheckItemID
-	Severity CheckType
-	Passed   bool
-	Message  string
+	Item     CheckItemID `json:"item"`
+	Severity CheckType   `json:"severity"`
+	Passed   bool        `json:"passed"`
+	Message  string      `json:"message"`
+	// Fixed is whether the check passes after it's fixed automatically.
+	Fixed bool `json:"fixed,omitempty"`
 }
 
 // Checker is the interface for precheck items
@@ -86,3 +104,70 @@ type Checker interface {
 	Check(ctx context.Context) (*CheckResult, error)
 	GetCheckItemID() CheckItemID
 }
+
+// Fixer is implemented by the precheck items which can fix the failed prerequisites
+// automatically, such as adjusting the configuration to fit the environment.
+type Fixer interface {
+	// Fix tries to fix the prerequisites which the failed result reports, the item
+	// is checked again after it.
+	Fix(ctx context.Context, result *CheckResult) error
+}
+
+// RunChecker runs the checker. If the check fails and autoFix is true, the checker is
+// fixed and checked again if it implements Fixer.
+func RunChecker(ctx context.Context, checker Checker, autoFix bool) (*CheckResult, error) {
+	result, err := checker.Check(ctx)
+	if err != nil || result == nil || result.Passed || !autoFix {
+		return result, err
+	}
+	fixer, ok := checker.(Fixer)
+	if !ok {
+		return result, nil
+	}
+	if err = fixer.Fix(ctx, result); err != nil {
+		return nil, err
+	}
+	fixedResult, err := checker.Check(ctx)
+	if err != nil || fixedResult == nil {
+		return fixedResult, err
+	}
+	fixedResult.Fixed = fixedResult.Passed
+	return fixedResult, nil
+}
+
+// ReportItem is the result of a check item in the report.
+type ReportItem struct {
+	*CheckResult
+	Name string `json:"name"`
+}
+
+// Report is the machine-readable results of the precheck items.
+type Report struct {
+	// Passed is false if any critical check item fails.
+	Passed bool          `json:"passed"`
+	Items  []*ReportItem `json:"items"`
+}
+
+// Add adds the result of a check item to the report.
+func (r *Report) Add(result *CheckResult) {
+	if !result.Passed && result.Severity == Critical {
+		r.Passed = false
+	}
+	r.Items = append(r.Items, &ReportItem{CheckResult: result, Name: result.Item.DisplayName()})
+}
+
+// Run runs the checkers one by one by RunChecker, and collects the results into a
+// report. The skipped checkers are not in the report.
+func Run(ctx context.Context, checkers []Checker, autoFix bool) (*Report, error) {
+	report := &Report{Passed: true, Items: make([]*ReportItem, 0, len(checkers))}
+	for _, checker := range checkers {
+		result, err := RunChecker(ctx, checker, autoFix)
+		if err != nil {
+			return nil, err
+		}
+		if result != nil {
+			report.Add(result)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package precheck
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockChecker struct {
+	id       CheckItemID
+	severity CheckType
+	passed   bool
+	fixable  bool
+	checked  int
+}
+
+func (c *mockChecker) Check(context.Context) (*CheckResult, error) {
+	c.checked++
+	return &CheckResult{Item: c.id, Severity: c.severity, Passed: c.passed, Message: "msg"}, nil
+}
+
+func (c *mockChecker) GetCheckItemID() CheckItemID {
+	return c.id
+}
+
+type mockFixableChecker struct {
+	mockChecker
+}
+
+func (c *mockFixableChecker) Fix(context.Context, *CheckResult) error {
+	c.passed = c.fixable
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	customID := CheckItemID("CHECK_CUSTOM")
+	require.True(t, RegisterCheckItem(customID, "Custom"))
+	require.False(t, RegisterCheckItem(customID, "Custom again"))
+	require.False(t, RegisterCheckItem(CheckCSVHeader, "CSV header"))
+	require.Equal(t, "Custom", customID.DisplayName())
+
+	warn := &mockChecker{id: CheckLocalDiskPlacement, severity: Warn}
+	fixable := &mockFixableChecker{mockChecker{id: CheckLocalTempKVDir, severity: Critical, fixable: true}}
+	report, err := Run(ctx, []Checker{warn, fixable}, false)
+	require.NoError(t, err)
+	require.False(t, report.Passed)
+	require.Len(t, report.Items, 2)
+	require.Equal(t, 1, fixable.checked)
+
+	// the fixable checker is checked again after it's fixed.
+	report, err = Run(ctx, []Checker{warn, fixable}, true)
+	require.NoError(t, err)
+	require.True(t, report.Passed)
+	require.Equal(t, 3, fixable.checked)
+	require.True(t, report.Items[1].Fixed)
+	// the checker without Fixer is checked only once.
+	require.Equal(t, 2, warn.checked)
+	require.False(t, report.Items[0].Passed)
+
+	unfixable := &mockFixableChecker{mockChecker{id: customID, severity: Critical}}
+	report, err = Run(ctx, []Checker{unfixable}, true)
+	require.NoError(t, err)
+	require.False(t, report.Passed)
+	require.False(t, report.Items[0].Fixed)
+
+	data, err := json.Marshal(report)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"passed":false,"items":[{"item":"CHECK_CUSTOM","name":"Custom","severity":"critical","passed":false,"message":"msg"}]}`, string(data))
+}
@@ -104,6 +104,11 @@ type GlobalBindingHandle interface {
 	// CaptureBaselines is used to automatically capture plan baselines.
 	CaptureBaselines()
 
+	// Methods for pinned plans.
+
+	// CheckPinnedPlans drops the expired pinned plans and disables the regressed ones.
+	CheckPinnedPlans(ttl time.Duration, maxRegression float64)
+
 	variable.Statistics
 }
 
@@ -0,0 +1,140 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo
+
+import (
+	"time"
+
+	"github.com/pingcap/tidb/pkg/bindinfo/internal/logutil"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"go.uber.org/zap"
+)
+
+// minPinnedPlanExecCount is the least executions of a pinned plan since it's pinned
+// before checking whether it regresses, to avoid unpinning it by a few slow executions.
+const minPinnedPlanExecCount = 10
+
+// planLatency is the executions and the total latency of a plan in the statement summary.
+type planLatency struct {
+	execCount  int64
+	sumLatency int64
+}
+
+func (l planLatency) avg() float64 {
+	return float64(l.sumLatency) / float64(l.execCount)
+}
+
+// isPinnedPlan returns whether the binding pins a plan, which is created from the plan
+// digest in the statement summary.
+func isPinnedPlan(b *Binding) bool {
+	return b.Source == History && b.PlanDigest != ""
+}
+
+// isPinnedPlanExpired returns whether the pinned plan is created more than ttl ago.
+func isPinnedPlanExpired(b *Binding, ttl time.Duration, now time.Time) bool {
+	if ttl <= 0 {
+		return false
+	}
+	createTime, err := b.CreateTime.GoTime(time.Local)
+	if err != nil {
+		return false
+	}
+	return now.Sub(createTime) > ttl
+}
+
+// isPinnedPlanRegressed returns whether the average latency of the pinned plan since it's
+// pinned exceeds maxRegression times of the one before it's pinned.
+func isPinnedPlanRegressed(before, after planLatency, maxRegression float64) bool {
+	if maxRegression <= 0 || before.execCount == 0 || after.execCount < minPinnedPlanExecCount {
+		return false
+	}
+	return after.avg() > before.avg()*maxRegression
+}
+
+// CheckPinnedPlans drops the pinned plans which are expired, and disables the ones whose
+// latency regresses, so the optimizer chooses the plans by itself again.
+func (h *globalBindingHandle) CheckPinnedPlans(ttl time.Duration, maxRegression float64) {
+	if ttl <= 0 && maxRegression <= 0 {
+		return
+	}
+	now := time.Now()
+	var changed bool
+	for _, binding := range h.GetAllGlobalBindings() {
+		if !isPinnedPlan(&binding) || !binding.IsBindingAvailable() {
+			continue
+		}
+		if isPinnedPlanExpired(&binding, ttl, now) {
+			logutil.BindLogger().Info("drop the expired pinned plan",
+				zap.String("sqlDigest", binding.SQLDigest), zap.String("planDigest", binding.PlanDigest),
+				zap.Stringer("createTime", binding.CreateTime), zap.Duration("ttl", ttl))
+			if _, err := h.dropGlobalBinding(binding.SQLDigest); err != nil {
+				logutil.BindLogger().Warn("drop the expired pinned plan failed", zap.Error(err))
+			}
+			changed = true
+			continue
+		}
+		if maxRegression <= 0 || !binding.IsBindingEnabled() {
+			continue
+		}
+		before, after, err := h.getPinnedPlanLatency(&binding)
+		if err != nil {
+			logutil.BindLogger().Warn("get the latency of the pinned plan failed",
+				zap.String("sqlDigest", binding.SQLDigest), zap.Error(err))
+			continue
+		}
+		if !isPinnedPlanRegressed(before, after, maxRegression) {
+			continue
+		}
+		logutil.BindLogger().Warn("disable the pinned plan because its latency regresses",
+			zap.String("sqlDigest", binding.SQLDigest), zap.String("planDigest", binding.PlanDigest),
+			zap.Float64("avgLatencyBefore", before.avg()), zap.Float64("avgLatencyAfter", after.avg()),
+			zap.Int64("execCountAfter", after.execCount))
+		if _, err = h.SetGlobalBindingStatus(Disabled, binding.SQLDigest); err != nil {
+			logutil.BindLogger().Warn("disable the regressed pinned plan failed", zap.Error(err))
+		}
+		changed = true
+	}
+	if changed {
+		if err := h.LoadFromStorageToCache(false); err != nil {
+			logutil.BindLogger().Warn("load the bindings after checking the pinned plans failed", zap.Error(err))
+		}
+	}
+}
+
+// getPinnedPlanLatency returns the latency of the pinned plan in the statement summary
+// of all the TiDB instances, before and since it's pinned.
+func (h *globalBindingHandle) getPinnedPlanLatency(b *Binding) (before, after planLatency, err error) {
+	createTime := b.CreateTime.String()
+	err = h.callWithSCtx(false, func(sctx sessionctx.Context) error {
+		rows, _, err := execRows(sctx, `SELECT summary_begin_time >= %? AS pinned,
+				CAST(SUM(exec_count) AS SIGNED), CAST(SUM(sum_latency) AS SIGNED)
+			FROM information_schema.cluster_statements_summary_history
+			WHERE plan_digest = %? AND (summary_begin_time >= %? OR summary_end_time <= %?)
+			GROUP BY pinned`, createTime, b.PlanDigest, createTime, createTime)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			l := planLatency{execCount: row.GetInt64(1), sumLatency: row.GetInt64(2)}
+			if row.GetInt64(0) == 1 {
+				after = l
+			} else {
+				before = l
+			}
+		}
+		return nil
+	})
+	return
+}
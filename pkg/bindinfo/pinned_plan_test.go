@@ -0,0 +1,48 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinnedPlanCheck(t *testing.T) {
+	now := time.Now()
+	createTime := types.NewTime(types.FromGoTime(now.Add(-2*time.Hour)), mysql.TypeTimestamp, 3)
+	binding := &Binding{Source: History, PlanDigest: "digest", Status: Enabled, CreateTime: createTime}
+	require.True(t, isPinnedPlan(binding))
+	require.False(t, isPinnedPlan(&Binding{Source: Manual}))
+
+	// 0 means never expire.
+	require.False(t, isPinnedPlanExpired(binding, 0, now))
+	require.False(t, isPinnedPlanExpired(binding, 3*time.Hour, now))
+	require.True(t, isPinnedPlanExpired(binding, time.Hour, now))
+
+	before := planLatency{execCount: 100, sumLatency: 100 * int64(time.Millisecond)}
+	slow := planLatency{execCount: 20, sumLatency: 20 * 3 * int64(time.Millisecond)}
+	require.True(t, isPinnedPlanRegressed(before, slow, 2))
+	require.False(t, isPinnedPlanRegressed(before, slow, 4))
+	// 0 disables the check.
+	require.False(t, isPinnedPlanRegressed(before, slow, 0))
+	// too few executions since pinned.
+	require.False(t, isPinnedPlanRegressed(before, planLatency{execCount: 1, sumLatency: int64(time.Second)}, 2))
+	// no executions before pinned.
+	require.False(t, isPinnedPlanRegressed(planLatency{}, slow, 2))
+}
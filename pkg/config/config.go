@@ -35,6 +35,7 @@ import (
 	zaplog "github.com/pingcap/log"
 	logbackupconf "github.com/pingcap/tidb/br/pkg/streamhelper/config"
 	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/util/encrypt"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/tiflashcompute"
 	"github.com/pingcap/tidb/pkg/util/tikvutil"
@@ -601,6 +602,12 @@ type Security struct {
 	SessionTokenSigningKey  string `toml:"session-token-signing-key" json:"session-token-signing-key"`
 	// If set to "plaintext", the spilled files will not be encrypted.
 	SpilledFileEncryptionMethod string `toml:"spilled-file-encryption-method" json:"spilled-file-encryption-method"`
+	// IngestFileEncryptionMethod is the method to encrypt the sorted KV files written by the ingest of
+	// the local backend, "plaintext" means they're not encrypted.
+	IngestFileEncryptionMethod string `toml:"ingest-file-encryption-method" json:"ingest-file-encryption-method"`
+	// IngestFileEncryptionMasterKeyPath is the path of the file which contains the hex encoded master key
+	// of IngestFileEncryptionMethod.
+	IngestFileEncryptionMasterKeyPath string `toml:"ingest-file-encryption-master-key-path" json:"ingest-file-encryption-master-key-path"`
 	// EnableSEM prevents SUPER users from having full access.
 	EnableSEM bool `toml:"enable-sem" json:"enable-sem"`
 	// Allow automatic TLS certificate generation
@@ -1060,6 +1067,7 @@ var defaultConf = Config{
 	EnableGlobalIndex:          false,
 	Security: Security{
 		SpilledFileEncryptionMethod: SpilledFileEncryptionMethodPlaintext,
+		IngestFileEncryptionMethod:  encrypt.MethodPlaintext,
 		EnableSEM:                   false,
 		AutoTLS:                     false,
 		RSAKeySize:                  4096,
@@ -1393,6 +1401,10 @@ func (c *Config) Valid() error {
 		return fmt.Errorf("unsupported [security]spilled-file-encryption-method %v, TiDB only supports [%v, %v]",
 			c.Security.SpilledFileEncryptionMethod, SpilledFileEncryptionMethodPlaintext, SpilledFileEncryptionMethodAES128CTR)
 	}
+	c.Security.IngestFileEncryptionMethod = strings.ToLower(c.Security.IngestFileEncryptionMethod)
+	if _, err := encrypt.LoadMasterKey(c.Security.IngestFileEncryptionMethod, c.Security.IngestFileEncryptionMasterKeyPath); err != nil {
+		return fmt.Errorf("invalid [security]ingest-file-encryption-method: %v", err)
+	}
 
 	// check stats load config
 	if c.Performance.StatsLoadConcurrency < DefStatsLoadConcurrencyLimit || c.Performance.StatsLoadConcurrency > DefMaxOfStatsLoadConcurrencyLimit {
@@ -147,6 +147,7 @@ func NewAddIndexIngestPipeline(
 	sessPool opSessPool,
 	backendCtx ingest.BackendCtx,
 	engines []ingest.Engine,
+	checkpoint *ingest.SubtaskCheckpoint,
 	sessCtx sessionctx.Context,
 	jobID int64,
 	tbl table.PhysicalTable,
@@ -176,9 +177,9 @@ func NewAddIndexIngestPipeline(
 	readerCnt, writerCnt := expectedIngestWorkerCnt(concurrency, avgRowSize)
 
 	srcOp := NewTableScanTaskSource(ctx, store, tbl, startKey, endKey)
-	scanOp := NewTableScanOperator(ctx, sessPool, copCtx, srcChkPool, readerCnt)
+	scanOp := NewTableScanOperator(ctx, sessPool, copCtx, srcChkPool, readerCnt, checkpoint)
 	ingestOp := NewIndexIngestOperator(ctx, copCtx, sessPool, tbl, indexes, engines, srcChkPool, writerCnt, reorgMeta)
-	sinkOp := newIndexWriteResultSink(ctx, backendCtx, tbl, indexes, totalRowCount, metricCounter)
+	sinkOp := newIndexWriteResultSink(ctx, backendCtx, checkpoint, tbl, indexes, totalRowCount, metricCounter)
 
 	operator.Compose[TableScanTask](srcOp, scanOp)
 	operator.Compose[IndexRecordChunk](scanOp, ingestOp)
@@ -244,10 +245,10 @@ func NewWriteIndexToExternalStoragePipeline(
 	}
 
 	srcOp := NewTableScanTaskSource(ctx, store, tbl, startKey, endKey)
-	scanOp := NewTableScanOperator(ctx, sessPool, copCtx, srcChkPool, readerCnt)
+	scanOp := NewTableScanOperator(ctx, sessPool, copCtx, srcChkPool, readerCnt, nil)
 	writeOp := NewWriteExternalStoreOperator(
 		ctx, copCtx, sessPool, jobID, subtaskID, tbl, indexes, extStore, srcChkPool, writerCnt, onClose, memSize, reorgMeta)
-	sinkOp := newIndexWriteResultSink(ctx, nil, tbl, indexes, totalRowCount, metricCounter)
+	sinkOp := newIndexWriteResultSink(ctx, nil, nil, tbl, indexes, totalRowCount, metricCounter)
 
 	operator.Compose[TableScanTask](srcOp, scanOp)
 	operator.Compose[IndexRecordChunk](scanOp, writeOp)
@@ -406,12 +407,14 @@ type TableScanOperator struct {
 }
 
 // NewTableScanOperator creates a new TableScanOperator.
+// If checkpoint is not nil, the scanned tasks and rows are recorded in it.
 func NewTableScanOperator(
 	ctx *OperatorCtx,
 	sessPool opSessPool,
 	copCtx copr.CopContext,
 	srcChkPool chan *chunk.Chunk,
 	concurrency int,
+	checkpoint *ingest.SubtaskCheckpoint,
 ) *TableScanOperator {
 	pool := workerpool.NewWorkerPool(
 		"TableScanOperator",
@@ -424,6 +427,7 @@ func NewTableScanOperator(
 				sessPool:   sessPool,
 				se:         nil,
 				srcChkPool: srcChkPool,
+				checkpoint: checkpoint,
 			}
 		})
 	return &TableScanOperator{
@@ -437,6 +441,7 @@ type tableScanWorker struct {
 	sessPool   opSessPool
 	se         *session.Session
 	srcChkPool chan *chunk.Chunk
+	checkpoint *ingest.SubtaskCheckpoint
 }
 
 func (w *tableScanWorker) HandleTask(task TableScanTask, sender func(IndexRecordChunk)) {
@@ -472,6 +477,9 @@ func (w *tableScanWorker) scanRecords(task TableScanTask, sender func(IndexRecor
 	logutil.Logger(w.ctx).Info("start a table scan task",
 		zap.Int("id", task.ID), zap.String("task", task.String()))
 
+	if w.checkpoint != nil {
+		w.checkpoint.Register(task.ID, task.End)
+	}
 	var idxResult IndexRecordChunk
 	err := wrapInBeginRollback(w.se, func(startTS uint64) error {
 		failpoint.Inject("mockScanRecordError", func(_ failpoint.Value) {
@@ -493,6 +501,9 @@ func (w *tableScanWorker) scanRecords(task TableScanTask, sender func(IndexRecor
 				terror.Call(rs.Close)
 				return err
 			}
+			if w.checkpoint != nil {
+				w.checkpoint.UpdateTotal(task.ID, srcChk.NumRows(), done)
+			}
 			idxResult = IndexRecordChunk{ID: task.ID, Chunk: srcChk, Done: done}
 			sender(idxResult)
 		}
@@ -739,6 +750,7 @@ func (w *indexIngestWorker) WriteLocal(rs *IndexRecordChunk) (count int, nextKey
 type indexWriteResultSink struct {
 	ctx        *OperatorCtx
 	backendCtx ingest.BackendCtx
+	checkpoint *ingest.SubtaskCheckpoint
 	tbl        table.PhysicalTable
 	indexes    []table.Index
 
@@ -752,6 +764,7 @@ type indexWriteResultSink struct {
 func newIndexWriteResultSink(
 	ctx *OperatorCtx,
 	backendCtx ingest.BackendCtx,
+	checkpoint *ingest.SubtaskCheckpoint,
 	tbl table.PhysicalTable,
 	indexes []table.Index,
 	rowCount *atomic.Int64,
@@ -760,6 +773,7 @@ func newIndexWriteResultSink(
 	return &indexWriteResultSink{
 		ctx:           ctx,
 		backendCtx:    backendCtx,
+		checkpoint:    checkpoint,
 		tbl:           tbl,
 		indexes:       indexes,
 		rowCount:      rowCount,
@@ -781,6 +795,13 @@ func (s *indexWriteResultSink) collectResult() error {
 	for {
 		select {
 		case <-s.ctx.Done():
+			// Keep the rows written so far, e.g. the task is paused, then the subtask
+			// continues from the checkpoint when it's resumed on this instance.
+			err := s.syncCheckpoint(ingest.FlushModeForceLocal)
+			if err != nil {
+				logutil.Logger(s.ctx).Warn("sync subtask checkpoint failed",
+					zap.String("category", "ddl"), zap.Error(err))
+			}
 			return s.ctx.Err()
 		case result, ok := <-s.source.Channel():
 			if !ok {
@@ -794,6 +815,14 @@ func (s *indexWriteResultSink) collectResult() error {
 			if s.metricCounter != nil {
 				s.metricCounter.Add(float64(result.Added))
 			}
+			if s.checkpoint != nil {
+				s.checkpoint.UpdateCurrent(result.ID, result.Added)
+				err := s.syncCheckpoint(ingest.FlushModeAuto)
+				if err != nil {
+					s.ctx.onError(err)
+					return err
+				}
+			}
 		}
 	}
 }
@@ -818,6 +847,31 @@ func (s *indexWriteResultSink) flush() error {
 			return err
 		}
 	}
+	if s.checkpoint != nil {
+		s.checkpoint.Sync()
+	}
+	return nil
+}
+
+// syncCheckpoint flushes the local engines and moves the subtask checkpoint forward.
+func (s *indexWriteResultSink) syncCheckpoint(mode ingest.FlushMode) error {
+	if s.checkpoint == nil {
+		return nil
+	}
+	for _, index := range s.indexes {
+		flushed, _, err := s.backendCtx.Flush(index.Meta().ID, mode)
+		if err != nil {
+			s.checkpoint.Reset()
+			return err
+		}
+		if !flushed {
+			return nil
+		}
+		// Once an engine is flushed, flush the others as well, so that the rows
+		// covered by the checkpoint are not in the memory of any writer.
+		mode = ingest.FlushModeForceLocalAndCheckDiskQuota
+	}
+	s.checkpoint.Sync()
 	return nil
 }
 
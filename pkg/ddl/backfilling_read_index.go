@@ -39,6 +39,8 @@ import (
 
 type readIndexExecutor struct {
 	execute.StepExecFrameworkInfo
+	// taskCtx is the context of the task executor, it's cancelled when the task is paused.
+	taskCtx context.Context
 	d       *ddl
 	job     *model.Job
 	indexes []*model.IndexInfo
@@ -60,6 +62,7 @@ type readIndexSummary struct {
 }
 
 func newReadIndexExecutor(
+	taskCtx context.Context,
 	d *ddl,
 	job *model.Job,
 	indexes []*model.IndexInfo,
@@ -74,6 +77,7 @@ func newReadIndexExecutor(
 		return nil, err
 	}
 	return &readIndexExecutor{
+		taskCtx:         taskCtx,
 		d:               d,
 		job:             job,
 		indexes:         indexes,
@@ -120,7 +124,7 @@ func (r *readIndexExecutor) RunSubtask(ctx context.Context, subtask *proto.Subta
 	if len(r.cloudStorageURI) > 0 {
 		pipe, err = r.buildExternalStorePipeline(opCtx, subtask.ID, sessCtx, sm, subtask.Concurrency)
 	} else {
-		pipe, err = r.buildLocalStorePipeline(opCtx, sessCtx, sm, subtask.Concurrency)
+		pipe, err = r.buildLocalStorePipeline(opCtx, subtask.ID, sessCtx, sm, subtask.Concurrency)
 	}
 	if err != nil {
 		return err
@@ -151,6 +155,14 @@ func (r *readIndexExecutor) RealtimeSummary() *execute.SubtaskSummary {
 func (r *readIndexExecutor) Cleanup(ctx context.Context) error {
 	logutil.Logger(ctx).Info("read index executor cleanup subtask exec env",
 		zap.String("category", "ddl"))
+	if r.taskCtx.Err() != nil && len(r.cloudStorageURI) == 0 {
+		// The task executor is cancelled, e.g. the task is paused. Keep the backend
+		// context, so that the local engines and the subtask checkpoints are reused
+		// when the task is resumed on this instance.
+		r.bc.ResetWorkers(r.job.ID)
+		ingest.LitBackCtxMgr.Pause(r.job.ID)
+		return nil
+	}
 	// cleanup backend context
 	ingest.LitBackCtxMgr.Unregister(r.job.ID)
 	return nil
@@ -220,6 +232,7 @@ func (r *readIndexExecutor) getTableStartEndKey(sm *BackfillSubTaskMeta) (
 
 func (r *readIndexExecutor) buildLocalStorePipeline(
 	opCtx *OperatorCtx,
+	subtaskID int64,
 	sessCtx sessionctx.Context,
 	sm *BackfillSubTaskMeta,
 	concurrency int,
@@ -228,6 +241,17 @@ func (r *readIndexExecutor) buildLocalStorePipeline(
 	if err != nil {
 		return nil, err
 	}
+	checkpoint := r.bc.SubtaskCheckpoint(subtaskID)
+	checkpoint.ResetTasks()
+	if cnt, next := checkpoint.Status(); len(next) > 0 && next.Cmp(start) > 0 {
+		logutil.Logger(opCtx).Info("resume subtask from checkpoint",
+			zap.Int64("subtask ID", subtaskID),
+			zap.String("start", hex.EncodeToString(start)),
+			zap.String("checkpoint", hex.EncodeToString(next)),
+			zap.Int("row count", cnt))
+		start = next
+		r.curRowCount.Store(int64(cnt))
+	}
 	d := r.d
 	engines := make([]ingest.Engine, 0, len(r.indexes))
 	for _, index := range r.indexes {
@@ -247,6 +271,7 @@ func (r *readIndexExecutor) buildLocalStorePipeline(
 		d.sessPool,
 		r.bc,
 		engines,
+		checkpoint,
 		sessCtx,
 		r.job.ID,
 		tbl,
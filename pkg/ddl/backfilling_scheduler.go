@@ -213,8 +213,8 @@ func restoreSessCtx(sessCtx sessionctx.Context) func(sessCtx sessionctx.Context)
 	}
 }
 
-func (*txnBackfillScheduler) expectedWorkerSize() (size int) {
-	workerCnt := int(variable.GetDDLReorgWorkerCounter())
+func (b *txnBackfillScheduler) expectedWorkerSize() (size int) {
+	workerCnt := b.reorgInfo.d.getReorgWorkerCnt(b.reorgInfo.Job.ID)
 	return min(workerCnt, maxBackfillWorkerSize)
 }
 
@@ -377,6 +377,7 @@ func (b *ingestBackfillScheduler) setupWorkers() error {
 	b.writerPool = writerPool
 	b.copReqSenderPool.chunkSender = writerPool
 	b.copReqSenderPool.adjustSize(readerCnt)
+	b.adjustWriteSpeedLimit()
 	logutil.Logger(b.ctx).Info("setup ingest backfill workers",
 		zap.Int64("jobID", job.ID), zap.Int("reader", readerCnt), zap.Int("writer", writerCnt))
 	return nil
@@ -440,6 +441,7 @@ func (b *ingestBackfillScheduler) adjustWorkerSize() error {
 	readerCnt, writer := b.expectedWorkerSize()
 	b.writerPool.Tune(int32(writer))
 	b.copReqSenderPool.adjustSize(readerCnt)
+	b.adjustWriteSpeedLimit()
 	return nil
 }
 
@@ -515,7 +517,17 @@ func (b *ingestBackfillScheduler) createCopReqSenderPool() (*copReqSenderPool, e
 }
 
 func (b *ingestBackfillScheduler) expectedWorkerSize() (readerSize int, writerSize int) {
-	return expectedIngestWorkerCnt(int(variable.GetDDLReorgWorkerCounter()), b.avgRowSize)
+	return expectedIngestWorkerCnt(b.reorgInfo.d.getReorgWorkerCnt(b.reorgInfo.Job.ID), b.avgRowSize)
+}
+
+// adjustWriteSpeedLimit applies the max write speed of the job to the local backend.
+func (b *ingestBackfillScheduler) adjustWriteSpeedLimit() {
+	if b.backendCtx == nil {
+		return
+	}
+	if backend := b.backendCtx.GetLocalBackend(); backend != nil {
+		backend.UpdateWriteSpeedLimit(b.reorgInfo.d.getReorgMaxWriteSpeed(b.reorgInfo.Job.ID))
+	}
 }
 
 func expectedIngestWorkerCnt(concurrency, avgRowSize int) (readerCnt, writerCnt int) {
@@ -809,6 +809,22 @@ func TestReportingMinStartTimestamp(t *testing.T) {
 	infoSyncer.SetSessionManager(sm)
 	infoSyncer.ReportMinStartTS(dom.Store())
 	require.Equal(t, validTS, infoSyncer.GetMinStartTS())
+
+	// the snapshots of the running statements are protected longer by tidb_gc_query_protect_time.
+	defer func(protectTime int64) {
+		variable.GCQueryProtectTime.Store(protectTime)
+	}(variable.GCQueryProtectTime.Load())
+	variable.GCQueryProtectTime.Store(variable.GCMaxWaitTime.Load() * 2)
+	queryTS := oracle.GoTimeToTS(now.Add(-time.Duration(variable.GCMaxWaitTime.Load()) * time.Second * 3 / 2))
+	sm.PS = []*util.ProcessInfo{
+		{CurTxnStartTS: validTS},
+		{CurTxnStartTS: queryTS - 1, Command: mysql.ComSleep},
+	}
+	infoSyncer.ReportMinStartTS(dom.Store())
+	require.Equal(t, validTS, infoSyncer.GetMinStartTS())
+	sm.PS = append(sm.PS, &util.ProcessInfo{CurTxnStartTS: queryTS, Command: mysql.ComQuery, Info: "select * from t"})
+	infoSyncer.ReportMinStartTS(dom.Store())
+	require.Equal(t, queryTS, infoSyncer.GetMinStartTS())
 }
 
 // for issue #34931
@@ -62,6 +62,7 @@ import (
 	tidbutil "github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/dbterror"
 	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/faultinject"
 	"github.com/pingcap/tidb/pkg/util/gcutil"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/syncutil"
@@ -197,6 +198,7 @@ type DDL interface {
 	AlterResourceGroup(ctx sessionctx.Context, stmt *ast.AlterResourceGroupStmt) error
 	DropResourceGroup(ctx sessionctx.Context, stmt *ast.DropResourceGroupStmt) error
 	FlashbackCluster(ctx sessionctx.Context, flashbackTS uint64) error
+	ReorganizeIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CIStr) error
 
 	// CreateSchemaWithInfo creates a database (schema) given its database info.
 	//
@@ -559,6 +561,15 @@ func (dc *ddlCtx) jobContext(jobID int64, reorgMeta *model.DDLReorgMeta) *JobCon
 	if reorgMeta != nil && len(ctx.resourceGroupName) == 0 {
 		ctx.resourceGroupName = reorgMeta.ResourceGroupName
 	}
+	// The job keeps staging the sorted KV on the cloud storage it started with
+	// when it's taken over by a new owner. The jobs submitted by the old versions
+	// don't keep the URI, the global one is used for them.
+	if reorgMeta != nil && reorgMeta.UseCloudStorage && len(ctx.cloudStorageURI) == 0 {
+		ctx.cloudStorageURI = reorgMeta.CloudStorageURI
+		if len(ctx.cloudStorageURI) == 0 {
+			ctx.cloudStorageURI = variable.CloudStorageURI.Load()
+		}
+	}
 	return ctx
 }
 
@@ -1056,7 +1067,7 @@ func getIntervalFromPolicy(policy []time.Duration, i int) (time.Duration, bool)
 func getJobCheckInterval(job *model.Job, i int) (time.Duration, bool) {
 	switch job.Type {
 	case model.ActionAddIndex, model.ActionAddPrimaryKey, model.ActionModifyColumn,
-		model.ActionReorganizePartition,
+		model.ActionReorganizeIndex, model.ActionReorganizePartition,
 		model.ActionRemovePartitioning,
 		model.ActionAlterTablePartitioning:
 		return getIntervalFromPolicy(slowDDLIntervalPolicy, i)
@@ -1153,6 +1164,9 @@ func (d *ddl) DoDDLJob(ctx sessionctx.Context, job *model.Job) error {
 		// Instead, we merge all the jobs into one pending job.
 		return appendToSubJobs(mci, job)
 	}
+	if err := ctx.GetSessionVars().InjectedFaults.Eval(d.ctx, faultinject.DDLBeforeSubmitJob); err != nil {
+		return errors.Trace(err)
+	}
 	// Get a global job ID and put the DDL job in the queue.
 	setDDLJobQuery(ctx, job)
 	setDDLJobMode(job)
@@ -1729,6 +1743,28 @@ func resumePausedJob(_ *sess.Session, job *model.Job,
 	return nil
 }
 
+// alterRunningJobReorgMeta check and change the reorg parameters of the running job.
+func alterRunningJobReorgMeta(job *model.Job, alter func(*model.Job)) error {
+	switch job.Type {
+	case model.ActionAddIndex, model.ActionAddPrimaryKey, model.ActionReorganizeIndex:
+	default:
+		return dbterror.ErrCannotAlterDDLJob.GenWithStackByArgs(job.ID, fmt.Sprintf("unsupported job type [%s]", job.Type))
+	}
+	if job.IsDone() || job.IsSynced() || job.IsCancelling() || job.IsCancelled() ||
+		job.IsRollingback() || job.IsRollbackDone() {
+		errMsg := fmt.Sprintf("state [%s] or schema state [%s]", job.State.String(), job.SchemaState.String())
+		return dbterror.ErrCannotAlterDDLJob.GenWithStackByArgs(job.ID, errMsg)
+	}
+	if job.ReorgMeta == nil {
+		return dbterror.ErrCannotAlterDDLJob.GenWithStackByArgs(job.ID, "missing reorg meta")
+	}
+	if job.ReorgMeta.IsDistReorg {
+		return dbterror.ErrCannotAlterDDLJob.GenWithStackByArgs(job.ID, "the job is running with the distributed framework")
+	}
+	alter(job)
+	return nil
+}
+
 // processJobs command on the Job according to the process
 func processJobs(process func(*sess.Session, *model.Job, model.AdminCommandOperator) (err error),
 	sessCtx sessionctx.Context,
@@ -1825,6 +1861,16 @@ func ResumeJobs(se sessionctx.Context, ids []int64) ([]error, error) {
 	return processJobs(resumePausedJob, se, ids, model.AdminCommandByEndUser)
 }
 
+// AlterJobsReorgMeta changes the reorg parameters of the running DDL jobs according to user command.
+// The reorg workers pick up the new parameters in the next round of the job without restarting it.
+// alter changes the reorg meta or the priority of the job, the priority decides the order the queued
+// reorg jobs are picked, see sortReorgJobCandidates.
+func AlterJobsReorgMeta(se sessionctx.Context, ids []int64, alter func(*model.Job)) ([]error, error) {
+	return processJobs(func(_ *sess.Session, job *model.Job, _ model.AdminCommandOperator) error {
+		return alterRunningJobReorgMeta(job, alter)
+	}, se, ids, model.AdminCommandByEndUser)
+}
+
 // CancelJobsBySystem cancels Jobs because of internal reasons.
 func CancelJobsBySystem(se sessionctx.Context, ids []int64) (errs []error, err error) {
 	return processJobs(cancelRunningJob, se, ids, model.AdminCommandBySystem)
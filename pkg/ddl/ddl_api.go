@@ -2811,6 +2811,7 @@ func (d *ddl) createTableWithInfoJob(
 
 func (d *ddl) createTableWithInfoPost(
 	ctx sessionctx.Context,
+	dbName model.CIStr,
 	tbInfo *model.TableInfo,
 	schemaID int64,
 ) error {
@@ -2819,7 +2820,7 @@ func (d *ddl) createTableWithInfoPost(
 	if pi := tbInfo.GetPartitionInfo(); pi != nil {
 		partitions = pi.Definitions
 	}
-	preSplitAndScatter(ctx, d.store, tbInfo, partitions)
+	preSplitAndScatter(ctx, d.store, dbName.L, tbInfo, partitions)
 	if tbInfo.AutoIncID > 1 {
 		// Default tableAutoIncID base is 0.
 		// If the first ID is expected to greater than 1, we need to do rebase.
@@ -2873,7 +2874,7 @@ func (d *ddl) CreateTableWithInfo(
 			err = nil
 		}
 	} else {
-		err = d.createTableWithInfoPost(ctx, tbInfo, job.SchemaID)
+		err = d.createTableWithInfoPost(ctx, dbName, tbInfo, job.SchemaID)
 	}
 
 	err = d.callHookOnChanged(job, err)
@@ -2988,7 +2989,7 @@ func (d *ddl) BatchCreateTableWithInfo(ctx sessionctx.Context,
 	}
 
 	for j := range args {
-		if err = d.createTableWithInfoPost(ctx, args[j], jobs.SchemaID); err != nil {
+		if err = d.createTableWithInfoPost(ctx, dbName, args[j], jobs.SchemaID); err != nil {
 			return errors.Trace(d.callHookOnChanged(jobs, err))
 		}
 	}
@@ -3098,7 +3099,8 @@ func (d *ddl) CreatePlacementPolicyWithInfo(ctx sessionctx.Context, policy *mode
 
 // preSplitAndScatter performs pre-split and scatter of the table's regions.
 // If `pi` is not nil, will only split region for `pi`, this is used when add partition.
-func preSplitAndScatter(ctx sessionctx.Context, store kv.Storage, tbInfo *model.TableInfo, parts []model.PartitionDefinition) {
+// The regions are split and scattered under the scheduling of the table in tidb_table_region_schedule.
+func preSplitAndScatter(ctx sessionctx.Context, store kv.Storage, schema string, tbInfo *model.TableInfo, parts []model.PartitionDefinition) {
 	if tbInfo.TempTableType != model.TempTableNone {
 		return
 	}
@@ -3116,12 +3118,13 @@ func preSplitAndScatter(ctx sessionctx.Context, store kv.Storage, tbInfo *model.
 	} else {
 		scatterRegion = variable.TiDBOptOn(val)
 	}
+	splitter := NewRegionSplitter(sp, schema, tbInfo, scatterRegion)
 	if len(parts) > 0 {
-		preSplit = func() { splitPartitionTableRegion(ctx, sp, tbInfo, parts, scatterRegion) }
+		preSplit = func() { splitPartitionTableRegion(ctx, splitter, tbInfo, parts) }
 	} else {
-		preSplit = func() { splitTableRegion(ctx, sp, tbInfo, scatterRegion) }
+		preSplit = func() { splitTableRegion(ctx, splitter, tbInfo) }
 	}
-	if scatterRegion {
+	if splitter.Scatter() {
 		preSplit()
 	} else {
 		go preSplit()
@@ -3821,6 +3824,18 @@ func isMultiSchemaChanges(specs []*ast.AlterTableSpec) bool {
 	return false
 }
 
+// isAddSecondaryIndexSpec checks whether the spec adds a non-primary index, which is allowed on the cached tables.
+func isAddSecondaryIndexSpec(spec *ast.AlterTableSpec) bool {
+	if spec.Tp != ast.AlterTableAddConstraint {
+		return false
+	}
+	switch spec.Constraint.Tp {
+	case ast.ConstraintKey, ast.ConstraintIndex, ast.ConstraintUniq, ast.ConstraintUniqIndex, ast.ConstraintUniqKey:
+		return true
+	}
+	return false
+}
+
 func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast.AlterTableStmt) (err error) {
 	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
 	validSpecs, err := ResolveAlterTableSpec(sctx, stmt.Specs)
@@ -3840,7 +3855,7 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 		if len(validSpecs) != 1 {
 			return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Alter Table")
 		}
-		if validSpecs[0].Tp != ast.AlterTableCache && validSpecs[0].Tp != ast.AlterTableNoCache {
+		if validSpecs[0].Tp != ast.AlterTableCache && validSpecs[0].Tp != ast.AlterTableNoCache && !isAddSecondaryIndexSpec(validSpecs[0]) {
 			return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Alter Table")
 		}
 	}
@@ -7592,9 +7607,6 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		return errors.Trace(err)
 	}
 
-	if t.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
-		return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Create Index"))
-	}
 	// Deal with anonymous index.
 	if len(indexName.L) == 0 {
 		colName := model.NewCIStr("expression_index")
@@ -7715,6 +7727,7 @@ func newReorgMetaFromVariables(job *model.Job, sctx sessionctx.Context) (*model.
 	reorgMeta := NewDDLReorgMeta(sctx)
 	reorgMeta.IsDistReorg = variable.EnableDistTask.Load()
 	reorgMeta.IsFastReorg = variable.EnableFastReorg.Load()
+	reorgMeta.PreSplitRegions = sctx.GetSessionVars().DDLIndexPreSplitRegions
 	if reorgMeta.IsDistReorg && !reorgMeta.IsFastReorg {
 		return nil, dbterror.ErrUnsupportedDistTask
 	}
@@ -8021,6 +8034,57 @@ func (d *ddl) dropIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CI
 	return errors.Trace(err)
 }
 
+// ReorganizeIndex rebuilds the index by the backfill process of adding index, and replaces the old index with the new one.
+// It reclaims the space of the index after mass updates and deletes.
+func (d *ddl) ReorganizeIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CIStr) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := t.Meta()
+	if tblInfo.IsView() || tblInfo.IsSequence() {
+		return dbterror.ErrWrongObject.GenWithStackByArgs(ti.Schema, ti.Name, "BASE TABLE")
+	}
+	if tblInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrOptOnTemporaryTable.GenWithStackByArgs("reorganize index")
+	}
+	if tblInfo.TableCacheStatusType != model.TableCacheStatusDisable {
+		return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Reorganize Index"))
+	}
+	indexInfo := tblInfo.FindIndexByName(indexName.L)
+	if indexInfo == nil || indexInfo.State != model.StatePublic {
+		return infoschema.ErrKeyNotExists.GenWithStackByArgs(indexName.O, tblInfo.Name.O)
+	}
+	if indexInfo.Primary {
+		return dbterror.ErrUnsupportedModifyPrimaryKey.GenWithStackByArgs("reorganize")
+	}
+
+	chs, coll := ctx.GetSessionVars().GetCharsetInfo()
+	job := &model.Job{
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionReorganizeIndex,
+		BinlogInfo:     &model.HistoryInfo{},
+		Args:           []any{indexInfo.Name},
+		Priority:       ctx.GetSessionVars().DDLReorgPriority,
+		Charset:        chs,
+		Collate:        coll,
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	reorgMeta, err := newReorgMetaFromVariables(job, ctx)
+	if err != nil {
+		return err
+	}
+	job.ReorgMeta = reorgMeta
+
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
 // CheckIsDropPrimaryKey checks if we will drop PK, there are many PK implementations so we provide a helper function.
 func CheckIsDropPrimaryKey(indexName model.CIStr, indexInfo *model.IndexInfo, t table.Table) (bool, error) {
 	var isPK bool
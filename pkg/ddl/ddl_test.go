@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/dbterror"
@@ -304,3 +305,43 @@ func TestCheckDuplicateConstraint(t *testing.T) {
 	err = checkDuplicateConstraint(constrNames, "u1", ast.ConstraintUniq)
 	require.EqualError(t, err, "[ddl:1061]Duplicate key name 'u1'")
 }
+
+func TestJobContextCloudStorageURI(t *testing.T) {
+	origin := variable.CloudStorageURI.Load()
+	t.Cleanup(func() {
+		variable.CloudStorageURI.Store(origin)
+	})
+	variable.CloudStorageURI.Store("s3://bucket/prefix")
+
+	dc := &ddlCtx{}
+	dc.jobCtx.jobCtxMap = make(map[int64]*JobContext)
+	// the job is taken over by a new owner after it has decided to use cloud storage,
+	// the URI kept in the job is used even if the global one is changed.
+	jc := dc.jobContext(1, &model.DDLReorgMeta{UseCloudStorage: true, CloudStorageURI: "s3://bucket/job"})
+	require.Equal(t, "s3://bucket/job", jc.cloudStorageURI)
+	// the job submitted by the old version doesn't keep the URI.
+	jc = dc.jobContext(2, &model.DDLReorgMeta{UseCloudStorage: true})
+	require.Equal(t, "s3://bucket/prefix", jc.cloudStorageURI)
+	jc = dc.jobContext(3, &model.DDLReorgMeta{})
+	require.Empty(t, jc.cloudStorageURI)
+}
+
+func TestSortReorgJobCandidates(t *testing.T) {
+	newCandidate := func(id int64, priority int, processing bool) jobCandidate {
+		return jobCandidate{job: &model.Job{ID: id, Priority: priority}, processing: processing}
+	}
+	candidates := []jobCandidate{
+		newCandidate(1, kv.PriorityLow, false),
+		newCandidate(2, kv.PriorityNormal, false),
+		newCandidate(3, kv.PriorityHigh, false),
+		newCandidate(4, kv.PriorityLow, true),
+		newCandidate(5, kv.PriorityNormal, false),
+		newCandidate(6, kv.PriorityHigh, false),
+	}
+	sortReorgJobCandidates(candidates)
+	ids := make([]int64, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.job.ID)
+	}
+	require.Equal(t, []int64{4, 3, 6, 2, 5, 1}, ids)
+}
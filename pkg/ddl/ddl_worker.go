@@ -669,7 +669,7 @@ func JobNeedGC(job *model.Job) bool {
 			model.ActionDropPrimaryKey,
 			model.ActionDropTablePartition, model.ActionTruncateTablePartition,
 			model.ActionDropColumn, model.ActionModifyColumn,
-			model.ActionAddIndex, model.ActionAddPrimaryKey,
+			model.ActionAddIndex, model.ActionAddPrimaryKey, model.ActionReorganizeIndex,
 			model.ActionReorganizePartition, model.ActionRemovePartitioning,
 			model.ActionAlterTablePartitioning:
 			return true
@@ -729,6 +729,10 @@ func (w *worker) finishDDLJob(t *meta.Meta, job *model.Job) (err error) {
 		}
 	}
 
+	// The URI may contain the credentials of the cloud storage.
+	if job.ReorgMeta != nil && len(job.ReorgMeta.CloudStorageURI) > 0 {
+		job.ReorgMeta.CloudStorageURI = ast.RedactURL(job.ReorgMeta.CloudStorageURI)
+	}
 	job.BinlogInfo.FinishedTS = t.StartTS
 	w.jobLogger(job).Info("finish DDL job", zap.String("job", job.String()))
 	updateRawArgs := true
@@ -744,7 +748,7 @@ func (w *worker) finishDDLJob(t *meta.Meta, job *model.Job) (err error) {
 }
 
 func markJobFinish(job *model.Job) {
-	if (job.Type == model.ActionAddIndex || job.Type == model.ActionAddPrimaryKey) &&
+	if (job.Type == model.ActionAddIndex || job.Type == model.ActionAddPrimaryKey || job.Type == model.ActionReorganizeIndex) &&
 		job.ReorgMeta != nil &&
 		job.ReorgMeta.IsFastReorg &&
 		ingest.LitBackCtxMgr != nil {
@@ -823,6 +827,7 @@ func (w *worker) unlockSeqNum(err error) {
 // DDLBackfillers contains the DDL need backfill step.
 var DDLBackfillers = map[model.ActionType]string{
 	model.ActionAddIndex:            "add_index",
+	model.ActionReorganizeIndex:     "reorganize_index",
 	model.ActionModifyColumn:        "modify_column",
 	model.ActionDropIndex:           "drop_index",
 	model.ActionReorganizePartition: "reorganize_partition",
@@ -1240,6 +1245,8 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = w.onCreateIndex(d, t, job, false)
 	case model.ActionAddPrimaryKey:
 		ver, err = w.onCreateIndex(d, t, job, true)
+	case model.ActionReorganizeIndex:
+		ver, err = w.onReorganizeIndex(d, t, job)
 	case model.ActionDropIndex, model.ActionDropPrimaryKey:
 		ver, err = onDropIndex(d, t, job)
 	case model.ActionRenameIndex:
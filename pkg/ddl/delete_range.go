@@ -354,6 +354,43 @@ func insertJobIntoDeleteRangeTable(ctx context.Context, wrapper DelRangeExecWrap
 				}
 			}
 		}
+	// ActionReorganizeIndex deletes the temporary index of the new index, and the old index if the job is done.
+	// The new index is deleted too if the job is rolled back.
+	case model.ActionReorganizeIndex:
+		var allIndexIDs []int64
+		var ifExists []bool
+		var partitionIDs []int64
+		var oldIndexID int64
+		var oldPhysicalIDs []int64
+		if err := job.DecodeArgs(&allIndexIDs, &ifExists, &partitionIDs, &oldIndexID, &oldPhysicalIDs); err != nil {
+			return errors.Trace(err)
+		}
+		physicalIDs := []int64{job.TableID}
+		if len(partitionIDs) > 0 {
+			physicalIDs = partitionIDs
+		}
+		for _, indexID := range allIndexIDs {
+			indexIDs := []int64{tablecodec.TempIndexPrefix | indexID}
+			if job.State == model.JobStateRollbackDone {
+				indexIDs = append(indexIDs, indexID)
+			}
+			for _, pid := range physicalIDs {
+				if err := doBatchDeleteIndiceRange(ctx, wrapper, job.ID, pid, indexIDs, ea, "reorganize index: physical table ID(s)"); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+		if job.State == model.JobStateDone && oldIndexID != 0 {
+			oldIDs := []int64{job.TableID}
+			if len(oldPhysicalIDs) > 0 {
+				oldIDs = oldPhysicalIDs
+			}
+			for _, pid := range oldIDs {
+				if err := doBatchDeleteIndiceRange(ctx, wrapper, job.ID, pid, []int64{oldIndexID}, ea, "reorganize index: old index"); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
 	case model.ActionDropIndex, model.ActionDropPrimaryKey:
 		tableID := job.TableID
 		var indexName any
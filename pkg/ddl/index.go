@@ -556,7 +556,7 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
-	if tblInfo.TableCacheStatusType != model.TableCacheStatusDisable {
+	if tblInfo.TableCacheStatusType != model.TableCacheStatusDisable && (isPK || job.MultiSchemaInfo != nil) {
 		return ver, errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Create Index"))
 	}
 
@@ -678,6 +678,12 @@ SwitchIndexState:
 			indexInfo.State = model.StateDeleteOnly
 			moveAndUpdateHiddenColumnsToPublic(tblInfo, indexInfo)
 		}
+		if tblInfo.TableCacheStatusType == model.TableCacheStatusEnable {
+			// The backfill writes the index records without holding the write lock of the cached table,
+			// stop serving the reads from the table cache until the index is public, so that no one can
+			// read the incomplete index from the cache. The table cache is reloaded after it's enabled again.
+			tblInfo.TableCacheStatusType = model.TableCacheStatusSwitching
+		}
 		ver, err = updateVersionAndTableInfoWithCheck(d, t, job, tblInfo, originalState != model.StateDeleteOnly)
 		if err != nil {
 			return ver, err
@@ -712,6 +718,9 @@ SwitchIndexState:
 		if err != nil {
 			return ver, err
 		}
+		if job.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge && job.ReorgMeta.PreSplitRegions > 0 {
+			preSplitIndexRegionsByHistogram(w.ctx, d, job.SchemaName, tblInfo, allIndexInfos, job.ReorgMeta.PreSplitRegions)
+		}
 		// Initialize SnapshotVer to 0 for later reorganization check.
 		job.SnapshotVer = 0
 		job.SchemaState = model.StateWriteReorganization
@@ -742,6 +751,7 @@ SwitchIndexState:
 			}
 			indexInfo.State = model.StatePublic
 		}
+		restoreTableCacheStatus(tblInfo)
 
 		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != model.StatePublic)
 		if err != nil {
@@ -799,18 +809,39 @@ func pickBackfillType(ctx context.Context, job *model.Job) (model.ReorgType, err
 			job.ReorgMeta.ReorgTp = model.ReorgTypeLitMerge
 			return model.ReorgTypeLitMerge, nil
 		}
+		recordIngestFallback(job, "the ingest backend is unavailable")
+	} else if config.GetGlobalConfig().Store == "tikv" {
+		// Ingest is only supported by TiKV, the other stores don't fall back from it.
+		recordIngestFallback(job, "the lightning environment is not initialized")
 	}
 	// The lightning environment is unavailable, but we can still use the txn-merge backfill.
 	logutil.BgLogger().Info("fallback to txn-merge backfill process", zap.String("category", "ddl"),
-		zap.Bool("lightning env initialized", ingest.LitInitialized))
+		zap.Bool("lightning env initialized", ingest.LitInitialized),
+		zap.String("reason", job.ReorgMeta.IngestFallbackReason))
 	job.ReorgMeta.ReorgTp = model.ReorgTypeTxnMerge
 	return model.ReorgTypeTxnMerge, nil
 }
 
+// recordIngestFallback records the reason why the job falls back to the txn-merge backfill,
+// it's shown in information_schema.ddl_jobs and returned as a warning after the job is done.
+func recordIngestFallback(job *model.Job, reason string) {
+	job.ReorgMeta.IngestFallbackReason = reason
+	//nolint:forcetypeassert
+	warn := dbterror.ErrIngestFallback.FastGenByArgs(reason).(*terror.Error)
+	warnings, warningsCount := job.GetWarnings()
+	if warnings == nil || warningsCount == nil {
+		warnings, warningsCount = make(map[errors.ErrorID]*terror.Error), make(map[errors.ErrorID]int64)
+	}
+	warnings[warn.ID()] = warn
+	warningsCount[warn.ID()] = 1
+	job.SetWarnings(warnings, warningsCount)
+}
+
 func loadCloudStorageURI(w *worker, job *model.Job) {
 	jc := w.jobContext(job.ID, job.ReorgMeta)
 	jc.cloudStorageURI = variable.CloudStorageURI.Load()
 	job.ReorgMeta.UseCloudStorage = len(jc.cloudStorageURI) > 0
+	job.ReorgMeta.CloudStorageURI = jc.cloudStorageURI
 }
 
 // cleanupSortPath is used to clean up the temp data of the previous jobs.
@@ -859,8 +890,8 @@ func cleanupSortPath(ctx context.Context, currentJobID int64) error {
 // IngestJobsNotExisted checks the ddl about `add index` with ingest method not existed.
 func IngestJobsNotExisted(ctx sessionctx.Context) bool {
 	se := sess.NewSession(ctx)
-	template := "select job_meta from mysql.tidb_ddl_job where reorg and (type = %d or type = %d) and processing;"
-	sql := fmt.Sprintf(template, model.ActionAddIndex, model.ActionAddPrimaryKey)
+	template := "select job_meta from mysql.tidb_ddl_job where reorg and (type = %d or type = %d or type = %d) and processing;"
+	sql := fmt.Sprintf(template, model.ActionAddIndex, model.ActionAddPrimaryKey, model.ActionReorganizeIndex)
 	rows, err := se.Execute(context.Background(), sql, "check-pitr")
 	if err != nil {
 		logutil.BgLogger().Warn("cannot check ingest job", zap.Error(err))
@@ -984,8 +1015,11 @@ func runIngestReorgJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 		//nolint:forcetypeassert
 		discovery = d.store.(tikv.Storage).GetRegionCache().PDClient().GetServiceDiscovery()
 	}
-	bc, err = ingest.LitBackCtxMgr.Register(ctx, job.ID, allIndexInfos[0].Unique, nil, discovery, job.ReorgMeta.ResourceGroupName)
+	bc, err = ingest.LitBackCtxMgr.Register(ctx, job.ID, allIndexInfos[0].Unique, nil, discovery, job.ReorgMeta.ResourceGroupName, job.Priority)
 	if err != nil {
+		if tryFallbackToTxnMerge(w, job, allIndexInfos, err) {
+			return false, ver, nil
+		}
 		ver, err = convertAddIdxJob2RollbackJob(d, t, job, tbl.Meta(), allIndexInfos, err)
 		return false, ver, errors.Trace(err)
 	}
@@ -1009,9 +1043,13 @@ func runIngestReorgJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 			}
 			if kv.ErrKeyExists.Equal(err) {
 				logutil.BgLogger().Warn("import index duplicate key, convert job to rollback", zap.String("category", "ddl"), zap.String("job", job.String()), zap.Error(err))
+				collectIndexConflicts(w, job, tbl.Meta(), []*model.IndexInfo{indexInfo})
 				ver, err = convertAddIdxJob2RollbackJob(d, t, job, tbl.Meta(), allIndexInfos, err)
 			} else {
 				logutil.BgLogger().Warn("lightning import error", zap.String("category", "ddl"), zap.Error(err))
+				if tryFallbackToTxnMerge(w, job, allIndexInfos, err) {
+					return false, ver, nil
+				}
 				if !errorIsRetryable(err, job) {
 					ver, err = convertAddIdxJob2RollbackJob(d, t, job, tbl.Meta(), allIndexInfos, err)
 				}
@@ -1023,6 +1061,63 @@ func runIngestReorgJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 	return true, ver, nil
 }
 
+// tryFallbackToTxnMerge switches the ingest backfill to the txn-merge backfill if the ingest keeps
+// failing, it returns true if the job is switched. The index records imported by ingest are kept,
+// and the txn-merge backfill continues from the global checkpoint of ingest.
+func tryFallbackToTxnMerge(w *worker, job *model.Job, allIndexInfos []*model.IndexInfo, err error) bool {
+	limit := variable.DDLIngestFallbackErrorCount.Load()
+	if limit <= 0 || job.ReorgMeta.ReorgTp != model.ReorgTypeLitMerge || job.ReorgMeta.IsDistReorg {
+		return false
+	}
+	if job.ErrorCount+1 < limit || kv.ErrKeyExists.Equal(err) || common.ErrFoundDuplicateKeys.Equal(err) ||
+		dbterror.ErrCancelledDDLJob.Equal(err) {
+		return false
+	}
+	logutil.BgLogger().Warn("ingest backfill keeps failing, fallback to txn-merge backfill process", zap.String("category", "ddl-ingest"),
+		zap.Int64("job ID", job.ID), zap.Int64("error count", job.ErrorCount), zap.Error(err))
+	ingest.LitBackCtxMgr.Unregister(job.ID)
+	if err := resetReorgHandleToIngestCheckpoint(w, job, allIndexInfos[0]); err != nil {
+		logutil.BgLogger().Warn("reset reorg handle to ingest checkpoint failed", zap.String("category", "ddl-ingest"),
+			zap.Int64("job ID", job.ID), zap.Error(err))
+		return false
+	}
+	recordIngestFallback(job, fmt.Sprintf("ingest keeps failing after %d retries: %s", job.ErrorCount+1, err.Error()))
+	job.ReorgMeta.ReorgTp = model.ReorgTypeTxnMerge
+	job.ReorgMeta.Progress = nil
+	return true
+}
+
+// resetReorgHandleToIngestCheckpoint resets the reorg handle to the range not imported by ingest yet.
+// The data written to the local engines but not imported is discarded, so these ranges must be backfilled again.
+func resetReorgHandleToIngestCheckpoint(w *worker, job *model.Job, indexInfo *model.IndexInfo) error {
+	sctx, err := w.sessPool.Get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer w.sessPool.Put(sctx)
+	return sess.NewSession(sctx).RunInTxn(func(se *sess.Session) error {
+		cp, err := getReorgCheckpoint(se, job)
+		if err != nil {
+			if meta.ErrDDLReorgElementNotExist.Equal(err) {
+				// The reorg is not started yet.
+				return nil
+			}
+			return errors.Trace(err)
+		}
+		if cp == nil || cp.PhysicalID == 0 {
+			// Nothing is known to be imported, backfill from the beginning.
+			job.SnapshotVer = 0
+			return nil
+		}
+		start := cp.StartKey
+		if len(cp.GlobalSyncKey) > 0 {
+			start = cp.GlobalSyncKey
+		}
+		element := &meta.Element{ID: indexInfo.ID, TypeKey: meta.IndexElementKey}
+		return updateDDLReorgHandle(se, job.ID, start, cp.EndKey, cp.PhysicalID, element)
+	})
+}
+
 func errorIsRetryable(err error, job *model.Job) bool {
 	if job.ErrorCount+1 >= variable.GetDDLErrorCountLimit() {
 		return false
@@ -1108,8 +1203,14 @@ func runReorgJobAndHandleErr(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
 			// TODO(tangenta): get duplicate column and match index.
 			err = convertToKeyExistsErr(err, allIndexInfos[0], tbl.Meta())
 		}
+		if !mergingTmpIdx && tryFallbackToTxnMerge(w, job, allIndexInfos, err) {
+			return false, ver, nil
+		}
 		if !errorIsRetryable(err, job) {
 			logutil.BgLogger().Warn("run add index job failed, convert job to rollback", zap.String("category", "ddl"), zap.String("job", job.String()), zap.Error(err))
+			if kv.ErrKeyExists.Equal(err) {
+				collectIndexConflicts(w, job, tbl.Meta(), allIndexInfos)
+			}
 			ver, err = convertAddIdxJob2RollbackJob(d, t, job, tbl.Meta(), allIndexInfos, err)
 			if err1 := rh.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
 				logutil.BgLogger().Warn("run add index job failed, convert job to rollback, RemoveDDLReorgHandle failed", zap.String("category", "ddl"), zap.String("job", job.String()), zap.Error(err1))
@@ -1135,7 +1236,7 @@ func onDropIndex(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
 		}
 		return ver, errors.Trace(err)
 	}
-	if tblInfo.TableCacheStatusType != model.TableCacheStatusDisable {
+	if tblInfo.TableCacheStatusType != model.TableCacheStatusDisable && !job.IsRollingback() {
 		return ver, errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Drop Index"))
 	}
 
@@ -1181,10 +1282,16 @@ func onDropIndex(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
 			indexInfo.State = model.StateNone
 			// Set column index flag.
 			DropIndexColumnFlag(tblInfo, indexInfo)
-			RemoveDependentHiddenColumns(tblInfo, indexInfo)
+			// The hidden columns are still used by the index being reorganized.
+			if job.Type != model.ActionReorganizeIndex {
+				RemoveDependentHiddenColumns(tblInfo, indexInfo)
+			}
 			removeIndexInfo(tblInfo, indexInfo)
 			idxIDs = append(idxIDs, indexInfo.ID)
 		}
+		if job.IsRollingback() {
+			restoreTableCacheStatus(tblInfo)
+		}
 
 		failpoint.Inject("mockExceedErrorLimit", func(val failpoint.Value) {
 			//nolint:forcetypeassert
@@ -1221,6 +1328,14 @@ func onDropIndex(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
 	return ver, errors.Trace(err)
 }
 
+// restoreTableCacheStatus enables the table cache again if it's switched off by adding index.
+// The DDL jobs on the same table are executed one by one, so the switching state must be set by the current job.
+func restoreTableCacheStatus(tblInfo *model.TableInfo) {
+	if tblInfo.TableCacheStatusType == model.TableCacheStatusSwitching {
+		tblInfo.TableCacheStatusType = model.TableCacheStatusEnable
+	}
+}
+
 // RemoveDependentHiddenColumns removes hidden columns by the indexInfo.
 func RemoveDependentHiddenColumns(tblInfo *model.TableInfo, idxInfo *model.IndexInfo) {
 	hiddenColOffs := make([]int, 0)
@@ -2050,7 +2165,7 @@ func checkDuplicateForUniqueIndex(ctx context.Context, t table.Table, reorgInfo
 		if indexInfo.Unique {
 			ctx := logutil.WithCategory(ctx, "ddl-ingest")
 			if bc == nil {
-				bc, err = ingest.LitBackCtxMgr.Register(ctx, reorgInfo.ID, indexInfo.Unique, nil, discovery, reorgInfo.ReorgMeta.ResourceGroupName)
+				bc, err = ingest.LitBackCtxMgr.Register(ctx, reorgInfo.ID, indexInfo.Unique, nil, discovery, reorgInfo.ReorgMeta.ResourceGroupName, reorgInfo.Job.Priority)
 				if err != nil {
 					return err
 				}
@@ -0,0 +1,137 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+// collectIndexConflicts records the conflicting records of the unique indexes into mysql.add_index_conflicts,
+// so that the users can clean all the conflicting data at once instead of one by one.
+// It's only a best effort, the error is logged and the original duplicate key error is returned anyway.
+func collectIndexConflicts(w *worker, job *model.Job, tblInfo *model.TableInfo, allIndexInfos []*model.IndexInfo) {
+	limit := variable.DDLMaxIndexConflictRecords.Load()
+	if limit <= 0 {
+		return
+	}
+	for _, indexInfo := range allIndexInfos {
+		if !indexInfo.Unique {
+			continue
+		}
+		if err := collectIndexConflictsForIndex(w, job, tblInfo, indexInfo, limit); err != nil {
+			logutil.BgLogger().Warn("collect index conflicts failed", zap.String("category", "ddl"),
+				zap.Int64("job ID", job.ID), zap.String("index", indexInfo.Name.O), zap.Error(err))
+		}
+	}
+}
+
+func collectIndexConflictsForIndex(w *worker, job *model.Job, tblInfo *model.TableInfo, indexInfo *model.IndexInfo, limit int64) error {
+	sctx, err := w.sessPool.Get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer w.sessPool.Put(sctx)
+	ctx := kv.WithInternalSourceType(w.ctx, kv.InternalTxnDDL)
+	exec := sctx.GetRestrictedSQLExecutor()
+
+	// Remove the records collected by the previous run of the job.
+	_, _, err = exec.ExecRestrictedSQL(ctx, nil, "delete from mysql.add_index_conflicts where job_id = %? and index_name = %?",
+		job.ID, indexInfo.Name.O)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// The rows sharing the same index value are found by counting the rows in each window of the index value.
+	// Rows with a NULL index column never conflict in a unique index.
+	var buf strings.Builder
+	paramsList := make([]any, 0, 16)
+	buf.WriteString("insert into mysql.add_index_conflicts (job_id, table_schema, table_name, index_name, index_value, handle) ")
+	buf.WriteString("select %?, %?, %?, %?, v, h from (select concat_ws('-'")
+	paramsList = append(paramsList, job.ID, job.SchemaName, tblInfo.Name.O, indexInfo.Name.O)
+	for _, idxCol := range indexInfo.Columns {
+		buf.WriteString(", ")
+		paramsList = writeIndexColumnExpr(&buf, paramsList, tblInfo, idxCol)
+	}
+	buf.WriteString(") as v, ")
+	paramsList = writeHandleExpr(&buf, paramsList, tblInfo)
+	buf.WriteString(" as h, count(*) over (partition by ")
+	for i, idxCol := range indexInfo.Columns {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		paramsList = writeIndexColumnExpr(&buf, paramsList, tblInfo, idxCol)
+	}
+	buf.WriteString(") as cnt from %n.%n where ")
+	paramsList = append(paramsList, job.SchemaName, tblInfo.Name.L)
+	for i, idxCol := range indexInfo.Columns {
+		if i > 0 {
+			buf.WriteString(" and ")
+		}
+		paramsList = writeIndexColumnExpr(&buf, paramsList, tblInfo, idxCol)
+		buf.WriteString(" is not null")
+	}
+	buf.WriteString(") t where cnt > 1 order by v, h limit %?")
+	paramsList = append(paramsList, limit)
+	_, _, err = exec.ExecRestrictedSQL(ctx, nil, buf.String(), paramsList...)
+	return errors.Trace(err)
+}
+
+// writeIndexColumnExpr writes the expression of the index column value, the prefix index column
+// is cut to the prefix length, and the hidden column of the expression index is replaced by its expression.
+func writeIndexColumnExpr(buf *strings.Builder, paramsList []any, tblInfo *model.TableInfo, idxCol *model.IndexColumn) []any {
+	col := tblInfo.Columns[idxCol.Offset]
+	if col.Hidden && col.GeneratedExprString != "" {
+		buf.WriteString("(")
+		buf.WriteString(strings.ReplaceAll(col.GeneratedExprString, "%", "%%"))
+		buf.WriteString(")")
+		return paramsList
+	}
+	if idxCol.Length != types.UnspecifiedLength {
+		buf.WriteString("left(%n, %?)")
+		return append(paramsList, col.Name.L, idxCol.Length)
+	}
+	buf.WriteString("%n")
+	return append(paramsList, col.Name.L)
+}
+
+// writeHandleExpr writes the expression of the row handle, the columns of the common handle are
+// joined by '-' as the key of the duplicate entry error does.
+func writeHandleExpr(buf *strings.Builder, paramsList []any, tblInfo *model.TableInfo) []any {
+	switch {
+	case tblInfo.PKIsHandle:
+		buf.WriteString("%n")
+		return append(paramsList, tblInfo.GetPkColInfo().Name.L)
+	case tblInfo.IsCommonHandle:
+		pkIdx := tblInfo.GetPrimaryKey()
+		buf.WriteString("concat_ws('-'")
+		for _, idxCol := range pkIdx.Columns {
+			buf.WriteString(", %n")
+			paramsList = append(paramsList, tblInfo.Columns[idxCol.Offset].Name.L)
+		}
+		buf.WriteString(")")
+		return paramsList
+	default:
+		buf.WriteString("%n")
+		return append(paramsList, model.ExtraHandleName.L)
+	}
+}
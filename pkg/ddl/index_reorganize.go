@@ -0,0 +1,259 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/ddl/ingest"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+// onReorganizeIndex rebuilds an index. A new index with the same definition is built in the same way as
+// adding index, then it takes the place of the old index, and the old index is dropped.
+//
+// The states of the new index: none -> delete only -> write only -> write reorganization -> public
+// The states of the old index: public ---------------------------------------------------> write only -> delete only -> delete reorganization -> none
+//
+// The new index is named with the changing index prefix until it takes the place of the old index, then the
+// old index is renamed with the prefix. The job is in StatePublic since then, and it can't be rolled back.
+func (w *worker) onReorganizeIndex(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	// Handle the rolling back job, the new index is dropped.
+	if job.IsRollingback() {
+		ver, err = onDropIndex(d, t, job)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		return ver, nil
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	var indexName model.CIStr
+	if err = job.DecodeArgs(&indexName); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	oldIdx, newIdx := findReorganizingIndexes(tblInfo, indexName, job.SchemaState == model.StatePublic)
+	if oldIdx == nil {
+		job.State = model.JobStateCancelled
+		return ver, infoschema.ErrKeyNotExists.GenWithStackByArgs(indexName.O, tblInfo.Name.O)
+	}
+	if job.SchemaState == model.StatePublic {
+		return dropReorganizedIndex(d, t, job, tblInfo, oldIdx, newIdx)
+	}
+
+	if newIdx == nil {
+		if oldIdx.Primary || oldIdx.State != model.StatePublic {
+			job.State = model.JobStateCancelled
+			return ver, dbterror.ErrInvalidDDLState.GenWithStackByArgs("index", oldIdx.State)
+		}
+		newIdx = oldIdx.Clone()
+		newIdx.ID = AllocateIndexID(tblInfo)
+		newIdx.Name = model.NewCIStr(genChangingIndexUniqueName(tblInfo, oldIdx))
+		newIdx.State = model.StateNone
+		tblInfo.Indices = append(tblInfo.Indices, newIdx)
+		if err = checkTooManyIndexes(tblInfo.Indices); err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+		logutil.BgLogger().Info("run reorganize index job", zap.String("category", "ddl"), zap.String("job", job.String()),
+			zap.Int64("old index ID", oldIdx.ID), zap.Int64("new index ID", newIdx.ID))
+	}
+
+	originalState := newIdx.State
+	switch newIdx.State {
+	case model.StateNone:
+		// none -> delete only
+		var reorgTp model.ReorgType
+		reorgTp, err = pickBackfillType(w.ctx, job)
+		if err != nil {
+			if !errorIsRetryable(err, job) {
+				job.State = model.JobStateCancelled
+			}
+			return ver, err
+		}
+		loadCloudStorageURI(w, job)
+		if reorgTp.NeedMergeProcess() {
+			newIdx.BackfillState = model.BackfillStateRunning
+		}
+		newIdx.State = model.StateDeleteOnly
+		ver, err = updateVersionAndTableInfoWithCheck(d, t, job, tblInfo, originalState != model.StateDeleteOnly)
+		if err != nil {
+			return ver, err
+		}
+		job.SchemaState = model.StateDeleteOnly
+	case model.StateDeleteOnly:
+		// delete only -> write only
+		newIdx.State = model.StateWriteOnly
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != model.StateWriteOnly)
+		if err != nil {
+			return ver, err
+		}
+		job.SchemaState = model.StateWriteOnly
+	case model.StateWriteOnly:
+		// write only -> reorganization
+		newIdx.State = model.StateWriteReorganization
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != model.StateWriteReorganization)
+		if err != nil {
+			return ver, err
+		}
+		// Initialize SnapshotVer to 0 for later reorganization check.
+		job.SnapshotVer = 0
+		job.SchemaState = model.StateWriteReorganization
+	case model.StateWriteReorganization:
+		// reorganization -> public
+		var tbl table.Table
+		tbl, err = getTable((*asAutoIDRequirement)(d), job.SchemaID, tblInfo)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		var done bool
+		done, ver, err = doReorgWorkForCreateIndex(w, d, t, job, tbl, []*model.IndexInfo{newIdx})
+		if !done {
+			return ver, err
+		}
+
+		// The new index takes the place of the old index. The old index is still written but not read,
+		// so the TiDB instances which haven't loaded the new schema can still read the old index safely.
+		swapReorganizingIndexes(tblInfo, oldIdx, newIdx)
+		newIdx.State = model.StatePublic
+		oldIdx.State = model.StateWriteOnly
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.SchemaState = model.StatePublic
+		if !job.ReorgMeta.IsDistReorg && job.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge {
+			ingest.LitBackCtxMgr.Unregister(job.ID)
+		}
+	default:
+		err = dbterror.ErrInvalidDDLState.GenWithStackByArgs("index", newIdx.State)
+	}
+	return ver, errors.Trace(err)
+}
+
+// dropReorganizedIndex drops the old index after the new index takes the place of it.
+func dropReorganizedIndex(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo,
+	oldIdx, newIdx *model.IndexInfo) (ver int64, err error) {
+	switch oldIdx.State {
+	case model.StateWriteOnly:
+		// write only -> delete only
+		oldIdx.State = model.StateDeleteOnly
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	case model.StateDeleteOnly:
+		// delete only -> reorganization
+		oldIdx.State = model.StateDeleteReorganization
+		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	case model.StateDeleteReorganization:
+		// reorganization -> absent
+		// The hidden columns of the expression index are still used by the new index, so they are kept.
+		oldIdx.State = model.StateNone
+		DropIndexColumnFlag(tblInfo, oldIdx)
+		removeIndexInfo(tblInfo, oldIdx)
+		ver, err = updateVersionAndTableInfoWithCheck(d, t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		// The temporary index of the new index and the old index are deleted by the delete-range.
+		partitionIDs := getPartitionIDs(tblInfo)
+		oldPhysicalIDs := partitionIDs
+		if oldIdx.Global {
+			// Global index key has t{tableID}_ prefix.
+			oldPhysicalIDs = []int64{}
+		}
+		job.Args = []any{[]int64{newIdx.ID}, []bool{false}, partitionIDs, oldIdx.ID, oldPhysicalIDs}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+		logutil.BgLogger().Info("run reorganize index job done", zap.String("category", "ddl"),
+			zap.Int64("job ID", job.ID), zap.Int64("old index ID", oldIdx.ID), zap.Int64("new index ID", newIdx.ID))
+	default:
+		err = dbterror.ErrInvalidDDLState.GenWithStackByArgs("index", oldIdx.State)
+	}
+	return ver, errors.Trace(err)
+}
+
+// findReorganizingIndexes finds the index being reorganized and the new index built for it.
+// The new index takes the name of the old index once it's swapped in.
+func findReorganizingIndexes(tblInfo *model.TableInfo, indexName model.CIStr, swapped bool) (oldIdx, newIdx *model.IndexInfo) {
+	var named, changing *model.IndexInfo
+	for _, idx := range tblInfo.Indices {
+		if idx.Name.L == indexName.L {
+			named = idx
+		} else if strings.HasPrefix(idx.Name.O, changingIndexPrefix) &&
+			strings.EqualFold(getChangingIndexOriginName(idx), indexName.O) {
+			changing = idx
+		}
+	}
+	if swapped {
+		return changing, named
+	}
+	return named, changing
+}
+
+// swapReorganizingIndexes exchanges the names and the positions of the old index and the new index,
+// so that the new index is shown in the place of the old index.
+func swapReorganizingIndexes(tblInfo *model.TableInfo, oldIdx, newIdx *model.IndexInfo) {
+	oldIdx.Name, newIdx.Name = newIdx.Name, oldIdx.Name
+	oldOffset, newOffset := -1, -1
+	for i, idx := range tblInfo.Indices {
+		switch idx.ID {
+		case oldIdx.ID:
+			oldOffset = i
+		case newIdx.ID:
+			newOffset = i
+		}
+	}
+	if oldOffset >= 0 && newOffset >= 0 {
+		tblInfo.Indices[oldOffset], tblInfo.Indices[newOffset] = newIdx, oldIdx
+	}
+}
+
+func rollingbackReorganizeIndex(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	if needNotifyAndStopReorgWorker(job) {
+		// The reorg workers are started, ask them to exit.
+		w.jobLogger(job).Info("run the cancelling DDL job", zap.String("job", job.String()))
+		d.notifyReorgWorkerJobStateChange(job)
+		return w.onReorganizeIndex(d, t, job)
+	}
+	defer func() {
+		if ingest.LitBackCtxMgr != nil {
+			ingest.LitBackCtxMgr.Unregister(job.ID)
+		}
+	}()
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	var indexName model.CIStr
+	if err = job.DecodeArgs(&indexName); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	_, newIdx := findReorganizingIndexes(tblInfo, indexName, false)
+	if newIdx == nil {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrCancelledDDLJob
+	}
+	return convertAddIdxJob2RollbackJob(d, t, job, tblInfo, []*model.IndexInfo{newIdx}, dbterror.ErrCancelledDDLJob)
+}
@@ -17,6 +17,7 @@ package ingest
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -56,7 +57,12 @@ type BackendCtx interface {
 	AttachCheckpointManager(*CheckpointManager)
 	GetCheckpointManager() *CheckpointManager
 
+	// SubtaskCheckpoint returns the checkpoint of the subtask which writes to the local engines.
+	SubtaskCheckpoint(subtaskID int64) *SubtaskCheckpoint
+
 	GetLocalBackend() *local.Backend
+	// WrittenBytes returns the size of the KVs written to the local engines.
+	WrittenBytes() int64
 }
 
 // FlushMode is used to control how to flush.
@@ -87,9 +93,15 @@ type litBackendCtx struct {
 	done     bool
 
 	timeOfLastFlush atomicutil.Time
+	writtenBytes    atomicutil.Int64
 	updateInterval  time.Duration
 	checkpointMgr   *CheckpointManager
 	etcdClient      *clientv3.Client
+
+	// paused is set when the distributed task of the job is paused. See litBackendCtxMgr.Pause.
+	paused     atomicutil.Bool
+	subtaskMu  sync.Mutex
+	subtaskCPs map[int64]*SubtaskCheckpoint
 }
 
 func (bc *litBackendCtx) handleErrorAfterCollectRemoteDuplicateRows(err error, indexID int64, tbl table.Table, hasDupe bool) error {
@@ -313,7 +325,24 @@ func (bc *litBackendCtx) GetCheckpointManager() *CheckpointManager {
 	return bc.checkpointMgr
 }
 
+// SubtaskCheckpoint returns the checkpoint of the subtask, it creates one if not exists.
+func (bc *litBackendCtx) SubtaskCheckpoint(subtaskID int64) *SubtaskCheckpoint {
+	bc.subtaskMu.Lock()
+	defer bc.subtaskMu.Unlock()
+	cp, ok := bc.subtaskCPs[subtaskID]
+	if !ok {
+		cp = NewSubtaskCheckpoint()
+		bc.subtaskCPs[subtaskID] = cp
+	}
+	return cp
+}
+
 // GetLocalBackend returns the local backend.
 func (bc *litBackendCtx) GetLocalBackend() *local.Backend {
 	return bc.backend
 }
+
+// WrittenBytes implements BackendCtx.WrittenBytes interface.
+func (bc *litBackendCtx) WrittenBytes() int64 {
+	return bc.writtenBytes.Load()
+}
@@ -45,8 +45,11 @@ type BackendCtxMgr interface {
 		etcdClient *clientv3.Client,
 		pdSvcDiscovery pd.ServiceDiscovery,
 		resourceGroupName string,
+		priority int,
 	) (BackendCtx, error)
 	Unregister(jobID int64)
+	// Pause keeps the BackendCtx of the job whose distributed task is paused.
+	Pause(jobID int64)
 	Load(jobID int64) (BackendCtx, bool)
 
 	MarkJobProcessing(jobID int64) (ok bool)
@@ -121,18 +124,20 @@ func (m *litBackendCtxMgr) Register(
 	etcdClient *clientv3.Client,
 	pdSvcDiscovery pd.ServiceDiscovery,
 	resourceGroupName string,
+	priority int,
 ) (BackendCtx, error) {
-	bc, exist := m.Load(jobID)
-	if exist {
+	if bc, exist := m.SyncMap.Load(jobID); exist {
+		bc.paused.Store(false)
 		return bc, nil
 	}
+	m.unregisterPaused()
 
 	m.memRoot.RefreshConsumption()
 	ok := m.memRoot.CheckConsume(StructSizeBackendCtx)
 	if !ok {
 		return nil, genBackendAllocMemFailedErr(ctx, m.memRoot, jobID)
 	}
-	cfg, err := genConfig(ctx, m.memRoot, jobID, unique, resourceGroupName)
+	cfg, err := genConfig(ctx, m.memRoot, jobID, unique, resourceGroupName, priority)
 	if err != nil {
 		logutil.Logger(ctx).Warn(LitWarnConfigError, zap.Int64("job ID", jobID), zap.Error(err))
 		return nil, err
@@ -188,6 +193,7 @@ func newBackendContext(ctx context.Context, jobID int64, be *local.Backend, cfg
 		diskRoot:       diskRoot,
 		updateInterval: checkpointUpdateInterval,
 		etcdClient:     etcdClient,
+		subtaskCPs:     make(map[int64]*SubtaskCheckpoint),
 	}
 	bCtx.timeOfLastFlush.Store(time.Now())
 	return bCtx
@@ -211,6 +217,27 @@ func (m *litBackendCtxMgr) Unregister(jobID int64) {
 		zap.Int64("max memory quota", m.memRoot.MaxMemoryQuota()))
 }
 
+// Pause keeps the backend context of the job after its distributed task is paused,
+// so that the local engines and the subtask checkpoints are reused when the task is
+// resumed on this instance. A paused backend context is unregistered when another
+// job registers a backend context, because the job may never be resumed here.
+func (m *litBackendCtxMgr) Pause(jobID int64) {
+	bc, exist := m.SyncMap.Load(jobID)
+	if !exist {
+		return
+	}
+	bc.paused.Store(true)
+	logutil.Logger(bc.ctx).Info(LitInfoPauseBackend, zap.Int64("job ID", jobID))
+}
+
+func (m *litBackendCtxMgr) unregisterPaused() {
+	for _, jobID := range m.Keys() {
+		if bc, exist := m.SyncMap.Load(jobID); exist && bc.paused.Load() {
+			m.Unregister(jobID)
+		}
+	}
+}
+
 func (m *litBackendCtxMgr) Load(jobID int64) (BackendCtx, bool) {
 	return m.SyncMap.Load(jobID)
 }
@@ -322,7 +322,16 @@ func (s *CheckpointManager) resumeCheckpoint() error {
 				s.localDataIsValid = true
 				s.minKeySyncLocal = cp.LocalSyncKey
 				s.localCnt = cp.LocalKeyCount
+			} else {
+				// The job is resumed by another instance, e.g. the DDL owner is changed. The local data of
+				// the previous instance is lost, so continue from the data imported to the storage.
+				s.minKeySyncLocal = cp.GlobalSyncKey
+				s.localCnt = cp.GlobalKeyCount
 			}
+			// Restore the local meta, so that the checkpoint is kept when resetting to the same physical table.
+			s.pidLocal = cp.PhysicalID
+			s.startLocal = cp.StartKey
+			s.endLocal = cp.EndKey
 			logutil.BgLogger().Info("resume checkpoint", zap.String("category", "ddl-ingest"),
 				zap.Int64("job ID", s.jobID), zap.Int64s("index IDs", s.indexIDs),
 				zap.String("local checkpoint", hex.EncodeToString(s.minKeySyncLocal)),
@@ -425,3 +434,95 @@ func (s *CheckpointManager) updateCheckpointLoop() {
 		}
 	}
 }
+
+// SubtaskCheckpoint is the checkpoint of a subtask of the distributed reorganization
+// which writes the index records to the local engines. Unlike CheckpointManager,
+// it isn't persisted: it describes the data flushed to the local engines, so it is
+// kept in the backend context and lives as long as the engines do. When the task is
+// paused and resumed on the same instance, the subtask continues from the synced key
+// instead of scanning from its start key again.
+type SubtaskCheckpoint struct {
+	mu              sync.Mutex
+	checkpoints     map[int]*TaskCheckpoint // task ID -> checkpoint
+	minTaskIDSynced int
+	syncedKey       kv.Key
+	syncedCnt       int
+}
+
+// NewSubtaskCheckpoint creates a new SubtaskCheckpoint.
+func NewSubtaskCheckpoint() *SubtaskCheckpoint {
+	return &SubtaskCheckpoint{
+		checkpoints: make(map[int]*TaskCheckpoint),
+	}
+}
+
+// Register registers a new task.
+func (c *SubtaskCheckpoint) Register(taskID int, end kv.Key) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checkpoints[taskID] = &TaskCheckpoint{
+		endKey: end,
+	}
+}
+
+// UpdateTotal updates the total rows of the task.
+// This is called by the reader before it sends the chunk to the writer.
+func (c *SubtaskCheckpoint) UpdateTotal(taskID int, added int, last bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cp := c.checkpoints[taskID]
+	cp.totalKeys += added
+	cp.lastBatchSent = last
+}
+
+// UpdateCurrent updates the rows of the task written to the local engines.
+func (c *SubtaskCheckpoint) UpdateCurrent(taskID int, added int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cp := c.checkpoints[taskID]
+	cp.currentKeys += added
+}
+
+// Sync moves the synced key forward over the completed tasks.
+// It must be called after the local engines are flushed.
+func (c *SubtaskCheckpoint) Sync() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for {
+		cp := c.checkpoints[c.minTaskIDSynced+1]
+		if cp == nil || !cp.lastBatchSent || cp.currentKeys < cp.totalKeys {
+			break
+		}
+		c.minTaskIDSynced++
+		c.syncedKey = cp.endKey
+		c.syncedCnt += cp.totalKeys
+		delete(c.checkpoints, c.minTaskIDSynced)
+	}
+}
+
+// Status returns the number of the synced rows and the key to continue from.
+func (c *SubtaskCheckpoint) Status() (int, kv.Key) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.syncedCnt, c.syncedKey
+}
+
+// ResetTasks forgets the tasks of the previous run and keeps the synced progress.
+// It is called before the subtask runs, because the task IDs start over.
+func (c *SubtaskCheckpoint) ResetTasks() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checkpoints = make(map[int]*TaskCheckpoint)
+	c.minTaskIDSynced = 0
+}
+
+// Reset forgets all the progress. It is called when the local data is not reliable,
+// e.g. flushing the local engines fails.
+func (c *SubtaskCheckpoint) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checkpoints = make(map[int]*TaskCheckpoint)
+	c.minTaskIDSynced = 0
+	c.syncedKey = nil
+	c.syncedCnt = 0
+}
@@ -150,6 +150,88 @@ func TestCheckpointManagerResumeReorg(t *testing.T) {
 	require.EqualValues(t, []byte{'2', '9'}, globalNextKey)
 }
 
+func TestSubtaskCheckpoint(t *testing.T) {
+	cp := ingest.NewSubtaskCheckpoint()
+	cp.Register(1, []byte{'1', '9'})
+	cp.Register(2, []byte{'2', '9'})
+	cp.Register(3, []byte{'3', '9'})
+	cp.UpdateTotal(1, 100, true)
+	cp.UpdateTotal(2, 50, false)
+	cp.UpdateTotal(3, 100, true)
+	cp.UpdateCurrent(1, 100)
+	cp.UpdateCurrent(2, 50)
+	cp.UpdateCurrent(3, 100)
+	// The synced key only moves forward when the local engines are flushed.
+	cnt, next := cp.Status()
+	require.Equal(t, 0, cnt)
+	require.Nil(t, next)
+	// The last batch of task 2 is not written, so task 3 is not covered.
+	cp.Sync()
+	cnt, next = cp.Status()
+	require.Equal(t, 100, cnt)
+	require.EqualValues(t, []byte{'1', '9'}, next)
+
+	// The subtask is paused and resumed: the tasks start over from the synced key.
+	cp.ResetTasks()
+	cp.Register(1, []byte{'2', '9'})
+	cp.UpdateTotal(1, 80, true)
+	cp.UpdateCurrent(1, 80)
+	cp.Sync()
+	cnt, next = cp.Status()
+	require.Equal(t, 180, cnt)
+	require.EqualValues(t, []byte{'2', '9'}, next)
+
+	// The local data is not reliable anymore.
+	cp.Reset()
+	cnt, next = cp.Status()
+	require.Equal(t, 0, cnt)
+	require.Nil(t, next)
+}
+
+func TestCheckpointManagerResumeReorgOnAnotherInstance(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	reorgMeta := &ingest.JobReorgMeta{
+		Checkpoint: &ingest.ReorgCheckpoint{
+			LocalSyncKey:   []byte{'3', '9'},
+			LocalKeyCount:  300,
+			GlobalSyncKey:  []byte{'2', '9'},
+			GlobalKeyCount: 200,
+			InstanceAddr:   "127.0.0.2:4000:/tmp/tidb",
+			PhysicalID:     10,
+			StartKey:       []byte{'0'},
+			EndKey:         []byte{'9'},
+			Version:        1,
+		},
+	}
+	reorgMetaRaw, err := json.Marshal(reorgMeta)
+	require.NoError(t, err)
+	tk.MustExec("insert into mysql.tidb_ddl_reorg (job_id, ele_id, ele_type, reorg_meta) values (1, 1, '_idx_', ?);", reorgMetaRaw)
+	rs := pools.NewResourcePool(func() (pools.Resource, error) {
+		newTk := testkit.NewTestKit(t, store)
+		return newTk.Session(), nil
+	}, 8, 8, 0)
+	ctx := context.Background()
+	sessPool := session.NewSessionPool(rs, store)
+	flushCtrl := &dummyFlushCtrl{imported: false}
+	mgr, err := ingest.NewCheckpointManager(ctx, flushCtrl, sessPool, 1, []int64{1})
+	require.NoError(t, err)
+	defer mgr.Close()
+
+	// Resetting to the same physical table keeps the checkpoint.
+	mgr.Reset(10, []byte{'0'}, []byte{'9'})
+	require.True(t, mgr.IsComplete([]byte{'2', '9'}))
+	// The local data of the previous instance is not available.
+	require.False(t, mgr.IsComplete([]byte{'3', '9'}))
+	localCnt, globalNextKey := mgr.Status()
+	require.Equal(t, 200, localCnt)
+	require.EqualValues(t, []byte{'2', '9'}, globalNextKey)
+
+	mgr.Reset(11, []byte{'0'}, []byte{'9'})
+	require.False(t, mgr.IsComplete([]byte{'2', '9'}))
+}
+
 type dummyFlushCtrl struct {
 	imported bool
 }
@@ -23,6 +23,7 @@ import (
 	"sync/atomic"
 
 	tidb "github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/lightning/backend"
 	"github.com/pingcap/tidb/pkg/lightning/checkpoints"
 	"github.com/pingcap/tidb/pkg/lightning/common"
@@ -44,7 +45,7 @@ type litConfig struct {
 	resourceGroup string
 }
 
-func genConfig(ctx context.Context, memRoot MemRoot, jobID int64, unique bool, resourceGroup string) (*litConfig, error) {
+func genConfig(ctx context.Context, memRoot MemRoot, jobID int64, unique bool, resourceGroup string, priority int) (*litConfig, error) {
 	tidbCfg := tidb.GetGlobalConfig()
 	cfg := lightning.NewConfig()
 	cfg.TikvImporter.Backend = lightning.BackendLocal
@@ -55,12 +56,16 @@ func genConfig(ctx context.Context, memRoot MemRoot, jobID int64, unique bool, r
 	} else {
 		cfg.TikvImporter.RangeConcurrency = int(variable.GetDDLReorgWorkerCounter())
 	}
+	cfg.TikvImporter.EncryptionMethod = tidbCfg.Security.IngestFileEncryptionMethod
+	cfg.TikvImporter.EncryptionMasterKeyPath = tidbCfg.Security.IngestFileEncryptionMasterKeyPath
 	err := cfg.AdjustForDDL()
 	if err != nil {
 		logutil.Logger(ctx).Warn(LitWarnConfigError, zap.Error(err))
 		return nil, err
 	}
 	adjustImportMemory(ctx, memRoot, cfg)
+	adjustImportResourceByPriority(ctx, cfg, priority)
+	cfg.TikvImporter.StoreWriteBWLimit = lightning.ByteSize(variable.DDLIngestMaxWriteSpeed.Load())
 	cfg.Checkpoint.Enable = true
 	if unique {
 		cfg.Conflict.Strategy = lightning.ErrorOnDup
@@ -153,6 +158,25 @@ func adjustImportMemory(ctx context.Context, memRoot MemRoot, cfg *lightning.Con
 		zap.Int("range concurrency", cfg.TikvImporter.RangeConcurrency))
 }
 
+// adjustImportResourceByPriority adjusts the lightning resource parameters according to the priority of the job.
+// The low priority job uses half of the memory cache and the range concurrency, so it leaves more local backend
+// resources to the other jobs and puts less ingest pressure on TiKV.
+func adjustImportResourceByPriority(ctx context.Context, cfg *lightning.Config, priority int) {
+	if priority != kv.PriorityLow {
+		return
+	}
+	cfg.TikvImporter.LocalWriterMemCacheSize /= 2
+	cfg.TikvImporter.EngineMemCacheSize /= 2
+	if cfg.TikvImporter.RangeConcurrency > 1 {
+		cfg.TikvImporter.RangeConcurrency /= 2
+	}
+	logutil.Logger(ctx).Info(LitInfoChgMemSetting,
+		zap.String("priority", "low"),
+		zap.Int64("local writer memory cache size", int64(cfg.TikvImporter.LocalWriterMemCacheSize)),
+		zap.Int64("engine memory cache size", int64(cfg.TikvImporter.EngineMemCacheSize)),
+		zap.Int("range concurrency", cfg.TikvImporter.RangeConcurrency))
+}
+
 // tryAggressiveMemory lightning memory parameters according memory root's max limitation.
 func tryAggressiveMemory(ctx context.Context, memRoot MemRoot, cfg *lightning.Config) bool {
 	var defaultMemSize int64
@@ -16,7 +16,9 @@ package ingest
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 
@@ -154,6 +156,66 @@ func (d *diskRootImpl) StartupCheck() error {
 	return nil
 }
 
+// JobDiskUsage is the temporary disk usage of the local engines of an ingest job.
+type JobDiskUsage struct {
+	JobID     int64
+	Path      string
+	DiskUsage uint64
+}
+
+// ListJobDiskUsages returns the temporary disk usage of each ingest job in the sort path.
+// Each job has its own directory named by the backend tag in the sort path.
+func ListJobDiskUsages(sortPath string) ([]JobDiskUsage, error) {
+	if len(sortPath) == 0 {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(sortPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Trace(err)
+	}
+	usages := make([]JobDiskUsage, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		jobID, err := DecodeBackendTag(entry.Name())
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(sortPath, entry.Name())
+		var size uint64
+		err = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// The files may be removed by the import or cleanup concurrently.
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			size += uint64(info.Size())
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		usages = append(usages, JobDiskUsage{JobID: jobID, Path: path, DiskUsage: size})
+	}
+	return usages, nil
+}
+
 // RiskOfDiskFull checks if the disk has less than 10% space.
 func RiskOfDiskFull(available, capacity uint64) bool {
 	return float64(available) < (1-capacityThreshold)*float64(capacity)
@@ -0,0 +1,46 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/ddl/ingest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListJobDiskUsages(t *testing.T) {
+	usages, err := ingest.ListJobDiskUsages(filepath.Join(t.TempDir(), "not-exist"))
+	require.NoError(t, err)
+	require.Empty(t, usages)
+
+	sortPath := t.TempDir()
+	jobDir := filepath.Join(sortPath, ingest.EncodeBackendTag(100))
+	require.NoError(t, os.MkdirAll(filepath.Join(jobDir, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(jobDir, "a.sst"), make([]byte, 10), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(jobDir, "sub", "b.sst"), make([]byte, 20), 0o644))
+	// Directories not named by a job ID are ignored.
+	require.NoError(t, os.MkdirAll(filepath.Join(sortPath, "other"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sortPath, "file"), make([]byte, 5), 0o644))
+
+	usages, err = ingest.ListJobDiskUsages(sortPath)
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	require.Equal(t, int64(100), usages[0].JobID)
+	require.Equal(t, jobDir, usages[0].Path)
+	require.Equal(t, uint64(30), usages[0].DiskUsage)
+}
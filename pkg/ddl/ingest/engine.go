@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/pkg/lightning/config"
 	"github.com/pingcap/tidb/pkg/util/generic"
 	"github.com/pingcap/tidb/pkg/util/logutil"
+	atomicutil "go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -63,6 +64,7 @@ type engineInfo struct {
 	memRoot      MemRoot
 	flushLock    *sync.RWMutex
 	flushing     atomic.Bool
+	writtenBytes *atomicutil.Int64
 }
 
 // newEngineInfo create a new engineInfo struct.
@@ -173,9 +175,10 @@ func (ei *engineInfo) ImportAndClean() error {
 
 // writerContext is used to keep a lightning local writer for each backfill worker.
 type writerContext struct {
-	ctx    context.Context
-	lWrite backend.EngineWriter
-	fLock  *sync.RWMutex
+	ctx          context.Context
+	lWrite       backend.EngineWriter
+	fLock        *sync.RWMutex
+	writtenBytes *atomicutil.Int64
 }
 
 // CreateWriter creates a new writerContext.
@@ -219,9 +222,10 @@ func (ei *engineInfo) newWriterContext(workerID int) (*writerContext, error) {
 		ei.writerCache.Store(workerID, lWrite)
 	}
 	wc := &writerContext{
-		ctx:    ei.ctx,
-		lWrite: lWrite,
-		fLock:  ei.flushLock,
+		ctx:          ei.ctx,
+		lWrite:       lWrite,
+		fLock:        ei.flushLock,
+		writtenBytes: ei.writtenBytes,
 	}
 	return wc, nil
 }
@@ -251,7 +255,13 @@ func (wCtx *writerContext) WriteRow(ctx context.Context, key, idxVal []byte, han
 		kvs[0].RowID = handle.Encoded()
 	}
 	row := kv.MakeRowsFromKvPairs(kvs)
-	return wCtx.lWrite.AppendRows(ctx, nil, row)
+	if err := wCtx.lWrite.AppendRows(ctx, nil, row); err != nil {
+		return err
+	}
+	if wCtx.writtenBytes != nil {
+		wCtx.writtenBytes.Add(int64(len(key) + len(idxVal)))
+	}
+	return nil
 }
 
 // LockForWrite locks the local writer for write.
@@ -16,6 +16,7 @@ package ingest_test
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/pkg/ddl/util/callback"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/tests/realtikvtest"
@@ -120,6 +122,45 @@ func TestIngestError(t *testing.T) {
 	require.True(t, strings.Contains(jobTp, "ingest"), jobTp)
 }
 
+func TestIngestErrorFallbackToTxnMerge(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test;")
+	defer ingesttestutil.InjectMockBackendMgr(t, store)()
+
+	tk.MustExec("set @@global.tidb_ddl_reorg_worker_cnt = 1;")
+	tk.MustExec("set @@global.tidb_ddl_ingest_fallback_error_count = 2;")
+	defer tk.MustExec("set @@global.tidb_ddl_ingest_fallback_error_count = default;")
+	tk.MustExec("create table t (a int primary key, b int);")
+	for i := 0; i < 4; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t values (%d, %d);", i*10000, i*10000))
+	}
+	tk.MustQuery("split table t between (0) and (50000) regions 5;").Check(testkit.Rows("4 1"))
+
+	// the local writer keeps failing, the job falls back to txn-merge instead of failing.
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/ddl/mockLocalWriterError", "return"))
+	tk.MustExec("alter table t add index idx(b);")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/ddl/mockLocalWriterError"))
+	warnings := tk.MustQuery("show warnings;").Rows()
+	require.Len(t, warnings, 1)
+	require.Equal(t, strconv.Itoa(errno.ErrIngestFallback), warnings[0][1])
+	tk.MustExec("admin check table t;")
+	rows := tk.MustQuery("admin show ddl jobs 1;").Rows()
+	//nolint: forcetypeassert
+	jobTp := rows[0][3].(string)
+	require.True(t, strings.Contains(jobTp, "txn-merge"), jobTp)
+	rows = tk.MustQuery("select ingest_fallback_reason from information_schema.ddl_jobs where table_name = 't' and job_type like 'add index%';").Rows()
+	//nolint: forcetypeassert
+	reason := rows[0][0].(string)
+	require.True(t, strings.HasPrefix(reason, "ingest keeps failing after 2 retries"), reason)
+
+	// the jobs not falling back have no reason.
+	tk.MustExec("alter table t add index idx1(a);")
+	tk.MustQuery("show warnings;").Check(testkit.Rows())
+	tk.MustQuery("select ingest_fallback_reason from information_schema.ddl_jobs where table_name = 't' and job_type like 'add index /* ingest */%';").
+		Check(testkit.Rows("<nil>"))
+}
+
 func TestAddIndexIngestPanic(t *testing.T) {
 	store := realtikvtest.CreateMockStoreAndSetup(t)
 	tk := testkit.NewTestKit(t, store)
@@ -341,6 +382,81 @@ func TestAddIndexDuplicateMessage(t *testing.T) {
 	tk.MustQuery("select * from t;").Check(testkit.Rows("1 1 1", "2 1 2"))
 }
 
+func TestAddIndexCollectConflicts(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	defer ingesttestutil.InjectMockBackendMgr(t, store)()
+	tk.MustExec("set @@global.tidb_ddl_max_index_conflict_records = 3;")
+	defer tk.MustExec("set @@global.tidb_ddl_max_index_conflict_records = default;")
+
+	tk.MustExec("create table t(id int primary key, b int, c varchar(10));")
+	tk.MustExec("insert into t values (1, 1, 'aa'), (2, 1, 'ab'), (3, 2, 'ba'), (4, 2, 'bb'), (5, 3, 'cc'), (6, null, null), (7, null, null);")
+
+	tk.MustGetErrCode("alter table t add unique index idx(b);", errno.ErrDupEntry)
+	tk.MustQuery("select table_schema, table_name, index_value, handle from mysql.add_index_conflicts where index_name = 'idx';").
+		Check(testkit.Rows("test t 1 1", "test t 1 2", "test t 2 3"))
+
+	tk.MustExec("set @@global.tidb_ddl_max_index_conflict_records = 100;")
+	tk.MustGetErrCode("alter table t add unique index idx_prefix(c(1), b);", errno.ErrDupEntry)
+	tk.MustQuery("select index_value, handle from mysql.add_index_conflicts where index_name = 'idx_prefix';").
+		Check(testkit.Rows("a-1 1", "a-1 2", "b-2 3", "b-2 4"))
+
+	tk.MustExec("set @@global.tidb_ddl_max_index_conflict_records = 0;")
+	tk.MustGetErrCode("alter table t add unique index idx_c(c(1));", errno.ErrDupEntry)
+	tk.MustQuery("select count(*) from mysql.add_index_conflicts where index_name = 'idx_c';").Check(testkit.Rows("0"))
+	tk.MustExec("delete from t where id in (2, 4);")
+	tk.MustExec("alter table t add unique index idx(b);")
+	tk.MustExec("admin check table t;")
+}
+
+func TestAlterDDLJobReorgMeta(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tkAlter := testkit.NewTestKit(t, store)
+	defer ingesttestutil.InjectMockBackendMgr(t, store)()
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int);")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3);")
+
+	var jobID int64
+	var altered bool
+	var alterErr error
+	var reorgMeta model.DDLReorgMeta
+	var priority int
+	hook := &callback.TestDDLCallback{Do: dom}
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		switch job.SchemaState {
+		case model.StateWriteOnly:
+			if altered {
+				return
+			}
+			altered = true
+			jobID = job.ID
+			alterErr = tkAlter.ExecToErr(fmt.Sprintf("admin alter ddl jobs %d thread = 2, batch_size = 64, max_write_speed = 1024, priority = 'high'", job.ID))
+		case model.StateWriteReorganization:
+			reorgMeta = *job.ReorgMeta
+			priority = job.Priority
+		}
+	}
+	dom.DDL().SetHook(hook.Clone())
+	tk.MustExec("alter table t add index idx(b);")
+	tk.MustExec("admin check table t;")
+	require.NoError(t, alterErr)
+	require.Equal(t, 2, reorgMeta.Concurrency)
+	require.Equal(t, 64, reorgMeta.BatchSize)
+	require.Equal(t, int64(1024), reorgMeta.MaxWriteSpeed)
+	require.Equal(t, kv.PriorityHigh, priority)
+
+	// The finished job can't be altered.
+	tkAlter.MustContainErrMsg(fmt.Sprintf("admin alter ddl jobs %d thread = 4", jobID), "DDL Job:")
+	// The options are validated before the job is altered.
+	tkAlter.MustContainErrMsg(fmt.Sprintf("admin alter ddl jobs %d thread = 0", jobID), "should be in [1,")
+	tkAlter.MustContainErrMsg(fmt.Sprintf("admin alter ddl jobs %d unknown = 1", jobID), "unsupported option 'unknown'")
+	tkAlter.MustContainErrMsg(fmt.Sprintf("admin alter ddl jobs %d priority = 'urgent'", jobID), "should be one of 'low', 'normal' and 'high'")
+}
+
 func TestMultiSchemaAddIndexMerge(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	defer ingesttestutil.InjectMockBackendMgr(t, store)()
@@ -372,3 +488,82 @@ func TestMultiSchemaAddIndexMerge(t *testing.T) {
 		tk.MustExec("admin check table t;")
 	}
 }
+
+func TestAddIndexIngestProgress(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test;")
+	defer ingesttestutil.InjectMockBackendMgr(t, store)()
+
+	tk.MustExec("create table t (a int, b int);")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3);")
+	tk.MustExec("alter table t add index idx(b);")
+	tk.MustQuery("select job_type, reorg_phase, progress, written_bytes, estimated_end_time from information_schema.ddl_jobs where table_name = 't' and job_type like 'add index%';").
+		Check(testkit.Rows("add index /* ingest */ merge 100 0 <nil>"))
+
+	// the jobs not using ingest have no progress.
+	tk.MustExec("set @@tidb_ddl_enable_fast_reorg = 0;")
+	tk.MustExec("alter table t add index idx1(a);")
+	tk.MustQuery("select reorg_phase, progress, written_bytes, estimated_end_time from information_schema.ddl_jobs where table_name = 't' and job_type like 'add index /* txn-merge */%';").
+		Check(testkit.Rows("<nil> <nil> <nil> <nil>"))
+}
+
+func TestAddIndexIngestCachedTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test;")
+	defer ingesttestutil.InjectMockBackendMgr(t, store)()
+
+	tk.MustExec("create table t (a int primary key, b int);")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3);")
+	tk.MustExec("alter table t cache;")
+	tk.MustExec("alter table t add index idx(b);")
+	tk.MustExec("create unique index idx1 on t(b);")
+	tk.MustExec("admin check table t;")
+	tk.MustQuery("select job_type from information_schema.ddl_jobs where table_name = 't' and job_type like 'add index%';").
+		Check(testkit.Rows("add index /* ingest */", "add index /* ingest */"))
+	tk.MustQuery("select create_options from information_schema.tables where table_schema = 'test' and table_name = 't';").
+		Check(testkit.Rows("cached=on"))
+	tk.MustQuery("select b from t use index(idx) where b > 1;").Sort().Check(testkit.Rows("2", "3"))
+
+	// The multi-schema change is still not supported on the cached tables.
+	tk.MustGetErrCode("alter table t add index idx2(b), add index idx3(a);", errno.ErrOptOnCacheTable)
+	tk.MustExec("alter table t nocache;")
+}
+
+func TestReorganizeIndexIngest(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test;")
+	defer ingesttestutil.InjectMockBackendMgr(t, store)()
+
+	tk.MustExec("create table t (a int primary key nonclustered, b int, c varchar(10), unique key idx(b), key idx_expr((lower(c))));")
+	tk.MustExec("insert into t values (1, 1, 'A'), (2, 2, 'B'), (3, 3, 'C');")
+	createTable := tk.MustQuery("show create table t;").Rows()
+	indexID := func(name string) int64 {
+		tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+		require.NoError(t, err)
+		idx := tbl.Meta().FindIndexByName(name)
+		require.NotNil(t, idx)
+		return idx.ID
+	}
+
+	for _, name := range []string{"idx", "idx_expr"} {
+		oldID := indexID(name)
+		tk.MustExec("admin reorganize index t " + name)
+		require.NotEqual(t, oldID, indexID(name))
+	}
+	tk.MustExec("admin check table t;")
+	tk.MustQuery("show create table t;").Check(createTable)
+	tk.MustQuery("select job_type from information_schema.ddl_jobs where table_name = 't' and job_type like 'reorganize index%';").
+		Check(testkit.Rows("reorganize index /* ingest */", "reorganize index /* ingest */"))
+	tk.MustQuery("select b from t use index(idx) where b > 1;").Sort().Check(testkit.Rows("2", "3"))
+	tk.MustQuery("select a from t use index(idx_expr) where lower(c) = 'b';").Check(testkit.Rows("2"))
+	tk.MustGetErrCode("insert into t values (4, 1, 'D');", errno.ErrDupEntry)
+
+	tk.MustContainErrMsg("admin reorganize index t `primary`", "reorganize primary key")
+	tk.MustContainErrMsg("admin reorganize index t idx_not_exist", "doesn't exist")
+	tk.MustExec("alter table t cache;")
+	tk.MustContainErrMsg("admin reorganize index t idx", "unsupported on cache tables")
+	tk.MustExec("alter table t nocache;")
+}
@@ -49,6 +49,7 @@ const (
 	LitInfoSortDir          string = "the ingest sorted directory"
 	LitInfoCreateBackend    string = "create one backend for an DDL job"
 	LitInfoCloseBackend     string = "close one backend for DDL job"
+	LitInfoPauseBackend     string = "keep the backend of the paused DDL job"
 	LitInfoOpenEngine       string = "open an engine for index reorg task"
 	LitInfoAddWriter        string = "reuse engine and add a writer for index reorg task"
 	LitInfoCreateWrite      string = "create one local writer for index reorg task"
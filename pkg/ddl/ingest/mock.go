@@ -33,6 +33,7 @@ import (
 type MockBackendCtxMgr struct {
 	sessCtxProvider func() sessionctx.Context
 	runningJobs     map[int64]*MockBackendCtx
+	pausedJobs      map[int64]struct{}
 }
 
 // NewMockBackendCtxMgr creates a new mock backend context manager.
@@ -40,6 +41,7 @@ func NewMockBackendCtxMgr(sessCtxProvider func() sessionctx.Context) *MockBacken
 	return &MockBackendCtxMgr{
 		sessCtxProvider: sessCtxProvider,
 		runningJobs:     make(map[int64]*MockBackendCtx),
+		pausedJobs:      make(map[int64]struct{}),
 	}
 }
 
@@ -58,15 +60,20 @@ func (m *MockBackendCtxMgr) CheckAvailable() (bool, error) {
 }
 
 // Register implements BackendCtxMgr.Register interface.
-func (m *MockBackendCtxMgr) Register(ctx context.Context, jobID int64, unique bool, etcdClient *clientv3.Client, pdSvcDiscovery pd.ServiceDiscovery, resourceGroupName string) (BackendCtx, error) {
+func (m *MockBackendCtxMgr) Register(ctx context.Context, jobID int64, unique bool, etcdClient *clientv3.Client, pdSvcDiscovery pd.ServiceDiscovery, resourceGroupName string, priority int) (BackendCtx, error) {
 	logutil.BgLogger().Info("mock backend mgr register", zap.Int64("jobID", jobID))
 	if mockCtx, ok := m.runningJobs[jobID]; ok {
+		delete(m.pausedJobs, jobID)
 		return mockCtx, nil
 	}
+	for pausedJobID := range m.pausedJobs {
+		m.Unregister(pausedJobID)
+	}
 	sessCtx := m.sessCtxProvider()
 	mockCtx := &MockBackendCtx{
-		mu:      sync.Mutex{},
-		sessCtx: sessCtx,
+		mu:         sync.Mutex{},
+		sessCtx:    sessCtx,
+		subtaskCPs: make(map[int64]*SubtaskCheckpoint),
 	}
 	m.runningJobs[jobID] = mockCtx
 	return mockCtx, nil
@@ -79,12 +86,21 @@ func (m *MockBackendCtxMgr) Unregister(jobID int64) {
 		err := mCtx.sessCtx.CommitTxn(context.Background())
 		logutil.BgLogger().Info("mock backend mgr unregister", zap.Int64("jobID", jobID), zap.Error(err))
 		delete(m.runningJobs, jobID)
+		delete(m.pausedJobs, jobID)
 		if mCtx.checkpointMgr != nil {
 			mCtx.checkpointMgr.Close()
 		}
 	}
 }
 
+// Pause implements BackendCtxMgr.Pause interface.
+func (m *MockBackendCtxMgr) Pause(jobID int64) {
+	logutil.BgLogger().Info("mock backend mgr pause", zap.Int64("jobID", jobID))
+	if _, ok := m.runningJobs[jobID]; ok {
+		m.pausedJobs[jobID] = struct{}{}
+	}
+}
+
 // Load implements BackendCtxMgr.Load interface.
 func (m *MockBackendCtxMgr) Load(jobID int64) (BackendCtx, bool) {
 	logutil.BgLogger().Info("mock backend mgr load", zap.Int64("jobID", jobID))
@@ -106,6 +122,7 @@ type MockBackendCtx struct {
 	sessCtx       sessionctx.Context
 	mu            sync.Mutex
 	checkpointMgr *CheckpointManager
+	subtaskCPs    map[int64]*SubtaskCheckpoint
 }
 
 // Register implements BackendCtx.Register interface.
@@ -159,11 +176,28 @@ func (m *MockBackendCtx) GetCheckpointManager() *CheckpointManager {
 	return m.checkpointMgr
 }
 
+// SubtaskCheckpoint implements BackendCtx.SubtaskCheckpoint interface.
+func (m *MockBackendCtx) SubtaskCheckpoint(subtaskID int64) *SubtaskCheckpoint {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp, ok := m.subtaskCPs[subtaskID]
+	if !ok {
+		cp = NewSubtaskCheckpoint()
+		m.subtaskCPs[subtaskID] = cp
+	}
+	return cp
+}
+
 // GetLocalBackend returns the local backend.
 func (*MockBackendCtx) GetLocalBackend() *local.Backend {
 	return nil
 }
 
+// WrittenBytes implements BackendCtx.WrittenBytes interface.
+func (*MockBackendCtx) WrittenBytes() int64 {
+	return 0
+}
+
 // MockWriteHook the hook for write in mock engine.
 type MockWriteHook func(key, val []byte)
 
@@ -16,6 +16,7 @@ package ddl
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/hex"
 	"encoding/json"
@@ -100,17 +101,22 @@ func (d *ddl) getJob(se *sess.Session, tp jobType, filter func(*model.Job) (bool
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	candidates := make([]jobCandidate, 0, len(rows))
 	for _, row := range rows {
 		jobBinary := row.GetBytes(0)
-		isJobProcessing := row.GetInt64(1) == 1
-
-		job := model.Job{}
+		job := &model.Job{}
 		err = job.Decode(jobBinary)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
-
-		isRunnable, err := d.processJobDuringUpgrade(se, &job)
+		candidates = append(candidates, jobCandidate{job: job, processing: row.GetInt64(1) == 1})
+	}
+	if tp == jobTypeReorg {
+		sortReorgJobCandidates(candidates)
+	}
+	for _, c := range candidates {
+		job := c.job
+		isRunnable, err := d.processJobDuringUpgrade(se, job)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -119,28 +125,60 @@ func (d *ddl) getJob(se *sess.Session, tp jobType, filter func(*model.Job) (bool
 		}
 
 		// The job has already been picked up, just return to continue it.
-		if isJobProcessing {
-			return &job, nil
+		if c.processing {
+			return job, nil
 		}
 
-		b, err := filter(&job)
+		b, err := filter(job)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
 		if b {
-			if err = d.markJobProcessing(se, &job); err != nil {
+			if err = d.markJobProcessing(se, job); err != nil {
 				logutil.BgLogger().Warn(
 					"[ddl] handle ddl job failed: mark job is processing meet error",
 					zap.Error(err),
 					zap.String("job", job.String()))
 				return nil, errors.Trace(err)
 			}
-			return &job, nil
+			return job, nil
 		}
 	}
 	return nil, nil
 }
 
+type jobCandidate struct {
+	job        *model.Job
+	processing bool
+}
+
+// sortReorgJobCandidates sorts the reorg job candidates, so that the processing jobs come first, and then the
+// queueing jobs with higher priority. The jobs with the same priority keep the order of the job ID. Because only
+// one job can use the ingest backend at the same time, it decides which ADD INDEX job gets the ingest backend.
+func sortReorgJobCandidates(candidates []jobCandidate) {
+	slices.SortStableFunc(candidates, func(a, b jobCandidate) int {
+		if a.processing != b.processing {
+			if a.processing {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(jobPriorityRank(b.job.Priority), jobPriorityRank(a.job.Priority))
+	})
+}
+
+// jobPriorityRank returns the rank of the job priority, the higher rank is scheduled first.
+func jobPriorityRank(priority int) int {
+	switch priority {
+	case kv.PriorityHigh:
+		return 2
+	case kv.PriorityLow:
+		return 0
+	default:
+		return 1
+	}
+}
+
 func hasSysDB(job *model.Job) bool {
 	for _, info := range job.GetInvolvingSchemaInfo() {
 		if tidb_util.IsSysDB(info.Database) {
@@ -228,7 +266,7 @@ func (d *ddl) getReorgJob(sess *sess.Session) (*model.Job, error) {
 		if !d.runningJobs.checkRunnable(job) {
 			return false, nil
 		}
-		if (job.Type == model.ActionAddIndex || job.Type == model.ActionAddPrimaryKey) &&
+		if (job.Type == model.ActionAddIndex || job.Type == model.ActionAddPrimaryKey || job.Type == model.ActionReorganizeIndex) &&
 			job.State == model.JobStateQueueing &&
 			job.ReorgMeta != nil &&
 			job.ReorgMeta.IsFastReorg &&
@@ -638,38 +676,46 @@ func getDDLReorgHandle(se *sess.Session, job *model.Job) (element *meta.Element,
 
 func getCheckpointReorgHandle(se *sess.Session, job *model.Job) (startKey, endKey kv.Key, physicalTableID int64, err error) {
 	startKey, endKey = kv.Key{}, kv.Key{}
+	cp, err := getReorgCheckpoint(se, job)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if cp != nil {
+		logutil.BgLogger().Info("resume physical table ID from checkpoint", zap.String("category", "ddl-ingest"),
+			zap.Int64("jobID", job.ID),
+			zap.String("start", hex.EncodeToString(cp.StartKey)),
+			zap.String("end", hex.EncodeToString(cp.EndKey)),
+			zap.Int64("checkpoint physical ID", cp.PhysicalID))
+		physicalTableID = cp.PhysicalID
+		if len(cp.StartKey) > 0 {
+			startKey = cp.StartKey
+		}
+		if len(cp.EndKey) > 0 {
+			endKey = cp.EndKey
+		}
+	}
+	return
+}
+
+// getReorgCheckpoint returns the checkpoint persisted by the ingest reorg job, it returns nil if there is no checkpoint.
+func getReorgCheckpoint(se *sess.Session, job *model.Job) (*ingest.ReorgCheckpoint, error) {
 	sql := fmt.Sprintf("select reorg_meta from mysql.tidb_ddl_reorg where job_id = %d", job.ID)
 	ctx := kv.WithInternalSourceType(context.Background(), getDDLRequestSource(job.Type))
 	rows, err := se.Execute(ctx, sql, "get_handle")
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, err
 	}
 	if len(rows) == 0 {
-		return nil, nil, 0, meta.ErrDDLReorgElementNotExist
+		return nil, meta.ErrDDLReorgElementNotExist
 	}
-	if !rows[0].IsNull(0) {
-		rawReorgMeta := rows[0].GetBytes(0)
-		var reorgMeta ingest.JobReorgMeta
-		err = json.Unmarshal(rawReorgMeta, &reorgMeta)
-		if err != nil {
-			return nil, nil, 0, errors.Trace(err)
-		}
-		if cp := reorgMeta.Checkpoint; cp != nil {
-			logutil.BgLogger().Info("resume physical table ID from checkpoint", zap.String("category", "ddl-ingest"),
-				zap.Int64("jobID", job.ID),
-				zap.String("start", hex.EncodeToString(cp.StartKey)),
-				zap.String("end", hex.EncodeToString(cp.EndKey)),
-				zap.Int64("checkpoint physical ID", cp.PhysicalID))
-			physicalTableID = cp.PhysicalID
-			if len(cp.StartKey) > 0 {
-				startKey = cp.StartKey
-			}
-			if len(cp.EndKey) > 0 {
-				endKey = cp.EndKey
-			}
-		}
+	if rows[0].IsNull(0) {
+		return nil, nil
 	}
-	return
+	var reorgMeta ingest.JobReorgMeta
+	if err = json.Unmarshal(rows[0].GetBytes(0), &reorgMeta); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return reorgMeta.Checkpoint, nil
 }
 
 // updateDDLReorgHandle update startKey, endKey physicalTableID and element of the handle.
@@ -351,13 +351,16 @@ func checkOperateSameColAndIdx(info *model.MultiSchemaInfo) error {
 
 func mergeAddIndex(info *model.MultiSchemaInfo) {
 	consistentUnique := false
-	for i, subJob := range info.SubJobs {
+	hasAddIndex := false
+	for _, subJob := range info.SubJobs {
 		if subJob.Type == model.ActionAddForeignKey {
 			// Foreign key requires the order of adding indexes is unchanged.
 			return
 		}
 		if subJob.Type == model.ActionAddIndex || subJob.Type == model.ActionAddPrimaryKey {
-			if i == 0 {
+			if !hasAddIndex {
+				// Compare with the first sub-job which adds index, other types of sub-jobs may come before it.
+				hasAddIndex = true
 				consistentUnique = subJob.Args[0].(bool)
 			} else {
 				if consistentUnique != subJob.Args[0].(bool) {
@@ -678,6 +678,32 @@ func TestMultiSchemaChangeAdminShowDDLJobs(t *testing.T) {
 	dom.DDL().SetHook(originHook)
 }
 
+func TestMultiSchemaChangeMergeAddIndexes(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c int)")
+	tk.MustExec("insert into t values (1, 2, 3)")
+
+	originHook := dom.DDL().GetHook()
+	hook := &callback.TestDDLCallback{Do: dom}
+	subJobCnt := 0
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if job.Type == model.ActionMultiSchemaChange {
+			subJobCnt = len(job.MultiSchemaInfo.SubJobs)
+		}
+	}
+	dom.DDL().SetHook(hook)
+	// The unique indexes are built in one sub-job even if other sub-jobs come before them.
+	tk.MustExec("alter table t add column d int, add unique index i1(a), add unique index i2(b)")
+	require.Equal(t, 2, subJobCnt)
+	// The unique and non-unique indexes are not merged.
+	tk.MustExec("alter table t add column e int, add unique index i3(a), add index i4(b)")
+	require.Equal(t, 3, subJobCnt)
+	dom.DDL().SetHook(originHook)
+	tk.MustExec("admin check table t")
+}
+
 func TestMultiSchemaChangeWithExpressionIndex(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	tk := testkit.NewTestKit(t, store)
@@ -217,7 +217,7 @@ func (w *worker) onAddTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (v
 		// For normal and replica finished table, move the `addingDefinitions` into `Definitions`.
 		updatePartitionInfo(tblInfo)
 
-		preSplitAndScatter(w.sess.Context, d.store, tblInfo, addingDefinitions)
+		preSplitAndScatter(w.sess.Context, d.store, job.SchemaName, tblInfo, addingDefinitions)
 
 		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
 		if err != nil {
@@ -2234,7 +2234,7 @@ func (w *worker) onTruncateTablePartition(d *ddlCtx, t *meta.Meta, job *model.Jo
 			return ver, err
 		}
 
-		preSplitAndScatter(w.sess.Context, d.store, tblInfo, newPartitions)
+		preSplitAndScatter(w.sess.Context, d.store, job.SchemaName, tblInfo, newPartitions)
 
 		job.CtxVars = []any{oldIDs, newIDs}
 		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
@@ -2373,7 +2373,7 @@ func (w *worker) onTruncateTablePartition(d *ddlCtx, t *meta.Meta, job *model.Jo
 		tblInfo.Partition.DroppingDefinitions = nil
 		tblInfo.Partition.NewPartitionIDs = nil
 
-		preSplitAndScatter(w.sess.Context, d.store, tblInfo, newPartitions)
+		preSplitAndScatter(w.sess.Context, d.store, job.SchemaName, tblInfo, newPartitions)
 
 		// used by ApplyDiff in updateSchemaVersion
 		job.CtxVars = []any{oldIDs, newIDs}
@@ -2919,7 +2919,7 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		// and we will soon start writing to the new partitions.
 		if s, ok := d.store.(kv.SplittableStore); ok && s != nil {
 			// partInfo only contains the AddingPartitions
-			splitPartitionTableRegion(w.sess.Context, s, tblInfo, partInfo.Definitions, true)
+			splitPartitionTableRegion(w.sess.Context, NewRegionSplitter(s, job.SchemaName, tblInfo, true), tblInfo, partInfo.Definitions)
 		}
 
 		// Assume we cannot have more than MaxUint64 rows, set the progress to 1/10 of that.
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
@@ -71,6 +72,11 @@ type reorgCtx struct {
 	}
 
 	references atomicutil.Int32
+
+	// The reorg parameters changed by `ADMIN ALTER DDL JOBS`, 0 means using the global variables.
+	concurrency   atomicutil.Int64
+	batchSize     atomicutil.Int64
+	maxWriteSpeed atomicutil.Int64
 }
 
 // newContext gets a context. It is only used for adding column in reorganization state.
@@ -133,6 +139,49 @@ func (rc *reorgCtx) getRowCount() int64 {
 	return row
 }
 
+// setReorgParams sets the reorg parameters of the job, so the running reorg workers can pick them up.
+func (rc *reorgCtx) setReorgParams(reorgMeta *model.DDLReorgMeta) {
+	if reorgMeta == nil {
+		return
+	}
+	rc.concurrency.Store(int64(reorgMeta.Concurrency))
+	rc.batchSize.Store(int64(reorgMeta.BatchSize))
+	rc.maxWriteSpeed.Store(reorgMeta.MaxWriteSpeed)
+}
+
+// getReorgWorkerCnt returns the worker count of the reorg job, it's the value changed by `ADMIN ALTER DDL JOBS`
+// if there is, otherwise the value of tidb_ddl_reorg_worker_cnt.
+func (dc *ddlCtx) getReorgWorkerCnt(jobID int64) int {
+	if rc := dc.getReorgCtx(jobID); rc != nil {
+		if cnt := rc.concurrency.Load(); cnt > 0 {
+			return int(cnt)
+		}
+	}
+	return int(variable.GetDDLReorgWorkerCounter())
+}
+
+// getReorgBatchSize returns the batch size of the reorg job, it's the value changed by `ADMIN ALTER DDL JOBS`
+// if there is, otherwise the value of tidb_ddl_reorg_batch_size.
+func (dc *ddlCtx) getReorgBatchSize(jobID int64) int {
+	if rc := dc.getReorgCtx(jobID); rc != nil {
+		if size := rc.batchSize.Load(); size > 0 {
+			return int(size)
+		}
+	}
+	return int(variable.GetDDLReorgBatchSize())
+}
+
+// getReorgMaxWriteSpeed returns the max write speed of the ingest job, it's the value changed by
+// `ADMIN ALTER DDL JOBS` if there is, otherwise the value of tidb_ddl_ingest_max_write_speed.
+func (dc *ddlCtx) getReorgMaxWriteSpeed(jobID int64) int {
+	if rc := dc.getReorgCtx(jobID); rc != nil {
+		if speed := rc.maxWriteSpeed.Load(); speed > 0 {
+			return int(speed)
+		}
+	}
+	return int(variable.DDLIngestMaxWriteSpeed.Load())
+}
+
 // runReorgJob is used as a portal to do the reorganization work.
 // eg:
 // 1: add index
@@ -200,11 +249,14 @@ func (w *worker) runReorgJob(reorgInfo *reorgInfo, tblInfo *model.TableInfo,
 		}
 
 		rc = w.newReorgCtx(reorgInfo.Job.ID, reorgInfo.Job.GetRowCount())
+		rc.setReorgParams(job.ReorgMeta)
 		w.wg.Add(1)
 		go func() {
 			defer w.wg.Done()
 			rc.doneCh <- f()
 		}()
+	} else {
+		rc.setReorgParams(job.ReorgMeta)
 	}
 
 	waitTimeout := defaultWaitReorgTimeout
@@ -238,7 +290,7 @@ func (w *worker) runReorgJob(reorgInfo *reorgInfo, tblInfo *model.TableInfo,
 
 		d.removeReorgCtx(job.ID)
 
-		updateBackfillProgress(w, reorgInfo, tblInfo, rowCount)
+		updateBackfillProgress(w, reorgInfo, tblInfo, rowCount, err == nil)
 
 		// For other errors, even err is not nil here, we still wait the partial counts to be collected.
 		// since in the next round, the startKey is brand new which is stored by last time.
@@ -253,7 +305,7 @@ func (w *worker) runReorgJob(reorgInfo *reorgInfo, tblInfo *model.TableInfo,
 	case <-time.After(waitTimeout):
 		rowCount := rc.getRowCount()
 		job.SetRowCount(rowCount)
-		updateBackfillProgress(w, reorgInfo, tblInfo, rowCount)
+		updateBackfillProgress(w, reorgInfo, tblInfo, rowCount, false)
 
 		// Update a job's warnings.
 		w.mergeWarningsIntoJob(job)
@@ -329,13 +381,14 @@ func (w *worker) mergeWarningsIntoJob(job *model.Job) {
 }
 
 func updateBackfillProgress(w *worker, reorgInfo *reorgInfo, tblInfo *model.TableInfo,
-	addedRowCount int64) {
+	addedRowCount int64, scanDone bool) {
 	if tblInfo == nil {
 		return
 	}
 	progress := float64(0)
+	totalCount := int64(0)
 	if addedRowCount != 0 {
-		totalCount := getTableTotalCount(w, tblInfo)
+		totalCount = getTableTotalCount(w, tblInfo)
 		if totalCount > 0 {
 			progress = float64(addedRowCount) / float64(totalCount)
 		} else {
@@ -350,7 +403,7 @@ func updateBackfillProgress(w *worker, reorgInfo *reorgInfo, tblInfo *model.Tabl
 			zap.Int64("totalCount", totalCount))
 	}
 	switch reorgInfo.Type {
-	case model.ActionAddIndex, model.ActionAddPrimaryKey:
+	case model.ActionAddIndex, model.ActionAddPrimaryKey, model.ActionReorganizeIndex:
 		var label string
 		if reorgInfo.mergingTmpIdx {
 			label = metrics.LblAddIndexMerge
@@ -364,6 +417,34 @@ func updateBackfillProgress(w *worker, reorgInfo *reorgInfo, tblInfo *model.Tabl
 		model.ActionAlterTablePartitioning:
 		metrics.GetBackfillProgressByLabel(metrics.LblReorgPartition, reorgInfo.SchemaName, tblInfo.Name.String()).Set(progress * 100)
 	}
+	updateIngestProgress(reorgInfo, totalCount, addedRowCount, scanDone)
+}
+
+// updateIngestProgress updates the progress of the ingest job, which is shown in information_schema.ddl_jobs.
+func updateIngestProgress(reorgInfo *reorgInfo, totalCount, addedRowCount int64, scanDone bool) {
+	job := reorgInfo.Job
+	if job.ReorgMeta == nil || job.ReorgMeta.ReorgTp != model.ReorgTypeLitMerge {
+		return
+	}
+	if job.ReorgMeta.Progress == nil {
+		job.ReorgMeta.Progress = &model.ReorgProgress{}
+	}
+	progress := job.ReorgMeta.Progress
+	phase := model.ReorgPhaseScan
+	switch {
+	case reorgInfo.mergingTmpIdx:
+		phase = model.ReorgPhaseMerge
+	case scanDone:
+		phase = model.ReorgPhaseImport
+	}
+	if totalCount == 0 {
+		totalCount = progress.TotalRows
+	}
+	writtenBytes := progress.WrittenBytes
+	if bc, ok := ingest.LitBackCtxMgr.Load(job.ID); ok {
+		writtenBytes = max(writtenBytes, bc.WrittenBytes())
+	}
+	progress.Update(phase, addedRowCount, totalCount, writtenBytes, time.Now())
 }
 
 func getTableTotalCount(w *worker, tblInfo *model.TableInfo) int64 {
@@ -480,6 +480,8 @@ func convertJob2RollbackJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job)
 		ver, err = rollingbackAddIndex(w, d, t, job, false)
 	case model.ActionAddPrimaryKey:
 		ver, err = rollingbackAddIndex(w, d, t, job, true)
+	case model.ActionReorganizeIndex:
+		ver, err = rollingbackReorganizeIndex(w, d, t, job)
 	case model.ActionAddTablePartition:
 		ver, err = rollingbackAddTablePartition(d, t, job)
 	case model.ActionReorganizePartition, model.ActionRemovePartitioning,
@@ -125,6 +125,24 @@ func expectedDeleteRangeCnt(ctx delRangeCntCtx, job *model.Job) (int, error) {
 			idxIDNumFactor = 2 * len(indexID) // Add origin index to del-range table.
 		}
 		return mathutil.Max(len(partitionIDs)*idxIDNumFactor, idxIDNumFactor), nil
+	case model.ActionReorganizeIndex:
+		var indexIDs []int64
+		var ifExists []bool
+		var partitionIDs []int64
+		var oldIndexID int64
+		var oldPhysicalIDs []int64
+		if err := job.DecodeArgs(&indexIDs, &ifExists, &partitionIDs, &oldIndexID, &oldPhysicalIDs); err != nil {
+			return 0, errors.Trace(err)
+		}
+		idxIDNumFactor := len(indexIDs)
+		if job.State == model.JobStateRollbackDone {
+			idxIDNumFactor = 2 * len(indexIDs)
+		}
+		cnt := mathutil.Max(len(partitionIDs)*idxIDNumFactor, idxIDNumFactor)
+		if job.State == model.JobStateDone && oldIndexID != 0 {
+			cnt += mathutil.Max(len(oldPhysicalIDs), 1)
+		}
+		return cnt, nil
 	case model.ActionDropIndex, model.ActionDropPrimaryKey:
 		var indexName any
 		ifNotExists := make([]bool, 1)
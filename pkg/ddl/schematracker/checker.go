@@ -278,6 +278,16 @@ func (*Checker) FlashbackCluster(_ sessionctx.Context, _ uint64) (err error) {
 	panic("implement me")
 }
 
+// ReorganizeIndex implements the DDL interface.
+func (d *Checker) ReorganizeIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CIStr) error {
+	err := d.realDDL.ReorganizeIndex(ctx, ti, indexName)
+	if err != nil {
+		return err
+	}
+	d.checkTableInfo(ctx, ti.Schema, ti.Name)
+	return nil
+}
+
 // DropView implements the DDL interface.
 func (d *Checker) DropView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error) {
 	err = d.realDDL.DropView(ctx, stmt)
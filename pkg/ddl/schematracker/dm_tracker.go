@@ -331,6 +331,11 @@ func (SchemaTracker) FlashbackCluster(_ sessionctx.Context, _ uint64) (err error
 	return nil
 }
 
+// ReorganizeIndex implements the DDL interface, which is no-op in DM's case.
+func (SchemaTracker) ReorganizeIndex(_ sessionctx.Context, _ ast.Ident, _ model.CIStr) error {
+	return nil
+}
+
 // RecoverSchema implements the DDL interface, which is no-op in DM's case.
 func (SchemaTracker) RecoverSchema(_ sessionctx.Context, _ *ddl.RecoverSchemaInfo) (err error) {
 	return nil
@@ -16,6 +16,7 @@ package ddl
 
 import (
 	"context"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/kv"
@@ -23,14 +24,80 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	tikverr "github.com/tikv/client-go/v2/error"
 	"go.uber.org/zap"
 )
 
-func splitPartitionTableRegion(ctx sessionctx.Context, store kv.SplittableStore, tbInfo *model.TableInfo, parts []model.PartitionDefinition, scatter bool) {
+// RegionSplitter splits the regions of a table under its scheduling in tidb_table_region_schedule.
+type RegionSplitter struct {
+	store   kv.SplittableStore
+	tableID int64
+	scatter bool
+	// deferred is true if the regions should be scattered but it's out of the maintenance window.
+	deferred bool
+	rate     uint64
+	// nextBatch is the time when the next batch of the regions can be split under the rate.
+	nextBatch time.Time
+}
+
+// NewRegionSplitter creates a RegionSplitter for the table. The regions are scattered if scatter is true
+// and it's in the maintenance window of the table.
+func NewRegionSplitter(store kv.SplittableStore, schema string, tblInfo *model.TableInfo, scatter bool) *RegionSplitter {
+	s := &RegionSplitter{store: store, tableID: tblInfo.ID, scatter: scatter}
+	if schedule, ok := variable.GetTableRegionSchedule(schema, tblInfo.Name.L); ok {
+		if scatter && !schedule.InWindow(time.Now()) {
+			s.scatter, s.deferred = false, true
+			logutil.BgLogger().Info("the regions aren't scattered out of the maintenance window", zap.String("category", "ddl"),
+				zap.String("schema", schema), zap.Stringer("table", tblInfo.Name), zap.Stringer("schedule", schedule))
+		}
+		s.rate = schedule.Rate
+	}
+	return s
+}
+
+// Scatter returns whether the split regions are scattered.
+func (s *RegionSplitter) Scatter() bool {
+	return s.scatter
+}
+
+// ScatterDeferred returns whether the split regions aren't scattered because it's out of the maintenance window.
+func (s *RegionSplitter) ScatterDeferred() bool {
+	return s.deferred
+}
+
+// SplitRegions splits the regions at the keys, and at most rate regions are split per second.
+func (s *RegionSplitter) SplitRegions(ctx context.Context, keys [][]byte) ([]uint64, error) {
+	if s.rate == 0 {
+		return s.store.SplitRegions(ctx, keys, s.scatter, &s.tableID)
+	}
+	var regionIDs []uint64
+	for len(keys) > 0 {
+		if wait := time.Until(s.nextBatch); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return regionIDs, errors.Trace(ctx.Err())
+			case <-time.After(wait):
+			}
+		}
+		n := int(min(s.rate, uint64(len(keys))))
+		s.nextBatch = time.Now().Add(time.Duration(n) * time.Second / time.Duration(s.rate))
+		ids, err := s.store.SplitRegions(ctx, keys[:n], s.scatter, &s.tableID)
+		regionIDs = append(regionIDs, ids...)
+		if err != nil {
+			return regionIDs, err
+		}
+		keys = keys[n:]
+	}
+	return regionIDs, nil
+}
+
+func splitPartitionTableRegion(ctx sessionctx.Context, splitter *RegionSplitter, tbInfo *model.TableInfo, parts []model.PartitionDefinition) {
 	// Max partition count is 8192, should we sample and just choose some partitions to split?
 	regionIDs := make([]uint64, 0, len(parts))
 	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), ctx.GetSessionVars().GetSplitRegionTimeout())
@@ -38,34 +105,34 @@ func splitPartitionTableRegion(ctx sessionctx.Context, store kv.SplittableStore,
 	ctxWithTimeout = kv.WithInternalSourceType(ctxWithTimeout, kv.InternalTxnDDL)
 	if shardingBits(tbInfo) > 0 && tbInfo.PreSplitRegions > 0 {
 		for _, def := range parts {
-			regionIDs = append(regionIDs, preSplitPhysicalTableByShardRowID(ctxWithTimeout, store, tbInfo, def.ID, scatter)...)
+			regionIDs = append(regionIDs, preSplitPhysicalTableByShardRowID(ctxWithTimeout, splitter, tbInfo, def.ID)...)
 		}
 	} else {
 		for _, def := range parts {
-			regionIDs = append(regionIDs, SplitRecordRegion(ctxWithTimeout, store, def.ID, tbInfo.ID, scatter))
+			regionIDs = append(regionIDs, splitRecordRegion(ctxWithTimeout, splitter, def.ID))
 		}
 	}
-	if scatter {
-		WaitScatterRegionFinish(ctxWithTimeout, store, regionIDs...)
+	if splitter.Scatter() {
+		WaitScatterRegionFinish(ctxWithTimeout, splitter.store, regionIDs...)
 	}
 }
 
-func splitTableRegion(ctx sessionctx.Context, store kv.SplittableStore, tbInfo *model.TableInfo, scatter bool) {
+func splitTableRegion(ctx sessionctx.Context, splitter *RegionSplitter, tbInfo *model.TableInfo) {
 	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), ctx.GetSessionVars().GetSplitRegionTimeout())
 	defer cancel()
 	ctxWithTimeout = kv.WithInternalSourceType(ctxWithTimeout, kv.InternalTxnDDL)
 	var regionIDs []uint64
 	if shardingBits(tbInfo) > 0 && tbInfo.PreSplitRegions > 0 {
-		regionIDs = preSplitPhysicalTableByShardRowID(ctxWithTimeout, store, tbInfo, tbInfo.ID, scatter)
+		regionIDs = preSplitPhysicalTableByShardRowID(ctxWithTimeout, splitter, tbInfo, tbInfo.ID)
 	} else {
-		regionIDs = append(regionIDs, SplitRecordRegion(ctxWithTimeout, store, tbInfo.ID, tbInfo.ID, scatter))
+		regionIDs = append(regionIDs, splitRecordRegion(ctxWithTimeout, splitter, tbInfo.ID))
 	}
-	if scatter {
-		WaitScatterRegionFinish(ctxWithTimeout, store, regionIDs...)
+	if splitter.Scatter() {
+		WaitScatterRegionFinish(ctxWithTimeout, splitter.store, regionIDs...)
 	}
 }
 
-func preSplitPhysicalTableByShardRowID(ctx context.Context, store kv.SplittableStore, tbInfo *model.TableInfo, physicalID int64, scatter bool) []uint64 {
+func preSplitPhysicalTableByShardRowID(ctx context.Context, splitter *RegionSplitter, tbInfo *model.TableInfo, physicalID int64) []uint64 {
 	// Example:
 	// sharding_bits = 4
 	// PreSplitRegions = 2
@@ -108,19 +175,23 @@ func preSplitPhysicalTableByShardRowID(ctx context.Context, store kv.SplittableS
 		splitTableKeys = append(splitTableKeys, key)
 	}
 	var err error
-	regionIDs, err := store.SplitRegions(ctx, splitTableKeys, scatter, &tbInfo.ID)
+	regionIDs, err := splitter.SplitRegions(ctx, splitTableKeys)
 	if err != nil {
 		logutil.BgLogger().Warn("pre split some table regions failed", zap.String("category", "ddl"),
 			zap.Stringer("table", tbInfo.Name), zap.Int("successful region count", len(regionIDs)), zap.Error(err))
 	}
-	regionIDs = append(regionIDs, splitIndexRegion(store, tbInfo, scatter)...)
+	regionIDs = append(regionIDs, splitIndexRegion(ctx, splitter, tbInfo)...)
 	return regionIDs
 }
 
 // SplitRecordRegion is to split region in store by table prefix.
 func SplitRecordRegion(ctx context.Context, store kv.SplittableStore, physicalTableID, tableID int64, scatter bool) uint64 {
+	return splitRecordRegion(ctx, &RegionSplitter{store: store, tableID: tableID, scatter: scatter}, physicalTableID)
+}
+
+func splitRecordRegion(ctx context.Context, splitter *RegionSplitter, physicalTableID int64) uint64 {
 	tableStartKey := tablecodec.GenTablePrefix(physicalTableID)
-	regionIDs, err := store.SplitRegions(ctx, [][]byte{tableStartKey}, scatter, &tableID)
+	regionIDs, err := splitter.SplitRegions(ctx, [][]byte{tableStartKey})
 	if err != nil {
 		// It will be automatically split by TiKV later.
 		logutil.BgLogger().Warn("split table region failed", zap.String("category", "ddl"), zap.Error(err))
@@ -131,13 +202,13 @@ func SplitRecordRegion(ctx context.Context, store kv.SplittableStore, physicalTa
 	return 0
 }
 
-func splitIndexRegion(store kv.SplittableStore, tblInfo *model.TableInfo, scatter bool) []uint64 {
+func splitIndexRegion(ctx context.Context, splitter *RegionSplitter, tblInfo *model.TableInfo) []uint64 {
 	splitKeys := make([][]byte, 0, len(tblInfo.Indices))
 	for _, idx := range tblInfo.Indices {
 		indexPrefix := tablecodec.EncodeTableIndexPrefix(tblInfo.ID, idx.ID)
 		splitKeys = append(splitKeys, indexPrefix)
 	}
-	regionIDs, err := store.SplitRegions(context.Background(), splitKeys, scatter, &tblInfo.ID)
+	regionIDs, err := splitter.SplitRegions(ctx, splitKeys)
 	if err != nil {
 		logutil.BgLogger().Warn("pre split some table index regions failed", zap.String("category", "ddl"),
 			zap.Stringer("table", tblInfo.Name), zap.Int("successful region count", len(regionIDs)), zap.Error(err))
@@ -145,6 +216,95 @@ func splitIndexRegion(store kv.SplittableStore, tblInfo *model.TableInfo, scatte
 	return regionIDs
 }
 
+// preSplitIndexRegionsByHistogram pre-splits each index to be ingested into about `regions` regions by the
+// histogram of its first column, so that the skewed index KVs are imported into the scattered regions, rather
+// than splitting the regions heavily at the import phase. It's best-effort, the index without the histogram
+// of its first column is skipped.
+func preSplitIndexRegionsByHistogram(ctx context.Context, d *ddlCtx, schema string, tblInfo *model.TableInfo, idxInfos []*model.IndexInfo, regions int) {
+	store, ok := d.store.(kv.SplittableStore)
+	if !ok || d.statsHandle == nil {
+		return
+	}
+	splitter := NewRegionSplitter(store, schema, tblInfo, true)
+	physicalIDs := []int64{tblInfo.ID}
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		physicalIDs = physicalIDs[:0]
+		for _, def := range pi.Definitions {
+			physicalIDs = append(physicalIDs, def.ID)
+		}
+	}
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, variable.DefWaitSplitRegionTimeout*time.Second)
+	defer cancel()
+	ctxWithTimeout = kv.WithInternalSourceType(ctxWithTimeout, kv.InternalTxnDDL)
+
+	var regionIDs []uint64
+	for _, pid := range physicalIDs {
+		var statsTbl *statistics.Table
+		if pid == tblInfo.ID {
+			statsTbl = d.statsHandle.GetTableStats(tblInfo)
+		} else {
+			statsTbl = d.statsHandle.GetPartitionStats(tblInfo, pid)
+		}
+		if statsTbl == nil || statsTbl.Pseudo {
+			continue
+		}
+		for _, idxInfo := range idxInfos {
+			colInfo := tblInfo.Columns[idxInfo.Columns[0].Offset]
+			col := statsTbl.Columns[colInfo.ID]
+			if col == nil || !col.IsAnalyzed() {
+				continue
+			}
+			splitKeys := make([][]byte, 0, regions)
+			splitKeys = append(splitKeys, tablecodec.EncodeTableIndexPrefix(pid, idxInfo.ID))
+			for _, bound := range histogramSplitBounds(&col.Histogram, regions) {
+				encoded, err := codec.EncodeKey(time.UTC, nil, bound)
+				if err != nil {
+					logutil.BgLogger().Warn("encode the pre-split key of index failed", zap.String("category", "ddl"),
+						zap.Stringer("table", tblInfo.Name), zap.Stringer("index", idxInfo.Name), zap.Error(err))
+					break
+				}
+				splitKeys = append(splitKeys, tablecodec.EncodeIndexSeekKey(pid, idxInfo.ID, encoded))
+			}
+			ids, err := splitter.SplitRegions(ctxWithTimeout, splitKeys)
+			if err != nil {
+				logutil.BgLogger().Warn("pre split index regions by histogram failed", zap.String("category", "ddl"),
+					zap.Stringer("table", tblInfo.Name), zap.Stringer("index", idxInfo.Name),
+					zap.Int("successful region count", len(ids)), zap.Error(err))
+			}
+			regionIDs = append(regionIDs, ids...)
+		}
+	}
+	if splitter.Scatter() {
+		WaitScatterRegionFinish(ctxWithTimeout, store, regionIDs...)
+	}
+	logutil.BgLogger().Info("pre split index regions by histogram", zap.String("category", "ddl"),
+		zap.Stringer("table", tblInfo.Name), zap.Int("regions", regions), zap.Int("split region count", len(regionIDs)))
+}
+
+// histogramSplitBounds returns the upper bounds of the buckets which divide the rows in the histogram into
+// about `regions` parts evenly, the same bound is returned only once.
+func histogramSplitBounds(hg *statistics.Histogram, regions int) []types.Datum {
+	if hg.Len() == 0 || regions <= 1 {
+		return nil
+	}
+	total := hg.Buckets[hg.Len()-1].Count
+	bounds := make([]types.Datum, 0, regions-1)
+	lastIdx := -1
+	idx := 0
+	for i := 1; i < regions; i++ {
+		target := total * int64(i) / int64(regions)
+		for idx < hg.Len()-1 && hg.Buckets[idx].Count < target {
+			idx++
+		}
+		if idx == lastIdx || idx == hg.Len()-1 {
+			continue
+		}
+		bounds = append(bounds, *hg.GetUpper(idx))
+		lastIdx = idx
+	}
+	return bounds
+}
+
 // WaitScatterRegionFinish will block until all regions are scattered.
 func WaitScatterRegionFinish(ctx context.Context, store kv.SplittableStore, regionIDs ...uint64) {
 	for _, regionID := range regionIDs {
@@ -0,0 +1,54 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramSplitBounds(t *testing.T) {
+	newHistogram := func(counts ...int64) *statistics.Histogram {
+		hg := statistics.NewHistogram(1, 0, 0, 0, types.NewFieldType(mysql.TypeLonglong), len(counts), 0)
+		for i, count := range counts {
+			lower, upper := types.NewIntDatum(int64(i*10+1)), types.NewIntDatum(int64(i*10+10))
+			hg.AppendBucket(&lower, &upper, count, 1)
+		}
+		return hg
+	}
+	check := func(hg *statistics.Histogram, regions int, expected ...int64) {
+		bounds := histogramSplitBounds(hg, regions)
+		require.Len(t, bounds, len(expected))
+		for i, bound := range bounds {
+			require.Equal(t, expected[i], bound.GetInt64())
+		}
+	}
+
+	uniform := newHistogram(10, 20, 30, 40, 50, 60, 70, 80, 90, 100)
+	check(uniform, 4, 30, 50, 80)
+	check(uniform, 2, 50)
+	check(uniform, 1)
+	// the bounds are at most the buckets.
+	check(uniform, 100, 10, 20, 30, 40, 50, 60, 70, 80, 90)
+
+	// most of the rows are in the first bucket.
+	skewed := newHistogram(90, 92, 94, 96, 98, 100)
+	check(skewed, 4, 10)
+	check(newHistogram(), 4)
+}
@@ -851,7 +851,7 @@ func (w *worker) onTruncateTable(d *ddlCtx, t *meta.Meta, job *model.Job) (ver i
 	if pi := tblInfo.GetPartitionInfo(); pi != nil {
 		partitions = tblInfo.GetPartitionInfo().Definitions
 	}
-	preSplitAndScatter(w.sess.Context, d.store, tblInfo, partitions)
+	preSplitAndScatter(w.sess.Context, d.store, job.SchemaName, tblInfo, partitions)
 
 	ver, err = updateSchemaVersion(d, t, job)
 	if err != nil {
@@ -17,6 +17,7 @@ package context
 import (
 	"time"
 
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/pkg/domain/resourcegroup"
 	"github.com/pingcap/tidb/pkg/errctx"
 	"github.com/pingcap/tidb/pkg/kv"
@@ -83,6 +84,7 @@ type DistSQLContext struct {
 	TiKVClientReadTimeout         uint64
 
 	ReplicaClosestReadThreshold int64
+	ReplicaReadStoreLabels      []*metapb.StoreLabel
 	ConnectionID                uint64
 	SessionAlias                string
 
@@ -47,6 +47,8 @@ type RequestBuilder struct {
 	kv.Request
 	is  infoschema.InfoSchema
 	err error
+	// replicaReadStoreLabels is the labels that the stores must match when reading from replicas.
+	replicaReadStoreLabels []*metapb.StoreLabel
 }
 
 // Build builds a "kv.Request".
@@ -62,6 +64,9 @@ func (builder *RequestBuilder) Build() (*kv.Request, error) {
 			},
 		}
 	}
+	if len(builder.replicaReadStoreLabels) > 0 && (builder.ReplicaRead.IsFollowerRead() || builder.IsStaleness) {
+		builder.MatchStoreLabels = append(builder.MatchStoreLabels, builder.replicaReadStoreLabels...)
+	}
 	failpoint.Inject("assertRequestBuilderReplicaOption", func(val failpoint.Value) {
 		assertScope := val.(string)
 		if builder.ReplicaRead.IsClosestRead() && assertScope != builder.ReadReplicaScope {
@@ -300,6 +305,7 @@ func (builder *RequestBuilder) SetFromSessionVars(dctx *distsqlctx.DistSQLContex
 	builder.Request.TaskID = dctx.TaskID
 	builder.Request.Priority = builder.getKVPriority(dctx)
 	builder.Request.ReplicaRead = replicaReadType
+	builder.replicaReadStoreLabels = dctx.ReplicaReadStoreLabels
 	builder.SetResourceGroupTagger(dctx.ResourceGroupTagger)
 	{
 		builder.SetPaging(dctx.EnablePaging)
@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/pkg/domain/resourcegroup"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -676,6 +677,34 @@ func TestRequestBuilderTiKVClientReadTimeout(t *testing.T) {
 	require.Equal(t, expect, actual)
 }
 
+func TestRequestBuilderReplicaReadStoreLabels(t *testing.T) {
+	labels := []*metapb.StoreLabel{{Key: "role", Value: "analytics"}}
+	dctx := NewDistSQLContextForTest()
+	dctx.ReplicaReadStoreLabels = labels
+
+	// the labels are ignored when reading from leader.
+	actual, err := (&RequestBuilder{}).SetFromSessionVars(dctx).Build()
+	require.NoError(t, err)
+	require.Empty(t, actual.MatchStoreLabels)
+
+	// the labels are used by stale read.
+	actual, err = (&RequestBuilder{}).SetFromSessionVars(dctx).SetIsStaleness(true).Build()
+	require.NoError(t, err)
+	require.Equal(t, labels, actual.MatchStoreLabels)
+
+	// the labels are used by follower read.
+	dctx.ReplicaReadType = kv.ReplicaReadFollower
+	actual, err = (&RequestBuilder{}).SetFromSessionVars(dctx).Build()
+	require.NoError(t, err)
+	require.Equal(t, labels, actual.MatchStoreLabels)
+
+	// the labels are combined with the zone label of closest read.
+	dctx.ReplicaReadType = kv.ReplicaReadClosest
+	actual, err = (&RequestBuilder{}).SetFromSessionVars(dctx).SetReadReplicaScope("z1").Build()
+	require.NoError(t, err)
+	require.Equal(t, []*metapb.StoreLabel{{Key: "zone", Value: "z1"}, {Key: "role", Value: "analytics"}}, actual.MatchStoreLabels)
+}
+
 func TestTableRangesToKVRangesWithFbs(t *testing.T) {
 	ranges := []*ranger.Range{
 		{
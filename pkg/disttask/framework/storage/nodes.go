@@ -201,3 +201,65 @@ func (mgr *TaskManager) getCPUCountOfManagedNode(ctx context.Context, se session
 	}
 	return cpuCount, nil
 }
+
+// NodeResourceUsage is the resource usage of a node in dist_framework_meta.
+type NodeResourceUsage struct {
+	proto.ManagedNode
+	// UsedSlots is the slots taken by the pending and running subtasks on the node.
+	UsedSlots       int
+	RunningSubtasks int
+	PendingSubtasks int
+	// RowsPerSecond is the sum of the average processing speed of the running
+	// subtasks on the node.
+	RowsPerSecond float64
+}
+
+// GetNodesResourceUsage gets the resource usage of all nodes in dist_framework_meta.
+func (mgr *TaskManager) GetNodesResourceUsage(ctx context.Context) ([]NodeResourceUsage, error) {
+	nodes, err := mgr.GetAllNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slotsOnNodes, err := mgr.GetUsedSlotsOnNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := mgr.ExecuteSQLWithNewSession(ctx, `
+		select
+			exec_id, state, ifnull(unix_timestamp() - start_time, 0),
+			ifnull(cast(json_extract(summary, '$.row_count') as signed), 0)
+		from mysql.tidb_background_subtask
+		where state in (%?, %?)`,
+		proto.SubtaskStatePending, proto.SubtaskStateRunning,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]NodeResourceUsage, 0, len(nodes))
+	idx := make(map[string]int, len(nodes))
+	for _, node := range nodes {
+		idx[node.ID] = len(usages)
+		usages = append(usages, NodeResourceUsage{
+			ManagedNode: node,
+			UsedSlots:   slotsOnNodes[node.ID],
+		})
+	}
+	for _, r := range rs {
+		i, ok := idx[r.GetString(0)]
+		if !ok {
+			// the node is dead and its subtasks are not rebalanced yet.
+			continue
+		}
+		switch proto.SubtaskState(r.GetString(1)) {
+		case proto.SubtaskStatePending:
+			usages[i].PendingSubtasks++
+		case proto.SubtaskStateRunning:
+			usages[i].RunningSubtasks++
+			if elapsed := r.GetInt64(2); elapsed > 0 {
+				usages[i].RowsPerSecond += float64(r.GetInt64(3)) / float64(elapsed)
+			}
+		}
+	}
+	return usages, nil
+}
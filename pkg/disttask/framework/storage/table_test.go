@@ -432,6 +432,37 @@ func TestGetUsedSlotsOnNodes(t *testing.T) {
 	}, slotsOnNodes)
 }
 
+func TestGetNodesResourceUsage(t *testing.T) {
+	_, sm, ctx := testutil.InitTableTest(t)
+
+	require.NoError(t, sm.InitMeta(ctx, "tidb-1", ""))
+	require.NoError(t, sm.InitMeta(ctx, "tidb-2", ""))
+	testutil.InsertSubtask(t, sm, 1, proto.StepOne, "tidb-1", []byte(""), proto.SubtaskStateRunning, "test", 4)
+	testutil.InsertSubtask(t, sm, 1, proto.StepOne, "tidb-1", []byte(""), proto.SubtaskStatePending, "test", 4)
+	testutil.InsertSubtask(t, sm, 2, proto.StepOne, "tidb-2", []byte(""), proto.SubtaskStatePending, "test", 2)
+	testutil.InsertSubtask(t, sm, 3, proto.StepOne, "tidb-2", []byte(""), proto.SubtaskStateSucceed, "test", 2)
+	// the subtasks of the dead node are ignored.
+	testutil.InsertSubtask(t, sm, 3, proto.StepOne, "tidb-3", []byte(""), proto.SubtaskStateRunning, "test", 2)
+	require.NoError(t, sm.UpdateSubtaskRowCount(ctx, 1, 100))
+	_, err := sm.ExecuteSQLWithNewSession(ctx, "update mysql.tidb_background_subtask set start_time = unix_timestamp() - 10 where id = 1")
+	require.NoError(t, err)
+
+	usages, err := sm.GetNodesResourceUsage(ctx)
+	require.NoError(t, err)
+	require.Len(t, usages, 2)
+	require.Equal(t, "tidb-1", usages[0].ID)
+	require.Equal(t, 4, usages[0].UsedSlots)
+	require.Equal(t, 1, usages[0].RunningSubtasks)
+	require.Equal(t, 1, usages[0].PendingSubtasks)
+	// the clock may tick during the test.
+	require.InDelta(t, 10, usages[0].RowsPerSecond, 1)
+	require.Equal(t, "tidb-2", usages[1].ID)
+	require.Equal(t, 2, usages[1].UsedSlots)
+	require.Equal(t, 0, usages[1].RunningSubtasks)
+	require.Equal(t, 1, usages[1].PendingSubtasks)
+	require.Zero(t, usages[1].RowsPerSecond)
+}
+
 func TestGetActiveSubtasks(t *testing.T) {
 	_, tm, ctx := testutil.InitTableTest(t)
 	require.NoError(t, tm.InitMeta(ctx, ":4000", ""))
@@ -34,6 +34,7 @@ import (
 	"github.com/pingcap/tidb/pkg/lightning/backend"
 	"github.com/pingcap/tidb/pkg/lightning/backend/external"
 	"github.com/pingcap/tidb/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/pkg/lightning/checkpoints"
 	"github.com/pingcap/tidb/pkg/lightning/common"
 	"github.com/pingcap/tidb/pkg/lightning/config"
 	"github.com/pingcap/tidb/pkg/lightning/log"
@@ -148,6 +149,16 @@ func (s *importStepExecutor) RunSubtask(ctx context.Context, subtask *proto.Subt
 		if err != nil {
 			return err
 		}
+		if s.tableImporter.PreSplitRegions {
+			chunks := make([]*checkpoints.ChunkCheckpoint, 0, len(subtaskMeta.Chunks))
+			for _, chunk := range subtaskMeta.Chunks {
+				ccp := toChunkCheckpoint(chunk)
+				chunks = append(chunks, &ccp)
+			}
+			if err = s.tableImporter.PreSplitRegionsBySample(ctx, chunks); err != nil {
+				return err
+			}
+		}
 	}
 	sharedVars := &SharedVars{
 		TableImporter:    s.tableImporter,
@@ -0,0 +1,226 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+	driver "github.com/pingcap/tidb/pkg/types/parser_driver"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/intest"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+const coldPartitionMoveInterval = 10 * time.Minute
+
+const (
+	// ColdPartitionStateCold means the partition has been moved to the cold storage.
+	ColdPartitionStateCold = "COLD"
+	// ColdPartitionStateRecalled means the partition has been recalled from the cold storage by
+	// altering its placement policy, and it won't be moved again.
+	ColdPartitionStateRecalled = "RECALLED"
+)
+
+// ColdPartitionMover moves the aged range partitions to the cold storage. The cold storage is
+// the stores selected by the placement policy tidb_cold_partition_placement_policy, e.g. the
+// stores backed by S3 which are read-only and have higher latency, so a partition is moved by
+// altering its placement policy and remains queryable through the same table.
+//
+// A partition is recalled by altering its placement policy to another one or DEFAULT, e.g.
+// ALTER TABLE t PARTITION p PLACEMENT POLICY = DEFAULT, and the recalled partitions are recorded
+// in mysql.tidb_cold_partitions so that they won't be moved again. Deleting the record makes the
+// partition be moved again when it's aged.
+type ColdPartitionMover struct {
+	// make some fields public for unit test.
+	InfoCache *infoschema.InfoCache
+	sessPool  *sessionPool
+}
+
+// NewColdPartitionMover build a ColdPartitionMover from Domain.
+func NewColdPartitionMover(do *Domain) *ColdPartitionMover {
+	return &ColdPartitionMover{
+		InfoCache: do.infoCache,
+		sessPool:  do.sysSessionPool,
+	}
+}
+
+func (do *Domain) coldPartitionMoveLoop() {
+	// do not start move loop in unit test.
+	if intest.InTest {
+		return
+	}
+	mover := NewColdPartitionMover(do)
+	ticker := time.NewTicker(coldPartitionMoveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-do.exit:
+			return
+		case <-ticker.C:
+		}
+		policy := variable.ColdPartitionPlacementPolicy.Load()
+		if policy == "" || !do.DDL().OwnerManager().IsOwner() {
+			continue
+		}
+		start := time.Now()
+		moved, err := mover.Move(policy, variable.ColdPartitionAge.Load(), start)
+		if err != nil {
+			logutil.BgLogger().Warn("[cold-partition] move cold partitions failed, will try next time", zap.Error(err))
+			continue
+		}
+		if moved > 0 {
+			logutil.BgLogger().Info("[cold-partition] finish moving cold partitions", zap.Int("moved", moved),
+				zap.Stringer("cost", time.Since(start)))
+		}
+	}
+}
+
+// Move moves the range partitions whose upper bounds are older than age to the placement policy
+// policyName, and returns the number of the moved partitions. Only the partitions without their
+// own placement policies are moved, and the partitions recalled from the cold storage are skipped.
+func (m *ColdPartitionMover) Move(policyName string, age time.Duration, now time.Time) (int, error) {
+	is := m.InfoCache.GetLatest()
+	policy, ok := is.PolicyByName(model.NewCIStr(policyName))
+	if !ok {
+		return 0, infoschema.ErrPlacementPolicyNotExists.GenWithStackByArgs(policyName)
+	}
+	states, err := m.syncStates(is, policy, now)
+	if err != nil {
+		return 0, err
+	}
+
+	moved := 0
+	for _, db := range is.AllSchemas() {
+		if util.IsMemOrSysDB(db.Name.L) {
+			continue
+		}
+		for _, tbl := range is.SchemaTableInfos(db.Name) {
+			col := coldPartitionColumn(tbl)
+			if col == nil {
+				continue
+			}
+			for i := range tbl.Partition.Definitions {
+				def := &tbl.Partition.Definitions[i]
+				if _, ok := states[def.ID]; ok {
+					continue
+				}
+				if def.PlacementPolicyRef != nil {
+					// the partitions moved to the cold storage manually are recorded, so that
+					// they're known to be recalled later.
+					if def.PlacementPolicyRef.ID == policy.ID {
+						if err := m.record(db, tbl, def, now); err != nil {
+							return moved, err
+						}
+					}
+					continue
+				}
+				upperBound, ok := partitionUpperBound(col, def)
+				if !ok || now.Sub(upperBound) < age {
+					continue
+				}
+				if _, err := execRestrictedSQL(m.sessPool, "ALTER TABLE %n.%n PARTITION %n PLACEMENT POLICY = %n",
+					[]any{db.Name.O, tbl.Name.O, def.Name.O, policy.Name.O}); err != nil {
+					logutil.BgLogger().Warn("[cold-partition] move partition to the cold storage failed",
+						zap.String("schema", db.Name.O), zap.String("table", tbl.Name.O),
+						zap.String("partition", def.Name.O), zap.Error(err))
+					continue
+				}
+				if err := m.record(db, tbl, def, now); err != nil {
+					return moved, err
+				}
+				moved++
+			}
+		}
+	}
+	return moved, nil
+}
+
+// syncStates returns the states of the partitions recorded in mysql.tidb_cold_partitions. The
+// records of the dropped partitions are removed, and the partitions whose placement policies have
+// been altered are marked as recalled.
+func (m *ColdPartitionMover) syncStates(is infoschema.InfoSchema, policy *model.PolicyInfo, now time.Time) (map[int64]string, error) {
+	rows, err := execRestrictedSQL(m.sessPool, "SELECT partition_id, state FROM mysql.tidb_cold_partitions", nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	states := make(map[int64]string, len(rows))
+	for _, row := range rows {
+		id, state := row.GetInt64(0), row.GetString(1)
+		_, _, def := is.FindTableInfoByPartitionID(id)
+		switch {
+		case def == nil:
+			_, err = execRestrictedSQL(m.sessPool, "DELETE FROM mysql.tidb_cold_partitions WHERE partition_id = %?", []any{id})
+		case state == ColdPartitionStateCold && (def.PlacementPolicyRef == nil || def.PlacementPolicyRef.ID != policy.ID):
+			state = ColdPartitionStateRecalled
+			_, err = execRestrictedSQL(m.sessPool, "UPDATE mysql.tidb_cold_partitions SET state = %?, recall_time = %? WHERE partition_id = %?",
+				[]any{state, now, id})
+		}
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		states[id] = state
+	}
+	return states, nil
+}
+
+func (m *ColdPartitionMover) record(db *model.DBInfo, tbl *model.TableInfo, def *model.PartitionDefinition, now time.Time) error {
+	_, err := execRestrictedSQL(m.sessPool, `REPLACE INTO mysql.tidb_cold_partitions
+		(partition_id, table_id, table_schema, table_name, partition_name, state, move_time)
+		VALUES (%?, %?, %?, %?, %?, %?, %?)`,
+		[]any{def.ID, tbl.ID, db.Name.O, tbl.Name.O, def.Name.O, ColdPartitionStateCold, now})
+	return errors.Trace(err)
+}
+
+// coldPartitionColumn returns the partition column of the table if it's partitioned by RANGE COLUMNS
+// on a single DATE or DATETIME column, whose partitions can be aged.
+func coldPartitionColumn(tbl *model.TableInfo) *model.ColumnInfo {
+	pi := tbl.GetPartitionInfo()
+	if pi == nil || pi.Type != model.PartitionTypeRange || len(pi.Columns) != 1 || tbl.TempTableType != model.TempTableNone {
+		return nil
+	}
+	col := model.FindColumnInfo(tbl.Columns, pi.Columns[0].L)
+	if col == nil || (col.GetType() != mysql.TypeDate && col.GetType() != mysql.TypeDatetime) {
+		return nil
+	}
+	return col
+}
+
+// partitionUpperBound returns the upper bound of the range partition in the local time zone.
+func partitionUpperBound(col *model.ColumnInfo, def *model.PartitionDefinition) (time.Time, bool) {
+	if len(def.LessThan) != 1 {
+		return time.Time{}, false
+	}
+	lessThan := driver.UnwrapFromSingleQuotes(def.LessThan[0])
+	if strings.EqualFold(lessThan, "MAXVALUE") {
+		return time.Time{}, false
+	}
+	t, err := types.ParseTime(types.DefaultStmtNoWarningContext, lessThan, col.GetType(), types.MaxFsp)
+	if err != nil {
+		return time.Time{}, false
+	}
+	upperBound, err := t.GoTime(time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return upperBound, true
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColdPartitionMover(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create placement policy cold followers=1")
+	tk.MustExec("create placement policy hot followers=2")
+	tk.MustExec(`create table t (id int, d date) partition by range columns(d) (
+		partition p2000 values less than ('2000-01-01'),
+		partition p2001 values less than ('2001-01-01') placement policy hot,
+		partition p2002 values less than ('2002-01-01'),
+		partition p2003 values less than ('2003-01-01'),
+		partition pmax values less than (maxvalue))`)
+	// the tables not partitioned by a date column are never moved.
+	tk.MustExec("create table t2 (id int) partition by range (id) (partition p0 values less than (10))")
+
+	mover := domain.NewColdPartitionMover(dom)
+	now := time.Date(2003, 1, 1, 0, 0, 0, 0, time.Local)
+	_, err := mover.Move("not_exist", time.Hour, now)
+	require.ErrorContains(t, err, "Unknown placement policy")
+
+	// the partitions with their own placement policies are kept.
+	moved, err := mover.Move("cold", 365*24*time.Hour, now)
+	require.NoError(t, err)
+	require.Equal(t, 2, moved)
+	tk.MustQuery("select partition_name, tidb_placement_policy_name from information_schema.partitions " +
+		"where table_name = 't' order by partition_ordinal_position").Check(testkit.Rows(
+		"p2000 cold", "p2001 hot", "p2002 cold", "p2003 <nil>", "pmax <nil>"))
+
+	// the recalled partitions aren't moved again.
+	tk.MustExec("alter table t partition p2000 placement policy = default")
+	moved, err = mover.Move("cold", time.Hour, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+	tk.MustQuery("select partition_name, state, recall_time is not null from mysql.tidb_cold_partitions " +
+		"order by partition_name").Check(testkit.Rows(
+		"p2000 RECALLED 1", "p2002 COLD 0", "p2003 COLD 0"))
+	tk.MustQuery("select partition_name, tidb_placement_policy_name from information_schema.partitions " +
+		"where table_name = 't' order by partition_ordinal_position").Check(testkit.Rows(
+		"p2000 <nil>", "p2001 hot", "p2002 cold", "p2003 cold", "pmax <nil>"))
+
+	// the records of the dropped partitions are removed.
+	tk.MustExec("alter table t drop partition p2002")
+	moved, err = mover.Move("cold", time.Hour, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0, moved)
+	tk.MustQuery("select partition_name, state from mysql.tidb_cold_partitions order by partition_name").Check(testkit.Rows(
+		"p2000 RECALLED", "p2003 COLD"))
+}
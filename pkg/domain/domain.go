@@ -1293,6 +1293,8 @@ func (do *Domain) Init(
 	do.wg.Run(do.runawayRecordFlushLoop, "runawayRecordFlushLoop")
 	do.wg.Run(do.runawayWatchSyncLoop, "runawayWatchSyncLoop")
 	do.wg.Run(do.requestUnitsWriterLoop, "requestUnitsWriterLoop")
+	do.wg.Run(do.placementCheckLoop, "placementCheckLoop")
+	do.wg.Run(do.coldPartitionMoveLoop, "coldPartitionMoveLoop")
 	if !skipRegisterToDashboard {
 		do.wg.Run(do.topologySyncerKeeper, "topologySyncerKeeper")
 	}
@@ -1921,6 +1923,7 @@ func (do *Domain) globalBindHandleWorkerLoop(owner owner.Manager) {
 				if err != nil {
 					logutil.BgLogger().Error("GC bind record failed", zap.Error(err))
 				}
+				do.BindHandle().CheckPinnedPlans(variable.PinnedPlanTTL.Load(), variable.PinnedPlanMaxRegression.Load())
 			}
 		}
 	}, "globalBindHandleWorkerLoop")
@@ -766,8 +766,17 @@ func (is *InfoSyncer) ReportMinStartTS(store kv.Storage) {
 	minStartTS := oracle.GoTimeToTS(now)
 	logutil.BgLogger().Debug("ReportMinStartTS", zap.Uint64("initial minStartTS", minStartTS),
 		zap.Uint64("StartTSLowerLimit", startTSLowerLimit))
+	// The snapshots of the running statements can be protected longer by tidb_gc_query_protect_time.
+	queryStartTSLowerLimit := startTSLowerLimit
+	if protectTime := variable.GCQueryProtectTime.Load(); protectTime > variable.GCMaxWaitTime.Load() {
+		queryStartTSLowerLimit = oracle.GoTimeToLowerLimitStartTS(now, protectTime*1000)
+	}
 	for _, info := range pl {
-		if info.CurTxnStartTS > startTSLowerLimit && info.CurTxnStartTS < minStartTS {
+		lowerLimit := startTSLowerLimit
+		if info.Command != mysql.ComSleep {
+			lowerLimit = queryStartTSLowerLimit
+		}
+		if info.CurTxnStartTS > lowerLimit && info.CurTxnStartTS < minStartTS {
 			minStartTS = info.CurTxnStartTS
 		}
 	}
@@ -0,0 +1,243 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/ddl/placement"
+	"github.com/pingcap/tidb/pkg/domain/infosync"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/intest"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	pdhttp "github.com/tikv/pd/client/http"
+	"go.uber.org/zap"
+)
+
+const placementCheckInterval = 10 * time.Minute
+
+const (
+	// PlacementViolationRulesMissing means the placement rules of the object are not found in PD.
+	PlacementViolationRulesMissing = "RULES_MISSING"
+	// PlacementViolationRulesMismatch means the placement rules in PD are different from the placement policy.
+	PlacementViolationRulesMismatch = "RULES_MISMATCH"
+	// PlacementViolationNotScheduled means the replicas have not been placed as the rules require yet.
+	PlacementViolationNotScheduled = "NOT_SCHEDULED"
+)
+
+// PlacementViolation is a table or partition whose replicas don't comply with its placement policy.
+type PlacementViolation struct {
+	PhysicalID    int64
+	SchemaName    string
+	TableName     string
+	PartitionName string
+	Violation     string
+	Detail        string
+	// Repaired indicates the placement rules have been sent to PD again.
+	Repaired bool
+}
+
+// PlacementChecker compares the placement rules and the replica placement in PD
+// with the placement policies of the tables and partitions, and records the
+// violations into mysql.tidb_placement_violations.
+type PlacementChecker struct {
+	// make some fields public for unit test.
+	InfoCache           *infoschema.InfoCache
+	GetRuleBundle       func(ctx context.Context, name string) (*placement.Bundle, error)
+	PutRuleBundles      func(ctx context.Context, bundles []*placement.Bundle) error
+	GetReplicationState func(ctx context.Context, startKey, endKey []byte) (infosync.PlacementScheduleState, error)
+	sessPool            *sessionPool
+}
+
+// NewPlacementChecker build a PlacementChecker from Domain.
+func NewPlacementChecker(do *Domain) *PlacementChecker {
+	return &PlacementChecker{
+		InfoCache:           do.infoCache,
+		GetRuleBundle:       infosync.GetRuleBundle,
+		PutRuleBundles:      infosync.PutRuleBundlesWithDefaultRetry,
+		GetReplicationState: infosync.GetReplicationState,
+		sessPool:            do.sysSessionPool,
+	}
+}
+
+func (do *Domain) placementCheckLoop() {
+	// do not start check loop in unit test.
+	if intest.InTest {
+		return
+	}
+	checker := NewPlacementChecker(do)
+	ticker := time.NewTicker(placementCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-do.exit:
+			return
+		case <-ticker.C:
+		}
+		if !variable.EnablePlacementCheck.Load() || !do.DDL().OwnerManager().IsOwner() {
+			continue
+		}
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), placementCheckInterval)
+		violations, err := checker.Check(ctx, variable.EnablePlacementAutoRepair.Load())
+		cancel()
+		if err == nil {
+			err = checker.Record(violations, start)
+		}
+		if err != nil {
+			logutil.BgLogger().Warn("[placement] check placement failed, will try next time", zap.Error(err))
+			continue
+		}
+		logutil.BgLogger().Info("[placement] finish checking placement", zap.Int("violations", len(violations)),
+			zap.Stringer("cost", time.Since(start)))
+	}
+}
+
+// Check returns the tables and partitions whose placement doesn't comply with
+// their placement policies. If repair is true, the expected placement rules of
+// the objects whose rules are missing or mismatched are sent to PD again, and PD
+// will schedule the replicas accordingly.
+func (c *PlacementChecker) Check(ctx context.Context, repair bool) ([]*PlacementViolation, error) {
+	is := c.InfoCache.GetLatest()
+	bundles := is.AllPlacementBundles()
+	slices.SortFunc(bundles, func(a, b *placement.Bundle) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	violations := make([]*PlacementViolation, 0)
+	toRepair := make([]*PlacementViolation, 0)
+	repairBundles := make([]*placement.Bundle, 0)
+	for _, expected := range bundles {
+		if expected.IsEmpty() {
+			continue
+		}
+		id, err := expected.ObjectID()
+		if err != nil {
+			continue
+		}
+		tbl, partDef := infoschema.FindTableByTblOrPartID(is, id)
+		if tbl == nil {
+			continue
+		}
+		db, ok := infoschema.SchemaByTable(is, tbl.Meta())
+		if !ok {
+			continue
+		}
+		v := &PlacementViolation{
+			PhysicalID: id,
+			SchemaName: db.Name.O,
+			TableName:  tbl.Meta().Name.O,
+		}
+		if partDef != nil {
+			v.PartitionName = partDef.Name.O
+		}
+
+		actual, err := c.GetRuleBundle(ctx, expected.ID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		switch {
+		case actual == nil || actual.IsEmpty():
+			v.Violation = PlacementViolationRulesMissing
+			v.Detail = fmt.Sprintf("expected: %s", expected.String())
+		case !placementRulesEqual(expected, actual):
+			v.Violation = PlacementViolationRulesMismatch
+			v.Detail = fmt.Sprintf("expected: %s, actual: %s", expected.String(), actual.String())
+		default:
+			startKey := codec.EncodeBytes(nil, tablecodec.GenTablePrefix(id))
+			endKey := codec.EncodeBytes(nil, tablecodec.GenTablePrefix(id+1))
+			state, err := c.GetReplicationState(ctx, startKey, endKey)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if state == infosync.PlacementScheduleStateScheduled {
+				continue
+			}
+			v.Violation = PlacementViolationNotScheduled
+			v.Detail = fmt.Sprintf("schedule state: %s", state.String())
+		}
+		violations = append(violations, v)
+		// PD keeps scheduling the replicas once the rules are in place, so only
+		// the rules need to be repaired.
+		if repair && v.Violation != PlacementViolationNotScheduled {
+			toRepair = append(toRepair, v)
+			repairBundles = append(repairBundles, expected)
+		}
+	}
+
+	if len(repairBundles) > 0 {
+		if err := c.PutRuleBundles(ctx, repairBundles); err != nil {
+			logutil.BgLogger().Warn("[placement] repair placement rules failed", zap.Error(err))
+			return violations, nil
+		}
+		for _, v := range toRepair {
+			v.Repaired = true
+		}
+	}
+	return violations, nil
+}
+
+// Record writes the violations into mysql.tidb_placement_violations, and removes
+// the records which are not found by the check started at checkTime.
+func (c *PlacementChecker) Record(violations []*PlacementViolation, checkTime time.Time) error {
+	for _, v := range violations {
+		var partitionName any
+		if v.PartitionName != "" {
+			partitionName = v.PartitionName
+		}
+		var repairTime any
+		if v.Repaired {
+			repairTime = time.Now()
+		}
+		if _, err := execRestrictedSQL(c.sessPool, `REPLACE INTO mysql.tidb_placement_violations
+			(table_id, table_schema, table_name, partition_name, violation, detail, check_time, repair_time)
+			VALUES (%?, %?, %?, %?, %?, %?, %?, %?)`,
+			[]any{v.PhysicalID, v.SchemaName, v.TableName, partitionName, v.Violation, v.Detail, checkTime, repairTime}); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	_, err := execRestrictedSQL(c.sessPool, "DELETE FROM mysql.tidb_placement_violations WHERE check_time < %?", []any{checkTime})
+	return errors.Trace(err)
+}
+
+func placementRulesEqual(expected, actual *placement.Bundle) bool {
+	if expected.Index != actual.Index || expected.Override != actual.Override || len(expected.Rules) != len(actual.Rules) {
+		return false
+	}
+	for _, e := range expected.Rules {
+		idx := slices.IndexFunc(actual.Rules, func(r *pdhttp.Rule) bool {
+			return r.ID == e.ID
+		})
+		if idx < 0 {
+			return false
+		}
+		a := actual.Rules[idx]
+		if e.Role != a.Role || e.Count != a.Count || e.StartKeyHex != a.StartKeyHex || e.EndKeyHex != a.EndKeyHex ||
+			e.IsolationLevel != a.IsolationLevel || !slices.Equal(e.LocationLabels, a.LocationLabels) ||
+			!reflect.DeepEqual(e.LabelConstraints, a.LabelConstraints) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/ddl/placement"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/domain/infosync"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlacementChecker(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create placement policy p1 followers=2")
+	tk.MustExec("create table t (a int) placement policy p1")
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	tblID := tbl.Meta().ID
+
+	scheduleState := infosync.PlacementScheduleStateScheduled
+	var repaired []*placement.Bundle
+	checker := domain.NewPlacementChecker(dom)
+	checker.GetReplicationState = func(context.Context, []byte, []byte) (infosync.PlacementScheduleState, error) {
+		return scheduleState, nil
+	}
+	checker.PutRuleBundles = func(_ context.Context, bundles []*placement.Bundle) error {
+		repaired = append(repaired, bundles...)
+		return nil
+	}
+	ctx := context.Background()
+
+	// the placement complies with the policy.
+	violations, err := checker.Check(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, violations, 0)
+
+	// the replicas are not scheduled yet.
+	scheduleState = infosync.PlacementScheduleStateInProgress
+	violations, err = checker.Check(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, domain.PlacementViolationNotScheduled, violations[0].Violation)
+	require.False(t, violations[0].Repaired)
+	require.Len(t, repaired, 0)
+	scheduleState = infosync.PlacementScheduleStateScheduled
+
+	// the rules in PD are different from the policy.
+	checker.GetRuleBundle = func(ctx context.Context, name string) (*placement.Bundle, error) {
+		bundle, err := infosync.GetRuleBundle(ctx, name)
+		if err != nil || bundle.IsEmpty() {
+			return bundle, err
+		}
+		bundle = bundle.Clone()
+		bundle.Rules[0].Count++
+		return bundle, nil
+	}
+	violations, err = checker.Check(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, domain.PlacementViolationRulesMismatch, violations[0].Violation)
+	require.False(t, violations[0].Repaired)
+	require.Len(t, repaired, 0)
+
+	// the rules are missing in PD and auto repair is enabled.
+	checker.GetRuleBundle = func(context.Context, string) (*placement.Bundle, error) {
+		return nil, nil
+	}
+	start := time.Now()
+	violations, err = checker.Check(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, domain.PlacementViolationRulesMissing, violations[0].Violation)
+	require.Equal(t, tblID, violations[0].PhysicalID)
+	require.True(t, violations[0].Repaired)
+	require.Len(t, repaired, 1)
+
+	require.NoError(t, checker.Record(violations, start))
+	tk.MustQuery("select table_id, table_schema, table_name, partition_name, violation, repair_time is not null from mysql.tidb_placement_violations").
+		Check(testkit.Rows(fmt.Sprintf("%d test t <nil> RULES_MISSING 1", tblID)))
+
+	// the records not found by the latest check are removed.
+	require.NoError(t, checker.Record(nil, time.Now().Add(time.Second)))
+	tk.MustQuery("select count(*) from mysql.tidb_placement_violations").Check(testkit.Rows("0"))
+}
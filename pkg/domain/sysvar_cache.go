@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"time"
 
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/sessionctx"
@@ -151,6 +152,15 @@ func (do *Domain) rebuildSysVarCache(ctx sessionctx.Context) error {
 		}
 	}
 
+	// tidb_gc_life_time skips the sysvar cache, it's read from mysql.tidb where the GC worker reads it.
+	if accessor := ctx.GetSessionVars().GlobalVarsAccessor; accessor != nil {
+		if val, err := accessor.GetTiDBTableValue("tikv_gc_life_time"); err == nil {
+			if lifeTime, err := time.ParseDuration(val); err == nil {
+				variable.GCLifeTime.Store(lifeTime)
+			}
+		}
+	}
+
 	logutil.BgLogger().Debug("rebuilding sysvar cache")
 
 	do.sysVarCache.Lock()
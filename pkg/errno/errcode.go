@@ -938,6 +938,7 @@ const (
 	ErUserAccessDeniedForUserAccountBlockedByPasswordLock    = 3955
 	ErrDependentByCheckConstraint                            = 3959
 	ErrJSONInBooleanContext                                  = 3986
+	ErrSchemaReadOnly                                        = 3989
 	ErrTableWithoutPrimaryKey                                = 3750
 	// MariaDB errors.
 	ErrOnlyOneDefaultPartionAllowed         = 4030
@@ -1080,6 +1081,7 @@ const (
 	ErrBRJobNotFound                       = 8174
 	ErrMemoryExceedForQuery                = 8175
 	ErrMemoryExceedForInstance             = 8176
+	ErrSnapshotGCProtectionExceeded        = 8177
 
 	// Error codes used by TiDB ddl package
 	ErrUnsupportedDDLOperation            = 8200
@@ -1135,6 +1137,8 @@ const (
 	ErrCannotResumeDDLJob = 8261
 	ErrPausedDDLJob       = 8262
 	ErrBDRRestrictedDDL   = 8263
+	ErrCannotAlterDDLJob  = 8264
+	ErrIngestFallback     = 8265
 
 	// Resource group errors.
 	ErrResourceGroupExists                    = 8248
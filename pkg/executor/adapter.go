@@ -978,7 +978,7 @@ func (a *ExecStmt) handlePessimisticDML(ctx context.Context, e exec.Executor) (e
 			// If it's not a retryable error, rollback current transaction instead of rolling back current statement like
 			// in normal transactions, because we cannot locate and rollback the statement that leads to the lock error.
 			// This is too strict, but since the feature is not for everyone, it's the easiest way to guarantee safety.
-			stmtText := parser.Normalize(a.OriginText(), sctx.GetSessionVars().EnableRedactLog)
+			stmtText := parser.Normalize(a.OriginText(), sctx.GetSessionVars().RedactLogMode(variable.RedactLogDestErrorLog))
 			logutil.Logger(ctx).Info("Transaction abort for the safety of lazy uniqueness check. "+
 				"Note this may not be a uniqueness violation.",
 				zap.Error(err),
@@ -992,6 +992,10 @@ func (a *ExecStmt) handlePessimisticDML(ctx context.Context, e exec.Executor) (e
 		}
 	}()
 
+	if err = waitTableWriteQueues(ctx, a.Ctx, a.Plan); err != nil {
+		return err
+	}
+
 	txnManager := sessiontxn.GetTxnManager(a.Ctx)
 	err = txnManager.OnPessimisticStmtStart(ctx)
 	if err != nil {
@@ -1517,8 +1521,11 @@ func (a *ExecStmt) LogSlowQuery(txnTS uint64, succ bool, hasMoreResults bool) {
 	if (!enable || costTime < threshold) && !force {
 		return
 	}
-	sql := FormatSQL(a.GetTextToLog(true))
+	sql := FormatSQL(a.GetTextToLogFor(true, variable.RedactLogDestSlowLog))
 	_, digest := stmtCtx.SQLDigest()
+	if succ && sessVars.EnableCardinalityFeedback && a.Plan != nil {
+		plannercore.RecordCardinalityFeedback(digest.String(), a.Plan, stmtCtx.RuntimeStatsColl)
+	}
 
 	var indexNames string
 	if len(stmtCtx.IndexNames) > 0 {
@@ -1881,7 +1888,7 @@ func (a *ExecStmt) SummaryStmt(succ bool) {
 	copTaskInfo := stmtCtx.CopTasksDetails()
 	memMax := sessVars.MemTracker.MaxConsumed()
 	diskMax := sessVars.DiskTracker.MaxConsumed()
-	sql := a.GetTextToLog(false)
+	sql := a.GetTextToLogFor(false, variable.RedactLogDestStmtSummary)
 	var stmtDetail execdetails.StmtExecDetails
 	stmtDetailRaw := a.GoCtx.Value(execdetails.StmtExecDetailKey)
 	if stmtDetailRaw != nil {
@@ -1962,9 +1969,14 @@ func (a *ExecStmt) SummaryStmt(succ bool) {
 
 // GetTextToLog return the query text to log.
 func (a *ExecStmt) GetTextToLog(keepHint bool) string {
+	return a.GetTextToLogFor(keepHint, variable.RedactLogDestErrorLog)
+}
+
+// GetTextToLogFor return the query text to log to the destination, it's redacted by the redact mode of the destination.
+func (a *ExecStmt) GetTextToLogFor(keepHint bool, destination string) string {
 	var sql string
 	sessVars := a.Ctx.GetSessionVars()
-	rmode := sessVars.EnableRedactLog
+	rmode := sessVars.RedactLogMode(destination)
 	if rmode == errors.RedactLogEnable {
 		if keepHint {
 			sql = parser.NormalizeKeepHint(sessVars.StmtCtx.OriginalSQL)
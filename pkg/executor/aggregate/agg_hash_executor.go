@@ -127,20 +127,23 @@ type HashAggExec struct {
 
 	stats *HashAggRuntimeStats
 
-	// dataInDisk is the chunks to store row values for spilled data.
-	// The HashAggExec may be set to `spill mode` multiple times, and all spilled data will be appended to DataInDiskByRows.
-	dataInDisk *chunk.DataInDiskByChunks
-	// numOfSpilledChks indicates the number of all the spilled chunks.
-	numOfSpilledChks int
-	// offsetOfSpilledChks indicates the offset of the chunk be read from the disk.
-	// In each round of processing, we need to re-fetch all the chunks spilled in the last one.
+	// spilledPartitions are the partitions of the rows spilled in the current round.
+	// The rows are partitioned by the hash of their group keys, so each partition can be re-aggregated
+	// independently in the following rounds, and only the rows of one partition are read in each round.
+	spilledPartitions []*chunk.DataInDiskByChunks
+	// pendingSpilledPartitions are the spilled partitions waiting to be re-aggregated.
+	pendingSpilledPartitions []*spilledAggPartition
+	// curSpilledPartition is the spilled partition re-aggregated in the current round, it's nil in the
+	// first round which aggregates the data fetched from the child executor.
+	curSpilledPartition *spilledAggPartition
+	// offsetOfSpilledChks indicates the offset of the chunk be read from curSpilledPartition.
 	offsetOfSpilledChks int
 	// inSpillMode indicates whether HashAgg is in `spill mode`.
 	// When HashAgg is in `spill mode`, the size of `partialResultMap` is no longer growing and all the data fetched
 	// from the child executor is spilled to the disk.
 	inSpillMode uint32
-	// tmpChkForSpill is the temp chunk for spilling.
-	tmpChkForSpill *chunk.Chunk
+	// tmpChksForSpill are the temp chunks for spilling the partitions.
+	tmpChksForSpill []*chunk.Chunk
 	// The `inflightChunkSync` calls `Add(1)` when the data fetcher goroutine inserts a chunk into the channel,
 	// and `Done()` when any partial worker retrieves a chunk from the channel and updates it in the `partialResultMap`.
 	// In scenarios where it is necessary to wait for all partial workers to finish processing the inflight chunk,
@@ -169,13 +172,11 @@ func (e *HashAggExec) Close() error {
 		if e.memTracker != nil {
 			e.memTracker.ReplaceBytesUsed(0)
 		}
-		if e.dataInDisk != nil {
-			e.dataInDisk.Close()
-		}
+		e.closeSpilledPartitions()
 		if e.spillAction != nil {
 			e.spillAction.SetFinished()
 		}
-		e.spillAction, e.tmpChkForSpill = nil, nil
+		e.spillAction, e.tmpChksForSpill = nil, nil
 		err := e.BaseExecutor.Close()
 		if err != nil {
 			return err
@@ -266,16 +267,15 @@ func (e *HashAggExec) initForUnparallelExec() {
 	e.childResult = exec.TryNewCacheChunk(e.Children(0))
 	e.memTracker.Consume(e.childResult.MemoryUsage())
 
-	e.offsetOfSpilledChks, e.numOfSpilledChks = 0, 0
+	e.offsetOfSpilledChks = 0
 	e.executed.Store(false)
 	e.isChildDrained = false
-	e.dataInDisk = chunk.NewDataInDiskByChunks(exec.RetTypes(e.Children(0)))
-
-	e.tmpChkForSpill = exec.TryNewCacheChunk(e.Children(0))
+	e.closeSpilledPartitions()
+	e.spilledPartitions = make([]*chunk.DataInDiskByChunks, unparallelSpilledPartitionNum)
+	e.tmpChksForSpill = make([]*chunk.Chunk, unparallelSpilledPartitionNum)
 	if vars := e.Ctx().GetSessionVars(); vars.TrackAggregateMemoryUsage && variable.EnableTmpStorageOnOOM.Load() {
 		e.diskTracker = disk.NewTracker(e.ID(), -1)
 		e.diskTracker.AttachTo(vars.StmtCtx.DiskTracker)
-		e.dataInDisk.GetDiskTracker().AttachTo(e.diskTracker)
 		vars.MemTracker.FallbackOldAndSetNewActionForSoftLimit(e.ActionSpill())
 	}
 }
@@ -394,6 +394,7 @@ func (e *HashAggExec) initForParallelExec(ctx sessionctx.Context) error {
 	e.spillHelper = newSpillHelper(e.memTracker, e.PartialAggFuncs, func() *chunk.Chunk {
 		return chunk.New(spillChunkFieldTypes, e.InitCap(), e.MaxChunkSize())
 	}, spillChunkFieldTypes)
+	e.spillHelper.restoreConcurrency = finalConcurrency
 
 	if isTrackerEnabled && isParallelHashAggSpillEnabled {
 		e.diskTracker = disk.NewTracker(e.ID(), -1)
@@ -683,8 +684,7 @@ func (e *HashAggExec) resetSpillMode() {
 	e.partialResultMap = make(aggfuncs.AggPartialResultMapper)
 	e.bInMap = 0
 	e.prepared.Store(false)
-	e.executed.Store(e.numOfSpilledChks == e.dataInDisk.NumChunks()) // No data is spilling again, all data have been processed.
-	e.numOfSpilledChks = e.dataInDisk.NumChunks()
+	e.executed.Store(!e.nextSpilledPartition()) // No data is spilled, all data have been processed.
 	e.memTracker.ReplaceBytesUsed(setSize)
 	atomic.StoreUint32(&e.inSpillMode, 0)
 }
@@ -692,9 +692,8 @@ func (e *HashAggExec) resetSpillMode() {
 // execute fetches Chunks from src and update each aggregate function for each row in Chunk.
 func (e *HashAggExec) execute(ctx context.Context) (err error) {
 	defer func() {
-		if e.tmpChkForSpill.NumRows() > 0 && err == nil {
-			err = e.dataInDisk.Add(e.tmpChkForSpill)
-			e.tmpChkForSpill.Reset()
+		if err == nil {
+			err = e.flushSpilledPartitions()
 		}
 	}()
 	exprCtx := e.Ctx().GetExprCtx()
@@ -725,13 +724,15 @@ func (e *HashAggExec) execute(ctx context.Context) (err error) {
 		}
 
 		allMemDelta := int64(0)
-		sel := make([]int, 0, e.childResult.NumRows())
 		var tmpBuf [1]chunk.Row
 		for j := 0; j < e.childResult.NumRows(); j++ {
 			groupKey := string(e.groupKeyBuffer[j]) // do memory copy here, because e.groupKeyBuffer may be reused.
 			if !e.groupSet.Exist(groupKey) {
+				// spill the rows of the new groups when exceeded.
 				if atomic.LoadUint32(&e.inSpillMode) == 1 && e.groupSet.Count() > 0 {
-					sel = append(sel, j)
+					if err := e.spillRow(j, e.groupKeyBuffer[j]); err != nil {
+						return err
+					}
 					continue
 				}
 				allMemDelta += e.groupSet.Insert(groupKey)
@@ -748,37 +749,11 @@ func (e *HashAggExec) execute(ctx context.Context) (err error) {
 			}
 		}
 
-		// spill unprocessed data when exceeded.
-		if len(sel) > 0 {
-			e.childResult.SetSel(sel)
-			err = e.spillUnprocessedData(len(sel) == cap(sel))
-			if err != nil {
-				return err
-			}
-		}
-
 		failpoint.Inject("ConsumeRandomPanic", nil)
 		e.memTracker.Consume(allMemDelta)
 	}
 }
 
-func (e *HashAggExec) spillUnprocessedData(isFullChk bool) (err error) {
-	if isFullChk {
-		return e.dataInDisk.Add(e.childResult)
-	}
-	for i := 0; i < e.childResult.NumRows(); i++ {
-		e.tmpChkForSpill.AppendRow(e.childResult.GetRow(i))
-		if e.tmpChkForSpill.IsFull() {
-			err = e.dataInDisk.Add(e.tmpChkForSpill)
-			if err != nil {
-				return err
-			}
-			e.tmpChkForSpill.Reset()
-		}
-	}
-	return nil
-}
-
 func (e *HashAggExec) getNextChunk(ctx context.Context) (err error) {
 	e.childResult.Reset()
 	if !e.isChildDrained {
@@ -790,8 +765,8 @@ func (e *HashAggExec) getNextChunk(ctx context.Context) (err error) {
 		}
 		e.isChildDrained = true
 	}
-	if e.offsetOfSpilledChks < e.numOfSpilledChks {
-		e.childResult, err = e.dataInDisk.GetChunk(e.offsetOfSpilledChks)
+	if p := e.curSpilledPartition; p != nil && e.offsetOfSpilledChks < p.data.NumChunks() {
+		e.childResult, err = p.data.GetChunk(e.offsetOfSpilledChks)
 		if err != nil {
 			return err
 		}
@@ -19,6 +19,7 @@ import (
 	"sync/atomic"
 
 	"github.com/pingcap/tidb/pkg/executor/aggfuncs"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
@@ -26,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/hack"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/twmb/murmur3"
 	"go.uber.org/zap"
 )
 
@@ -39,11 +41,18 @@ const (
 )
 
 const (
-	// maxSpillTimes indicates how many times the data can spill at most.
-	maxSpillTimes = 10
-
 	spilledPartitionNum = 256
 
+	// splitPartitionNum is the number of the sub-partitions of a spilled partition which is too large to be
+	// restored in memory.
+	splitPartitionNum = 16
+	// maxSplitLevel indicates how many times a spilled partition can be split at most.
+	maxSplitLevel = 3
+
+	// unparallelSpilledPartitionNum is the number of the partitions of the rows spilled by the unparallel HashAgg
+	// in each round.
+	unparallelSpilledPartitionNum = 16
+
 	spillLogInfo string = "memory exceeds quota, set aggregate mode to spill-mode"
 )
 
@@ -56,8 +65,16 @@ type parallelHashAggSpillHelper struct {
 		status            spillStatus
 		memoryConsumption int64
 		memoryQuota       int64
+		// splitPartitions are the partitions split from the ones too large to be restored, they're
+		// restored before the remaining partitions.
+		splitPartitions []int
+		// partitionLevels are the number of times each partition has been split.
+		partitionLevels []int
 	}
 
+	// restoreConcurrency is the number of the partitions restored in memory at the same time.
+	restoreConcurrency int
+
 	memTracker  *memory.Tracker
 	diskTracker *disk.Tracker
 	hasError    atomic.Bool
@@ -85,6 +102,8 @@ func newSpillHelper(
 			status            spillStatus
 			memoryConsumption int64
 			memoryQuota       int64
+			splitPartitions   []int
+			partitionLevels   []int
 		}{
 			Mutex:             mu,
 			waitIfInSpilling:  sync.NewCond(mu),
@@ -93,7 +112,9 @@ func newSpillHelper(
 			nextPartitionIdx:  spilledPartitionNum - 1,
 			memoryConsumption: 0,
 			memoryQuota:       0,
+			partitionLevels:   make([]int, spilledPartitionNum),
 		},
+		restoreConcurrency:   1,
 		memTracker:           tracker,
 		hasError:             atomic.Bool{},
 		aggFuncsForRestoring: aggFuncsForRestoring,
@@ -128,6 +149,11 @@ func (p *parallelHashAggSpillHelper) isSpilledChunksIOEmpty() bool {
 func (p *parallelHashAggSpillHelper) getNextPartition() (int, bool) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
+	if n := len(p.lock.splitPartitions); n > 0 {
+		partitionIdx := p.lock.splitPartitions[n-1]
+		p.lock.splitPartitions = p.lock.splitPartitions[:n-1]
+		return partitionIdx, true
+	}
 	partitionIdx := p.lock.nextPartitionIdx
 	if partitionIdx < 0 {
 		return -1, false
@@ -216,12 +242,22 @@ func (p *parallelHashAggSpillHelper) restoreOnePartition(ctx sessionctx.Context)
 	bInMap := 0
 	restoredMem := int64(0)
 
-	restoredPartitionIdx, isSuccess := p.getNextPartition()
-	if !isSuccess {
-		return nil, restoredMem, nil
+	var spilledFilesIO []*chunk.DataInDiskByChunks
+	for {
+		restoredPartitionIdx, isSuccess := p.getNextPartition()
+		if !isSuccess {
+			return nil, restoredMem, nil
+		}
+		var needSplit bool
+		spilledFilesIO, needSplit = p.checkNeedSplit(restoredPartitionIdx)
+		if !needSplit {
+			break
+		}
+		if err := p.splitPartition(restoredPartitionIdx); err != nil {
+			return nil, restoredMem, err
+		}
 	}
 
-	spilledFilesIO := p.getListInDisks(restoredPartitionIdx)
 	for _, spilledFile := range spilledFilesIO {
 		memDelta, expandMem, err := p.restoreFromOneSpillFile(ctx, &restoredData, spilledFile, &bInMap)
 		if err != nil {
@@ -234,6 +270,96 @@ func (p *parallelHashAggSpillHelper) restoreOnePartition(ctx sessionctx.Context)
 	return restoredData, restoredMem, nil
 }
 
+// checkNeedSplit returns the spilled files of the partition, and whether the partition is too large to be
+// restored in memory. The memory quota is shared by all the partitions restored at the same time.
+func (p *parallelHashAggSpillHelper) checkNeedSplit(partitionIdx int) ([]*chunk.DataInDiskByChunks, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	spilledFilesIO := p.lock.spilledChunksIO[partitionIdx]
+	if p.lock.memoryQuota <= 0 || p.lock.partitionLevels[partitionIdx] >= maxSplitLevel {
+		return spilledFilesIO, false
+	}
+	diskSize := int64(0)
+	for _, spilledFile := range spilledFilesIO {
+		diskSize += spilledFile.GetDiskTracker().BytesConsumed()
+	}
+	return spilledFilesIO, diskSize > p.lock.memoryQuota/int64(2*max(p.restoreConcurrency, 1))
+}
+
+// splitPartition re-partitions the spilled rows of the partition by the hash of their keys with a
+// different seed, and the sub-partitions are restored one by one instead.
+func (p *parallelHashAggSpillHelper) splitPartition(partitionIdx int) (err error) {
+	p.lock.Lock()
+	spilledFilesIO := p.lock.spilledChunksIO[partitionIdx]
+	level := p.lock.partitionLevels[partitionIdx] + 1
+	p.lock.Unlock()
+
+	keyColPos := len(p.aggFuncsForRestoring)
+	subPartitions := make([]*chunk.DataInDiskByChunks, splitPartitionNum)
+	tmpChks := make([]*chunk.Chunk, splitPartitionNum)
+	defer func() {
+		if err != nil {
+			for _, subPartition := range subPartitions {
+				if subPartition != nil {
+					subPartition.Close()
+				}
+			}
+		}
+	}()
+	for _, spilledFile := range spilledFilesIO {
+		for i := 0; i < spilledFile.NumChunks(); i++ {
+			var chk *chunk.Chunk
+			chk, err = spilledFile.GetChunk(i)
+			if err != nil {
+				return err
+			}
+			for rowIdx := 0; rowIdx < chk.NumRows(); rowIdx++ {
+				row := chk.GetRow(rowIdx)
+				subIdx := int(murmur3.SeedSum32(uint32(level), row.GetBytes(keyColPos)) % splitPartitionNum)
+				if subPartitions[subIdx] == nil {
+					subPartitions[subIdx] = chunk.NewDataInDiskByChunks(p.spillChunkFieldTypes)
+					if p.diskTracker != nil {
+						subPartitions[subIdx].GetDiskTracker().AttachTo(p.diskTracker)
+					}
+					tmpChks[subIdx] = p.getNewSpillChunkFunc()
+				}
+				tmpChks[subIdx].AppendRow(row)
+				if tmpChks[subIdx].IsFull() {
+					if err = subPartitions[subIdx].Add(tmpChks[subIdx]); err != nil {
+						return err
+					}
+					tmpChks[subIdx].Reset()
+				}
+			}
+		}
+	}
+	for i, tmpChk := range tmpChks {
+		if tmpChk != nil && tmpChk.NumRows() > 0 {
+			if err = subPartitions[i].Add(tmpChk); err != nil {
+				return err
+			}
+		}
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, spilledFile := range spilledFilesIO {
+		spilledFile.Close()
+	}
+	p.lock.spilledChunksIO[partitionIdx] = nil
+	for _, subPartition := range subPartitions {
+		if subPartition == nil {
+			continue
+		}
+		p.lock.splitPartitions = append(p.lock.splitPartitions, len(p.lock.spilledChunksIO))
+		p.lock.spilledChunksIO = append(p.lock.spilledChunksIO, []*chunk.DataInDiskByChunks{subPartition})
+		p.lock.partitionLevels = append(p.lock.partitionLevels, level)
+	}
+	logutil.BgLogger().Info("split the spilled partition of hash aggregation which is too large to be restored",
+		zap.Int("partition", partitionIdx), zap.Int("level", level))
+	return nil
+}
+
 type processRowContext struct {
 	ctx                    sessionctx.Context
 	chunk                  *chunk.Chunk
@@ -319,6 +445,103 @@ func (p *parallelHashAggSpillHelper) processRow(context *processRowContext) (tot
 	return totalMemDelta, expandMem, nil
 }
 
+// spilledAggPartition is a partition of the rows spilled by the unparallel HashAgg.
+type spilledAggPartition struct {
+	data *chunk.DataInDiskByChunks
+	// level is the number of times its rows have been partitioned. It's the seed of the hash to partition
+	// its rows when they're spilled again, so the rows are distributed to different partitions.
+	level int
+}
+
+// spillLevel returns the level of the rows aggregated in the current round.
+func (e *HashAggExec) spillLevel() int {
+	if e.curSpilledPartition == nil {
+		return 0
+	}
+	return e.curSpilledPartition.level
+}
+
+// spillRow spills the row of the child result to the partition chosen by its group key.
+func (e *HashAggExec) spillRow(rowIdx int, groupKey []byte) error {
+	idx := int(murmur3.SeedSum32(uint32(e.spillLevel()), groupKey) % unparallelSpilledPartitionNum)
+	if e.spilledPartitions[idx] == nil {
+		e.spilledPartitions[idx] = chunk.NewDataInDiskByChunks(exec.RetTypes(e.Children(0)))
+		if e.diskTracker != nil {
+			e.spilledPartitions[idx].GetDiskTracker().AttachTo(e.diskTracker)
+		}
+	}
+	if e.tmpChksForSpill[idx] == nil {
+		e.tmpChksForSpill[idx] = exec.TryNewCacheChunk(e.Children(0))
+	}
+	tmpChk := e.tmpChksForSpill[idx]
+	tmpChk.AppendRow(e.childResult.GetRow(rowIdx))
+	if !tmpChk.IsFull() {
+		return nil
+	}
+	err := e.spilledPartitions[idx].Add(tmpChk)
+	tmpChk.Reset()
+	return err
+}
+
+// flushSpilledPartitions writes the remaining spilled rows of the current round to the disk, and puts
+// the spilled partitions into the pending ones to be re-aggregated.
+func (e *HashAggExec) flushSpilledPartitions() error {
+	for i, p := range e.spilledPartitions {
+		if p == nil {
+			continue
+		}
+		if tmpChk := e.tmpChksForSpill[i]; tmpChk != nil && tmpChk.NumRows() > 0 {
+			if err := p.Add(tmpChk); err != nil {
+				return err
+			}
+			tmpChk.Reset()
+		}
+		e.spilledPartitions[i] = nil
+		e.pendingSpilledPartitions = append(e.pendingSpilledPartitions, &spilledAggPartition{
+			data:  p,
+			level: e.spillLevel() + 1,
+		})
+	}
+	return nil
+}
+
+// nextSpilledPartition releases the spilled partition re-aggregated in the current round, and takes the
+// next one to re-aggregate. It returns false if all the spilled partitions have been re-aggregated.
+// The partitions spilled last are taken first, so the partitions are re-aggregated depth-first and only
+// a few of them are kept on the disk.
+func (e *HashAggExec) nextSpilledPartition() bool {
+	if e.curSpilledPartition != nil {
+		e.curSpilledPartition.data.Close()
+		e.curSpilledPartition = nil
+	}
+	n := len(e.pendingSpilledPartitions)
+	if n == 0 {
+		return false
+	}
+	e.curSpilledPartition = e.pendingSpilledPartitions[n-1]
+	e.pendingSpilledPartitions[n-1] = nil
+	e.pendingSpilledPartitions = e.pendingSpilledPartitions[:n-1]
+	e.offsetOfSpilledChks = 0
+	return true
+}
+
+func (e *HashAggExec) closeSpilledPartitions() {
+	for i, p := range e.spilledPartitions {
+		if p != nil {
+			p.Close()
+			e.spilledPartitions[i] = nil
+		}
+	}
+	for _, p := range e.pendingSpilledPartitions {
+		p.data.Close()
+	}
+	e.pendingSpilledPartitions = nil
+	if e.curSpilledPartition != nil {
+		e.curSpilledPartition.data.Close()
+		e.curSpilledPartition = nil
+	}
+}
+
 // Guarantee that processed data is at least 20% of the threshold, to avoid spilling too frequently.
 func hasEnoughDataToSpill(aggTracker *memory.Tracker, passedInTracker *memory.Tracker) bool {
 	return aggTracker.BytesConsumed() >= passedInTracker.GetBytesLimit()/5
@@ -326,7 +549,8 @@ func hasEnoughDataToSpill(aggTracker *memory.Tracker, passedInTracker *memory.Tr
 
 // AggSpillDiskAction implements memory.ActionOnExceed for unparalleled HashAgg.
 // If the memory quota of a query is exceeded, AggSpillDiskAction.Action is
-// triggered.
+// triggered. The HashAgg can spill any times, since at least one group is
+// aggregated in each round.
 type AggSpillDiskAction struct {
 	memory.BaseOOMAction
 	e          *HashAggExec
@@ -335,7 +559,7 @@ type AggSpillDiskAction struct {
 
 // Action set HashAggExec spill mode.
 func (a *AggSpillDiskAction) Action(t *memory.Tracker) {
-	if atomic.LoadUint32(&a.e.inSpillMode) == 0 && hasEnoughDataToSpill(a.e.memTracker, t) && a.spillTimes < maxSpillTimes {
+	if atomic.LoadUint32(&a.e.inSpillMode) == 0 && hasEnoughDataToSpill(a.e.memTracker, t) {
 		a.spillTimes++
 		logutil.BgLogger().Info(spillLogInfo,
 			zap.Uint32("spillTimes", a.spillTimes),
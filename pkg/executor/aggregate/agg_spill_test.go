@@ -226,6 +226,28 @@ func buildHashAggExecutor(t *testing.T, ctx sessionctx.Context, child exec.Execu
 	return aggExec
 }
 
+func buildUnparallelHashAggExecutor(t *testing.T, ctx sessionctx.Context, child exec.Executor) *aggregate.HashAggExec {
+	childCols := getColumns()
+	schema := expression.NewSchema(childCols...)
+	groupItems := []expression.Expression{childCols[0]}
+
+	aggFirstRow, err := aggregation.NewAggFuncDesc(ctx.GetExprCtx(), ast.AggFuncFirstRow, []expression.Expression{childCols[0]}, false)
+	require.NoError(t, err)
+	aggFunc, err := aggregation.NewAggFuncDesc(ctx.GetExprCtx(), ast.AggFuncSum, []expression.Expression{childCols[1]}, false)
+	require.NoError(t, err)
+
+	aggExec := &aggregate.HashAggExec{
+		BaseExecutor:     exec.NewBaseExecutor(ctx, schema, 0, child),
+		Sc:               ctx.GetSessionVars().StmtCtx,
+		GroupByItems:     groupItems,
+		IsUnparallelExec: true,
+	}
+	for i, aggDesc := range []*aggregation.AggFuncDesc{aggFirstRow, aggFunc} {
+		aggExec.PartialAggFuncs = append(aggExec.PartialAggFuncs, aggfuncs.Build(ctx.GetExprCtx(), aggDesc, i))
+	}
+	return aggExec
+}
+
 func initCtx(ctx *mock.Context, newRootExceedAction *testutil.MockActionOnExceed, hardLimitBytesNum int64, chkSize int) {
 	ctx.GetSessionVars().InitChunkSize = chkSize
 	ctx.GetSessionVars().MaxChunkSize = chkSize
@@ -432,3 +454,44 @@ func TestRandomFail(t *testing.T) {
 	finishChan.Store(true)
 	wg.Wait()
 }
+
+func TestUnparallelSpill(t *testing.T) {
+	newRootExceedAction := new(testutil.MockActionOnExceed)
+	// The quota is far less than the memory of all the groups, so the data is spilled many times.
+	hardLimitBytesNum := int64(500000)
+
+	ctx := mock.NewContext()
+	initCtx(ctx, newRootExceedAction, hardLimitBytesNum, 256)
+
+	rowNum := 100000
+	ndv := 50000
+	col1, col2 := generateData(rowNum, ndv)
+	result := generateResult(col1)
+	opt := getMockDataSourceParameters(ctx)
+	dataSource := buildMockDataSource(opt, col1, col2)
+
+	for i := 0; i < 3; i++ {
+		diskTracker := ctx.GetSessionVars().StmtCtx.DiskTracker
+		diskTracker.ResetMaxConsumed()
+		aggExec := buildUnparallelHashAggExecutor(t, ctx, dataSource)
+		dataSource.PrepareChunks()
+		tmpCtx := context.Background()
+		chk := exec.NewFirstChunk(aggExec)
+		resContainer := resultsContainer{}
+		require.NoError(t, aggExec.Open(tmpCtx))
+		for {
+			require.NoError(t, aggExec.Next(tmpCtx, chk))
+			if chk.NumRows() == 0 {
+				break
+			}
+			resContainer.add(chk)
+			chk.Reset()
+		}
+		require.NoError(t, aggExec.Close())
+
+		require.Greater(t, diskTracker.MaxConsumed(), int64(0))
+		// all the spilled files are removed.
+		require.Equal(t, int64(0), diskTracker.BytesConsumed())
+		require.True(t, resContainer.check(result))
+	}
+}
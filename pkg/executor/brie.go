@@ -376,6 +376,8 @@ func (b *executorBuilder) buildBRIE(s *ast.BRIEStmt, schema *expression.Schema)
 				e.backupCfg.TimeAgo = time.Duration(opt.UintValue)
 			case ast.BRIEOptionBackupTSO:
 				e.backupCfg.BackupTS = opt.UintValue
+			case ast.BRIEOptionEstimateOnly:
+				e.backupCfg.EstimateOnly = opt.UintValue != 0
 			case ast.BRIEOptionBackupTS:
 				tso, err := b.parseTSString(opt.StrValue)
 				if err != nil {
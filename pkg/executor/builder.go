@@ -169,6 +169,8 @@ func (b *executorBuilder) build(p plannercore.Plan) exec.Executor {
 		return b.buildCheckIndexRange(v)
 	case *plannercore.ChecksumTable:
 		return b.buildChecksumTable(v)
+	case *plannercore.ReclaimTable:
+		return b.buildReclaimTable(v)
 	case *plannercore.ReloadExprPushdownBlacklist:
 		return b.buildReloadExprPushdownBlacklist(v)
 	case *plannercore.ReloadOptRuleBlacklist:
@@ -709,11 +711,23 @@ func (b *executorBuilder) buildChecksumTable(v *plannercore.ChecksumTable) exec.
 		return nil
 	}
 	for _, t := range v.Tables {
-		e.tables[t.TableInfo.ID] = newChecksumContext(t.DBInfo, t.TableInfo, startTs)
+		partitionIDs, err := getChecksumPartitionIDs(t.TableInfo, t.PartitionNames)
+		if err != nil {
+			b.err = err
+			return nil
+		}
+		e.tables[t.TableInfo.ID] = newChecksumContext(t.DBInfo, t.TableInfo, partitionIDs, startTs)
 	}
 	return e
 }
 
+func (b *executorBuilder) buildReclaimTable(v *plannercore.ReclaimTable) exec.Executor {
+	return &ReclaimTableExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		tables:       v.Tables,
+	}
+}
+
 func (b *executorBuilder) buildReloadExprPushdownBlacklist(_ *plannercore.ReloadExprPushdownBlacklist) exec.Executor {
 	base := exec.NewBaseExecutor(b.ctx, nil, 0)
 	return &ReloadExprPushdownBlacklistExec{base}
@@ -1167,10 +1181,12 @@ func (b *executorBuilder) buildRevoke(revoke *ast.RevokeStmt) exec.Executor {
 
 func (b *executorBuilder) buildDDL(v *plannercore.DDL) exec.Executor {
 	e := &DDLExec{
-		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
-		stmt:         v.Statement,
-		is:           b.is,
-		tempTableDDL: temptable.GetTemporaryTableDDL(b.ctx),
+		BaseExecutor:   exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		stmt:           v.Statement,
+		is:             b.is,
+		tempTableDDL:   temptable.GetTemporaryTableDDL(b.ctx),
+		ctasCols:       v.CTASCols,
+		ctasFieldNames: v.CTASFieldNames,
 	}
 	return e
 }
@@ -1569,6 +1585,10 @@ func (b *executorBuilder) buildHashJoin(v *plannercore.PhysicalHashJoin) exec.Ex
 	}
 	e.buildWorker.buildKeyColIdx, e.buildWorker.buildNAKeyColIdx, e.buildWorker.buildSideExec, e.buildWorker.hashJoinCtx = buildKeyColIdx, buildNAKeyColIdx, buildSideExec, e.hashJoinCtx
 	e.hashJoinCtx.isNullAware = isNAJoin
+	if rfs := v.RuntimeFilterList(); len(rfs) > 0 {
+		waitTime := time.Duration(b.ctx.GetSessionVars().GetRuntimeFilterWaitTimeMs()) * time.Millisecond
+		e.hashJoinCtx.tikvRFs = buildTiKVRuntimeFilters(rfs, buildSideExec, e.probeSideTupleFetcher.probeSideExec, buildKeys, waitTime)
+	}
 	executor_metrics.ExecutorCountHashJoinExec.Inc()
 
 	// We should use JoinKey to construct the type information using by hashing, instead of using the child's schema directly.
@@ -1828,14 +1848,19 @@ func (b *executorBuilder) getSnapshot() (kv.Snapshot, error) {
 	snapshot.SetOption(kv.ResourceGroupName, sessVars.StmtCtx.ResourceGroupName)
 	snapshot.SetOption(kv.ExplicitRequestSourceType, sessVars.ExplicitRequestSourceType)
 
+	var matchStoreLabels []*metapb.StoreLabel
 	if replicaReadType.IsClosestRead() && b.readReplicaScope != kv.GlobalTxnScope {
-		snapshot.SetOption(kv.MatchStoreLabels, []*metapb.StoreLabel{
-			{
-				Key:   placement.DCLabelKey,
-				Value: b.readReplicaScope,
-			},
+		matchStoreLabels = append(matchStoreLabels, &metapb.StoreLabel{
+			Key:   placement.DCLabelKey,
+			Value: b.readReplicaScope,
 		})
 	}
+	if len(sessVars.ReplicaReadStoreLabels) > 0 && (replicaReadType.IsFollowerRead() || b.isStaleness) {
+		matchStoreLabels = append(matchStoreLabels, sessVars.ReplicaReadStoreLabels...)
+	}
+	if len(matchStoreLabels) > 0 {
+		snapshot.SetOption(kv.MatchStoreLabels, matchStoreLabels)
+	}
 
 	return snapshot, nil
 }
@@ -2005,7 +2030,12 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.TableTiDBCheckConstraints),
 			strings.ToLower(infoschema.TableKeywords),
 			strings.ToLower(infoschema.TableTiDBIndexUsage),
-			strings.ToLower(infoschema.ClusterTableTiDBIndexUsage):
+			strings.ToLower(infoschema.ClusterTableTiDBIndexUsage),
+			strings.ToLower(infoschema.TableTiDBRunningStatementStats),
+			strings.ToLower(infoschema.TableTiDBIngestDiskUsage),
+			strings.ToLower(infoschema.TableDistFrameworkNodes),
+			strings.ToLower(infoschema.TableTiKVCompactionProgress),
+			strings.ToLower(infoschema.TableTiDBAccountLocks):
 			memTracker := memory.NewTracker(v.ID(), -1)
 			memTracker.AttachTo(b.ctx.GetSessionVars().StmtCtx.MemTracker)
 			return &MemTableReaderExec{
@@ -2306,6 +2336,7 @@ func (b *executorBuilder) buildSplitRegion(v *plannercore.SplitRegion) exec.Exec
 	if v.IndexInfo != nil {
 		return &SplitIndexRegionExec{
 			BaseExecutor:   base,
+			dbName:         v.DBName,
 			tableInfo:      v.TableInfo,
 			partitionNames: v.PartitionNames,
 			indexInfo:      v.IndexInfo,
@@ -2319,6 +2350,7 @@ func (b *executorBuilder) buildSplitRegion(v *plannercore.SplitRegion) exec.Exec
 	if len(v.ValueLists) > 0 {
 		return &SplitTableRegionExec{
 			BaseExecutor:   base,
+			dbName:         v.DBName,
 			tableInfo:      v.TableInfo,
 			partitionNames: v.PartitionNames,
 			handleCols:     handleCols,
@@ -2327,6 +2359,7 @@ func (b *executorBuilder) buildSplitRegion(v *plannercore.SplitRegion) exec.Exec
 	}
 	return &SplitTableRegionExec{
 		BaseExecutor:   base,
+		dbName:         v.DBName,
 		tableInfo:      v.TableInfo,
 		partitionNames: v.PartitionNames,
 		handleCols:     handleCols,
@@ -3468,8 +3501,45 @@ func getPartitionKeyColOffsets(keyColIDs []int64, pt table.PartitionedTable) []i
 	return keyColOffsets
 }
 
+// getFixedIndexColValues returns the inner columns which aren't join keys but fixed to
+// constants by the index ranges, e.g. `b` of `t1 join t2 on t1.a = t2.a where t2.b = 1`
+// when t2 has an index on (a, b). Together with the join keys, they may determine the
+// partition of each lookup when the inner table is partitioned by (a, b).
+func getFixedIndexColValues(tc types.Context, tblInfo *model.TableInfo, idxInfo *model.IndexInfo,
+	ranges []*ranger.Range, keyOff2IdxOff []int, cwc *plannercore.ColWithCmpFuncManager) (colIDs []int64, vals []types.Datum) {
+	if idxInfo == nil || len(ranges) != 1 {
+		return nil, nil
+	}
+	ran := ranges[0]
+	lastPos := len(ran.LowVal) - 1
+	isKey := make(map[int]struct{}, len(keyOff2IdxOff))
+	for _, idxOff := range keyOff2IdxOff {
+		isKey[idxOff] = struct{}{}
+	}
+	for i := 0; i <= lastPos && i < len(idxInfo.Columns); i++ {
+		if _, ok := isKey[i]; ok {
+			continue
+		}
+		if i == lastPos && (cwc != nil || ran.LowExclude || ran.HighExclude) {
+			// the last column is a range built from the outer row or not a point.
+			break
+		}
+		low, high := ran.LowVal[i], ran.HighVal[i]
+		if i >= len(ran.Collators) || low.IsNull() || low.Kind() == types.KindMinNotNull || high.Kind() == types.KindMaxValue {
+			continue
+		}
+		if cmp, err := low.Compare(tc, &high, ran.Collators[i]); err != nil || cmp != 0 {
+			continue
+		}
+		colIDs = append(colIDs, tblInfo.Columns[idxInfo.Columns[i].Offset].ID)
+		vals = append(vals, low)
+	}
+	return colIDs, vals
+}
+
 func (builder *dataReaderBuilder) prunePartitionForInnerExecutor(tbl table.Table, physPlanPartInfo *plannercore.PhysPlanPartInfo,
-	lookUpContent []*indexJoinLookUpContent) (usedPartition []table.PhysicalTable, canPrune bool, contentPos []int64, err error) {
+	lookUpContent []*indexJoinLookUpContent, idxInfo *model.IndexInfo, indexRanges []*ranger.Range, keyOff2IdxOff []int,
+	cwc *plannercore.ColWithCmpFuncManager) (usedPartition []table.PhysicalTable, canPrune bool, contentPos []int64, err error) {
 	partitionTbl := tbl.(table.PartitionedTable)
 
 	// In index join, this is called by multiple goroutines simultaneously, but partitionPruning is not thread-safe.
@@ -3487,12 +3557,20 @@ func (builder *dataReaderBuilder) prunePartitionForInnerExecutor(tbl table.Table
 	if lookUpContent[0].keyColIDs == nil {
 		return nil, false, nil, plannererrors.ErrInternal.GenWithStack("cannot get column IDs when dynamic pruning")
 	}
-	keyColOffsets := getPartitionKeyColOffsets(lookUpContent[0].keyColIDs, partitionTbl)
+	// The partition columns which aren't join keys may still be fixed by the index ranges.
+	keyColIDs := lookUpContent[0].keyColIDs
+	fixedColIDs, fixedVals := getFixedIndexColValues(builder.ctx.GetSessionVars().StmtCtx.TypeCtx(),
+		partitionTbl.Meta(), idxInfo, indexRanges, keyOff2IdxOff, cwc)
+	colIDs := append(slices.Clip(keyColIDs), fixedColIDs...)
+	keyColOffsets := getPartitionKeyColOffsets(colIDs, partitionTbl)
 	if len(keyColOffsets) == 0 {
 		return condPruneResult, false, nil, nil
 	}
 
 	locateKey := make([]types.Datum, len(partitionTbl.Cols()))
+	for i, val := range fixedVals {
+		locateKey[keyColOffsets[len(keyColIDs)+i]] = val
+	}
 	partitions := make(map[int64]table.PhysicalTable)
 	contentPos = make([]int64, len(lookUpContent))
 	exprCtx := builder.ctx.GetExprCtx()
@@ -4387,7 +4465,8 @@ func (builder *dataReaderBuilder) buildIndexReaderForIndexJoin(ctx context.Conte
 	}
 
 	tbl, _ := builder.executorBuilder.is.TableByID(tbInfo.ID)
-	usedPartition, canPrune, contentPos, err := builder.prunePartitionForInnerExecutor(tbl, &v.PlanPartInfo, lookUpContents)
+	usedPartition, canPrune, contentPos, err := builder.prunePartitionForInnerExecutor(tbl, &v.PlanPartInfo, lookUpContents,
+		is.Index, indexRanges, keyOff2IdxOff, cwc)
 	if err != nil {
 		return nil, err
 	}
@@ -4459,7 +4538,8 @@ func (builder *dataReaderBuilder) buildIndexLookUpReaderForIndexJoin(ctx context
 	}
 
 	tbl, _ := builder.executorBuilder.is.TableByID(tbInfo.ID)
-	usedPartition, canPrune, contentPos, err := builder.prunePartitionForInnerExecutor(tbl, &v.PlanPartInfo, lookUpContents)
+	usedPartition, canPrune, contentPos, err := builder.prunePartitionForInnerExecutor(tbl, &v.PlanPartInfo, lookUpContents,
+		is.Index, indexRanges, keyOff2IdxOff, cwc)
 	if err != nil {
 		return nil, err
 	}
@@ -5324,7 +5404,8 @@ func (b *executorBuilder) getCacheTable(tblInfo *model.TableInfo, startTS uint64
 }
 
 func (b *executorBuilder) buildCompactTable(v *plannercore.CompactTable) exec.Executor {
-	if v.ReplicaKind != ast.CompactReplicaKindTiFlash && v.ReplicaKind != ast.CompactReplicaKindAll {
+	if v.ReplicaKind != ast.CompactReplicaKindTiFlash && v.ReplicaKind != ast.CompactReplicaKindTiKV &&
+		v.ReplicaKind != ast.CompactReplicaKindAll {
 		b.err = errors.Errorf("compact %v replica is not supported", strings.ToLower(string(v.ReplicaKind)))
 		return nil
 	}
@@ -5332,7 +5413,7 @@ func (b *executorBuilder) buildCompactTable(v *plannercore.CompactTable) exec.Ex
 	store := b.ctx.GetStore()
 	tikvStore, ok := store.(tikv.Storage)
 	if !ok {
-		b.err = errors.New("compact tiflash replica can only run with tikv compatible storage")
+		b.err = errors.Errorf("compact %v replica can only run with tikv compatible storage", strings.ToLower(string(v.ReplicaKind)))
 		return nil
 	}
 
@@ -5358,6 +5439,14 @@ func (b *executorBuilder) buildCompactTable(v *plannercore.CompactTable) exec.Ex
 		}
 	}
 
+	if v.ReplicaKind == ast.CompactReplicaKindTiKV {
+		return &CompactTableTiKVExec{
+			BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+			dbName:       v.DBName,
+			tableInfo:    v.TableInfo,
+			partitionIDs: partitionIDs,
+		}
+	}
 	return &CompactTableTiFlashExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
 		tableInfo:    v.TableInfo,
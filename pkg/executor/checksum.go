@@ -18,6 +18,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/distsql"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/ranger"
@@ -35,6 +37,9 @@ import (
 var _ exec.Executor = &ChecksumTableExec{}
 
 // ChecksumTableExec represents ChecksumTable executor.
+// The checksum is not a distributed task, all the checksum requests are sent
+// by the executing TiDB, tidb_checksum_table_concurrency limits the requests
+// in flight and tidb_distsql_scan_concurrency limits the regions of each one.
 type ChecksumTableExec struct {
 	exec.BaseExecutor
 
@@ -203,20 +208,53 @@ type checksumResult struct {
 type checksumContext struct {
 	dbInfo    *model.DBInfo
 	tableInfo *model.TableInfo
-	startTs   uint64
-	response  *tipb.ChecksumResponse
+	// partitionIDs is the partitions to calculate the checksum, all the partitions are used if it's empty.
+	partitionIDs []int64
+	startTs      uint64
+	response     *tipb.ChecksumResponse
 }
 
-func newChecksumContext(db *model.DBInfo, table *model.TableInfo, startTs uint64) *checksumContext {
+func newChecksumContext(db *model.DBInfo, table *model.TableInfo, partitionIDs []int64, startTs uint64) *checksumContext {
 	return &checksumContext{
-		dbInfo:    db,
-		tableInfo: table,
-		startTs:   startTs,
-		response:  &tipb.ChecksumResponse{},
+		dbInfo:       db,
+		tableInfo:    table,
+		partitionIDs: partitionIDs,
+		startTs:      startTs,
+		response:     &tipb.ChecksumResponse{},
 	}
 }
 
+// getChecksumPartitionIDs returns the IDs of the partitions specified in the statement.
+func getChecksumPartitionIDs(tblInfo *model.TableInfo, partitionNames []model.CIStr) ([]int64, error) {
+	if len(partitionNames) == 0 {
+		return nil, nil
+	}
+	pi := tblInfo.GetPartitionInfo()
+	if pi == nil {
+		return nil, errors.Errorf("table:%s is not a partition table, but user specify partition name list:%+v", tblInfo.Name.O, partitionNames)
+	}
+	partitionIDs := make([]int64, 0, len(partitionNames))
+	for _, name := range partitionNames {
+		idx := pi.FindPartitionDefinitionByName(name.L)
+		if idx < 0 {
+			return nil, table.ErrUnknownPartition.GenWithStackByArgs(name.O, tblInfo.Name.O)
+		}
+		partitionIDs = append(partitionIDs, pi.Definitions[idx].ID)
+	}
+	return partitionIDs, nil
+}
+
 func (c *checksumContext) buildTasks(ctx sessionctx.Context) ([]*checksumTask, error) {
+	if len(c.partitionIDs) > 0 {
+		reqs := make([]*checksumTask, 0, (len(c.tableInfo.Indices)+1)*len(c.partitionIDs))
+		for _, partitionID := range c.partitionIDs {
+			if err := c.appendRequest4PhysicalTable(ctx, c.tableInfo.ID, partitionID, &reqs); err != nil {
+				return nil, err
+			}
+		}
+		return reqs, nil
+	}
+
 	var partDefs []model.PartitionDefinition
 	if part := c.tableInfo.Partition; part != nil {
 		partDefs = part.Definitions
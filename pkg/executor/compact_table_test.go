@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
@@ -826,6 +827,32 @@ func TestCompactTableWithTiFlashDownAndRestore(t *testing.T) {
 	tk.MustQuery(`show warnings;`).Check(testkit.Rows())
 }
 
+func TestCompactTableTiKV(t *testing.T) {
+	store := testkit.CreateMockStore(t, withMockTiFlash(0))
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int)")
+	tk.MustExec("create table tp(a int) partition by hash(a) partitions 4")
+
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/executor/mockTiKVCompact", `return("")`))
+	tk.MustExec("alter table t compact tikv replica")
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustExec("alter table tp compact tikv replica")
+	tk.MustExec("alter table tp compact partition p1, p2 tikv replica")
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/executor/mockTiKVCompact", `return("store is down")`))
+	tk.MustExec("alter table t compact tikv replica")
+	tk.MustQuery("show warnings").CheckContain("failed: store is down")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/executor/mockTiKVCompact"))
+
+	tk.MustQuery("select table_schema, table_name, state, compacted_ranges, total_ranges, error, end_time is not null " +
+		"from information_schema.tikv_compaction_progress order by id").Check(testkit.Rows(
+		"test t FINISHED 2 2 <nil> 1",
+		"test tp FINISHED 8 8 <nil> 1",
+		"test tp FINISHED 4 4 <nil> 1",
+		"test t FAILED 0 2 store is down 1",
+	))
+}
+
 // Code below are helper utilities for the test cases.
 
 type compactClientHandler struct {
@@ -0,0 +1,327 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/kvproto/pkg/debugpb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var _ exec.Executor = &CompactTableTiKVExec{}
+
+const (
+	// tikvCompactThreads is the number of the threads used by a TiKV store to compact a range.
+	tikvCompactThreads = 4
+	// maxTiKVCompactionHistory is the max number of the finished compactions kept for the progress.
+	maxTiKVCompactionHistory = 256
+)
+
+// The states of the TiKV compactions.
+const (
+	TiKVCompactionRunning  = "RUNNING"
+	TiKVCompactionFinished = "FINISHED"
+	TiKVCompactionFailed   = "FAILED"
+)
+
+// tikvCompactCFs are the column families compacted for a table. The lock CF is small and
+// short-lived, so it's not compacted.
+var tikvCompactCFs = []string{"default", "write"}
+
+func getTiKVStores(ctx sessionctx.Context) ([]infoschema.ServerInfo, error) {
+	aliveTiKVStores := make([]infoschema.ServerInfo, 0)
+	stores, err := infoschema.GetStoreServerInfo(ctx.GetStore())
+	if err != nil {
+		return nil, err
+	}
+	for _, store := range stores {
+		if store.ServerType == kv.TiKV.Name() {
+			aliveTiKVStores = append(aliveTiKVStores, store)
+		}
+	}
+	return aliveTiKVStores, nil
+}
+
+// TiKVCompactionProgress is the progress of compacting a table in a TiKV store.
+type TiKVCompactionProgress struct {
+	ID              int64
+	DBName          string
+	TableName       string
+	TableID         int64
+	StoreAddress    string
+	State           string
+	CompactedRanges int
+	TotalRanges     int
+	StartTime       time.Time
+	EndTime         time.Time
+	Error           string
+}
+
+// tikvCompactionRegistry records the progress of the TiKV compactions in the current instance.
+type tikvCompactionRegistry struct {
+	sync.Mutex
+	nextID int64
+	// progresses are ordered by ID.
+	progresses []*TiKVCompactionProgress
+}
+
+var tikvCompactions tikvCompactionRegistry
+
+func (r *tikvCompactionRegistry) add(p *TiKVCompactionProgress) {
+	r.Lock()
+	defer r.Unlock()
+	r.nextID++
+	p.ID = r.nextID
+	finished := 0
+	for _, progress := range r.progresses {
+		if progress.State != TiKVCompactionRunning {
+			finished++
+		}
+	}
+	// drop the oldest finished compactions.
+	if finished >= maxTiKVCompactionHistory {
+		toDrop := finished - maxTiKVCompactionHistory + 1
+		kept := r.progresses[:0]
+		for _, progress := range r.progresses {
+			if toDrop > 0 && progress.State != TiKVCompactionRunning {
+				toDrop--
+				continue
+			}
+			kept = append(kept, progress)
+		}
+		clear(r.progresses[len(kept):])
+		r.progresses = kept
+	}
+	r.progresses = append(r.progresses, p)
+}
+
+func (r *tikvCompactionRegistry) update(p *TiKVCompactionProgress, fn func(p *TiKVCompactionProgress)) {
+	r.Lock()
+	defer r.Unlock()
+	fn(p)
+}
+
+// GetTiKVCompactionProgresses returns the snapshot of the progress of the running and the recently
+// finished TiKV compactions in the current instance.
+func GetTiKVCompactionProgresses() []TiKVCompactionProgress {
+	tikvCompactions.Lock()
+	defer tikvCompactions.Unlock()
+	progresses := make([]TiKVCompactionProgress, 0, len(tikvCompactions.progresses))
+	for _, p := range tikvCompactions.progresses {
+		progresses = append(progresses, *p)
+	}
+	return progresses
+}
+
+// CompactTableTiKVExec represents an executor for "ALTER TABLE [NAME] COMPACT TIKV REPLICA" statement.
+// It triggers the manual compaction of the key ranges of the table in all TiKV stores, which
+// reclaims the space of the deleted MVCC versions after bulk deletes or ingesting the indexes.
+type CompactTableTiKVExec struct {
+	exec.BaseExecutor
+
+	dbName       model.CIStr
+	tableInfo    *model.TableInfo
+	partitionIDs []int64
+	done         bool
+}
+
+// Next implements the Executor Next interface.
+func (e *CompactTableTiKVExec) Next(ctx context.Context, chk *chunk.Chunk) error {
+	chk.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+	return e.doCompact(ctx)
+}
+
+func (e *CompactTableTiKVExec) doCompact(execCtx context.Context) error {
+	physicalIDs := e.partitionIDs
+	if len(physicalIDs) == 0 {
+		if pi := e.tableInfo.GetPartitionInfo(); pi != nil {
+			for _, def := range pi.Definitions {
+				physicalIDs = append(physicalIDs, def.ID)
+			}
+		} else {
+			physicalIDs = []int64{e.tableInfo.ID}
+		}
+	}
+	keyCodec := e.Ctx().GetStore().GetCodec()
+	keyRanges := make([]kv.KeyRange, 0, len(physicalIDs))
+	for _, pid := range physicalIDs {
+		startKey, endKey := keyCodec.EncodeRegionRange(tablecodec.EncodeTablePrefix(pid), tablecodec.EncodeTablePrefix(pid+1))
+		keyRanges = append(keyRanges, kv.KeyRange{StartKey: startKey, EndKey: endKey})
+	}
+
+	tikvStores, err := getTiKVStores(e.Ctx())
+	if err != nil {
+		return err
+	}
+	// All the stores are compacted in parallel, and the ranges are compacted in series in each store.
+	g, ctx := errgroup.WithContext(execCtx)
+	for _, store := range tikvStores {
+		task := &tikvStoreCompactTask{
+			ctx:         ctx,
+			parentExec:  e,
+			targetStore: store,
+			keyRanges:   keyRanges,
+		}
+		g.Go(task.work)
+	}
+	_ = g.Wait() // Errors have been turned into warnings, let's simply discard them.
+	return nil
+}
+
+// tikvStoreCompactTask compacts the key ranges of a table in a TiKV store.
+type tikvStoreCompactTask struct {
+	ctx         context.Context
+	parentExec  *CompactTableTiKVExec
+	targetStore infoschema.ServerInfo
+	keyRanges   []kv.KeyRange
+}
+
+func (task *tikvStoreCompactTask) work() error {
+	e := task.parentExec
+	progress := &TiKVCompactionProgress{
+		DBName:       e.dbName.O,
+		TableName:    e.tableInfo.Name.O,
+		TableID:      e.tableInfo.ID,
+		StoreAddress: task.targetStore.Address,
+		State:        TiKVCompactionRunning,
+		TotalRanges:  len(task.keyRanges) * len(tikvCompactCFs),
+		StartTime:    time.Now(),
+	}
+	tikvCompactions.add(progress)
+	log.Info("Begin compacting table in a TiKV store",
+		zap.String("table", e.tableInfo.Name.O),
+		zap.Int64("table-id", e.tableInfo.ID),
+		zap.Int64s("partition-id", e.partitionIDs),
+		zap.String("store-address", task.targetStore.Address),
+	)
+
+	err := task.compactRanges(func() {
+		tikvCompactions.update(progress, func(p *TiKVCompactionProgress) {
+			p.CompactedRanges++
+		})
+	})
+	tikvCompactions.update(progress, func(p *TiKVCompactionProgress) {
+		p.EndTime = time.Now()
+		if err != nil {
+			p.State = TiKVCompactionFailed
+			p.Error = err.Error()
+		} else {
+			p.State = TiKVCompactionFinished
+		}
+	})
+	if err != nil {
+		log.Warn("Compact table failed in a TiKV store",
+			zap.String("table", e.tableInfo.Name.O),
+			zap.Int64("table-id", e.tableInfo.ID),
+			zap.Int64s("partition-id", e.partitionIDs),
+			zap.String("store-address", task.targetStore.Address),
+			zap.Error(err),
+		)
+		e.Ctx().GetSessionVars().StmtCtx.AppendWarning(
+			errors.NewNoStackErrorf("compact on store %s failed: %s", task.targetStore.Address, err.Error()))
+		return nil
+	}
+	log.Info("Compact table finished in a TiKV store",
+		zap.Duration("elapsed", time.Since(progress.StartTime)),
+		zap.String("table", e.tableInfo.Name.O),
+		zap.Int64("table-id", e.tableInfo.ID),
+		zap.Int64s("partition-id", e.partitionIDs),
+		zap.String("store-address", task.targetStore.Address),
+	)
+	return nil
+}
+
+func (task *tikvStoreCompactTask) compactRanges(onCompacted func()) error {
+	failpoint.Inject("mockTiKVCompact", func(val failpoint.Value) {
+		if msg := val.(string); msg != "" {
+			failpoint.Return(errors.New(msg))
+		}
+		for range task.keyRanges {
+			for range tikvCompactCFs {
+				onCompacted()
+			}
+		}
+		failpoint.Return(nil)
+	})
+
+	opt := grpc.WithTransportCredentials(insecure.NewCredentials())
+	security := config.GetGlobalConfig().Security
+	if len(security.ClusterSSLCA) != 0 {
+		clusterSecurity := security.ClusterSecurity()
+		tlsConfig, err := clusterSecurity.ToTLSConfig()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		opt = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	conn, err := grpc.DialContext(task.ctx, task.targetStore.Address, opt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Error("close grpc connection error", zap.Error(err))
+		}
+	}()
+	client := debugpb.NewDebugClient(conn)
+	for _, r := range task.keyRanges {
+		for _, cf := range tikvCompactCFs {
+			// The keys in the RocksDB of TiKV are the region keys with the 'z' prefix.
+			_, err := client.Compact(task.ctx, &debugpb.CompactRequest{
+				Db:                        debugpb.DB_KV,
+				Cf:                        cf,
+				FromKey:                   tikvDataKey(r.StartKey),
+				ToKey:                     tikvDataKey(r.EndKey),
+				Threads:                   tikvCompactThreads,
+				BottommostLevelCompaction: debugpb.BottommostLevelCompaction_IfHaveCompactionFilter,
+			})
+			if err != nil {
+				return errors.Trace(err)
+			}
+			onCompacted()
+		}
+	}
+	return nil
+}
+
+// tikvDataKey returns the key in the RocksDB of TiKV of the region key, which is already encoded by
+// the codec of the store, e.g. memcomparable encoded, with the keyspace prefix in API v2.
+func tikvDataKey(key []byte) []byte {
+	dataKey := make([]byte, 0, len(key)+1)
+	dataKey = append(dataKey, 'z')
+	return append(dataKey, key...)
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/ddl"
@@ -27,10 +28,12 @@ import (
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/sessiontxn"
 	"github.com/pingcap/tidb/pkg/sessiontxn/staleread"
@@ -42,6 +45,8 @@ import (
 	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
 	"github.com/pingcap/tidb/pkg/util/gcutil"
 	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sqlescape"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
 	"go.uber.org/zap"
 )
 
@@ -54,6 +59,10 @@ type DDLExec struct {
 	is           infoschema.InfoSchema
 	tempTableDDL temptable.TemporaryTableDDL
 	done         bool
+
+	// ctasCols and ctasFieldNames are only set for CREATE TABLE ... SELECT, see core.DDL for details.
+	ctasCols       []*ast.ColumnDef
+	ctasFieldNames []model.CIStr
 }
 
 // toErr converts the error to the ErrInfoSchemaChanged when the schema is outdated.
@@ -157,7 +166,11 @@ func (e *DDLExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 	case *ast.FlashBackDatabaseStmt:
 		err = e.executeFlashbackDatabase(x)
 	case *ast.CreateTableStmt:
-		err = e.executeCreateTable(x)
+		if x.Select != nil {
+			err = e.executeCreateTableAsSelect(ctx, x)
+		} else {
+			err = e.executeCreateTable(x)
+		}
 	case *ast.CreateViewStmt:
 		err = e.executeCreateView(ctx, x)
 	case *ast.DropIndexStmt:
@@ -269,6 +282,84 @@ func (e *DDLExec) executeCreateTable(s *ast.CreateTableStmt) error {
 	return err
 }
 
+// executeCreateTableAsSelect executes CREATE TABLE ... SELECT. The rows of the select are imported by the ingest
+// pipeline of IMPORT INTO instead of being inserted in transactions. To make the new table visible atomically,
+// the table is created with a temporary name and renamed to the target name after the rows are imported,
+// and it's dropped if the import fails.
+func (e *DDLExec) executeCreateTableAsSelect(ctx context.Context, s *ast.CreateTableStmt) error {
+	is := domain.GetDomain(e.Ctx()).InfoSchema()
+	if is.TableExists(s.Table.Schema, s.Table.Name) {
+		err := infoschema.ErrTableExists.FastGenByArgs(ast.Ident{Schema: s.Table.Schema, Name: s.Table.Name})
+		if s.IfNotExists {
+			e.Ctx().GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	// The statements are executed in a new session, since IMPORT INTO runs other statements in the session.
+	// The session variables which the select depends on are copied from the user session.
+	se, err := CreateSession(e.Ctx())
+	if err != nil {
+		return err
+	}
+	defer CloseSession(se)
+	userVars, vars := e.Ctx().GetSessionVars(), se.GetSessionVars()
+	vars.CurrentDB, vars.TimeZone, vars.SQLMode = userVars.CurrentDB, userVars.TimeZone, userVars.SQLMode
+	sqlExec := se.GetSQLExecutor()
+
+	tmpTable := &ast.TableName{Schema: s.Table.Schema, Name: model.NewCIStr(ctasTempTablePrefix + strings.ReplaceAll(uuid.NewString(), "-", ""))}
+	tmpStmt := *s
+	tmpStmt.Table, tmpStmt.Cols, tmpStmt.Select, tmpStmt.IfNotExists = tmpTable, e.ctasCols, nil, false
+	var sb strings.Builder
+	if err = tmpStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err = sqlexec.ExecSQL(ctx, sqlExec, sb.String()); err != nil {
+		return err
+	}
+
+	affectedRows, err := importCTASRows(ctx, se, tmpTable, e.ctasFieldNames, s.Select)
+	if err == nil {
+		_, err = sqlexec.ExecSQL(ctx, sqlExec, "RENAME TABLE %n.%n TO %n.%n",
+			tmpTable.Schema.O, tmpTable.Name.O, s.Table.Schema.O, s.Table.Name.O)
+	}
+	if err != nil {
+		if _, dropErr := sqlexec.ExecSQL(ctx, sqlExec, "DROP TABLE IF EXISTS %n.%n", tmpTable.Schema.O, tmpTable.Name.O); dropErr != nil {
+			logutil.Logger(ctx).Warn("drop the table of CREATE TABLE ... SELECT failed",
+				zap.String("table", tmpTable.Name.O), zap.Error(dropErr))
+		}
+		return err
+	}
+	e.Ctx().GetSessionVars().StmtCtx.SetAffectedRows(affectedRows)
+	return nil
+}
+
+// ctasTempTablePrefix is the name prefix of the table created by CREATE TABLE ... SELECT before it's renamed.
+const ctasTempTablePrefix = "_tidb_ctas_"
+
+// importCTASRows imports the rows of the select into the table by IMPORT INTO ... FROM SELECT.
+func importCTASRows(ctx context.Context, se sessionctx.Context, tbl *ast.TableName, fieldNames []model.CIStr,
+	sel ast.ResultSetNode) (uint64, error) {
+	var sb strings.Builder
+	sqlescape.MustFormatSQL(&sb, "IMPORT INTO %n.%n (", tbl.Schema.O, tbl.Name.O)
+	for i, name := range fieldNames {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sqlescape.MustFormatSQL(&sb, "%n", name.O)
+	}
+	sb.WriteString(") FROM ")
+	if err := sel.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return 0, errors.Trace(err)
+	}
+	// The restored select isn't escaped again, it may contain '%'.
+	if _, err := sqlexec.ExecSQL(ctx, se.GetSQLExecutor(), sb.String()); err != nil {
+		return 0, err
+	}
+	return se.GetSessionVars().StmtCtx.AffectedRows(), nil
+}
+
 func (e *DDLExec) createSessionTemporaryTable(s *ast.CreateTableStmt) error {
 	is := e.Ctx().GetInfoSchema().(infoschema.InfoSchema)
 	dbInfo, ok := is.SchemaByName(s.Table.Schema)
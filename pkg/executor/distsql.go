@@ -213,6 +213,8 @@ type IndexReaderExecutor struct {
 	// If dummy flag is set, this is not a real IndexReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
 	dummy bool
+
+	tikvRuntimeFilterReceiver
 }
 
 // Table implements the dataSourceExecutor interface.
@@ -233,6 +235,7 @@ func (e *IndexReaderExecutor) Close() (err error) {
 	if e.result != nil {
 		err = e.result.Close()
 	}
+	e.closeRuntimeFilter(e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl)
 	e.result = nil
 	e.kvRanges = e.kvRanges[:0]
 	if e.dummy {
@@ -248,6 +251,14 @@ func (e *IndexReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 		return nil
 	}
 
+	if e.waitRuntimeFilter {
+		if err := e.receiveRuntimeFilter(ctx, e.Ctx().GetExprCtx().GetEvalCtx(), e.Ctx().GetClient(), e.dagPB, e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl); err != nil {
+			return err
+		}
+		if err := e.buildResult(ctx, e.kvRanges); err != nil {
+			return err
+		}
+	}
 	return e.result.Next(ctx, req)
 }
 
@@ -319,6 +330,7 @@ func (e *IndexReaderExecutor) buildKVReq(r []kv.KeyRange) (*kv.Request, error) {
 
 func (e *IndexReaderExecutor) open(ctx context.Context, kvRanges []kv.KeyRange) error {
 	var err error
+	e.resetRuntimeFilter(e.dagPB)
 	if e.corColInFilter {
 		e.dagPB.Executors, err = builder.ConstructListBasedDistExec(e.Ctx().GetPlanCtx(), e.plans)
 		if err != nil {
@@ -383,13 +395,22 @@ func (e *IndexReaderExecutor) open(ctx context.Context, kvRanges []kv.KeyRange)
 	slices.SortFunc(kvRanges, func(i, j kv.KeyRange) int {
 		return bytes.Compare(i.StartKey, j.StartKey)
 	})
+	if e.runtimeFilters != nil {
+		// The requests are sent after the conditions of the runtime filters are pushed down in the first Next.
+		e.waitRuntimeFilter = true
+		return nil
+	}
+	return e.buildResult(ctx, kvRanges)
+}
+
+func (e *IndexReaderExecutor) buildResult(ctx context.Context, kvRanges []kv.KeyRange) error {
 	// use sortedSelectResults only when byItems pushed down and partition numbers > 1
 	if e.byItems == nil || len(e.partitions) <= 1 {
 		kvReq, err := e.buildKVReq(kvRanges)
 		if err != nil {
 			return err
 		}
-		e.result, err = e.SelectResult(ctx, e.Ctx().GetDistSQLCtx(), kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+		e.result, err = e.SelectResult(ctx, e.Ctx().GetDistSQLCtx(), kvReq, exec.RetTypes(e), e.copPlanIDs(getPhysicalPlanIDs(e.plans)), e.ID())
 		if err != nil {
 			return err
 		}
@@ -404,7 +425,7 @@ func (e *IndexReaderExecutor) open(ctx context.Context, kvRanges []kv.KeyRange)
 		}
 		var results []distsql.SelectResult
 		for _, kvReq := range kvReqs {
-			result, err := e.SelectResult(ctx, e.Ctx().GetDistSQLCtx(), kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+			result, err := e.SelectResult(ctx, e.Ctx().GetDistSQLCtx(), kvReq, exec.RetTypes(e), e.copPlanIDs(getPhysicalPlanIDs(e.plans)), e.ID())
 			if err != nil {
 				return err
 			}
@@ -485,6 +506,15 @@ type IndexLookUpExecutor struct {
 	// If dummy flag is set, this is not a real IndexLookUpReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
 	dummy bool
+
+	// streaming indicates that the table workers send the table rows to resultCh as soon as they're read,
+	// instead of the index worker sending the tasks to resultCh in the order of the index. It's enabled by
+	// tidb_index_lookup_streaming when the order isn't required.
+	streaming bool
+	// inflightMem limits the memory of the rows sent to resultCh but not returned yet in the streaming mode.
+	inflightMem *lookupInflightMemLimiter
+
+	tikvRuntimeFilterReceiver
 }
 
 type getHandleType int8
@@ -581,6 +611,7 @@ func (e *IndexLookUpExecutor) open(_ context.Context) error {
 	e.resultCh = make(chan *lookupTableTask, atomic.LoadInt32(&LookupTableTaskChannelSize))
 
 	var err error
+	e.resetRuntimeFilter(e.dagPB)
 	if e.corColInIdxSide {
 		e.dagPB.Executors, err = builder.ConstructListBasedDistExec(e.Ctx().GetPlanCtx(), e.idxPlans)
 		if err != nil {
@@ -594,6 +625,8 @@ func (e *IndexLookUpExecutor) open(_ context.Context) error {
 			return err
 		}
 	}
+	// The index worker is started after the conditions of the runtime filters are pushed down to the index side.
+	e.waitRuntimeFilter = e.runtimeFilters != nil
 	return nil
 }
 
@@ -602,11 +635,27 @@ func (e *IndexLookUpExecutor) startWorkers(ctx context.Context, initBatchSize in
 	// so fetching index and getting table data can run concurrently.
 	ctx, cancel := context.WithCancel(ctx)
 	e.cancelFunc = cancel
+	sessVars := e.Ctx().GetSessionVars()
+	e.streaming = !e.keepOrder && e.checkIndexValue == nil && sessVars.IndexLookupStreaming
+	e.inflightMem = nil
+	if e.streaming && sessVars.IndexLookupMaxInflightMem > 0 {
+		e.inflightMem = newLookupInflightMemLimiter(sessVars.IndexLookupMaxInflightMem)
+	}
 	workCh := make(chan *lookupTableTask, 1)
 	if err := e.startIndexWorker(ctx, workCh, initBatchSize); err != nil {
 		return err
 	}
 	e.startTableWorker(ctx, workCh)
+	if e.streaming {
+		// Both the index worker and the table workers send to resultCh in the streaming mode,
+		// so it's closed after all of them exit.
+		resultCh := e.resultCh
+		go func() {
+			e.idxWorkerWg.Wait()
+			e.tblWorkerWg.Wait()
+			close(resultCh)
+		}()
+	}
 	e.workerStarted = true
 	return nil
 }
@@ -742,7 +791,7 @@ func (e *IndexLookUpExecutor) startIndexWorker(ctx context.Context, workCh chan<
 				worker.syncErr(err)
 				break
 			}
-			result, err := distsql.SelectWithRuntimeStats(ctx, e.Ctx().GetDistSQLCtx(), kvReq, tps, getPhysicalPlanIDs(e.idxPlans), idxID)
+			result, err := distsql.SelectWithRuntimeStats(ctx, e.Ctx().GetDistSQLCtx(), kvReq, tps, e.copPlanIDs(getPhysicalPlanIDs(e.idxPlans)), idxID)
 			if err != nil {
 				worker.syncErr(err)
 				break
@@ -765,7 +814,9 @@ func (e *IndexLookUpExecutor) startIndexWorker(ctx context.Context, workCh chan<
 			}
 		}
 		close(workCh)
-		close(e.resultCh)
+		if !e.streaming {
+			close(e.resultCh)
+		}
 		e.idxWorkerWg.Done()
 	}()
 	return nil
@@ -856,6 +907,7 @@ func (e *IndexLookUpExecutor) Close() error {
 	channel.Clear(e.resultCh)
 	e.idxWorkerWg.Wait()
 	e.tblWorkerWg.Wait()
+	e.closeRuntimeFilter(e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl)
 	e.finished = nil
 	e.workerStarted = false
 	e.memTracker = nil
@@ -871,6 +923,11 @@ func (e *IndexLookUpExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 	}
 
 	if !e.workerStarted {
+		if e.waitRuntimeFilter {
+			if err := e.receiveRuntimeFilter(ctx, e.Ctx().GetExprCtx().GetEvalCtx(), e.Ctx().GetClient(), e.dagPB, e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl); err != nil {
+				return err
+			}
+		}
 		if err := e.startWorkers(ctx, req.RequiredRows()); err != nil {
 			return err
 		}
@@ -904,6 +961,12 @@ func (e *IndexLookUpExecutor) getResultTask() (*lookupTableTask, error) {
 		start               time.Time
 		indexFetchedInstant time.Time
 	)
+	// Release the memory usage of last task before we handle a new task.
+	if e.resultCurr != nil {
+		e.resultCurr.memTracker.Consume(-e.resultCurr.memUsage)
+		e.inflightMem.release(e.resultCurr.memUsage)
+		e.resultCurr = nil
+	}
 	if enableStats {
 		start = time.Now()
 	}
@@ -925,11 +988,6 @@ func (e *IndexLookUpExecutor) getResultTask() (*lookupTableTask, error) {
 		}
 		e.stats.NextWaitTableLookUpResp += time.Since(indexFetchedInstant)
 	}
-
-	// Release the memory usage of last task before we handle a new task.
-	if e.resultCurr != nil {
-		e.resultCurr.memTracker.Consume(-e.resultCurr.memUsage)
-	}
 	e.resultCurr = task
 	return e.resultCurr, nil
 }
@@ -1034,7 +1092,9 @@ func (w *indexWorker) fetchHandles(ctx context.Context, results []distsql.Select
 		case <-w.finished:
 			return nil
 		case w.workCh <- task:
-			w.resultCh <- task
+			if !w.idxLookup.streaming {
+				w.resultCh <- task
+			}
 		}
 		if w.idxLookup.stats != nil {
 			atomic.AddInt64(&w.idxLookup.stats.FetchHandle, int64(finishFetch.Sub(startTime)))
@@ -1182,7 +1242,7 @@ func (w *tableWorker) pickAndExecTask(ctx context.Context) {
 		if r := recover(); r != nil {
 			logutil.Logger(ctx).Error("tableWorker in IndexLookUpExecutor panicked", zap.Any("recover", r), zap.Stack("stack"))
 			err := util.GetRecoverError(r)
-			task.doneCh <- err
+			w.finishTask(task, err)
 		}
 	}()
 	for {
@@ -1197,13 +1257,41 @@ func (w *tableWorker) pickAndExecTask(ctx context.Context) {
 		case <-w.finished:
 			return
 		}
+		// Don't send the lookup requests until the rows in flight are consumed.
+		if !w.idxLookup.inflightMem.wait(w.finished) {
+			return
+		}
 		startTime := time.Now()
 		err := w.executeTask(ctx, task)
 		if w.idxLookup.stats != nil {
 			atomic.AddInt64(&w.idxLookup.stats.TableRowScan, int64(time.Since(startTime)))
 			atomic.AddInt64(&w.idxLookup.stats.TableTaskNum, 1)
 		}
+		w.finishTask(task, err)
+	}
+}
+
+// finishTask notifies that the task is done. In the streaming mode, the rows of the task have been sent to
+// resultCh, so only the error is sent.
+func (w *tableWorker) finishTask(task *lookupTableTask, err error) {
+	if !w.idxLookup.streaming {
 		task.doneCh <- err
+		return
+	}
+	if err != nil {
+		doneCh := make(chan error, 1)
+		doneCh <- err
+		w.sendResult(&lookupTableTask{doneCh: doneCh})
+	}
+}
+
+// sendResult sends the rows to resultCh in the streaming mode, it returns false if the executor is closed.
+func (w *tableWorker) sendResult(result *lookupTableTask) bool {
+	select {
+	case w.idxLookup.resultCh <- result:
+		return true
+	case <-w.finished:
+		return false
 	}
 }
 
@@ -1491,6 +1579,9 @@ func (w *tableWorker) executeTask(ctx context.Context, task *lookupTableTask) er
 		task.memUsage = memUsage
 		task.memTracker.Consume(memUsage)
 	}
+	if w.idxLookup.streaming {
+		return w.streamTask(ctx, task, tableReader)
+	}
 	handleCnt := len(task.handles)
 	task.rows = make([]chunk.Row, 0, handleCnt)
 	for {
@@ -1549,28 +1640,138 @@ func (w *tableWorker) executeTask(ctx context.Context, task *lookupTableTask) er
 				}
 				obtainedHandlesMap.Set(handle, true)
 			}
-			missHds := GetLackHandles(task.handles, obtainedHandlesMap)
-			return (&consistency.Reporter{
-				HandleEncode: func(hd kv.Handle) kv.Key {
-					return tablecodec.EncodeRecordKey(w.idxLookup.table.RecordPrefix(), hd)
-				},
-				Tbl:  w.idxLookup.table.Meta(),
-				Idx:  w.idxLookup.index,
-				Sctx: w.idxLookup.Ctx(),
-			}).ReportLookupInconsistent(ctx,
-				handleCnt,
-				len(task.rows),
-				missHds,
-				task.handles,
-				nil,
-				//missRecords,
-			)
+			return w.reportLookupInconsistent(ctx, task, obtainedHandlesMap, len(task.rows))
 		}
 	}
 
 	return nil
 }
 
+// streamTask sends the rows of the task to resultCh as soon as they're read by the table reader.
+func (w *tableWorker) streamTask(ctx context.Context, task *lookupTableTask, tableReader exec.Executor) error {
+	// The handles of the task are no longer used after all the rows are read.
+	defer task.memTracker.Consume(-task.memUsage)
+	var obtainedHandlesMap *kv.HandleMap
+	if len(w.idxLookup.tblPlans) == 1 && !w.idxLookup.Ctx().GetSessionVars().StmtCtx.WeakConsistency {
+		obtainedHandlesMap = kv.NewHandleMap()
+	}
+	obtainedCnt := 0
+	for {
+		chk := exec.TryNewCacheChunk(tableReader)
+		if err := exec.Next(ctx, tableReader, chk); err != nil {
+			logutil.Logger(ctx).Error("table reader fetch next chunk failed", zap.Error(err))
+			return err
+		}
+		if chk.NumRows() == 0 {
+			break
+		}
+		rows := make([]chunk.Row, 0, chk.NumRows())
+		iter := chunk.NewIterator4Chunk(chk)
+		for row := iter.Begin(); row != iter.End(); row = iter.Next() {
+			rows = append(rows, row)
+			if obtainedHandlesMap != nil {
+				handle, err := w.idxLookup.getHandle(row, w.handleIdx, w.idxLookup.isCommonHandle(), getHandleFromTable)
+				if err != nil {
+					return err
+				}
+				obtainedHandlesMap.Set(handle, true)
+			}
+		}
+		obtainedCnt += len(rows)
+		result := &lookupTableTask{
+			rows:       rows,
+			memUsage:   chk.MemoryUsage() + int64(cap(rows))*int64(unsafe.Sizeof(chunk.Row{})),
+			memTracker: w.memTracker,
+			doneCh:     make(chan error, 1),
+		}
+		result.doneCh <- nil
+		result.memTracker.Consume(result.memUsage)
+		w.idxLookup.inflightMem.acquire(result.memUsage)
+		if !w.sendResult(result) || !w.idxLookup.inflightMem.wait(w.finished) {
+			return nil
+		}
+	}
+
+	if obtainedHandlesMap != nil && obtainedCnt != len(task.handles) && !util.HasCancelled(ctx) {
+		return w.reportLookupInconsistent(ctx, task, obtainedHandlesMap, obtainedCnt)
+	}
+	return nil
+}
+
+func (w *tableWorker) reportLookupInconsistent(ctx context.Context, task *lookupTableTask, obtainedHandlesMap *kv.HandleMap, obtainedCnt int) error {
+	missHds := GetLackHandles(task.handles, obtainedHandlesMap)
+	return (&consistency.Reporter{
+		HandleEncode: func(hd kv.Handle) kv.Key {
+			return tablecodec.EncodeRecordKey(w.idxLookup.table.RecordPrefix(), hd)
+		},
+		Tbl:  w.idxLookup.table.Meta(),
+		Idx:  w.idxLookup.index,
+		Sctx: w.idxLookup.Ctx(),
+	}).ReportLookupInconsistent(ctx,
+		len(task.handles),
+		obtainedCnt,
+		missHds,
+		task.handles,
+		nil,
+		//missRecords,
+	)
+}
+
+// lookupInflightMemLimiter limits the memory of the rows read by the table workers of the streaming
+// IndexLookUpExecutor but not returned yet.
+type lookupInflightMemLimiter struct {
+	mu       sync.Mutex
+	limit    int64
+	inflight int64
+	// released is closed and renewed when some memory is released, to wake up the waiting table workers.
+	released chan struct{}
+}
+
+func newLookupInflightMemLimiter(limit int64) *lookupInflightMemLimiter {
+	return &lookupInflightMemLimiter{limit: limit, released: make(chan struct{})}
+}
+
+func (l *lookupInflightMemLimiter) acquire(n int64) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	l.inflight += n
+	l.mu.Unlock()
+}
+
+func (l *lookupInflightMemLimiter) release(n int64) {
+	if l == nil || n == 0 {
+		return
+	}
+	l.mu.Lock()
+	l.inflight -= n
+	close(l.released)
+	l.released = make(chan struct{})
+	l.mu.Unlock()
+}
+
+// wait blocks until the memory in flight is under the limit, it returns false if finished is closed.
+func (l *lookupInflightMemLimiter) wait(finished <-chan struct{}) bool {
+	if l == nil {
+		return true
+	}
+	for {
+		l.mu.Lock()
+		if l.inflight < l.limit {
+			l.mu.Unlock()
+			return true
+		}
+		released := l.released
+		l.mu.Unlock()
+		select {
+		case <-released:
+		case <-finished:
+			return false
+		}
+	}
+}
+
 // GetLackHandles gets the handles in expectedHandles but not in obtainedHandlesMap.
 func GetLackHandles(expectedHandles []kv.Handle, obtainedHandlesMap *kv.HandleMap) []kv.Handle {
 	diffCnt := len(expectedHandles) - obtainedHandlesMap.Len()
@@ -591,3 +591,39 @@ func TestCoprCacheWithoutExecutionInfo(t *testing.T) {
 	tk.MustQuery("select * from t").Check(testkit.Rows("1", "2", "3"))
 	tk.MustQueryWithContext(ctx, "select * from t").Check(testkit.Rows("1", "2", "3"))
 }
+
+func TestIndexLookUpStreaming(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, c varchar(32), index idx(a))")
+	tk.MustExec("create table tp (a int, b int, c varchar(32), index idx(a)) partition by hash(b) partitions 3")
+	values := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d, '%d')", i, rand.Intn(1000), i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("insert into tp values " + strings.Join(values, ","))
+	tk.MustExec("set @@tidb_index_lookup_size = 10")
+
+	queries := []string{
+		"select * from t use index(idx) where a >= 100",
+		"select * from tp use index(idx) where a >= 100",
+	}
+	expected := make([][][]any, 0, len(queries))
+	for _, query := range queries {
+		expected = append(expected, tk.MustQuery(query).Sort().Rows())
+	}
+	tk.MustExec("set @@tidb_index_lookup_streaming = 1")
+	for _, inflightMem := range []int{0, 1, 1 << 20} {
+		tk.MustExec(fmt.Sprintf("set @@tidb_index_lookup_max_inflight_mem = %d", inflightMem))
+		for i, query := range queries {
+			tk.MustHavePlan(query, "IndexLookUp")
+			tk.MustQuery(query).Sort().Check(expected[i])
+			tk.MustQuery(fmt.Sprintf("select count(*) from (%s limit 50) tt", query)).Check(testkit.Rows("50"))
+		}
+		// the order of the index is still kept if it's required.
+		tk.MustQuery("select a from t use index(idx) where a >= 100 order by a limit 3").Check(testkit.Rows("100", "101", "102"))
+		tk.MustQuery("select a from t use index(idx) where a >= 100 order by a desc limit 3").Check(testkit.Rows("999", "998", "997"))
+	}
+}
@@ -75,6 +75,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/deadlockhistory"
 	"github.com/pingcap/tidb/pkg/util/disk"
 	"github.com/pingcap/tidb/pkg/util/execdetails"
+	"github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/pingcap/tidb/pkg/util/intest"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/logutil/consistency"
@@ -525,7 +526,7 @@ func (e *DDLJobRetriever) appendJobToChunk(req *chunk.Chunk, job *model.Job, che
 }
 
 func showAddIdxReorgTp(job *model.Job) string {
-	if job.Type == model.ActionAddIndex || job.Type == model.ActionAddPrimaryKey {
+	if job.Type == model.ActionAddIndex || job.Type == model.ActionAddPrimaryKey || job.Type == model.ActionReorganizeIndex {
 		if job.ReorgMeta != nil {
 			sb := strings.Builder{}
 			tp := job.ReorgMeta.ReorgTp.String()
@@ -1460,48 +1461,70 @@ func init() {
 	// but the plan package cannot import the executor package because of the dependency cycle.
 	// So we assign a function implemented in the executor package to the plan package to avoid the dependency cycle.
 	plannercore.EvalSubqueryFirstRow = func(ctx context.Context, p plannercore.PhysicalPlan, is infoschema.InfoSchema, pctx planctx.PlanContext) ([]types.Datum, error) {
-		defer func(begin time.Time) {
-			s := pctx.GetSessionVars()
-			s.StmtCtx.SetSkipPlanCache(errors.NewNoStackError("query has uncorrelated sub-queries is un-cacheable"))
-			s.RewritePhaseInfo.PreprocessSubQueries++
-			s.RewritePhaseInfo.DurationPreprocessSubQuery += time.Since(begin)
-		}(time.Now())
-
-		r, ctx := tracing.StartRegionEx(ctx, "executor.EvalSubQuery")
-		defer r.End()
-
-		sctx, err := plannercore.AsSctx(pctx)
-		intest.AssertNoError(err)
-		if err != nil {
-			return nil, err
-		}
-
-		e := newExecutorBuilder(sctx, is)
-		executor := e.build(p)
-		if e.err != nil {
-			return nil, e.err
-		}
-		err = exec.Open(ctx, executor)
-		defer func() { terror.Log(exec.Close(executor)) }()
-		if err != nil {
-			return nil, err
-		}
-		if pi, ok := sctx.(processinfoSetter); ok {
-			// Before executing the sub-query, we need update the processinfo to make the progress bar more accurate.
-			// because the sub-query may take a long time.
-			pi.UpdateProcessInfo()
-		}
-		chk := exec.TryNewCacheChunk(executor)
-		err = exec.Next(ctx, executor, chk)
-		if err != nil {
-			return nil, err
-		}
-		if chk.NumRows() == 0 {
-			return nil, nil
-		}
-		row := chk.GetRow(0).GetDatumRow(exec.RetTypes(executor))
+		var row []types.Datum
+		err := evalSubquery(ctx, p, is, pctx, func(ctx context.Context, executor exec.Executor) error {
+			chk := exec.TryNewCacheChunk(executor)
+			if err := exec.Next(ctx, executor, chk); err != nil || chk.NumRows() == 0 {
+				return err
+			}
+			row = chk.GetRow(0).GetDatumRow(exec.RetTypes(executor))
+			return nil
+		})
 		return row, err
 	}
+	plannercore.EvalSubqueryRows = func(ctx context.Context, p plannercore.PhysicalPlan, is infoschema.InfoSchema, pctx planctx.PlanContext, maxRows int) ([][]types.Datum, error) {
+		var rows [][]types.Datum
+		err := evalSubquery(ctx, p, is, pctx, func(ctx context.Context, executor exec.Executor) error {
+			chk := exec.TryNewCacheChunk(executor)
+			retTypes := exec.RetTypes(executor)
+			for len(rows) <= maxRows {
+				if err := exec.Next(ctx, executor, chk); err != nil || chk.NumRows() == 0 {
+					return err
+				}
+				for i := 0; i < chk.NumRows() && len(rows) <= maxRows; i++ {
+					rows = append(rows, chk.GetRow(i).GetDatumRow(retTypes))
+				}
+			}
+			return nil
+		})
+		return rows, err
+	}
+}
+
+// evalSubquery builds and opens the executor of the uncorrelated subquery, then reads its result by fetch.
+func evalSubquery(ctx context.Context, p plannercore.PhysicalPlan, is infoschema.InfoSchema, pctx planctx.PlanContext, fetch func(context.Context, exec.Executor) error) error {
+	defer func(begin time.Time) {
+		s := pctx.GetSessionVars()
+		s.StmtCtx.SetSkipPlanCache(errors.NewNoStackError("query has uncorrelated sub-queries is un-cacheable"))
+		s.RewritePhaseInfo.PreprocessSubQueries++
+		s.RewritePhaseInfo.DurationPreprocessSubQuery += time.Since(begin)
+	}(time.Now())
+
+	r, ctx := tracing.StartRegionEx(ctx, "executor.EvalSubQuery")
+	defer r.End()
+
+	sctx, err := plannercore.AsSctx(pctx)
+	intest.AssertNoError(err)
+	if err != nil {
+		return err
+	}
+
+	e := newExecutorBuilder(sctx, is)
+	executor := e.build(p)
+	if e.err != nil {
+		return e.err
+	}
+	err = exec.Open(ctx, executor)
+	defer func() { terror.Log(exec.Close(executor)) }()
+	if err != nil {
+		return err
+	}
+	if pi, ok := sctx.(processinfoSetter); ok {
+		// Before executing the sub-query, we need update the processinfo to make the progress bar more accurate.
+		// because the sub-query may take a long time.
+		pi.UpdateProcessInfo()
+	}
+	return fetch(ctx, executor)
 }
 
 // TableDualExec represents a dual table executor.
@@ -1801,6 +1824,10 @@ type UnionExec struct {
 		maxOpenedChildID int
 	}
 
+	// openedChildren are the children opened before the result pullers start, e.g. the ones whose
+	// point gets are prefetched.
+	openedChildren []bool
+
 	childInFlightForTest int32
 }
 
@@ -1826,10 +1853,16 @@ func (e *UnionExec) Open(context.Context) error {
 	e.finished = make(chan struct{})
 	e.mu.Mutex = &syncutil.Mutex{}
 	e.mu.maxOpenedChildID = -1
+	e.openedChildren = nil
 	return nil
 }
 
-func (e *UnionExec) initialize(ctx context.Context) {
+func (e *UnionExec) initialize(ctx context.Context) error {
+	if e.Ctx().GetSessionVars().EnableUnionPointGetBatch {
+		if err := e.prefetchPointGets(ctx); err != nil {
+			return err
+		}
+	}
 	if e.concurrency > e.ChildrenLen() {
 		e.concurrency = e.ChildrenLen()
 	}
@@ -1850,6 +1883,7 @@ func (e *UnionExec) initialize(ctx context.Context) {
 	}
 	close(e.childIDChan)
 	go e.waitAllFinished()
+	return nil
 }
 
 func (e *UnionExec) resultPuller(ctx context.Context, workerID int) {
@@ -1873,10 +1907,12 @@ func (e *UnionExec) resultPuller(ctx context.Context, workerID int) {
 			e.mu.maxOpenedChildID = childID
 		}
 		e.mu.Unlock()
-		if err := exec.Open(ctx, e.Children(childID)); err != nil {
-			result.err = err
-			e.stopFetchData.Store(true)
-			e.resultPool <- result
+		if childID >= len(e.openedChildren) || !e.openedChildren[childID] {
+			if err := exec.Open(ctx, e.Children(childID)); err != nil {
+				result.err = err
+				e.stopFetchData.Store(true)
+				e.resultPool <- result
+			}
 		}
 		failpoint.Inject("issue21441", func() {
 			atomic.AddInt32(&e.childInFlightForTest, 1)
@@ -1916,8 +1952,10 @@ func (e *UnionExec) resultPuller(ctx context.Context, workerID int) {
 func (e *UnionExec) Next(ctx context.Context, req *chunk.Chunk) error {
 	req.GrowAndReset(e.MaxChunkSize())
 	if !e.initialized {
-		e.initialize(ctx)
 		e.initialized = true
+		if err := e.initialize(ctx); err != nil {
+			return err
+		}
 	}
 	result, ok := <-e.resultPool
 	if !ok {
@@ -1952,7 +1990,10 @@ func (e *UnionExec) Close() error {
 	// We do not need to acquire the e.mu.Lock since all the resultPuller can be
 	// promised to exit when reaching here (e.childIDChan been closed).
 	var firstErr error
-	for i := 0; i <= e.mu.maxOpenedChildID; i++ {
+	for i := 0; i < e.ChildrenLen(); i++ {
+		if i > e.mu.maxOpenedChildID && (i >= len(e.openedChildren) || !e.openedChildren[i]) {
+			continue
+		}
 		if err := exec.Close(e.Children(i)); err != nil && firstErr == nil {
 			firstErr = err
 		}
@@ -2108,6 +2149,20 @@ func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 		sc.InVerboseExplain = strings.ToLower(explainForStmt.Format) == types.ExplainFormatVerbose
 	}
 
+	// sql_mode and time_zone are used to build the statement context, so their
+	// SET_VAR hints are applied here instead of in the optimizer. If it fails,
+	// the optimizer will try again and report the error as a warning.
+	for name, val := range hint.ExtractSetVarHints(s, variable.SQLModeVar, variable.TimeZone) {
+		oldV, err := vars.SetSystemVarWithOldValAsRet(name, val)
+		if err != nil {
+			continue
+		}
+		sc.AddSetVarHintRestore(name, oldV)
+		if name == variable.TimeZone {
+			sc.SetTimeZone(vars.Location())
+		}
+	}
+
 	// TODO: Many same bool variables here.
 	// We should set only two variables (
 	// IgnoreErr and StrictSQLMode) to avoid setting the same bool variables and
@@ -16,6 +16,7 @@ package executor
 
 import (
 	"fmt"
+	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
@@ -24,19 +25,24 @@ import (
 	"unsafe"
 
 	"github.com/hashicorp/go-version"
+	"github.com/pingcap/kvproto/pkg/diagnosticspb"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/errctx"
 	"github.com/pingcap/tidb/pkg/executor/aggfuncs"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/mock"
 	"github.com/pingcap/tidb/pkg/util/ranger"
+	"github.com/pingcap/tidb/pkg/util/set"
 	"github.com/pingcap/tidb/pkg/util/tableutil"
 	"github.com/stretchr/testify/require"
 )
@@ -490,3 +496,69 @@ func TestErrLevelsForResetStmtContext(t *testing.T) {
 		}
 	}
 }
+
+func TestGetChecksumPartitionIDs(t *testing.T) {
+	tblInfo := &model.TableInfo{Name: model.NewCIStr("t")}
+	partitionIDs, err := getChecksumPartitionIDs(tblInfo, nil)
+	require.NoError(t, err)
+	require.Nil(t, partitionIDs)
+	_, err = getChecksumPartitionIDs(tblInfo, []model.CIStr{model.NewCIStr("p0")})
+	require.ErrorContains(t, err, "is not a partition table")
+
+	tblInfo.Partition = &model.PartitionInfo{
+		Enable: true,
+		Definitions: []model.PartitionDefinition{
+			{ID: 1, Name: model.NewCIStr("p0")},
+			{ID: 2, Name: model.NewCIStr("p1")},
+		},
+	}
+	partitionIDs, err = getChecksumPartitionIDs(tblInfo, []model.CIStr{model.NewCIStr("P1"), model.NewCIStr("p0")})
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 1}, partitionIDs)
+	_, err = getChecksumPartitionIDs(tblInfo, []model.CIStr{model.NewCIStr("p2")})
+	require.True(t, table.ErrUnknownPartition.Equal(err))
+}
+
+func TestClusterLogModuleAndJobID(t *testing.T) {
+	messages := []string{
+		`[ddl_worker.go:100] ["run DDL job"] [category=ddl] [jobID=100]`,
+		`[backfilling.go:200] ["start backfill"] [category=ddl-ingest] ["job ID"=101]`,
+		`[gc_worker.go:300] ["start gc"] [category="gc worker"]`,
+		`[server.go:400] ["new connection"] [conn=1]`,
+	}
+	modulePattern := regexp.MustCompile(buildLogFieldPattern(logModuleKeys, set.NewStringSet("ddl", "gc worker")))
+	jobIDPattern := regexp.MustCompile(buildLogFieldPattern(logJobIDKeys, set.NewStringSet("101")))
+	var modules, jobIDs []bool
+	for _, message := range messages {
+		modules = append(modules, modulePattern.MatchString(message))
+		jobIDs = append(jobIDs, jobIDPattern.MatchString(message))
+	}
+	require.Equal(t, []bool{true, false, true, false}, modules)
+	require.Equal(t, []bool{false, true, false, false}, jobIDs)
+
+	e := &clusterLogRetriever{extractor: &plannercore.ClusterLogTableExtractor{}}
+	var rows [][]types.Datum
+	for _, message := range messages {
+		row, ok := e.buildRow("tidb", "127.0.0.1:4000", &diagnosticspb.LogMessage{Message: message})
+		require.True(t, ok)
+		rows = append(rows, row)
+	}
+	require.Equal(t, "ddl", rows[0][5].GetString())
+	require.Equal(t, int64(100), rows[0][6].GetInt64())
+	require.Equal(t, "ddl-ingest", rows[1][5].GetString())
+	require.Equal(t, int64(101), rows[1][6].GetInt64())
+	require.Equal(t, "gc worker", rows[2][5].GetString())
+	require.True(t, rows[2][6].IsNull())
+	require.True(t, rows[3][5].IsNull())
+	require.True(t, rows[3][6].IsNull())
+
+	// The messages which are not matched exactly are filtered out.
+	e.extractor.Modules = set.NewStringSet("ddl")
+	_, ok := e.buildRow("tidb", "127.0.0.1:4000", &diagnosticspb.LogMessage{Message: messages[1]})
+	require.False(t, ok)
+	_, ok = e.buildRow("tidb", "127.0.0.1:4000", &diagnosticspb.LogMessage{Message: messages[0]})
+	require.True(t, ok)
+	e.extractor.JobIDs = set.NewStringSet("101")
+	_, ok = e.buildRow("tidb", "127.0.0.1:4000", &diagnosticspb.LogMessage{Message: messages[0]})
+	require.False(t, ok)
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/faultinject"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/tikv/client-go/v2/util"
 	"go.uber.org/zap"
@@ -99,6 +100,10 @@ func (e *ImportIntoExec) Next(ctx context.Context, req *chunk.Chunk) (err error)
 		return err
 	}
 	e.controller = controller
+	// TODO: Support IMPORT INTO in unistore.
+	if e.userSctx.GetStore().Name() != "TiKV" {
+		return errors.Errorf("Not support IMPORT INTO in non-TiKV env, the encoded data is ingested into TiKV as SST files")
+	}
 
 	if e.selectExec != nil {
 		// `import from select` doesn't return rows, so no need to set dataFilled.
@@ -131,6 +136,9 @@ func (e *ImportIntoExec) Next(ctx context.Context, req *chunk.Chunk) (err error)
 		TestCancelFunc = cancel
 	})
 
+	if err = e.userSctx.GetSessionVars().InjectedFaults.Eval(ctx, faultinject.ImportIntoBeforeSubmitTask); err != nil {
+		return err
+	}
 	jobID, task, err := e.submitTask(ctx)
 	if err != nil {
 		return err
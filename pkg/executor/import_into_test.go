@@ -18,7 +18,9 @@ import (
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/pkg/util/sem"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSecurityEnhancedMode(t *testing.T) {
@@ -33,3 +35,17 @@ func TestSecurityEnhancedMode(t *testing.T) {
 	// regardless of what privileges they have available.
 	tk.MustGetErrMsg("IMPORT INTO test.t FROM '/file.csv'", "[planner:8132]Feature 'IMPORT INTO from server disk' is not supported when security enhanced mode is enabled")
 }
+
+func TestImportIntoNonTiKVStore(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int);")
+	tk.MustExec("create table t2 (id int);")
+	tk.MustExec("insert into t2 values (1);")
+	tk.MustGetErrMsg("IMPORT INTO t FROM select * from t2", "Not support IMPORT INTO in non-TiKV env, the encoded data is ingested into TiKV as SST files")
+	// the options are still checked.
+	require.ErrorIs(t, tk.QueryToErr("IMPORT INTO t FROM '/file.csv' with xx=1"), exeerrors.ErrUnknownOption)
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("0"))
+}
@@ -96,6 +96,7 @@ const (
 	disableTiKVImportModeOption = "disable_tikv_import_mode"
 	cloudStorageURIOption       = "cloud_storage_uri"
 	disablePrecheckOption       = "disable_precheck"
+	preSplitRegionsOption       = "pre_split_regions"
 	// used for test
 	maxEngineSizeOption = "__max_engine_size"
 	forceMergeStep      = "__force_merge_step"
@@ -124,6 +125,7 @@ var (
 		forceMergeStep:              false,
 		cloudStorageURIOption:       true,
 		disablePrecheckOption:       false,
+		preSplitRegionsOption:       false,
 	}
 
 	csvOnlyOptions = map[string]struct{}{
@@ -235,6 +237,9 @@ type Plan struct {
 	MaxEngineSize         config.ByteSize
 	CloudStorageURI       string
 	DisablePrecheck       bool
+	// PreSplitRegions indicates whether to sample the source data and pre-split
+	// the regions of the target table before encoding, only works for local sort.
+	PreSplitRegions bool
 
 	// used for checksum in physical mode
 	DistSQLScanConcurrency int
@@ -748,6 +753,14 @@ func (p *Plan) initOptions(ctx context.Context, seCtx sessionctx.Context, option
 	if _, ok := specifiedOptions[forceMergeStep]; ok {
 		p.ForceMergeStep = true
 	}
+	if _, ok := specifiedOptions[preSplitRegionsOption]; ok {
+		// when using global sort, regions are split using the statistics of
+		// sorted data in the write-and-ingest step, no need to pre-split.
+		if p.IsGlobalSort() {
+			return exeerrors.ErrLoadDataUnsupportedOption.FastGenByArgs(preSplitRegionsOption, "global sort")
+		}
+		p.PreSplitRegions = true
+	}
 
 	// when split-file is set, data file will be split into chunks of 256 MiB.
 	// skip_rows should be 0 or 1, we add this restriction to simplify skip_rows
@@ -1332,6 +1345,8 @@ func (e *LoadDataController) getLocalBackendCfg(pdAddr, dataDir string) local.Ba
 		PausePDSchedulerScope:       config.PausePDSchedulerScopeTable,
 		DisableAutomaticCompactions: true,
 		BlockSize:                   config.DefaultBlockSize,
+		EncryptionMethod:            tidb.GetGlobalConfig().Security.IngestFileEncryptionMethod,
+		EncryptionMasterKeyPath:     tidb.GetGlobalConfig().Security.IngestFileEncryptionMasterKeyPath,
 	}
 	if e.IsRaftKV2 {
 		backendConfig.RaftKV2SwitchModeDuration = config.DefaultSwitchTiKVModeInterval
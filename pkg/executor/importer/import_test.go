@@ -177,6 +177,22 @@ func TestInitOptionsPositiveCase(t *testing.T) {
 	err = plan.initOptions(ctx, sctx, convertOptions(stmt.(*ast.ImportIntoStmt).Options))
 	require.NoError(t, err, sql4)
 	require.Equal(t, "", plan.CloudStorageURI, sql4)
+	require.False(t, plan.PreSplitRegions, sql4)
+
+	// pre-split regions only works with local sort
+	sql5 := sql4 + ", " + preSplitRegionsOption
+	stmt, err = p.ParseOneStmt(sql5, "", "")
+	require.NoError(t, err, sql5)
+	plan = &Plan{Format: DataFormatCSV}
+	err = plan.initOptions(ctx, sctx, convertOptions(stmt.(*ast.ImportIntoStmt).Options))
+	require.NoError(t, err, sql5)
+	require.True(t, plan.PreSplitRegions, sql5)
+	sql6 := sql + ", " + preSplitRegionsOption
+	stmt, err = p.ParseOneStmt(sql6, "", "")
+	require.NoError(t, err, sql6)
+	plan = &Plan{Format: DataFormatCSV}
+	err = plan.initOptions(ctx, sctx, convertOptions(stmt.(*ast.ImportIntoStmt).Options))
+	require.ErrorIs(t, err, exeerrors.ErrLoadDataUnsupportedOption, sql6)
 }
 
 func TestAdjustOptions(t *testing.T) {
@@ -166,6 +166,13 @@ func (en *tableKVEncoder) getRow(vals []types.Datum, rowID int64) ([]types.Datum
 	row := make([]types.Datum, len(en.Columns))
 	hasValue := make([]bool, len(en.Columns))
 	for i := 0; i < len(en.insertColumns); i++ {
+		// the value of generated column is always evaluated from other columns,
+		// so the data file can use any placeholder for it, we don't cast it to
+		// avoid failing on the placeholder, same as INSERT which only allows
+		// DEFAULT for generated columns.
+		if en.insertColumns[i].IsGenerated() {
+			continue
+		}
 		casted, err := table.CastValue(en.SessionCtx, vals[i], en.insertColumns[i].ToInfo(), false, false)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,253 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/pingcap/errors"
+	tidbkv "github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/pkg/lightning/common"
+	"github.com/pingcap/tidb/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"go.uber.org/zap"
+)
+
+const (
+	// the max number of chunks we sample when pre-splitting regions.
+	preSplitMaxSampledChunks = 32
+	// the max number of rows we sample from each chunk.
+	preSplitMaxSampledRowsPerChunk = 2000
+	// the max number of ranges in one split&scatter batch.
+	preSplitBatchRanges = 4096
+)
+
+// kvGroupSample is the sampled keys of a KV group, i.e. the data KVs or the
+// KVs of one index.
+type kvGroupSample struct {
+	keys [][]byte
+	// total size of the sampled KVs.
+	size int64
+}
+
+// splitKeySampler samples source data of the chunks, and estimates the key
+// distribution of each KV group after encoding.
+type splitKeySampler struct {
+	dataSample  kvGroupSample
+	indexSample map[int64]*kvGroupSample
+	// sampledBytes is the size of source data we have read, and totalBytes is
+	// the size of all chunks, we use their ratio to scale the sampled size up.
+	sampledBytes int64
+	totalBytes   int64
+}
+
+func newSplitKeySampler() *splitKeySampler {
+	return &splitKeySampler{
+		indexSample: make(map[int64]*kvGroupSample),
+	}
+}
+
+func (s *splitKeySampler) add(pairs []common.KvPair) error {
+	for _, pair := range pairs {
+		// the key and value are allocated from the memory buffer of encoder,
+		// which will be recycled, so we need to clone the key.
+		key := slices.Clone(pair.Key)
+		size := int64(len(pair.Key) + len(pair.Val))
+		if tablecodec.IsRecordKey(pair.Key) {
+			s.dataSample.keys = append(s.dataSample.keys, key)
+			s.dataSample.size += size
+			continue
+		}
+		indexID, err := tablecodec.DecodeIndexID(pair.Key)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		sample, ok := s.indexSample[indexID]
+		if !ok {
+			sample = &kvGroupSample{}
+			s.indexSample[indexID] = sample
+		}
+		sample.keys = append(sample.keys, key)
+		sample.size += size
+	}
+	return nil
+}
+
+// splitKeys returns the estimated split keys of all KV groups, keys of each
+// group are sorted.
+func (s *splitKeySampler) splitKeys(regionSplitSize int64) [][]byte {
+	if s.sampledBytes <= 0 || regionSplitSize <= 0 {
+		return nil
+	}
+	ratio := float64(s.totalBytes) / float64(s.sampledBytes)
+	if ratio < 1 {
+		ratio = 1
+	}
+	res := getSplitKeysBySample(s.dataSample.keys, int64(float64(s.dataSample.size)*ratio), regionSplitSize)
+	for _, sample := range s.indexSample {
+		res = append(res, getSplitKeysBySample(sample.keys, int64(float64(sample.size)*ratio), regionSplitSize)...)
+	}
+	return res
+}
+
+// getSplitKeysBySample returns the keys which evenly divide the sampled keys
+// into ceil(estimatedSize/regionSplitSize) parts, duplicated keys are removed.
+// the input keys will be sorted in place.
+func getSplitKeysBySample(keys [][]byte, estimatedSize, regionSplitSize int64) [][]byte {
+	if len(keys) == 0 || estimatedSize <= regionSplitSize {
+		return nil
+	}
+	slices.SortFunc(keys, bytes.Compare)
+	regionCnt := (estimatedSize + regionSplitSize - 1) / regionSplitSize
+	if regionCnt > int64(len(keys)) {
+		regionCnt = int64(len(keys))
+	}
+	res := make([][]byte, 0, regionCnt-1)
+	for i := int64(1); i < regionCnt; i++ {
+		key := keys[int64(len(keys))*i/regionCnt]
+		if len(res) > 0 && bytes.Equal(res[len(res)-1], key) {
+			continue
+		}
+		res = append(res, key)
+	}
+	return res
+}
+
+// sampleChunks returns at most preSplitMaxSampledChunks chunks which are
+// evenly distributed in the input chunks.
+func sampleChunks(chunks []*checkpoints.ChunkCheckpoint) []*checkpoints.ChunkCheckpoint {
+	if len(chunks) <= preSplitMaxSampledChunks {
+		return chunks
+	}
+	res := make([]*checkpoints.ChunkCheckpoint, 0, preSplitMaxSampledChunks)
+	for i := 0; i < preSplitMaxSampledChunks; i++ {
+		res = append(res, chunks[len(chunks)*i/preSplitMaxSampledChunks])
+	}
+	return res
+}
+
+func chunkSourceSize(chunk *checkpoints.ChunkCheckpoint) int64 {
+	if chunk.FileMeta.Compression != mydump.CompressionNone && chunk.Chunk.Offset == 0 {
+		return chunk.FileMeta.RealSize
+	}
+	return chunk.Chunk.EndOffset - chunk.Chunk.Offset
+}
+
+// sampleChunk reads and encodes at most preSplitMaxSampledRowsPerChunk rows of
+// the chunk, and adds the encoded keys to the sampler.
+func (ti *TableImporter) sampleChunk(ctx context.Context, chunk *checkpoints.ChunkCheckpoint, sampler *splitKeySampler) (err error) {
+	parser, err := ti.getParser(ctx, chunk)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err2 := parser.Close(); err2 != nil && err == nil {
+			err = err2
+		}
+	}()
+	encoder, err := ti.getKVEncoder(chunk)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err2 := encoder.Close(); err2 != nil && err == nil {
+			err = err2
+		}
+	}()
+
+	startPos, _ := parser.Pos()
+	for i := 0; i < preSplitMaxSampledRowsPerChunk; i++ {
+		if pos, _ := parser.Pos(); pos >= chunk.Chunk.EndOffset {
+			break
+		}
+		if err = parser.ReadRow(); err != nil {
+			if errors.Cause(err) == io.EOF {
+				err = nil
+				break
+			}
+			return err
+		}
+		lastRow := parser.LastRow()
+		kvs, err2 := encoder.Encode(lastRow.Row, lastRow.RowID)
+		parser.RecycleRow(lastRow)
+		if err2 != nil {
+			// the row will be handled as an error row in the import step, we
+			// only need a rough distribution here, so just skip it.
+			continue
+		}
+		err = sampler.add(kvs.Pairs)
+		kvs.Clear()
+		if err != nil {
+			return err
+		}
+	}
+	endPos, _ := parser.Pos()
+	sampler.sampledBytes += endPos - startPos
+	return nil
+}
+
+// PreSplitRegionsBySample samples the source data of the chunks, and pre-split&scatter
+// the regions of the data and index KV ranges using the estimated key
+// distribution, to avoid write hotspot and split storm when ingesting.
+// the result is only an estimation, so we ignore the error of splitting.
+func (ti *TableImporter) PreSplitRegionsBySample(ctx context.Context, chunks []*checkpoints.ChunkCheckpoint) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+	startTime := time.Now()
+	sampler := newSplitKeySampler()
+	for _, chunk := range chunks {
+		sampler.totalBytes += chunkSourceSize(chunk)
+	}
+	for _, chunk := range sampleChunks(chunks) {
+		if err := ti.sampleChunk(ctx, chunk, sampler); err != nil {
+			return err
+		}
+	}
+
+	splitKeys := sampler.splitKeys(ti.regionSplitSize)
+	if len(splitKeys) == 0 {
+		ti.logger.Info("no need to pre-split regions", zap.Duration("takeTime", time.Since(startTime)))
+		return nil
+	}
+	codec := ti.backend.GetTiKVCodec()
+	for i, key := range splitKeys {
+		splitKeys[i] = codec.EncodeKey(key)
+	}
+	slices.SortFunc(splitKeys, bytes.Compare)
+	ranges := make([]common.Range, 0, len(splitKeys)-1)
+	for i := 1; i < len(splitKeys); i++ {
+		ranges = append(ranges, common.Range{Start: splitKeys[i-1], End: splitKeys[i]})
+	}
+	if len(ranges) == 0 {
+		ranges = append(ranges, common.Range{Start: splitKeys[0], End: tidbkv.Key(splitKeys[0]).Next()})
+	}
+	err := ti.backend.SplitAndScatterRegionInBatches(ctx, ranges, preSplitBatchRanges)
+	if err != nil {
+		if common.IsContextCanceledError(err) {
+			return err
+		}
+		ti.logger.Warn("failed to pre-split regions, ignore it", zap.Error(err))
+	}
+	ti.logger.Info("pre-split regions done", zap.Int("split-keys", len(splitKeys)),
+		zap.Int64("sampled-bytes", sampler.sampledBytes), zap.Int64("total-bytes", sampler.totalBytes),
+		zap.Duration("takeTime", time.Since(startTime)))
+	return nil
+}
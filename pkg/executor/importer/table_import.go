@@ -766,6 +766,9 @@ func PostProcess(
 	if err = RebaseAllocatorBases(ctx, se.GetStore(), maxIDs, plan, logger); err != nil {
 		return err
 	}
+	if err = RebaseSequences(ctx, se, plan, logger); err != nil {
+		return err
+	}
 
 	return VerifyChecksum(ctx, plan, localChecksum.MergedChecksum(), se, logger)
 }
@@ -827,6 +830,44 @@ func RebaseAllocatorBases(ctx context.Context, kvStore tidbkv.Storage, maxIDs ma
 	return errors.Trace(err)
 }
 
+// RebaseSequences moves the sequences used by the default values of the table past the imported values,
+// so the values generated by them later won't conflict with the imported ones.
+func RebaseSequences(ctx context.Context, se sessionctx.Context, plan *Plan, logger *zap.Logger) (err error) {
+	seqCols := common.GetSequenceColumns(plan.DesiredTableInfo)
+	if len(seqCols) == 0 {
+		return nil
+	}
+	callLog := log.BeginTask(logger, "rebase sequences")
+	defer func() {
+		callLog.End(zap.ErrorLevel, err)
+	}()
+
+	ctx = util.WithInternalSourceType(ctx, tidbkv.InternalImportInto)
+	for _, seqCol := range seqCols {
+		rows, err := sqlexec.ExecSQL(ctx, se.GetSQLExecutor(), "SELECT MIN(%n), MAX(%n) FROM %n.%n",
+			seqCol.Column.Name.O, seqCol.Column.Name.O, plan.DBName, plan.DesiredTableInfo.Name.O)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 || rows[0].IsNull(1) {
+			continue
+		}
+		seqSchema := seqCol.SequenceSchema
+		if seqSchema == "" {
+			seqSchema = plan.DBName
+		}
+		// SETVAL never moves a sequence backward, so only one of them takes effect, the max value for the
+		// ascending sequence, and the min value for the descending one.
+		for _, idx := range []int{1, 0} {
+			if _, err = sqlexec.ExecSQL(ctx, se.GetSQLExecutor(), "SELECT SETVAL(%n.%n, %?)",
+				seqSchema, seqCol.SequenceName, rows[0].GetInt64(idx)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // VerifyChecksum verify the checksum of the table.
 func VerifyChecksum(ctx context.Context, plan *Plan, localChecksum verify.KVChecksum, se sessionctx.Context, logger *zap.Logger) error {
 	if plan.Checksum == config.OpLevelOff {
@@ -208,3 +208,28 @@ func TestGetRegionSplitSizeKeys(t *testing.T) {
 	require.ErrorContains(t, err, "get region split size and keys failed")
 	// no positive case, more complex to mock it
 }
+
+func TestGetSplitKeysBySample(t *testing.T) {
+	genKeys := func(n int) [][]byte {
+		keys := make([][]byte, 0, n)
+		// reversed order, the keys should be sorted inside.
+		for i := n - 1; i >= 0; i-- {
+			keys = append(keys, []byte(fmt.Sprintf("key%03d", i)))
+		}
+		return keys
+	}
+	require.Nil(t, getSplitKeysBySample(nil, 1000, 10))
+	// estimated size is smaller than region size, no need to split.
+	require.Nil(t, getSplitKeysBySample(genKeys(100), 10, 10))
+	require.Equal(t, [][]byte{[]byte("key050")}, getSplitKeysBySample(genKeys(100), 20, 10))
+	require.Equal(t, [][]byte{
+		[]byte("key025"), []byte("key050"), []byte("key075"),
+	}, getSplitKeysBySample(genKeys(100), 40, 10))
+	// region count is limited by the number of sampled keys.
+	require.Equal(t, [][]byte{
+		[]byte("key001"), []byte("key002"),
+	}, getSplitKeysBySample(genKeys(3), 1000, 10))
+	// duplicated keys are removed.
+	keys := [][]byte{[]byte("a"), []byte("a"), []byte("a"), []byte("b")}
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, getSplitKeysBySample(keys, 1000, 10))
+}
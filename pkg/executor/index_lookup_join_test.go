@@ -116,6 +116,37 @@ func TestPartitionTableIndexJoinAndIndexReader(t *testing.T) {
 	}
 }
 
+func TestPartitionTableIndexJoinWithFixedPartitionColumn(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_partition_prune_mode='dynamic'")
+	// b isn't a join key but fixed by the condition, so (a, b) determines the partition of each lookup.
+	tk.MustExec("create table t (a int, b int, c int, key(a, b)) partition by key(a, b) partitions 4")
+	tk.MustExec("create table tr (a int, b int, c int, key(a, b)) partition by range columns(b, a) " +
+		"(partition p0 values less than (4, 0), partition p1 values less than (8, 0), partition p2 values less than (maxvalue, maxvalue))")
+	tk.MustExec("create table tnormal (a int, b int, c int, key(a, b))")
+	nRows := 256
+	values := make([]string, 0, nRows)
+	for i := 0; i < nRows; i++ {
+		values = append(values, fmt.Sprintf("(%v, %v, %v)", rand.Intn(32), rand.Intn(12), i))
+	}
+	for _, tbl := range []string{"t", "tr", "tnormal"} {
+		tk.MustExec(fmt.Sprintf("insert into %s values %v", tbl, strings.Join(values, ", ")))
+	}
+
+	for _, cond := range []string{"t2.b = 3", "t2.b = 9", "t2.b in (1, 5)", "t2.b > 6", "t2.b is null"} {
+		result := tk.MustQuery("select t1.a, t2.c from tnormal t1, tnormal t2 where t1.a = t2.a and " + cond).Sort().Rows()
+		for _, tbl := range []string{"t", "tr"} {
+			tk.MustQuery(fmt.Sprintf("select /*+ inl_join(t2) */ t1.a, t2.c from tnormal t1, %s t2 where t1.a = t2.a and %s", tbl, cond)).Sort().Check(result)
+			tk.MustQuery(fmt.Sprintf("select /*+ inl_hash_join(t2) */ t1.a, t2.c from tnormal t1, %s t2 where t1.a = t2.a and %s", tbl, cond)).Sort().Check(result)
+			tk.MustQuery(fmt.Sprintf("select /*+ inl_join(t2) */ t1.a, t2.b from tnormal t1, %s t2 use index(a) where t1.a = t2.a and %s", tbl, cond)).Sort().Check(
+				tk.MustQuery("select t1.a, t2.b from tnormal t1, tnormal t2 where t1.a = t2.a and " + cond).Sort().Rows())
+		}
+	}
+}
+
 func TestIssue45716(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
@@ -31,8 +31,10 @@ import (
 	"github.com/pingcap/kvproto/pkg/deadlock"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	rmpb "github.com/pingcap/kvproto/pkg/resource_manager"
+	"github.com/pingcap/tidb/pkg/ddl/ingest"
 	"github.com/pingcap/tidb/pkg/ddl/label"
 	"github.com/pingcap/tidb/pkg/ddl/placement"
+	"github.com/pingcap/tidb/pkg/disttask/framework/storage"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
 	"github.com/pingcap/tidb/pkg/domain/resourcegroup"
@@ -64,6 +66,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/codec"
 	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/cpu"
 	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
 	"github.com/pingcap/tidb/pkg/util/deadlockhistory"
 	"github.com/pingcap/tidb/pkg/util/execdetails"
@@ -209,6 +212,16 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			e.setDataFromIndexUsage(sctx, dbs)
 		case infoschema.ClusterTableTiDBIndexUsage:
 			err = e.setDataForClusterIndexUsage(sctx, dbs)
+		case infoschema.TableTiDBRunningStatementStats:
+			e.setDataForRunningStatementStats(sctx)
+		case infoschema.TableTiDBIngestDiskUsage:
+			err = e.setDataForIngestDiskUsage(sctx)
+		case infoschema.TableDistFrameworkNodes:
+			err = e.setDataForDistFrameworkNodes(ctx, sctx)
+		case infoschema.TableTiKVCompactionProgress:
+			e.setDataForTiKVCompactionProgress(sctx)
+		case infoschema.TableTiDBAccountLocks:
+			err = e.setDataForTiDBAccountLocks(ctx, sctx)
 		}
 		if err != nil {
 			return nil, err
@@ -1456,9 +1469,13 @@ func (e *DDLJobsReaderExec) Next(_ context.Context, req *chunk.Chunk) error {
 		for i := e.cursor; i < e.cursor+num; i++ {
 			e.appendJobToChunk(req, e.runningJobs[i], checker)
 			req.AppendString(12, e.runningJobs[i].Query)
+			e.appendJobProgressToChunk(req, e.runningJobs[i])
+			e.appendIngestFallbackToChunk(req, e.runningJobs[i])
 			if e.runningJobs[i].MultiSchemaInfo != nil {
 				for range e.runningJobs[i].MultiSchemaInfo.SubJobs {
 					req.AppendString(12, e.runningJobs[i].Query)
+					e.appendJobProgressToChunk(req, nil)
+					e.appendIngestFallbackToChunk(req, nil)
 				}
 			}
 		}
@@ -1476,9 +1493,13 @@ func (e *DDLJobsReaderExec) Next(_ context.Context, req *chunk.Chunk) error {
 		for _, job := range e.cacheJobs {
 			e.appendJobToChunk(req, job, checker)
 			req.AppendString(12, job.Query)
+			e.appendJobProgressToChunk(req, job)
+			e.appendIngestFallbackToChunk(req, job)
 			if job.MultiSchemaInfo != nil {
 				for range job.MultiSchemaInfo.SubJobs {
 					req.AppendString(12, job.Query)
+					e.appendJobProgressToChunk(req, nil)
+					e.appendIngestFallbackToChunk(req, nil)
 				}
 			}
 		}
@@ -1487,6 +1508,37 @@ func (e *DDLJobsReaderExec) Next(_ context.Context, req *chunk.Chunk) error {
 	return nil
 }
 
+// appendJobProgressToChunk appends the progress of the ingest job, the columns are NULL for the other jobs.
+func (e *DDLJobsReaderExec) appendJobProgressToChunk(req *chunk.Chunk, job *model.Job) {
+	if job == nil || job.ReorgMeta == nil || job.ReorgMeta.Progress == nil {
+		for i := 13; i <= 16; i++ {
+			req.AppendNull(i)
+		}
+		return
+	}
+	progress := job.ReorgMeta.Progress
+	rowCount := job.GetRowCount()
+	req.AppendString(13, string(progress.Phase))
+	req.AppendFloat64(14, progress.Percentage(rowCount))
+	req.AppendInt64(15, progress.WrittenBytes)
+	endTime, ok := progress.EstimatedEndTime(rowCount)
+	if !ok || job.IsFinished() {
+		req.AppendNull(16)
+		return
+	}
+	req.AppendTime(16, types.NewTime(types.FromGoTime(endTime.In(e.TZLoc)), mysql.TypeDatetime, types.DefaultFsp))
+}
+
+// appendIngestFallbackToChunk appends the reason why the job falls back from the ingest backfill,
+// the column is NULL if the job doesn't fall back.
+func (e *DDLJobsReaderExec) appendIngestFallbackToChunk(req *chunk.Chunk, job *model.Job) {
+	if job == nil || job.ReorgMeta == nil || job.ReorgMeta.IngestFallbackReason == "" {
+		req.AppendNull(17)
+		return
+	}
+	req.AppendString(17, job.ReorgMeta.IngestFallbackReason)
+}
+
 // Close implements the Executor Close interface.
 func (e *DDLJobsReaderExec) Close() error {
 	e.ReleaseSysSession(kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL), e.sess)
@@ -1651,6 +1703,204 @@ func (e *memtableRetriever) setDataForProcessList(ctx sessionctx.Context) {
 	e.rows = records
 }
 
+// setDataForRunningStatementStats fills the partial runtime stats of the operators collected so far
+// for the running statements, it is like running `explain for connection` for all the connections.
+func (e *memtableRetriever) setDataForRunningStatementStats(ctx sessionctx.Context) {
+	sm := ctx.GetSessionManager()
+	if sm == nil {
+		return
+	}
+
+	loginUser := ctx.GetSessionVars().User
+	hasProcessPriv := hasPriv(ctx, mysql.ProcessPriv)
+	pl := sm.ShowProcessList()
+
+	var records [][]types.Datum
+	for _, pi := range pl {
+		if !hasProcessPriv && loginUser != nil && pi.User != loginUser.Username {
+			continue
+		}
+		// Skip the idle connections, they keep the plan of the last statement.
+		if pi.Command == mysql.ComSleep || pi.ID == ctx.GetSessionVars().ConnectionID || pi.RuntimeStatsColl == nil {
+			continue
+		}
+		p, ok := pi.Plan.(plannercore.Plan)
+		if !ok || p == nil {
+			continue
+		}
+		explain := &plannercore.Explain{
+			TargetPlan:       p,
+			Format:           types.ExplainFormatROW,
+			RuntimeStatsColl: pi.RuntimeStatsColl,
+		}
+		explain.SetSCtx(ctx.GetPlanCtx())
+		// The columns are id, estRows, actRows, task, access object, execution info, operator info, memory and disk.
+		for _, row := range plannercore.GetExplainAnalyzeRowsForPlan(explain) {
+			record := make([]types.Datum, 0, len(row)+1)
+			record = append(record, types.NewUintDatum(pi.ID))
+			for _, col := range row {
+				record = append(record, types.NewStringDatum(col))
+			}
+			records = append(records, record)
+		}
+	}
+	e.rows = records
+}
+
+// setDataForIngestDiskUsage fills the temporary disk usage of the ingest jobs in the current instance.
+func (e *memtableRetriever) setDataForIngestDiskUsage(ctx sessionctx.Context) error {
+	if !hasPriv(ctx, mysql.ProcessPriv) {
+		return nil
+	}
+	usages, err := ingest.ListJobDiskUsages(ingest.LitSortPath)
+	if err != nil {
+		return err
+	}
+	quota := variable.DDLDiskQuota.Load()
+	rows := make([][]types.Datum, 0, len(usages))
+	for _, usage := range usages {
+		rows = append(rows, types.MakeDatums(
+			usage.JobID,     // JOB_ID
+			usage.Path,      // PATH
+			usage.DiskUsage, // DISK_USAGE
+			quota,           // DISK_QUOTA
+		))
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForDistFrameworkNodes fills the resource slots and usage of the nodes managed by the
+// distributed execution framework.
+func (e *memtableRetriever) setDataForDistFrameworkNodes(ctx context.Context, sctx sessionctx.Context) error {
+	if !hasPriv(sctx, mysql.ProcessPriv) {
+		return nil
+	}
+	taskMgr, err := storage.GetTaskManager()
+	if err != nil {
+		return err
+	}
+	usages, err := taskMgr.GetNodesResourceUsage(ctx)
+	if err != nil {
+		return err
+	}
+	// each slot represents 1 cpu core and 1/total-core of memory, and all nodes managed
+	// by the framework are assumed to be isomorphic, so the memory of each slot is
+	// estimated by the current node.
+	var memPerSlot uint64
+	if totalMem, err := memory.MemTotal(); err == nil && cpu.GetCPUCount() > 0 {
+		memPerSlot = totalMem / uint64(cpu.GetCPUCount())
+	}
+	rows := make([][]types.Datum, 0, len(usages))
+	for _, usage := range usages {
+		memBudget := memPerSlot * uint64(max(usage.CPUCount, 0))
+		rows = append(rows, types.MakeDatums(
+			usage.ID,              // NODE_ID
+			usage.Role,            // ROLE
+			usage.CPUCount,        // CPU_SLOTS
+			usage.UsedSlots,       // USED_SLOTS
+			memBudget,             // MEMORY_BUDGET
+			usage.RunningSubtasks, // RUNNING_SUBTASKS
+			usage.PendingSubtasks, // PENDING_SUBTASKS
+			usage.RowsPerSecond,   // ROWS_PER_SECOND
+		))
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForTiKVCompactionProgress fills the progress of the TiKV compactions in the current instance.
+func (e *memtableRetriever) setDataForTiKVCompactionProgress(sctx sessionctx.Context) {
+	if !hasPriv(sctx, mysql.ProcessPriv) {
+		return
+	}
+	loc := sctx.GetSessionVars().Location()
+	progresses := GetTiKVCompactionProgresses()
+	rows := make([][]types.Datum, 0, len(progresses))
+	for _, p := range progresses {
+		startTime := types.NewTime(types.FromGoTime(p.StartTime.In(loc)), mysql.TypeDatetime, types.DefaultFsp)
+		var endTime any
+		if !p.EndTime.IsZero() {
+			endTime = types.NewTime(types.FromGoTime(p.EndTime.In(loc)), mysql.TypeDatetime, types.DefaultFsp)
+		}
+		var errMsg any
+		if p.Error != "" {
+			errMsg = p.Error
+		}
+		rows = append(rows, types.MakeDatums(
+			p.ID,              // ID
+			p.DBName,          // TABLE_SCHEMA
+			p.TableName,       // TABLE_NAME
+			p.TableID,         // TABLE_ID
+			p.StoreAddress,    // STORE_ADDRESS
+			p.State,           // STATE
+			p.CompactedRanges, // COMPACTED_RANGES
+			p.TotalRanges,     // TOTAL_RANGES
+			startTime,         // START_TIME
+			endTime,           // END_TIME
+			errMsg,            // ERROR
+		))
+	}
+	e.rows = rows
+}
+
+// setDataForTiDBAccountLocks fills the failed-login tracking and the lock state of the accounts. They're read
+// from mysql.user rather than the privilege cache, since the failed login counts are updated by all the TiDB
+// instances. The users without the SELECT privilege on mysql.user can only see their own accounts.
+func (e *memtableRetriever) setDataForTiDBAccountLocks(ctx context.Context, sctx sessionctx.Context) error {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnPrivilege)
+	chunkRows, _, err := sctx.GetRestrictedSQLExecutor().ExecRestrictedSQL(ctx, nil,
+		`SELECT user, host, account_locked, user_attributes FROM %n.%n`, mysql.SystemDB, mysql.UserTable)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	vars := sctx.GetSessionVars()
+	checker := privilege.GetPrivilegeManager(sctx)
+	showAll := checker == nil || checker.RequestVerification(vars.ActiveRoles, mysql.SystemDB, mysql.UserTable, "", mysql.SelectPriv)
+	loc := vars.Location()
+	now := time.Now()
+	rows := make([][]types.Datum, 0, len(chunkRows))
+	for _, chunkRow := range chunkRows {
+		user, host := chunkRow.GetString(0), chunkRow.GetString(1)
+		if !showAll && (vars.User == nil || user != vars.User.AuthUsername || host != vars.User.AuthHostname) {
+			continue
+		}
+		var passwordLocking privileges.PasswordLocking
+		if !chunkRow.IsNull(3) {
+			if err := passwordLocking.ParseJSON(chunkRow.GetJSON(3)); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		// The account is unlocked automatically when the lock time has passed, which is recorded in
+		// mysql.user on the next login, so the expired locks are shown as unlocked.
+		autoLocked := "N"
+		var lockedTime, unlockTime any
+		if passwordLocking.AutoAccountLocked {
+			lockedAt := time.Unix(passwordLocking.AutoLockedLastChanged, 0)
+			lockedTime = types.NewTime(types.FromGoTime(lockedAt.In(loc)), mysql.TypeDatetime, types.DefaultFsp)
+			if days := passwordLocking.PasswordLockTimeDays; days == -1 {
+				autoLocked = "Y"
+			} else if unlockAt := lockedAt.Add(time.Duration(days) * 24 * time.Hour); unlockAt.After(now) {
+				autoLocked = "Y"
+				unlockTime = types.NewTime(types.FromGoTime(unlockAt.In(loc)), mysql.TypeDatetime, types.DefaultFsp)
+			}
+		}
+		rows = append(rows, types.MakeDatums(
+			user,                                 // USER
+			host,                                 // HOST
+			chunkRow.GetEnum(2).String(),         // ACCOUNT_LOCKED
+			passwordLocking.FailedLoginAttempts,  // FAILED_LOGIN_ATTEMPTS
+			passwordLocking.PasswordLockTimeDays, // PASSWORD_LOCK_TIME
+			passwordLocking.FailedLoginCount,     // FAILED_LOGIN_COUNT
+			autoLocked,                           // AUTO_ACCOUNT_LOCKED
+			lockedTime,                           // AUTO_LOCKED_TIME
+			unlockTime,                           // AUTO_UNLOCK_TIME
+		))
+	}
+	e.rows = rows
+	return nil
+}
+
 func (e *memtableRetriever) setDataFromUserPrivileges(ctx sessionctx.Context) {
 	pm := privilege.GetPrivilegeManager(ctx)
 	// The results depend on the user querying the information.
@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/stringutil"
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/tikv"
@@ -610,3 +611,29 @@ func newGetTiFlashSystemTableRequestMocker(t *testing.T) *getTiFlashSystemTableR
 		t:        t,
 	}
 }
+
+func TestRunningStatementStats(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk2 := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int)")
+	tk.MustExec("insert into t values (1), (2), (3)")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1", "2", "3"))
+
+	// Pretend the statement is still running.
+	pi := *tk.Session().ShowProcess()
+	pi.Command = mysql.ComQuery
+	tk2.Session().SetSessionManager(&testkit.MockSessionManager{PS: []*util.ProcessInfo{&pi}})
+	rows := tk2.MustQuery("select id, operator, act_rows, task from information_schema.tidb_running_statement_stats").Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, fmt.Sprint(pi.ID), rows[0][0])
+	require.Regexp(t, "^TableReader_", rows[0][1])
+	require.Equal(t, "3", rows[0][2])
+	require.Equal(t, "root", rows[0][3])
+	require.Regexp(t, "TableFullScan_", rows[1][1])
+
+	// The idle connections are skipped.
+	pi.Command = mysql.ComSleep
+	tk2.MustQuery("select count(*) from information_schema.tidb_running_statement_stats").Check(testkit.Rows("0"))
+}
@@ -79,14 +79,35 @@ type executorChunkAllocator struct {
 
 // newExecutorChunkAllocator creates a new `executorChunkAllocator`
 func newExecutorChunkAllocator(vars *variable.SessionVars, retFieldTypes []*types.FieldType) executorChunkAllocator {
+	maxChunkSize := adaptiveMaxChunkSize(vars.MaxChunkSize, vars.MaxChunkMemSize, retFieldTypes)
 	return executorChunkAllocator{
 		AllocPool:     vars.GetChunkAllocator(),
-		initCap:       vars.InitChunkSize,
-		maxChunkSize:  vars.MaxChunkSize,
+		initCap:       min(vars.InitChunkSize, maxChunkSize),
+		maxChunkSize:  maxChunkSize,
 		retFieldTypes: retFieldTypes,
 	}
 }
 
+// minAdaptiveChunkSize is the lower bound of the max chunk size reduced by the row width,
+// it keeps the per-chunk overhead of the executors with very wide rows acceptable.
+const minAdaptiveChunkSize = 32
+
+// adaptiveMaxChunkSize reduces the max row count of a chunk, so that the estimated memory size of a chunk
+// with the field types doesn't exceed maxChunkMemSize. It never exceeds maxChunkSize.
+func adaptiveMaxChunkSize(maxChunkSize, maxChunkMemSize int, fieldTypes []*types.FieldType) int {
+	if maxChunkMemSize <= 0 || len(fieldTypes) == 0 {
+		return maxChunkSize
+	}
+	rowWidth := 0
+	for _, fieldType := range fieldTypes {
+		rowWidth += chunk.EstimateTypeWidth(fieldType)
+	}
+	if rowWidth <= 0 {
+		return maxChunkSize
+	}
+	return min(maxChunkSize, max(maxChunkMemSize/rowWidth, minAdaptiveChunkSize))
+}
+
 // InitCap returns the initial capacity for chunk
 func (e *executorChunkAllocator) InitCap() int {
 	return e.initCap
@@ -0,0 +1,56 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exec_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveMaxChunkSize(t *testing.T) {
+	vars := variable.NewSessionVars(nil)
+	narrow := expression.NewSchema(&expression.Column{RetType: types.NewFieldType(mysql.TypeLonglong)})
+	wideCols := make([]*expression.Column, 0, 4)
+	for i := 0; i < 4; i++ {
+		ft := types.NewFieldType(mysql.TypeVarchar)
+		ft.SetFlen(1000)
+		wideCols = append(wideCols, &expression.Column{RetType: ft})
+	}
+	wide := expression.NewSchema(wideCols...)
+	maxChunkSize := func(schema *expression.Schema) int {
+		e := exec.NewBaseExecutorV2(vars, schema, 0)
+		return e.MaxChunkSize()
+	}
+
+	// no limit by default
+	require.Equal(t, variable.DefMaxChunkSize, maxChunkSize(narrow))
+	require.Equal(t, variable.DefMaxChunkSize, maxChunkSize(wide))
+
+	vars.MaxChunkMemSize = 1 << 20
+	require.Equal(t, variable.DefMaxChunkSize, maxChunkSize(narrow))
+	// each varchar(1000) is estimated as 516 bytes
+	require.Equal(t, (1<<20)/(4*516), maxChunkSize(wide))
+
+	vars.MaxChunkMemSize = 1
+	e := exec.NewBaseExecutorV2(vars, wide, 0)
+	require.Equal(t, 32, e.MaxChunkSize())
+	require.Equal(t, variable.DefInitChunkSize, e.InitCap())
+}
@@ -77,12 +77,14 @@ func NewExecutorWithRetry(ctx context.Context, sctx sessionctx.Context, parentTr
 
 	disaggTiFlashWithAutoScaler := config.GetGlobalConfig().DisaggregatedTiFlash && config.GetGlobalConfig().UseAutoScaler
 	_, allowTiFlashFallback := sctx.GetSessionVars().AllowFallbackToTiKV[kv.TiFlash]
+	retryOnNodeFailure := sctx.GetSessionVars().EnableMPPFailureRetry
 
-	// 1. For now, mpp err recovery only support MemLimit, which is only useful when AutoScaler is used.
+	// 1. For now, mpp err recovery supports MemLimit, which is only useful when AutoScaler is used,
+	//    and node failure, which retries the query on the alive TiFlash nodes.
 	// 2. When enable fallback to tikv, the returned mpp err will be ErrTiFlashServerTimeout,
 	//    which we cannot handle for now. Also there is no need to recovery because tikv will retry the query.
 	// 3. For cached table, will not dispatch tasks to TiFlash, so no need to recovery.
-	enableMPPRecovery := disaggTiFlashWithAutoScaler && !allowTiFlashFallback
+	enableMPPRecovery := (disaggTiFlashWithAutoScaler || retryOnNodeFailure) && !allowTiFlashFallback
 
 	failpoint.Inject("mpp_recovery_test_mock_enable", func() {
 		if !allowTiFlashFallback {
@@ -90,7 +92,7 @@ func NewExecutorWithRetry(ctx context.Context, sctx sessionctx.Context, parentTr
 		}
 	})
 
-	recoveryHandler := NewRecoveryHandler(disaggTiFlashWithAutoScaler,
+	recoveryHandler := NewRecoveryHandler(disaggTiFlashWithAutoScaler, retryOnNodeFailure,
 		uint64(holdCap), enableMPPRecovery, parentTracker)
 	memTracker := memory.NewTracker(parentTracker.Label(), 0)
 	memTracker.AttachTo(parentTracker)
@@ -43,11 +43,19 @@ const (
 	memLimitErrPattern = "Memory limit"
 )
 
+// nodeFailureErrPatterns are the patterns of the errors caused by an unavailable TiFlash node,
+// which are reported either by the dispatch/establish rpc or by the exchange between TiFlash nodes.
+var nodeFailureErrPatterns = []string{
+	"code = Unavailable",
+	"connection refused",
+	"connection reset by peer",
+}
+
 // NewRecoveryHandler returns new instance of RecoveryHandler.
-func NewRecoveryHandler(useAutoScaler bool, holderCap uint64, enable bool, parent *memory.Tracker) *RecoveryHandler {
+func NewRecoveryHandler(useAutoScaler, retryOnNodeFailure bool, holderCap uint64, enable bool, parent *memory.Tracker) *RecoveryHandler {
 	return &RecoveryHandler{
 		enable:   enable,
-		handlers: []handlerImpl{newMemLimitHandlerImpl(useAutoScaler)},
+		handlers: []handlerImpl{newMemLimitHandlerImpl(useAutoScaler), newNodeFailureHandlerImpl(retryOnNodeFailure)},
 		holder:   newMPPResultHolder(holderCap, parent),
 		// Default recovery 3 time.
 		maxRecoveryCnt: 3,
@@ -157,6 +165,38 @@ func (*memLimitHandlerImpl) doRecovery(info *RecoveryInfo) error {
 	return nil
 }
 
+var _ handlerImpl = &nodeFailureHandlerImpl{}
+
+type nodeFailureHandlerImpl struct {
+	enable bool
+}
+
+func newNodeFailureHandlerImpl(enable bool) *nodeFailureHandlerImpl {
+	return &nodeFailureHandlerImpl{
+		enable: enable,
+	}
+}
+
+func (h *nodeFailureHandlerImpl) chooseHandlerImpl(mppErr error) bool {
+	if !h.enable {
+		return false
+	}
+	for _, pattern := range nodeFailureErrPatterns {
+		if strings.Contains(mppErr.Error(), pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+func (*nodeFailureHandlerImpl) doRecovery(*RecoveryInfo) error {
+	// Nothing to do here. All the fragments will be cut and scheduled again when
+	// the coordinator is rebuilt, and the failed nodes are detected and excluded
+	// by the MPPFailedStoreProber at that time, so the data is shuffled among the
+	// alive nodes.
+	return nil
+}
+
 type mppResultHolder struct {
 	memTracker *memory.Tracker
 	resps      []*mppResponse
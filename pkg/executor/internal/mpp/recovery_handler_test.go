@@ -0,0 +1,37 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpp
+
+import (
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoveryNodeFailure(t *testing.T) {
+	nodeErr := errors.New("rpc error: code = Unavailable desc = connection error: dial tcp 127.0.0.1:3930: connect: connection refused")
+	otherErr := errors.New("Division by zero")
+
+	handler := NewRecoveryHandler(false, true, 2, true, memory.NewTracker(0, -1))
+	require.NoError(t, handler.Recovery(&RecoveryInfo{MPPErr: nodeErr, NodeCnt: 2}))
+	require.ErrorContains(t, handler.Recovery(&RecoveryInfo{MPPErr: otherErr, NodeCnt: 2}), "no handler to recovery")
+	require.NoError(t, handler.Recovery(&RecoveryInfo{MPPErr: nodeErr, NodeCnt: 2}))
+	require.ErrorContains(t, handler.Recovery(&RecoveryInfo{MPPErr: nodeErr, NodeCnt: 2}), "exceeds max recovery cnt")
+
+	handler = NewRecoveryHandler(false, false, 2, true, memory.NewTracker(0, -1))
+	require.ErrorContains(t, handler.Recovery(&RecoveryInfo{MPPErr: nodeErr, NodeCnt: 2}), "no handler to recovery")
+}
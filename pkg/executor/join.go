@@ -73,6 +73,8 @@ type hashJoinCtx struct {
 	isNullAware        bool
 	memTracker         *memory.Tracker // track memory usage.
 	diskTracker        *disk.Tracker   // track disk usage.
+	// tikvRFs are the runtime filters pushed down to the TiKV reader of the probe side.
+	tikvRFs *tikvRuntimeFilters
 }
 
 // probeSideTupleFetcher reads tuples from probeSideExec and send them to probeWorkers.
@@ -115,6 +117,9 @@ type buildWorker struct {
 	buildSideExec    exec.Executor
 	buildKeyColIdx   []int
 	buildNAKeyColIdx []int
+	// buildSideChunks are the chunks allocated for the build side rows, they're released
+	// to the allocator after the hash table is dropped.
+	buildSideChunks []*chunk.Chunk
 }
 
 // HashJoinExec implements the hash join algorithm.
@@ -177,6 +182,10 @@ func (e *HashJoinExec) Close() error {
 		e.probeSideTupleFetcher.probeChkResourceCh = nil
 		terror.Call(e.rowContainer.Close)
 		e.waiterWg.Wait()
+		for _, chk := range e.buildWorker.buildSideChunks {
+			chunk.Release(e.allocPool, chk)
+		}
+		e.buildWorker.buildSideChunks = nil
 	}
 	e.outerMatchedStatus = e.outerMatchedStatus[:0]
 	for _, w := range e.probeWorkers {
@@ -194,6 +203,9 @@ func (e *HashJoinExec) Close() error {
 	}
 	if e.stats != nil {
 		defer e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl.RegisterStats(e.ID(), e.stats)
+		if e.tikvRFs != nil && e.tikvRFs.stats != nil {
+			defer e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl.RegisterStats(e.ID(), e.tikvRFs.stats)
+		}
 	}
 	err := e.BaseExecutor.Close()
 	return err
@@ -201,6 +213,9 @@ func (e *HashJoinExec) Close() error {
 
 // Open implements the Executor Open interface.
 func (e *HashJoinExec) Open(ctx context.Context) error {
+	if e.tikvRFs != nil {
+		e.tikvRFs.prepare()
+	}
 	if err := e.BaseExecutor.Open(ctx); err != nil {
 		e.closeCh = nil
 		e.prepared = false
@@ -333,6 +348,7 @@ func (w *buildWorker) fetchBuildSideRows(ctx context.Context, chkCh chan<- *chun
 			return
 		}
 		chk := w.hashJoinCtx.allocPool.Alloc(w.buildSideExec.RetFieldTypes(), sessVars.MaxChunkSize, sessVars.MaxChunkSize)
+		w.buildSideChunks = append(w.buildSideChunks, chk)
 		err = exec.Next(ctx, w.buildSideExec, chk)
 		if err != nil {
 			errCh <- errors.Trace(err)
@@ -1182,6 +1198,14 @@ func (e *HashJoinExec) fetchAndBuildHashTable(ctx context.Context) {
 			e.stats.fetchAndBuildHashTable = time.Since(start)
 		}()
 	}
+	var rfConds []expression.Expression
+	if e.tikvRFs != nil {
+		// The TiKV reader of the probe side waits for the runtime filters, so they're always sent,
+		// and no condition is sent if building the hash table fails.
+		defer func() {
+			e.tikvRFs.send(rfConds)
+		}()
+	}
 	// buildSideResultCh transfers build side chunk from build side fetch to build hash table.
 	buildSideResultCh := make(chan *chunk.Chunk, 1)
 	doneCh := make(chan struct{})
@@ -1215,6 +1239,9 @@ func (e *HashJoinExec) fetchAndBuildHashTable(ctx context.Context) {
 			e.buildFinished <- err
 		}
 	}
+	if err == nil && e.tikvRFs != nil && !e.finished.Load() {
+		rfConds = e.tikvRFs.buildConditions(e.Ctx())
+	}
 }
 
 // buildHashTableForList builds hash table from `list`.
@@ -1240,6 +1267,11 @@ func (w *buildWorker) buildHashTableForList(buildSideResultCh <-chan *chunk.Chun
 		if w.hashJoinCtx.finished.Load() {
 			return nil
 		}
+		if w.hashJoinCtx.tikvRFs != nil {
+			if err = w.hashJoinCtx.tikvRFs.collect(w.hashJoinCtx.sessCtx, chk); err != nil {
+				return err
+			}
+		}
 		if !w.hashJoinCtx.useOuterToBuild {
 			err = rowContainer.PutChunk(chk, w.hashJoinCtx.isNullEQ)
 		} else {
@@ -23,7 +23,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -423,9 +425,18 @@ func (e *clusterLogRetriever) initialize(ctx context.Context, sctx sessionctx.Co
 		return nil, errors.New("denied to scan logs, please specified the end time, such as `time < '2020-01-01 00:00:00'`")
 	}
 	patterns := e.extractor.Patterns
-	if len(patterns) == 0 && len(levels) == 0 && len(instances) == 0 && len(nodeTypes) == 0 {
+	if len(patterns) == 0 && len(levels) == 0 && len(instances) == 0 && len(nodeTypes) == 0 &&
+		len(e.extractor.Modules) == 0 && len(e.extractor.JobIDs) == 0 {
 		return nil, errors.New("denied to scan full logs (use `SELECT * FROM cluster_log WHERE message LIKE '%'` explicitly if intentionally)")
 	}
+	// Pre-filter the logs by the module and the job ID in the server side, the results are checked
+	// again after they are received because the patterns only match the message roughly.
+	if len(e.extractor.Modules) > 0 {
+		patterns = append(patterns, buildLogFieldPattern(logModuleKeys, e.extractor.Modules))
+	}
+	if len(e.extractor.JobIDs) > 0 {
+		patterns = append(patterns, buildLogFieldPattern(logJobIDKeys, e.extractor.JobIDs))
+	}
 
 	req := &diagnosticspb.SearchLogRequest{
 		StartTime: e.extractor.StartTime,
@@ -552,14 +563,9 @@ func (e *clusterLogRetriever) retrieve(ctx context.Context, sctx sessionctx.Cont
 	for e.heap.Len() > 0 && len(finalRows) < clusterLogBatchSize {
 		minTimeItem := heap.Pop(e.heap).(logStreamResult)
 		headMessage := minTimeItem.messages[0]
-		loggingTime := time.UnixMilli(headMessage.Time)
-		finalRows = append(finalRows, types.MakeDatums(
-			loggingTime.Format("2006/01/02 15:04:05.000"),
-			minTimeItem.typ,
-			minTimeItem.addr,
-			strings.ToUpper(headMessage.Level.String()),
-			headMessage.Message,
-		))
+		if row, ok := e.buildRow(minTimeItem.typ, minTimeItem.addr, headMessage); ok {
+			finalRows = append(finalRows, row)
+		}
 		minTimeItem.messages = minTimeItem.messages[1:]
 		// Current streaming result is drained, read the next to supply.
 		if len(minTimeItem.messages) == 0 {
@@ -582,6 +588,74 @@ func (e *clusterLogRetriever) retrieve(ctx context.Context, sctx sessionctx.Cont
 	return finalRows, nil
 }
 
+// buildRow builds the row of the log message, it returns false if the log message is filtered out
+// by the module or the job ID.
+func (e *clusterLogRetriever) buildRow(typ, addr string, message *diagnosticspb.LogMessage) ([]types.Datum, bool) {
+	module := parseLogField(logModuleRegexp, message.Message)
+	if len(e.extractor.Modules) > 0 && !e.extractor.Modules.Exist(strings.ToLower(module)) {
+		return nil, false
+	}
+	jobID := parseLogField(logJobIDRegexp, message.Message)
+	if len(e.extractor.JobIDs) > 0 && !e.extractor.JobIDs.Exist(jobID) {
+		return nil, false
+	}
+	loggingTime := time.UnixMilli(message.Time)
+	row := types.MakeDatums(
+		loggingTime.Format("2006/01/02 15:04:05.000"),
+		typ,
+		addr,
+		strings.ToUpper(message.Level.String()),
+		message.Message,
+		nil,
+		nil,
+	)
+	if module != "" {
+		row[5].SetString(module, mysql.DefaultCollationName)
+	}
+	if id, err := strconv.ParseInt(jobID, 10, 64); err == nil {
+		row[6].SetInt64(id)
+	}
+	return row, true
+}
+
+var (
+	// logModuleKeys are the field keys of the module in the log.
+	logModuleKeys = []string{"category"}
+	// logJobIDKeys are the field keys of the DDL job ID in the log.
+	logJobIDKeys = []string{`"job ID"`, `"job id"`, "jobID", "job_id", "job-id"}
+
+	logModuleRegexp = regexp.MustCompile(logFieldKeysPattern(logModuleKeys) + `("[^"]*"|[^\]]*)\]`)
+	logJobIDRegexp  = regexp.MustCompile(logFieldKeysPattern(logJobIDKeys) + `("[^"]*"|[^\]]*)\]`)
+)
+
+func logFieldKeysPattern(keys []string) string {
+	quoted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		quoted = append(quoted, regexp.QuoteMeta(key))
+	}
+	return `\[(?:` + strings.Join(quoted, "|") + `)=`
+}
+
+// buildLogFieldPattern builds the pattern to match the log messages which contain one of the values
+// of the field, e.g. `[category=ddl]` or `[category="gc worker"]`.
+func buildLogFieldPattern(keys []string, values set.StringSet) string {
+	quoted := make([]string, 0, len(values))
+	for value := range values {
+		quoted = append(quoted, regexp.QuoteMeta(value))
+	}
+	slices.Sort(quoted)
+	return `(?i)` + logFieldKeysPattern(keys) + `"?(?:` + strings.Join(quoted, "|") + `)"?\]`
+}
+
+// parseLogField returns the value of the first field matched by re in the log message.
+func parseLogField(re *regexp.Regexp, message string) string {
+	matches := re.FindStringSubmatch(message)
+	if len(matches) < 2 {
+		return ""
+	}
+	return strings.Trim(matches[1], `"`)
+}
+
 func (e *clusterLogRetriever) close() error {
 	if e.cancel != nil {
 		e.cancel()
@@ -985,7 +985,9 @@ func TestTiDBClusterLog(t *testing.T) {
 				row[1],                      // type column
 				testServers[row[1]].address, // instance column
 				strings.ToUpper(sysutil.ParseLogLevel(row[2]).String()), // level column
-				row[3], // message column
+				row[3],  // message column
+				"<nil>", // module column
+				"<nil>", // job_id column
 			}
 			expected = append(expected, strings.Join(expectedRow, " "))
 		}
@@ -25,7 +25,10 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/disk"
+	"github.com/pingcap/tidb/pkg/util/memory"
 )
 
 type dataInfo struct {
@@ -45,7 +48,7 @@ type PipelinedWindowExec struct {
 	end                *core.FrameBound
 	groupChecker       *vecgroupchecker.VecGroupChecker
 
-	// childResult stores the child chunk. Note that even if remaining is 0, e.rows might still references rows in data[0].chk after returned it to upper executor, since there is no guarantee what the upper executor will do to the returned chunk, it might destroy the data (as in the benchmark test, it reused the chunk to pull data, and it will be chk.Reset(), causing panicking). So dataIdx, accumulated and dropped are added to ensure that chunk will only be returned if there is no row reference.
+	// childResult stores the child chunk. Note that even if remaining is 0, e.rowBuffer might still references rows in data[0].chk after returned it to upper executor, since there is no guarantee what the upper executor will do to the returned chunk, it might destroy the data (as in the benchmark test, it reused the chunk to pull data, and it will be chk.Reset(), causing panicking). So dataIdx, accumulated and dropped are added to ensure that chunk will only be returned if there is no row reference.
 	childResult *chunk.Chunk
 	data        []dataInfo
 	dataIdx     int
@@ -68,17 +71,35 @@ type PipelinedWindowExec struct {
 	// expectedCmpResult is used to decide if one value is included in the frame.
 	expectedCmpResult int64
 
-	// rows keeps rows starting from curStartRow
-	rows                     []chunk.Row
+	// rowBuffer buffers the child chunks, the rows in [e.dropped, e.appended) of the child's output are the
+	// rows of the current partition starting from e.rowStart. They're spilled to disk when the memory quota
+	// is exceeded.
+	rowBuffer                *windowRowBuffer
+	appended                 uint64
 	rowCnt                   uint64
 	whole                    bool
 	isRangeFrame             bool
 	emptyFrame               bool
 	initializedSlidingWindow bool
+
+	memTracker  *memory.Tracker
+	diskTracker *disk.Tracker
+	spillAction *windowSpillDiskAction
 }
 
 // Close implements the Executor Close interface.
 func (e *PipelinedWindowExec) Close() error {
+	if e.spillAction != nil {
+		e.spillAction.SetFinished()
+		e.spillAction = nil
+	}
+	if e.rowBuffer != nil {
+		e.rowBuffer.close()
+		e.rowBuffer = nil
+	}
+	if e.memTracker != nil {
+		e.memTracker.ReplaceBytesUsed(0)
+	}
 	return errors.Trace(e.BaseExecutor.Close())
 }
 
@@ -96,7 +117,18 @@ func (e *PipelinedWindowExec) Open(ctx context.Context) (err error) {
 			e.slidingWindowFuncs[i] = slidingWindowAggFunc
 		}
 	}
-	e.rows = make([]chunk.Row, 0)
+	e.appended = 0
+	if e.memTracker == nil {
+		e.memTracker = memory.NewTracker(e.ID(), -1)
+		e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+		e.diskTracker = disk.NewTracker(e.ID(), -1)
+		e.diskTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.DiskTracker)
+	}
+	e.rowBuffer = newWindowRowBuffer(exec.RetTypes(e.Children(0)), e.memTracker, e.diskTracker)
+	if variable.EnableTmpStorageOnOOM.Load() {
+		e.spillAction = &windowSpillDiskAction{buffer: e.rowBuffer}
+		e.Ctx().GetSessionVars().MemTracker.FallbackOldAndSetNewAction(e.spillAction)
+	}
 	return e.BaseExecutor.Open(ctx)
 }
 
@@ -104,7 +136,7 @@ func (e *PipelinedWindowExec) firstResultChunkNotReady() bool {
 	if !e.done && len(e.data) == 0 {
 		return true
 	}
-	// chunk can't be ready unless, 1. all of the rows in the chunk is filled, 2. e.rows doesn't contain rows in the chunk
+	// chunk can't be ready unless, 1. all of the rows in the chunk is filled, 2. the rows in the chunk are all dropped
 	return len(e.data) > 0 && (e.data[0].remaining != 0 || e.data[0].accumulated > e.dropped)
 }
 
@@ -162,6 +194,15 @@ func (e *PipelinedWindowExec) Next(ctx context.Context, chk *chunk.Chunk) (err e
 		}
 	}
 	if len(e.data) > 0 {
+		// the columns of the child are filled when the chunk is returned, so that the chunk can be spilled
+		// before that.
+		childChk, err := e.rowBuffer.popFront()
+		if err != nil {
+			return err
+		}
+		if err = e.copyChk(childChk, e.data[0].chk); err != nil {
+			return err
+		}
 		chk.SwapColumns(e.data[0].chk)
 		e.data = e.data[1:]
 		e.dataIdx--
@@ -171,7 +212,7 @@ func (e *PipelinedWindowExec) Next(ctx context.Context, chk *chunk.Chunk) (err e
 
 func (e *PipelinedWindowExec) getRowsInPartition(ctx context.Context) (err error) {
 	e.newPartition = true
-	if len(e.rows) == 0 {
+	if e.appended == e.dropped {
 		// if getRowsInPartition is called for the first time, we ignore it as a new partition
 		e.newPartition = false
 	}
@@ -198,9 +239,7 @@ func (e *PipelinedWindowExec) getRowsInPartition(ctx context.Context) (err error
 	}
 	begin, end := e.groupChecker.GetNextGroup()
 	e.rowToConsume += uint64(end - begin)
-	for i := begin; i < end; i++ {
-		e.rows = append(e.rows, e.childResult.GetRow(i))
-	}
+	e.appended += uint64(end - begin)
 	return
 }
 
@@ -217,12 +256,11 @@ func (e *PipelinedWindowExec) fetchChild(ctx context.Context) (eof bool, err err
 		return true, nil
 	}
 
-	// TODO: reuse chunks
-	resultChk := e.AllocPool.Alloc(e.RetFieldTypes(), 0, numRows)
-	err = e.copyChk(childResult, resultChk)
-	if err != nil {
+	if err = e.rowBuffer.add(childResult); err != nil {
 		return false, err
 	}
+	// TODO: reuse chunks
+	resultChk := e.AllocPool.Alloc(e.RetFieldTypes(), 0, numRows)
 	e.accumulated += uint64(numRows)
 	e.data = append(e.data, dataInfo{chk: resultChk, remaining: uint64(numRows), accumulated: e.accumulated})
 
@@ -241,11 +279,12 @@ func (e *PipelinedWindowExec) copyChk(src, dst *chunk.Chunk) error {
 }
 
 func (e *PipelinedWindowExec) getRow(i uint64) chunk.Row {
-	return e.rows[i-e.rowStart]
+	return e.rowBuffer.getRow(e.dropped + i - e.rowStart)
 }
 
-func (e *PipelinedWindowExec) getRows(start, end uint64) []chunk.Row {
-	return e.rows[start-e.rowStart : end-e.rowStart]
+// updatePartialResult updates the partial result of the window function with the rows in [start, end).
+func (e *PipelinedWindowExec) updatePartialResult(ctx sessionctx.Context, wf aggfuncs.AggFunc, pr aggfuncs.PartialResult, start, end uint64) error {
+	return e.rowBuffer.updatePartialResult(ctx.GetExprCtx().GetEvalCtx(), wf, pr, e.dropped+start-e.rowStart, e.dropped+end-e.rowStart, start)
 }
 
 // finish is called upon a whole partition is consumed
@@ -277,6 +316,9 @@ func (e *PipelinedWindowExec) getStart(ctx sessionctx.Context) (uint64, error) {
 				break
 			}
 		}
+		if e.rowBuffer.err != nil {
+			return 0, e.rowBuffer.err
+		}
 		e.stagedStartRow = start
 		return start, nil
 	}
@@ -317,6 +359,9 @@ func (e *PipelinedWindowExec) getEnd(ctx sessionctx.Context) (uint64, error) {
 				break
 			}
 		}
+		if e.rowBuffer.err != nil {
+			return 0, e.rowBuffer.err
+		}
 		e.stagedEndRow = end
 		return end, nil
 	}
@@ -385,6 +430,9 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 				if e.lastStartRow != start || e.lastEndRow != end {
 					if slidingWindowAggFunc != nil && e.initializedSlidingWindow {
 						err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), e.getRow, e.lastStartRow, e.lastEndRow, start-e.lastStartRow, end-e.lastEndRow, e.partialResults[i])
+						if err == nil {
+							err = e.rowBuffer.err
+						}
 					} else {
 						// For MinMaxSlidingWindowAggFuncs, it needs the absolute value of each start of window, to compare
 						// whether elements inside deque are out of current window.
@@ -394,7 +442,7 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 						}
 						// TODO(zhifeng): track memory usage here
 						wf.ResetPartialResult(e.partialResults[i])
-						_, err = wf.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), e.getRows(start, end), e.partialResults[i])
+						err = e.updatePartialResult(ctx, wf, e.partialResults[i], start, end)
 					}
 				}
 				if err != nil {
@@ -417,7 +465,6 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 	if extend > e.rowStart {
 		numDrop := extend - e.rowStart
 		e.dropped += numDrop
-		e.rows = e.rows[numDrop:]
 		e.rowStart = extend
 	}
 	return
@@ -452,7 +499,6 @@ func (e *PipelinedWindowExec) reset() {
 	e.whole = false
 	numDrop := e.rowCnt - e.rowStart
 	e.dropped += numDrop
-	e.rows = e.rows[numDrop:]
 	e.rowStart = 0
 	e.rowCnt = 0
 	e.initializedSlidingWindow = false
@@ -157,6 +157,10 @@ type PointGetExecutor struct {
 	// virtualColumnRetFieldTypes records the RetFieldTypes of virtual columns.
 	virtualColumnRetFieldTypes []*types.FieldType
 
+	// prefetched are the values of the keys read from the snapshot by UnionExec in advance, a nil value
+	// means the key doesn't exist.
+	prefetched map[string][]byte
+
 	stats *runtimeStatsWithSnapshot
 }
 
@@ -202,6 +206,7 @@ func (e *PointGetExecutor) Init(p *plannercore.PointGetPlan) {
 	e.idxInfo = p.IndexInfo
 	e.idxVals = p.IndexValues
 	e.done = false
+	e.prefetched = nil
 	if e.tblInfo.TempTableType == model.TempTableNone {
 		e.lock = p.Lock
 		e.lockWaitTime = p.LockWaitTime
@@ -256,6 +261,7 @@ func (e *PointGetExecutor) Close() error {
 		e.indexUsageReporter.ReportPointGetIndexUsage(tableID, physicalTableID, e.idxInfo.ID, e.ID(), kvReqTotal)
 	}
 	e.done = false
+	e.prefetched = nil
 	return nil
 }
 
@@ -543,6 +549,12 @@ func (e *PointGetExecutor) get(ctx context.Context, key kv.Key) ([]byte, error)
 			return val, nil
 		}
 	}
+	if val, ok := e.prefetched[string(key)]; ok {
+		if len(val) == 0 {
+			return nil, kv.ErrNotExist
+		}
+		return val, nil
+	}
 	// if not read lock or table was unlock then snapshot get
 	return e.snapshot.Get(ctx, key)
 }
@@ -412,3 +412,43 @@ func TestGlobalIndexPointGet(t *testing.T) {
 	tk.MustQuery("select * from t partition(p1) use index(idx) where b = 3").Check(testkit.Rows())
 	tk.MustQuery("select * from t partition(p1) use index(idx) where b in (15, 25, 35)").Check(testkit.Rows("15 15 0"))
 }
+
+func TestUnionPointGetBatch(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int, unique key uk(b))")
+	tk.MustExec("create table t2 (a varchar(10) primary key clustered, b int)")
+	tk.MustExec("insert into t values (1, 10), (2, 20)")
+	tk.MustExec("insert into t2 values ('x', 30)")
+
+	query := "select a, b from t where a = 1 union all select a, b from t where b = 20 union all " +
+		"select b, b from t2 where a = 'x' union all select a, b from t where a = 100"
+	pointGetsInfo := func() string {
+		var info strings.Builder
+		for _, row := range tk.MustQuery("explain analyze " + query).Rows() {
+			if strings.Contains(row[0].(string), "Point_Get") {
+				info.WriteString(row[5].(string))
+				info.WriteString("\n")
+			}
+		}
+		return info.String()
+	}
+	tk.MustQuery(query).Sort().Check(testkit.Rows("1 10", "2 20", "30 30"))
+	info := pointGetsInfo()
+	require.Regexp(t, `BatchGet:\{num_rpc:\d+`, info)
+	require.NotContains(t, info, " Get:{")
+
+	// the writes of the transaction are read instead of the prefetched values.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (100, 1000)")
+	tk.MustExec("delete from t where a = 1")
+	tk.MustExec("update t set b = 21 where a = 2")
+	tk.MustQuery(query).Sort().Check(testkit.Rows("100 1000", "30 30"))
+	tk.MustQuery(strings.Replace(query, "b = 20", "b = 21", 1)).Sort().Check(testkit.Rows("100 1000", "2 21", "30 30"))
+	tk.MustExec("rollback")
+
+	tk.MustExec("set @@tidb_enable_union_point_get_batch = 0")
+	tk.MustQuery(query).Sort().Check(testkit.Rows("1 10", "2 20", "30 30"))
+	require.Regexp(t, ` Get:\{num_rpc:\d+`, pointGetsInfo())
+}
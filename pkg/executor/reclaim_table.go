@@ -0,0 +1,122 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/store/helper"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/tikv/client-go/v2/tikv"
+	pd "github.com/tikv/pd/client/http"
+	"go.uber.org/zap"
+)
+
+var _ exec.Executor = &ReclaimTableExec{}
+
+// ReclaimTableExec represents ReclaimTable executor. It reports the region
+// statistics of the tables, asks PD to schedule the regions of the tables with
+// high priority, so the empty regions left by mass deletes or TTL jobs are
+// merged without waiting for the merge checker to scan them, and compacts the
+// key ranges of the tables in all TiKV stores like `ALTER TABLE ... COMPACT
+// TIKV REPLICA`, so the MVCC versions of the deleted rows are cleaned up by the
+// GC compaction filter without waiting for the compactions of RocksDB.
+//
+// The reported statistics are collected before reclaiming. The approximate size
+// is reported by the region heartbeats, so the reclaimed space isn't reported by
+// the statement, it's reflected by the statistics after the next heartbeats.
+type ReclaimTableExec struct {
+	exec.BaseExecutor
+
+	tables []*ast.TableName
+	done   bool
+}
+
+// Next implements the Executor Next interface.
+func (e *ReclaimTableExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+
+	store, ok := e.Ctx().GetStore().(helper.Storage)
+	if !ok {
+		return errors.New("ADMIN RECLAIM TABLE is only supported on TiKV")
+	}
+	pdCli, err := helper.NewHelper(store).TryGetPDHTTPClient()
+	if err != nil {
+		return err
+	}
+	for _, tbl := range e.tables {
+		keyRanges := reclaimKeyRanges(store.GetCodec(), tbl.TableInfo)
+		var regionCnt, emptyRegionCnt, size int64
+		for _, keyRange := range keyRanges {
+			stats, err := pdCli.GetRegionStatusByKeyRange(ctx, keyRange, false)
+			if err != nil {
+				return err
+			}
+			regionCnt += int64(stats.Count)
+			emptyRegionCnt += int64(stats.EmptyCount)
+			size += stats.StorageSize
+		}
+		if err = pdCli.AccelerateScheduleInBatch(ctx, keyRanges); err != nil {
+			return err
+		}
+		compactExec := &CompactTableTiKVExec{
+			BaseExecutor: e.BaseExecutor,
+			dbName:       tbl.DBInfo.Name,
+			tableInfo:    tbl.TableInfo,
+		}
+		// the failures of the compactions are turned into warnings.
+		if err = compactExec.doCompact(ctx); err != nil {
+			return err
+		}
+		logutil.Logger(ctx).Info("reclaim table",
+			zap.String("db", tbl.DBInfo.Name.O), zap.String("table", tbl.Name.O),
+			zap.Int64("regionCount", regionCnt), zap.Int64("emptyRegionCount", emptyRegionCnt),
+			zap.Int64("approximateSizeMB", size))
+		req.AppendString(0, tbl.DBInfo.Name.O)
+		req.AppendString(1, tbl.Name.O)
+		req.AppendInt64(2, regionCnt)
+		req.AppendInt64(3, emptyRegionCnt)
+		req.AppendInt64(4, size)
+	}
+	return nil
+}
+
+// reclaimKeyRanges returns the region key ranges of the physical tables of the
+// table, including the record and index data.
+func reclaimKeyRanges(codec tikv.Codec, tblInfo *model.TableInfo) []*pd.KeyRange {
+	physicalIDs := []int64{tblInfo.ID}
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		physicalIDs = physicalIDs[:0]
+		for _, def := range pi.Definitions {
+			physicalIDs = append(physicalIDs, def.ID)
+		}
+	}
+	keyRanges := make([]*pd.KeyRange, 0, len(physicalIDs))
+	for _, pid := range physicalIDs {
+		startKey, endKey := codec.EncodeRegionRange(tablecodec.EncodeTablePrefix(pid), tablecodec.EncodeTablePrefix(pid+1))
+		keyRanges = append(keyRanges, pd.NewKeyRange(startKey, endKey))
+	}
+	return keyRanges
+}
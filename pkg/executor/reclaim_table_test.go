@@ -0,0 +1,62 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/tikv"
+	pd "github.com/tikv/pd/client/http"
+)
+
+func TestReclaimKeyRanges(t *testing.T) {
+	c := tikv.NewCodecV1(tikv.ModeTxn)
+	expectedRange := func(pid int64) *pd.KeyRange {
+		return pd.NewKeyRange(
+			codec.EncodeBytes(nil, tablecodec.EncodeTablePrefix(pid)),
+			codec.EncodeBytes(nil, tablecodec.EncodeTablePrefix(pid+1)),
+		)
+	}
+
+	tblInfo := &model.TableInfo{ID: 100}
+	require.Equal(t, []*pd.KeyRange{expectedRange(100)}, reclaimKeyRanges(c, tblInfo))
+
+	tblInfo.Partition = &model.PartitionInfo{
+		Enable: true,
+		Definitions: []model.PartitionDefinition{
+			{ID: 101}, {ID: 102},
+		},
+	}
+	require.Equal(t, []*pd.KeyRange{expectedRange(101), expectedRange(102)}, reclaimKeyRanges(c, tblInfo))
+}
+
+func TestTiKVDataKey(t *testing.T) {
+	start, end := tablecodec.EncodeTablePrefix(100), tablecodec.EncodeTablePrefix(101)
+	// the region keys are memcomparable encoded in API v1, they're only prefixed by 'z'.
+	startKey, endKey := tikv.NewCodecV1(tikv.ModeTxn).EncodeRegionRange(start, end)
+	require.Equal(t, append([]byte{'z'}, codec.EncodeBytes(nil, start)...), tikvDataKey(startKey))
+	require.Equal(t, append([]byte{'z'}, codec.EncodeBytes(nil, end)...), tikvDataKey(endKey))
+
+	// the region keys have the keyspace prefix in API v2.
+	c, err := tikv.NewCodecV2(tikv.ModeTxn, 271828)
+	require.NoError(t, err)
+	startKey, endKey = c.EncodeRegionRange(start, end)
+	require.Equal(t, append([]byte{'z'}, codec.EncodeBytes(nil, c.EncodeKey(start))...), tikvDataKey(startKey))
+	require.Equal(t, append([]byte{'z'}, codec.EncodeBytes(nil, c.EncodeKey(end))...), tikvDataKey(endKey))
+}
@@ -1790,3 +1790,24 @@ func TestDivPrecisionIncrement(t *testing.T) {
 	// Test set global.
 	tk.MustExec("set global div_precision_increment = 4")
 }
+
+func TestSetVarHintSQLModeAndTimeZone(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a tinyint, b timestamp)")
+
+	tk.MustExec("set @@sql_mode = 'STRICT_TRANS_TABLES'")
+	tk.MustGetErrCode("insert into t (a) values (1000)", errno.ErrWarnDataOutOfRange)
+	tk.MustExec("insert /*+ SET_VAR(sql_mode='') */ into t (a) values (1000)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1264 Out of range value for column 'a' at row 1"))
+	tk.MustQuery("select @@sql_mode").Check(testkit.Rows("STRICT_TRANS_TABLES"))
+	tk.MustQuery("select a from t").Check(testkit.Rows("127"))
+
+	tk.MustExec("set @@time_zone = '+00:00'")
+	tk.MustExec("insert /*+ SET_VAR(time_zone='+08:00') */ into t (a, b) values (1, '2024-01-01 08:00:00')")
+	tk.MustQuery("select b from t where a = 1").Check(testkit.Rows("2024-01-01 00:00:00"))
+	tk.MustQuery("select /*+ SET_VAR(time_zone='+08:00') */ b from t where a = 1").Check(testkit.Rows("2024-01-01 08:00:00"))
+	require.Empty(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings())
+	tk.MustQuery("select @@time_zone").Check(testkit.Rows("+00:00"))
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/ngaut/pools"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/ddl"
 	"github.com/pingcap/tidb/pkg/ddl/placement"
 	"github.com/pingcap/tidb/pkg/distsql"
 	"github.com/pingcap/tidb/pkg/domain"
@@ -60,6 +61,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/pingcap/tidb/pkg/util/faultinject"
 	"github.com/pingcap/tidb/pkg/util/globalconn"
 	"github.com/pingcap/tidb/pkg/util/hack"
 	"github.com/pingcap/tidb/pkg/util/logutil"
@@ -193,9 +195,15 @@ func (e *SimpleExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 	case *ast.ShutdownStmt:
 		err = e.executeShutdown()
 	case *ast.AdminStmt:
-		err = e.executeAdmin(x)
+		err = e.executeAdmin(ctx, x)
 	case *ast.SetResourceGroupStmt:
-		err = e.executeSetResourceGroupName(x)
+		err = e.executeSetResourceGroupName(ctx, x)
+	case *ast.CreateVariableProfileStmt:
+		err = e.executeCreateVariableProfile(ctx, x)
+	case *ast.SetVariableProfileStmt:
+		err = e.executeSetVariableProfile(ctx, x)
+	case *ast.DropVariableProfileStmt:
+		err = e.executeDropVariableProfile(ctx, x)
 	case *ast.AlterRangeStmt:
 		err = e.executeAlterRange(x)
 	case *ast.DropQueryWatchStmt:
@@ -2717,7 +2725,7 @@ func (e *SimpleExec) executeDropStats(s *ast.DropStatsStmt) (err error) {
 func (e *SimpleExec) autoNewTxn() bool {
 	// Some statements cause an implicit commit
 	// See https://dev.mysql.com/doc/refman/5.7/en/implicit-commit.html
-	switch e.Statement.(type) {
+	switch x := e.Statement.(type) {
 	// Data definition language (DDL) statements that define or modify database objects.
 	// (handled in DDL package)
 	// Statements that implicitly use or modify tables in the mysql database.
@@ -2730,6 +2738,9 @@ func (e *SimpleExec) autoNewTxn() bool {
 	// Administrative statements. TODO: ANALYZE TABLE, CACHE INDEX, CHECK TABLE, FLUSH, LOAD INDEX INTO CACHE, OPTIMIZE TABLE, REPAIR TABLE, RESET (but not RESET PERSIST).
 	case *ast.FlushStmt:
 		return true
+	case *ast.AdminStmt:
+		// ADMIN REORGANIZE INDEX runs a DDL job.
+		return x.Tp == ast.AdminReorganizeIndex
 	}
 	return false
 }
@@ -2784,7 +2795,7 @@ func (e *SimpleExec) executeSetSessionStates(ctx context.Context, s *ast.SetSess
 	return e.Ctx().DecodeSessionStates(ctx, e.Ctx(), &sessionStates)
 }
 
-func (e *SimpleExec) executeAdmin(s *ast.AdminStmt) error {
+func (e *SimpleExec) executeAdmin(ctx context.Context, s *ast.AdminStmt) error {
 	switch s.Tp {
 	case ast.AdminReloadStatistics:
 		return e.executeAdminReloadStatistics(s)
@@ -2794,10 +2805,32 @@ func (e *SimpleExec) executeAdmin(s *ast.AdminStmt) error {
 		return e.executeAdminSetBDRRole(s)
 	case ast.AdminUnsetBDRRole:
 		return e.executeAdminUnsetBDRRole()
+	case ast.AdminInjectFault:
+		return e.executeAdminInjectFault(s)
+	case ast.AdminPinGCSafePoint:
+		return e.executeAdminPinGCSafePoint(ctx, s)
+	case ast.AdminUnpinGCSafePoint:
+		return e.executeAdminUnpinGCSafePoint(ctx)
+	case ast.AdminReorganizeIndex:
+		return e.executeAdminReorganizeIndex(s)
+	case ast.AdminAlterDDLJob:
+		return e.executeAdminAlterDDLJob(s)
 	}
 	return nil
 }
 
+func (e *SimpleExec) executeAdminInjectFault(s *ast.AdminStmt) error {
+	if s.Tp != ast.AdminInjectFault {
+		return errors.New("This AdminStmt is not ADMIN INJECT FAULT")
+	}
+	sessVars := e.Ctx().GetSessionVars()
+	if sessVars.InjectedFaults == nil {
+		sessVars.InjectedFaults = &faultinject.Faults{}
+	}
+	fault := s.FaultInjection
+	return sessVars.InjectedFaults.Inject(fault.Point, fault.Term, time.Duration(fault.TTL)*time.Second)
+}
+
 func (e *SimpleExec) executeAdminReloadStatistics(s *ast.AdminStmt) error {
 	if s.Tp != ast.AdminReloadStatistics {
 		return errors.New("This AdminStmt is not ADMIN RELOAD STATS_EXTENDED")
@@ -2850,21 +2883,183 @@ func (e *SimpleExec) executeAdminUnsetBDRRole() error {
 	return errors.Trace(meta.NewMeta(txn).ClearBDRRole())
 }
 
-func (e *SimpleExec) executeSetResourceGroupName(s *ast.SetResourceGroupStmt) error {
+// maxGCSafePointPinDuration is the max duration of pinning the GC safe point.
+const maxGCSafePointPinDuration = 24 * time.Hour
+
+// gcSafePointPinServiceID is the ID of the service safe point registered by
+// ADMIN PIN GC SAFEPOINT.
+const gcSafePointPinServiceID = "tidb-admin-pin-gc-safepoint"
+
+// executeAdminPinGCSafePoint pins the GC safe point of the cluster at the
+// current timestamp by registering a service safe point with TTL to PD. TiKV
+// has no GC safe point per key range, so the versions of all the tables are
+// retained. The retention is bounded by the duration and can be removed at
+// any time by ADMIN UNPIN GC SAFEPOINT, instead of enlarging tidb_gc_life_time
+// for a few long-running jobs. Pinning again replaces the previous pin.
+func (e *SimpleExec) executeAdminPinGCSafePoint(ctx context.Context, s *ast.AdminStmt) error {
+	pinDuration := time.Duration(s.PinDuration) * time.Second
+	if pinDuration <= 0 || pinDuration > maxGCSafePointPinDuration {
+		return errors.Errorf("the duration of pinning GC safe point should be in (0, %s]", maxGCSafePointPinDuration)
+	}
+	store, ok := e.Ctx().GetStore().(kv.StorageWithPD)
+	if !ok {
+		return errors.New("ADMIN PIN GC SAFEPOINT is only supported on TiKV")
+	}
+	ver, err := e.Ctx().GetStore().CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	pdCli := store.GetPDClient()
+	minSafePoint, err := pdCli.UpdateServiceGCSafePoint(ctx, gcSafePointPinServiceID, int64(s.PinDuration), ver.Ver)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if minSafePoint > ver.Ver {
+		// the versions before minSafePoint may be collected already, remove the pin
+		// instead of leaving a pin which doesn't protect the timestamp.
+		if _, err := pdCli.UpdateServiceGCSafePoint(ctx, gcSafePointPinServiceID, 0, 0); err != nil {
+			logutil.Logger(ctx).Warn("failed to remove the GC safe point pin", zap.Error(err))
+		}
+		return errors.Errorf("GC safe point %d is later than the timestamp %d to pin", minSafePoint, ver.Ver)
+	}
+	e.Ctx().GetSessionVars().StmtCtx.AppendNote(errors.NewNoStackErrorf(
+		"GC safe point of the cluster is pinned at %d for %s", ver.Ver, pinDuration))
+	return nil
+}
+
+func (e *SimpleExec) executeAdminUnpinGCSafePoint(ctx context.Context) error {
+	store, ok := e.Ctx().GetStore().(kv.StorageWithPD)
+	if !ok {
+		return errors.New("ADMIN UNPIN GC SAFEPOINT is only supported on TiKV")
+	}
+	// PD removes the service safe point whose TTL is not positive.
+	_, err := store.GetPDClient().UpdateServiceGCSafePoint(ctx, gcSafePointPinServiceID, 0, 0)
+	return errors.Trace(err)
+}
+
+// executeAdminReorganizeIndex rebuilds the index by a DDL job, the new index takes the place of the old one
+// when it's built, then the old one is dropped.
+func (e *SimpleExec) executeAdminReorganizeIndex(s *ast.AdminStmt) error {
+	defer func() {
+		e.Ctx().GetSessionVars().StmtCtx.IsDDLJobInQueue = false
+		e.Ctx().GetSessionVars().StmtCtx.DDLJobID = 0
+	}()
+	tbl := s.Tables[0]
+	ident := ast.Ident{Schema: tbl.Schema, Name: tbl.Name}
+	return domain.GetDomain(e.Ctx()).DDL().ReorganizeIndex(e.Ctx(), ident, model.NewCIStr(s.Index))
+}
+
+// executeAdminAlterDDLJob changes the reorg parameters of the running DDL jobs, the new parameters are
+// picked up by the reorg workers without restarting the jobs. The priority decides the order the queued
+// jobs are picked, and the local backend resources of the jobs which haven't started ingesting yet.
+func (e *SimpleExec) executeAdminAlterDDLJob(s *ast.AdminStmt) error {
+	var concurrency, batchSize, priority int
+	var maxWriteSpeed int64
+	var setConcurrency, setBatchSize, setMaxWriteSpeed, setPriority bool
+	for _, opt := range s.AlterJobOptions {
+		d, err := expression.EvalSimpleAst(e.Ctx().GetExprCtx(), opt.Value)
+		if err != nil {
+			return err
+		}
+		if d.IsNull() {
+			return errors.Errorf("the value of the option '%s' can't be NULL", opt.Name)
+		}
+		if opt.Name == "priority" {
+			str, err := d.ToString()
+			if err != nil {
+				return err
+			}
+			switch strings.ToLower(str) {
+			case "low":
+				priority = kv.PriorityLow
+			case "normal":
+				priority = kv.PriorityNormal
+			case "high":
+				priority = kv.PriorityHigh
+			default:
+				return errors.Errorf("the value of the option 'priority' should be one of 'low', 'normal' and 'high'")
+			}
+			setPriority = true
+			continue
+		}
+		v, err := d.ToInt64(e.Ctx().GetSessionVars().StmtCtx.TypeCtx())
+		if err != nil {
+			return err
+		}
+		switch opt.Name {
+		case "thread":
+			if v < 1 || v > variable.MaxConfigurableConcurrency {
+				return errors.Errorf("the value of the option 'thread' should be in [1, %d]", variable.MaxConfigurableConcurrency)
+			}
+			concurrency, setConcurrency = int(v), true
+		case "batch_size":
+			if v < int64(variable.MinDDLReorgBatchSize) || v > int64(variable.MaxDDLReorgBatchSize) {
+				return errors.Errorf("the value of the option 'batch_size' should be in [%d, %d]",
+					variable.MinDDLReorgBatchSize, variable.MaxDDLReorgBatchSize)
+			}
+			batchSize, setBatchSize = int(v), true
+		case "max_write_speed":
+			if v < 0 {
+				return errors.Errorf("the value of the option 'max_write_speed' should not be negative")
+			}
+			maxWriteSpeed, setMaxWriteSpeed = v, true
+		default:
+			return errors.Errorf("unsupported option '%s' for admin alter ddl jobs", opt.Name)
+		}
+	}
+	alter := func(job *model.Job) {
+		if setConcurrency {
+			job.ReorgMeta.Concurrency = concurrency
+		}
+		if setBatchSize {
+			job.ReorgMeta.BatchSize = batchSize
+		}
+		if setMaxWriteSpeed {
+			job.ReorgMeta.MaxWriteSpeed = maxWriteSpeed
+		}
+		if setPriority {
+			job.Priority = priority
+		}
+	}
+
+	// Use a global transaction to change the jobs, the same as the other admin commands on DDL jobs.
+	sysSess, err := e.GetSysSession()
+	if err != nil {
+		return err
+	}
+	defer e.ReleaseSysSession(kv.WithInternalSourceType(context.Background(), kv.InternalTxnDDL), sysSess)
+	jobErrs, err := ddl.AlterJobsReorgMeta(sysSess, s.JobIDs, alter)
+	if err != nil {
+		return err
+	}
+	for _, jobErr := range jobErrs {
+		if jobErr != nil {
+			return jobErr
+		}
+	}
+	return nil
+}
+
+func (e *SimpleExec) executeSetResourceGroupName(ctx context.Context, s *ast.SetResourceGroupStmt) error {
 	originalResourceGroup := e.Ctx().GetSessionVars().ResourceGroupName
+	newResourceGroup := resourcegroup.DefaultResourceGroupName
 	if s.Name.L != "" {
 		if _, ok := e.is.ResourceGroupByName(s.Name); !ok {
 			return infoschema.ErrResourceGroupNotExists.GenWithStackByArgs(s.Name.O)
 		}
-		e.Ctx().GetSessionVars().ResourceGroupName = s.Name.L
-	} else {
-		e.Ctx().GetSessionVars().ResourceGroupName = resourcegroup.DefaultResourceGroupName
+		newResourceGroup = s.Name.L
+	}
+	if originalResourceGroup == newResourceGroup {
+		return nil
 	}
-	newResourceGroup := e.Ctx().GetSessionVars().ResourceGroupName
-	if originalResourceGroup != newResourceGroup {
-		metrics.ConnGauge.WithLabelValues(originalResourceGroup).Dec()
-		metrics.ConnGauge.WithLabelValues(newResourceGroup).Inc()
+	// the variable profile bound to the new group is applied before switching, so the session
+	// stays in the original group with its variables unchanged if the profile can't be applied.
+	if err := e.applyResourceGroupVariableProfile(ctx, newResourceGroup); err != nil {
+		return err
 	}
+	e.Ctx().GetSessionVars().ResourceGroupName = newResourceGroup
+	metrics.ConnGauge.WithLabelValues(originalResourceGroup).Dec()
+	metrics.ConnGauge.WithLabelValues(newResourceGroup).Inc()
 	return nil
 }
 
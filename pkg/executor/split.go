@@ -22,7 +22,9 @@ import (
 	"math"
 	"time"
 
+	"github.com/pingcap/errors"
 	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb/pkg/ddl"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -45,6 +47,7 @@ import (
 type SplitIndexRegionExec struct {
 	exec.BaseExecutor
 
+	dbName         model.CIStr
 	tableInfo      *model.TableInfo
 	partitionNames []model.CIStr
 	indexInfo      *model.IndexInfo
@@ -99,7 +102,8 @@ func (e *SplitIndexRegionExec) splitIndexRegion(ctx context.Context) error {
 	start := time.Now()
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, e.Ctx().GetSessionVars().GetSplitRegionTimeout())
 	defer cancel()
-	regionIDs, err := s.SplitRegions(ctxWithTimeout, e.splitIdxKeys, true, &e.tableInfo.ID)
+	splitter := ddl.NewRegionSplitter(s, e.dbName.L, e.tableInfo, true)
+	regionIDs, err := splitter.SplitRegions(ctxWithTimeout, e.splitIdxKeys)
 	if err != nil {
 		logutil.BgLogger().Warn("split table index region failed",
 			zap.String("table", e.tableInfo.Name.L),
@@ -111,7 +115,10 @@ func (e *SplitIndexRegionExec) splitIndexRegion(ctx context.Context) error {
 		return nil
 	}
 
-	if !e.Ctx().GetSessionVars().WaitSplitRegionFinish {
+	if splitter.ScatterDeferred() {
+		appendScatterDeferredWarning(e.Ctx(), e.dbName, e.tableInfo)
+	}
+	if !splitter.Scatter() || !e.Ctx().GetSessionVars().WaitSplitRegionFinish {
 		return nil
 	}
 	e.finishScatterNum = waitScatterRegionFinish(ctxWithTimeout, e.Ctx(), start, s, regionIDs, e.tableInfo.Name.L, e.indexInfo.Name.L)
@@ -325,6 +332,7 @@ func datumSliceToString(ds []types.Datum) string {
 type SplitTableRegionExec struct {
 	exec.BaseExecutor
 
+	dbName         model.CIStr
 	tableInfo      *model.TableInfo
 	partitionNames []model.CIStr
 	lower          []types.Datum
@@ -371,7 +379,8 @@ func (e *SplitTableRegionExec) splitTableRegion(ctx context.Context) error {
 	defer cancel()
 	ctxWithTimeout = kv.WithInternalSourceType(ctxWithTimeout, kv.InternalTxnDDL)
 
-	regionIDs, err := s.SplitRegions(ctxWithTimeout, e.splitKeys, true, &e.tableInfo.ID)
+	splitter := ddl.NewRegionSplitter(s, e.dbName.L, e.tableInfo, true)
+	regionIDs, err := splitter.SplitRegions(ctxWithTimeout, e.splitKeys)
 	if err != nil {
 		logutil.BgLogger().Warn("split table region failed",
 			zap.String("table", e.tableInfo.Name.L),
@@ -382,7 +391,10 @@ func (e *SplitTableRegionExec) splitTableRegion(ctx context.Context) error {
 		return nil
 	}
 
-	if !e.Ctx().GetSessionVars().WaitSplitRegionFinish {
+	if splitter.ScatterDeferred() {
+		appendScatterDeferredWarning(e.Ctx(), e.dbName, e.tableInfo)
+	}
+	if !splitter.Scatter() || !e.Ctx().GetSessionVars().WaitSplitRegionFinish {
 		return nil
 	}
 
@@ -390,6 +402,11 @@ func (e *SplitTableRegionExec) splitTableRegion(ctx context.Context) error {
 	return nil
 }
 
+func appendScatterDeferredWarning(sctx sessionctx.Context, dbName model.CIStr, tblInfo *model.TableInfo) {
+	sctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackErrorf(
+		"the split regions of %s.%s are not scattered out of its maintenance window in tidb_table_region_schedule", dbName.O, tblInfo.Name.O))
+}
+
 func waitScatterRegionFinish(ctxWithTimeout context.Context, sctx sessionctx.Context, startTime time.Time, store kv.SplittableStore, regionIDs []uint64, tableName, indexName string) int {
 	remainMillisecond := 0
 	finishScatterNum := 0
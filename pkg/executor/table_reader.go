@@ -185,6 +185,8 @@ type TableReaderExecutor struct {
 	// If dummy flag is set, this is not a real TableReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
 	dummy bool
+
+	tikvRuntimeFilterReceiver
 }
 
 // Table implements the dataSourceExecutor interface.
@@ -226,6 +228,7 @@ func (e *TableReaderExecutor) Open(ctx context.Context) error {
 	}
 	e.memTracker.AttachTo(e.GetSessionVars().StmtCtx.MemTracker)
 
+	e.resetRuntimeFilter(e.dagPB)
 	var err error
 	if e.corColInFilter {
 		// If there's correlated column in filter, need to rewrite dagPB
@@ -288,6 +291,14 @@ func (e *TableReaderExecutor) Open(ctx context.Context) error {
 		return nil
 	}
 
+	if e.runtimeFilters != nil {
+		e.waitRuntimeFilter = true
+		return nil
+	}
+	return e.openResultHandler(ctx, firstPartRanges, secondPartRanges)
+}
+
+func (e *TableReaderExecutor) openResultHandler(ctx context.Context, firstPartRanges, secondPartRanges []*ranger.Range) error {
 	firstResult, err := e.buildResp(ctx, firstPartRanges)
 	if err != nil {
 		return err
@@ -305,6 +316,16 @@ func (e *TableReaderExecutor) Open(ctx context.Context) error {
 	return nil
 }
 
+// openWithRuntimeFilter waits for the conditions of the runtime filters from the hash join,
+// then pushes them down and sends the requests.
+func (e *TableReaderExecutor) openWithRuntimeFilter(ctx context.Context) error {
+	if err := e.receiveRuntimeFilter(ctx, e.ectx.GetEvalCtx(), e.dctx.Client, e.dagPB, e.GetSessionVars().StmtCtx.RuntimeStatsColl); err != nil {
+		return err
+	}
+	firstPartRanges, secondPartRanges := distsql.SplitRangesAcrossInt64Boundary(e.ranges, e.keepOrder, e.desc, e.table.Meta() != nil && e.table.Meta().IsCommonHandle)
+	return e.openResultHandler(ctx, firstPartRanges, secondPartRanges)
+}
+
 // Next fills data into the chunk passed by its caller.
 // The task was actually done by tableReaderHandler.
 func (e *TableReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error {
@@ -314,6 +335,12 @@ func (e *TableReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 		return nil
 	}
 
+	if e.waitRuntimeFilter {
+		if err := e.openWithRuntimeFilter(ctx); err != nil {
+			return err
+		}
+	}
+
 	logutil.Eventf(ctx, "table scan table: %s, range: %v", stringutil.MemoizeStr(func() string {
 		var tableName string
 		if meta := e.table.Meta(); meta != nil {
@@ -339,6 +366,7 @@ func (e *TableReaderExecutor) Close() error {
 	if e.resultHandler != nil {
 		err = e.resultHandler.Close()
 	}
+	e.closeRuntimeFilter(e.GetSessionVars().StmtCtx.RuntimeStatsColl)
 	e.kvRanges = e.kvRanges[:0]
 	if e.dummy {
 		return nil
@@ -358,7 +386,7 @@ func (e *TableReaderExecutor) buildResp(ctx context.Context, ranges []*ranger.Ra
 			}
 			var results []distsql.SelectResult
 			for _, kvReq := range kvReqs {
-				result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+				result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), e.copPlanIDs(getPhysicalPlanIDs(e.plans)), e.ID())
 				if err != nil {
 					return nil, err
 				}
@@ -371,7 +399,7 @@ func (e *TableReaderExecutor) buildResp(ctx context.Context, ranges []*ranger.Ra
 		if err != nil {
 			return nil, err
 		}
-		result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+		result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), e.copPlanIDs(getPhysicalPlanIDs(e.plans)), e.ID())
 		if err != nil {
 			return nil, err
 		}
@@ -386,7 +414,7 @@ func (e *TableReaderExecutor) buildResp(ctx context.Context, ranges []*ranger.Ra
 		}
 		var results []distsql.SelectResult
 		for _, kvReq := range kvReqs {
-			result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+			result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), e.copPlanIDs(getPhysicalPlanIDs(e.plans)), e.ID())
 			if err != nil {
 				return nil, err
 			}
@@ -407,7 +435,7 @@ func (e *TableReaderExecutor) buildResp(ctx context.Context, ranges []*ranger.Ra
 	})
 	e.kvRanges = kvReq.KeyRanges.AppendSelfTo(e.kvRanges)
 
-	result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+	result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), e.copPlanIDs(getPhysicalPlanIDs(e.plans)), e.ID())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,241 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+// tableWriteThrottleCheckInterval is the interval of checking whether the session is killed when the
+// writes are queued by tidb_table_write_rate_limit.
+const tableWriteThrottleCheckInterval = 100 * time.Millisecond
+
+// tableWriteQueue is a leaky bucket of the writes to a table. A transaction waits until the writes of the
+// former ones have drained at the limit, so the writes to the table are served in order and the write
+// throughput of the table is smoothed to the limit.
+type tableWriteQueue struct {
+	limit variable.TableWriteRateLimit
+	// drainedAt is the time when the queued writes have drained.
+	drainedAt time.Time
+}
+
+// tableWriteThrottler queues the writes to the tables limited by tidb_table_write_rate_limit. The queues are
+// kept in the memory of the instance, each TiDB instance limits the writes it serves on its own.
+type tableWriteThrottler struct {
+	sync.Mutex
+	// queues are keyed by the table ID, the writes to the partitions are queued in their table.
+	queues map[int64]*tableWriteQueue
+}
+
+var tableWriteThrottle = tableWriteThrottler{queues: make(map[int64]*tableWriteQueue)}
+
+// reserve queues n bytes or rows written to the table, and returns how long the writes should wait
+// before they go on.
+func (t *tableWriteThrottler) reserve(tableID int64, limit variable.TableWriteRateLimit, n uint64, now time.Time) time.Duration {
+	t.Lock()
+	defer t.Unlock()
+	// a drained queue is the same as an empty one, so they're removed to keep the map small.
+	for id, q := range t.queues {
+		if !q.drainedAt.After(now) {
+			delete(t.queues, id)
+		}
+	}
+	q, ok := t.queues[tableID]
+	if !ok || q.limit != limit {
+		q = &tableWriteQueue{limit: limit, drainedAt: now}
+		t.queues[tableID] = q
+	}
+	wait := q.drainedAt.Sub(now)
+	q.drainedAt = q.drainedAt.Add(time.Duration(float64(n) / float64(limit.Limit) * float64(time.Second)))
+	return wait
+}
+
+// queued returns how long the writes to the table should wait for the queued ones to drain.
+func (t *tableWriteThrottler) queued(tableID int64, now time.Time) time.Duration {
+	t.Lock()
+	defer t.Unlock()
+	q, ok := t.queues[tableID]
+	if !ok {
+		return 0
+	}
+	return q.drainedAt.Sub(now)
+}
+
+// limitedTables returns the tables limited by tidb_table_write_rate_limit with their limits.
+func limitedTables(is infoschema.InfoSchema) map[int64]limitedTable {
+	limits := variable.TableWriteRateLimits.Load()
+	if limits == nil || len(*limits) == 0 {
+		return nil
+	}
+	tables := make(map[int64]limitedTable, len(*limits))
+	for name, limit := range *limits {
+		schema, table, _ := strings.Cut(name, ".")
+		tbl, err := is.TableByName(model.NewCIStr(schema), model.NewCIStr(table))
+		if err != nil {
+			continue
+		}
+		tables[tbl.Meta().ID] = limitedTable{Table: tbl, limit: limit}
+	}
+	return tables
+}
+
+type limitedTable struct {
+	table.Table
+	limit variable.TableWriteRateLimit
+}
+
+// waitTableWriteQueues waits until the queued writes to the tables written by the pessimistic DML have
+// drained. It's called before the DML is executed, so the waiting transaction doesn't hold the pessimistic
+// locks and block the others. The writes of the transaction are queued without waiting when committing.
+func waitTableWriteQueues(ctx context.Context, sctx sessionctx.Context, p plannercore.Plan) error {
+	var tableIDs []int64
+	switch x := p.(type) {
+	case *plannercore.Insert:
+		tableIDs = append(tableIDs, x.Table.Meta().ID)
+	case *plannercore.Update:
+		for _, info := range x.TblColPosInfos {
+			tableIDs = append(tableIDs, info.TblID)
+		}
+	case *plannercore.Delete:
+		for _, info := range x.TblColPosInfos {
+			tableIDs = append(tableIDs, info.TblID)
+		}
+	}
+	if len(tableIDs) == 0 {
+		return nil
+	}
+	tables := limitedTables(sctx.GetInfoSchema().(infoschema.InfoSchema))
+	now := time.Now()
+	var wait time.Duration
+	for _, id := range tableIDs {
+		if _, ok := tables[id]; ok {
+			wait = max(wait, tableWriteThrottle.queued(id, now))
+		}
+	}
+	return waitTableWrites(ctx, sctx.GetSessionVars(), now, wait)
+}
+
+// ThrottleTableWrites queues the writes of the transaction to the tables limited by tidb_table_write_rate_limit
+// before the transaction is committed. The optimistic transaction waits until its writes can go on. The
+// pessimistic one has waited before its DMLs lock the keys, so it doesn't wait again with the locks held.
+func ThrottleTableWrites(ctx context.Context, sctx sessionctx.Context) error {
+	sessVars := sctx.GetSessionVars()
+	txnCtx := sessVars.TxnCtx
+	deltaMap := txnCtx.TableDeltaMap
+	if len(deltaMap) == 0 {
+		return nil
+	}
+	tables := limitedTables(sctx.GetInfoSchema().(infoschema.InfoSchema))
+	if len(tables) == 0 {
+		return nil
+	}
+	txn, err := sctx.Txn(false)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var wait time.Duration
+	for id, tbl := range tables {
+		physicalIDs := []int64{id}
+		if pi := tbl.Meta().GetPartitionInfo(); pi != nil {
+			for _, def := range pi.Definitions {
+				physicalIDs = append(physicalIDs, def.ID)
+			}
+		}
+		var written uint64
+		for _, pid := range physicalIDs {
+			delta, ok := deltaMap[pid]
+			if !ok {
+				continue
+			}
+			if tbl.limit.ByRows {
+				written += uint64(delta.Count)
+				continue
+			}
+			n, err := writtenBytes(txn, pid)
+			if err != nil {
+				return err
+			}
+			written += n
+		}
+		if written == 0 {
+			continue
+		}
+		wait = max(wait, tableWriteThrottle.reserve(id, tbl.limit, written, now))
+	}
+	if txnCtx.IsPessimistic {
+		return nil
+	}
+	return waitTableWrites(ctx, sessVars, now, wait)
+}
+
+// waitTableWrites waits for the queued writes, and returns early if the session is killed.
+func waitTableWrites(ctx context.Context, sessVars *variable.SessionVars, now time.Time, wait time.Duration) error {
+	if wait <= 0 {
+		return nil
+	}
+	logutil.Logger(ctx).Debug("the writes are queued by tidb_table_write_rate_limit", zap.Duration("wait", wait))
+	deadline := now.Add(wait)
+	for {
+		if err := sessVars.SQLKiller.HandleSignal(); err != nil {
+			return err
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-time.After(min(remaining, tableWriteThrottleCheckInterval)):
+		}
+	}
+}
+
+// writtenBytes returns the size of the mutations of the physical table in the transaction. The mutations
+// of the pipelined transactions are flushed before committing, so they're only limited by rows.
+func writtenBytes(txn kv.Transaction, physicalID int64) (uint64, error) {
+	if txn.IsPipelined() {
+		return 0, nil
+	}
+	iter, err := txn.GetMemBuffer().Iter(tablecodec.EncodeTablePrefix(physicalID), tablecodec.EncodeTablePrefix(physicalID+1))
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer iter.Close()
+	var size uint64
+	for iter.Valid() {
+		size += uint64(len(iter.Key()) + len(iter.Value()))
+		if err := iter.Next(); err != nil {
+			return 0, errors.Trace(err)
+		}
+	}
+	return size, nil
+}
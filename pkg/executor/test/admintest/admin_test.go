@@ -17,6 +17,7 @@ package admintest
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"regexp"
 	"strconv"
@@ -1773,3 +1774,36 @@ func TestAdminCheckTableErrorLocateForClusterIndex(t *testing.T) {
 		tk.MustExec("admin check table admin_test")
 	}
 }
+
+func TestAdminPinGCSafePoint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	// minServiceSafePoint returns the min service safe point registered to PD.
+	pdCli := store.(kv.StorageWithPD).GetPDClient()
+	minServiceSafePoint := func() uint64 {
+		minSafePoint, err := pdCli.UpdateServiceGCSafePoint(context.Background(), "admin-test", 1, math.MaxUint64)
+		require.NoError(t, err)
+		_, err = pdCli.UpdateServiceGCSafePoint(context.Background(), "admin-test", 0, 0)
+		require.NoError(t, err)
+		return minSafePoint
+	}
+
+	tk.MustGetErrMsg("admin pin gc safepoint duration 0",
+		"the duration of pinning GC safe point should be in (0, 24h0m0s]")
+	tk.MustGetErrMsg("admin pin gc safepoint duration 86401",
+		"the duration of pinning GC safe point should be in (0, 24h0m0s]")
+	initialSafePoint := minServiceSafePoint()
+
+	tk.MustExec("admin pin gc safepoint duration 60")
+	require.Len(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings(), 1)
+	firstSafePoint := minServiceSafePoint()
+	require.Less(t, firstSafePoint, initialSafePoint)
+	// pinning again replaces the previous pin.
+	tk.MustExec("admin pin gc safepoint duration 60")
+	secondSafePoint := minServiceSafePoint()
+	require.Greater(t, secondSafePoint, firstSafePoint)
+	require.Less(t, secondSafePoint, initialSafePoint)
+	tk.MustExec("admin unpin gc safepoint")
+	require.Equal(t, initialSafePoint, minServiceSafePoint())
+}
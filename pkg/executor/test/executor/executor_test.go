@@ -2543,6 +2543,15 @@ func TestAdmin(t *testing.T) {
 	// For "checksum_with_index", we have two checksums, so the result will be 1^1 = 0.
 	// For "checksum_without_index", we only have one checksum, so the result will be 1.
 	res.Sort().Check(testkit.Rows("test checksum_with_index 0 2 2", "test checksum_without_index 1 1 1"))
+	tk.MustExec("create table checksum_partition (id int, PRIMARY KEY(id)) partition by hash(id) partitions 4")
+	tk.MustQuery("admin checksum table checksum_partition").Check(testkit.Rows("test checksum_partition 0 4 4"))
+	tk.MustQuery("admin checksum table checksum_partition partition (p0, p1)").Check(testkit.Rows("test checksum_partition 0 2 2"))
+	tk.MustQuery("admin checksum table checksum_partition partition (p2)").Check(testkit.Rows("test checksum_partition 1 1 1"))
+	tk.MustGetErrCode("admin checksum table checksum_partition partition (p4)", mysql.ErrUnknownPartition)
+	tk.MustContainErrMsg("admin checksum table checksum_without_index partition (p0)", "is not a partition table")
+	tk.MustExec("begin")
+	tk.MustContainErrMsg("admin checksum table checksum_without_index as of timestamp now()", "as of timestamp can't be set in transaction")
+	tk.MustExec("rollback")
 
 	tk.MustExec("drop table if exists t1;")
 	tk.MustExec("CREATE TABLE t1 (c2 BOOL, PRIMARY KEY (c2));")
@@ -198,6 +198,25 @@ func TestHashJoin(t *testing.T) {
 	require.Equal(t, "5", outerActRows)
 }
 
+func TestHashJoinWithChunkArena(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b varchar(20))")
+	tk.MustExec("create table t2(a int, b varchar(20))")
+	tk.MustExec("insert into t1 values(1,'a'),(2,'b'),(3,'c')")
+	tk.MustExec("insert into t2 values(1,'x'),(2,'y'),(4,'z')")
+	tk.MustExec("set @@tidb_enable_reuse_chunk=on, @@tidb_chunk_arena_size=1073741824, @@tidb_max_chunk_size=32")
+	// the build side chunks released by the hash join are recycled by the next statements,
+	// the results of the statements must not be affected.
+	for i := 0; i < 3; i++ {
+		tk.MustQuery("select /*+ hash_join_build(t2) */ t1.b, t2.b from t1 join t2 on t1.a = t2.a order by t1.a").
+			Check(testkit.Rows("a x", "b y"))
+		tk.MustQuery("select /*+ hash_join_build(t1) */ t1.b, t2.b from t1 left join t2 on t1.a = t2.a order by t1.a").
+			Check(testkit.Rows("a x", "b y", "c <nil>"))
+	}
+}
+
 func TestOuterTableBuildHashTableIsuse13933(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -783,6 +783,51 @@ func TestFailedLoginTrackingCheckPrivilges(t *testing.T) {
 	tk.MustQuery(`select user()`).Check(testkit.Rows("testu1@localhost"))
 }
 
+func TestFailedLoginTrackingInfoSchema(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("CREATE USER 'u1'@'localhost' IDENTIFIED BY 'u1' FAILED_LOGIN_ATTEMPTS 2 PASSWORD_LOCK_TIME 3")
+	tk.MustExec("CREATE USER 'u2'@'localhost' IDENTIFIED BY 'u2' FAILED_LOGIN_ATTEMPTS 1 PASSWORD_LOCK_TIME UNBOUNDED")
+	tk.MustExec("CREATE USER 'u3'@'localhost' IDENTIFIED BY 'u3' ACCOUNT LOCK")
+	tk.MustExec("CREATE USER 'u4'@'localhost' IDENTIFIED BY 'u4'")
+	sql := "select * from information_schema.tidb_account_locks where user like 'u_' order by user"
+	tk.MustQuery(sql).Check(testkit.Rows(
+		"u1 localhost N 2 3 0 N <nil> <nil>",
+		"u2 localhost N 1 -1 0 N <nil> <nil>",
+		"u3 localhost Y 0 0 0 N <nil> <nil>",
+		"u4 localhost N 0 0 0 N <nil> <nil>",
+	))
+
+	loginFailedAncCheck(t, store, "u1", "localhost", "password", 1, "N")
+	loginFailedAncCheck(t, store, "u2", "localhost", "password", 1, "Y")
+	tk.MustQuery("select user, failed_login_count, auto_account_locked, auto_locked_time is not null, auto_unlock_time " +
+		"from information_schema.tidb_account_locks where user in ('u1', 'u2') order by user").Check(testkit.Rows(
+		"u1 1 N 0 <nil>",
+		"u2 1 Y 1 <nil>",
+	))
+
+	// The account is locked for PASSWORD_LOCK_TIME days.
+	loginFailedAncCheck(t, store, "u1", "localhost", "password", 2, "Y")
+	tk.MustQuery("select failed_login_count, auto_account_locked, timestampdiff(day, auto_locked_time, auto_unlock_time) " +
+		"from information_schema.tidb_account_locks where user = 'u1'").Check(testkit.Rows("2 Y 3"))
+
+	// The expired lock is shown as unlocked.
+	changeAutoLockedLastChanged(tk, "-73h", "u1")
+	tk.MustQuery("select auto_account_locked, auto_unlock_time from information_schema.tidb_account_locks where user = 'u1'").
+		Check(testkit.Rows("N <nil>"))
+
+	tk.MustExec("ALTER USER 'u2'@'localhost' ACCOUNT UNLOCK")
+	tk.MustQuery("select failed_login_count, auto_account_locked from information_schema.tidb_account_locks where user = 'u2'").
+		Check(testkit.Rows("0 N"))
+
+	// The users without the privilege to read mysql.user can only see their own accounts.
+	tk4 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk4.Session().Auth(&auth.UserIdentity{Username: "u4", Hostname: "localhost"}, sha1Password("u4"), nil, nil))
+	tk4.MustQuery("select user, host from information_schema.tidb_account_locks").Check(testkit.Rows("u4 localhost"))
+	tk.MustExec("GRANT SELECT ON mysql.user TO 'u4'@'localhost'")
+	tk4.MustQuery("select count(*) from information_schema.tidb_account_locks where user like 'u_'").Check(testkit.Rows("4"))
+}
+
 func TestUserPassword(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
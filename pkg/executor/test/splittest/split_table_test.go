@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/pkg/ddl"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
@@ -578,6 +579,27 @@ func TestShowTableRegion(t *testing.T) {
 	}
 }
 
+func TestTableRegionSchedule(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	defer tk.MustExec("set global tidb_table_region_schedule = ''")
+
+	// the regions aren't scattered out of the maintenance window.
+	hour := time.Now().Hour()
+	tk.MustExec(fmt.Sprintf("set global tidb_table_region_schedule = 'test.t:window=%02d:00-%02d:00;rate=5'", (hour+2)%24, (hour+3)%24))
+	start := time.Now()
+	tk.MustQuery("split table t between (0) and (100) regions 10").Check(testkit.Rows("9 0"))
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1105 the split regions of test.t are not scattered out of its maintenance window in tidb_table_region_schedule"))
+
+	tk.MustExec(fmt.Sprintf("set global tidb_table_region_schedule = 'test.t:window=%02d:00-%02d:00'", (hour+22)%24, (hour+2)%24))
+	tk.MustQuery("split table t between (100) and (200) regions 2").Check(testkit.Rows("1 1"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+}
+
 func BenchmarkLocateRegion(t *testing.B) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
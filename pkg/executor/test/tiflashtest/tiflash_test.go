@@ -1988,3 +1988,42 @@ func TestIssue50358(t *testing.T) {
 		tk.MustQuery("select 8 from t join t1").Check(testkit.Rows("8", "8"))
 	}
 }
+
+func TestTiKVRuntimeFilterFromTiFlash(t *testing.T) {
+	store := testkit.CreateMockStore(t, withMockTiFlash(1))
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(k1 int)")
+	tk.MustExec("alter table t1 set tiflash replica 1")
+	tb := external.GetTableByName(t, tk, "test", "t1")
+	err := domain.GetDomain(tk.Session()).DDL().UpdateTableReplicaInfo(tk.Session(), tb.Meta().ID, true)
+	require.NoError(t, err)
+	tk.MustExec("insert into t1 values (20), (30)")
+	tk.MustExec("create table t2(k1 int, k2 int, k3 int, key k1(k1), key k1k2(k1, k2))")
+	tk.MustExec("insert into t2 values (10, 1, 1), (20, 2, 2), (30, 3, 3), (40, 4, 4)")
+
+	tk.MustExec("set @@session.tidb_allow_mpp=0")
+	tk.MustExec("set @@session.tidb_allow_tiflash_cop=1")
+	tk.MustExec("set tidb_runtime_filter_mode=LOCAL")
+	tk.MustExec("set tidb_runtime_filter_type='IN,MIN_MAX'")
+	// the hash join in TiDB pushes down the runtime filters built from TiFlash to the index reader and
+	// the index lookup reader of TiKV, the rows with a match in the build side aren't filtered.
+	sql := "select /*+ hash_join_build(t1), read_from_storage(tiflash[t1], tikv[t2]), use_index(t2, k1k2) */ t2.k1, t2.k2 from t1, t2 where t1.k1 = t2.k1"
+	tk.MustQuery("explain " + sql).CheckContain("runtime filter:0[IN] <- test.t1.k1, 1[MIN_MAX] <- test.t1.k1")
+	for i := 0; i < 2; i++ {
+		// the reader is opened again by the second execution.
+		tk.MustQuery(sql + " order by t2.k1").Check(testkit.Rows("20 2", "30 3"))
+	}
+	sql = "select /*+ hash_join_build(t1), read_from_storage(tiflash[t1], tikv[t2]), use_index(t2, k1) */ t2.* from t1, t2 where t1.k1 = t2.k1"
+	tk.MustQuery("explain " + sql).CheckContain("runtime filter:0[IN] <- test.t1.k1, 1[MIN_MAX] <- test.t1.k1")
+	tk.MustQuery(sql + " order by t2.k1").Check(testkit.Rows("20 2 2", "30 3 3"))
+	// the execution info of the hash join shows the rows filtered by the runtime filters in TiKV.
+	rows := tk.MustQuery("explain analyze " + sql).Rows()
+	require.Contains(t, rows[0][5], "rows_filtered:2, 0[IN]:{pushed:1, values:2}, 1[MIN_MAX]:{pushed:1}}")
+	// the build side is empty, all the rows are filtered.
+	tk.MustQuery(sql + " and t1.k1 > 100").Check(testkit.Rows())
+	// the runtime filters are pushed down to the selection above the table scan of the table reader.
+	sql = "select /*+ hash_join_build(t1), read_from_storage(tiflash[t1], tikv[t2]), use_index(t2) */ t2.* from t1, t2 where t1.k1 = t2.k1 and t2.k2 > 1"
+	tk.MustQuery("explain " + sql).CheckContain("runtime filter:0[IN] <- test.t1.k1, 1[MIN_MAX] <- test.t1.k1")
+	tk.MustQuery(sql + " order by t2.k1").Check(testkit.Rows("20 2 2", "30 3 3"))
+}
@@ -0,0 +1,481 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/execdetails"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tipb/go-tipb"
+	"go.uber.org/zap"
+)
+
+// maxTiKVRuntimeFilterInValues is the max number of the values in the IN runtime filter pushed down to TiKV,
+// the IN runtime filter is given up if the build side has more distinct values.
+const maxTiKVRuntimeFilterInValues = 1024
+
+// tikvRuntimeFilters are the runtime filters built by the hash join in TiDB for the TiKV reader
+// of the probe side. The values of the build keys are collected when building the hash table, then they're
+// converted to the conditions and sent to the reader, which pushes them down to TiKV before sending
+// the requests.
+type tikvRuntimeFilters struct {
+	filters []*tikvRuntimeFilter
+	// condsCh is created in every Open of the hash join, exactly one list of conditions is sent to it,
+	// which is nil if building the hash table fails.
+	condsCh chan []expression.Expression
+	// waitTime is the max time the reader waits for the conditions, see tidb_runtime_filter_wait_time_ms.
+	waitTime time.Duration
+	// scanPlanID is the plan ID of the scan which the conditions are applied to. The conditions are pushed down
+	// as a selection right above the scan, selectionPlanID identifies the selection in the execution summaries.
+	// It's negative so it never conflicts with the ID of a plan.
+	scanPlanID      int
+	selectionPlanID int
+	// stats is created in every Open of the hash join, and registered to the hash join when it's closed.
+	stats *tikvRuntimeFilterRuntimeStats
+}
+
+type tikvRuntimeFilter struct {
+	id           int
+	rfType       variable.RuntimeFilterType
+	buildKeyIdx  int
+	buildKeyType *types.FieldType
+	// targetColumn is the column of the scan, the index of it is resolved by the schema of the scan.
+	targetColumn *expression.Column
+
+	hasValue   bool
+	minValue   types.Datum
+	maxValue   types.Datum
+	inValues   []types.Datum
+	inKeys     map[string]struct{}
+	inOverflow bool
+}
+
+// tikvRuntimeFilterTarget is the TiKV reader which the hash join in TiDB pushes down its runtime filters to.
+type tikvRuntimeFilterTarget interface {
+	setRuntimeFilters(rfs *tikvRuntimeFilters)
+}
+
+// buildTiKVRuntimeFilters builds the runtime filters of the hash join in TiDB,
+// it returns nil if the probe side isn't a table reader, an index reader or an index lookup reader of TiKV.
+func buildTiKVRuntimeFilters(planRFs []*plannercore.RuntimeFilter, buildSideExec, probeSideExec exec.Executor,
+	buildKeys []*expression.Column, waitTime time.Duration) *tikvRuntimeFilters {
+	var (
+		reader   tikvRuntimeFilterTarget
+		readerID int
+		scan     plannercore.PhysicalPlan
+	)
+	switch probe := probeSideExec.(type) {
+	case *TableReaderExecutor:
+		if probe.dummy || probe.storeType != kv.TiKV || len(probe.plans) == 0 {
+			return nil
+		}
+		reader, readerID, scan = probe, probe.ID(), probe.plans[0]
+	case *IndexReaderExecutor:
+		if probe.dummy || len(probe.plans) == 0 {
+			return nil
+		}
+		reader, readerID, scan = probe, probe.ID(), probe.plans[0]
+	case *IndexLookUpExecutor:
+		if probe.dummy || len(probe.idxPlans) == 0 {
+			return nil
+		}
+		reader, readerID, scan = probe, probe.ID(), probe.idxPlans[0]
+	default:
+		return nil
+	}
+	buildTypes := exec.RetTypes(buildSideExec)
+	scanSchema := scan.Schema()
+	rfs := &tikvRuntimeFilters{
+		waitTime:        waitTime,
+		scanPlanID:      scan.ID(),
+		selectionPlanID: -readerID,
+	}
+	for _, planRF := range planRFs {
+		srcColumn, targetColumn := planRF.SrcExprList()[0], planRF.TargetExprList()[0]
+		buildKeyIdx, targetIdx := -1, scanSchema.ColumnIndex(targetColumn)
+		for _, buildKey := range buildKeys {
+			if buildKey.UniqueID == srcColumn.UniqueID {
+				buildKeyIdx = buildKey.Index
+				break
+			}
+		}
+		if buildKeyIdx < 0 || targetIdx < 0 {
+			continue
+		}
+		targetColumn = targetColumn.Clone().(*expression.Column)
+		targetColumn.Index = targetIdx
+		rfs.filters = append(rfs.filters, &tikvRuntimeFilter{
+			id:           planRF.ID(),
+			rfType:       planRF.Type(),
+			buildKeyIdx:  buildKeyIdx,
+			buildKeyType: buildTypes[buildKeyIdx],
+			targetColumn: targetColumn,
+		})
+	}
+	if len(rfs.filters) == 0 {
+		return nil
+	}
+	reader.setRuntimeFilters(rfs)
+	return rfs
+}
+
+// prepare resets the collected values and makes the reader wait for the runtime filters.
+// It must be called before opening the reader.
+func (rfs *tikvRuntimeFilters) prepare() {
+	rfs.stats = &tikvRuntimeFilterRuntimeStats{filters: make([]tikvRuntimeFilterStats, 0, len(rfs.filters))}
+	for _, rf := range rfs.filters {
+		rfs.stats.filters = append(rfs.stats.filters, tikvRuntimeFilterStats{id: rf.id, rfType: rf.rfType})
+		rf.hasValue, rf.inOverflow = false, false
+		rf.minValue, rf.maxValue = types.Datum{}, types.Datum{}
+		rf.inValues, rf.inKeys = nil, nil
+		if rf.rfType == variable.In {
+			rf.inKeys = make(map[string]struct{})
+		}
+	}
+	rfs.condsCh = make(chan []expression.Expression, 1)
+}
+
+// collect collects the values of the build keys in the chunk.
+func (rfs *tikvRuntimeFilters) collect(sctx sessionctx.Context, chk *chunk.Chunk) error {
+	start := time.Now()
+	defer func() {
+		rfs.stats.build += time.Since(start)
+	}()
+	tc := sctx.GetSessionVars().StmtCtx.TypeCtx()
+	loc := sctx.GetSessionVars().StmtCtx.TimeZone()
+	for _, rf := range rfs.filters {
+		if rf.rfType == variable.In && rf.inOverflow {
+			continue
+		}
+		for i := 0; i < chk.NumRows(); i++ {
+			d := chk.GetRow(i).GetDatum(rf.buildKeyIdx, rf.buildKeyType)
+			if d.IsNull() {
+				continue
+			}
+			if err := rf.collectValue(tc, loc, d); err != nil {
+				return err
+			}
+			if rf.inOverflow {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (rf *tikvRuntimeFilter) collectValue(tc types.Context, loc *time.Location, d types.Datum) error {
+	if rf.rfType == variable.In {
+		key, err := codec.EncodeKey(loc, nil, d)
+		if err != nil {
+			return err
+		}
+		if _, ok := rf.inKeys[string(key)]; ok {
+			return nil
+		}
+		if len(rf.inValues) >= maxTiKVRuntimeFilterInValues {
+			rf.inOverflow, rf.inValues, rf.inKeys = true, nil, nil
+			return nil
+		}
+		rf.inKeys[string(key)] = struct{}{}
+		rf.inValues = append(rf.inValues, *d.Clone())
+		rf.hasValue = true
+		return nil
+	}
+	if !rf.hasValue {
+		d.Copy(&rf.minValue)
+		d.Copy(&rf.maxValue)
+		rf.hasValue = true
+		return nil
+	}
+	cmp, err := d.Compare(tc, &rf.minValue, collate.GetBinaryCollator())
+	if err != nil {
+		return err
+	}
+	if cmp < 0 {
+		d.Copy(&rf.minValue)
+	}
+	cmp, err = d.Compare(tc, &rf.maxValue, collate.GetBinaryCollator())
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		d.Copy(&rf.maxValue)
+	}
+	return nil
+}
+
+// buildConditions converts the collected values to the conditions of the target columns,
+// only the conditions which can be pushed down to TiKV are returned.
+func (rfs *tikvRuntimeFilters) buildConditions(sctx sessionctx.Context) []expression.Expression {
+	start := time.Now()
+	defer func() {
+		rfs.stats.build += time.Since(start)
+	}()
+	exprCtx := sctx.GetExprCtx()
+	pushDownCtx := expression.NewPushDownContextFromSessionVars(exprCtx.GetEvalCtx(), sctx.GetSessionVars(), sctx.GetClient())
+	conds := make([]expression.Expression, 0, len(rfs.filters))
+	for i, rf := range rfs.filters {
+		if rf.inOverflow {
+			rfs.stats.filters[i].givenUp++
+			continue
+		}
+		// The build side is empty.
+		if !rf.hasValue {
+			continue
+		}
+		cond, err := rf.buildCondition(exprCtx)
+		if err != nil {
+			logutil.BgLogger().Debug("failed to build the TiKV runtime filter", zap.Error(err))
+			continue
+		}
+		if !expression.CanExprsPushDown(pushDownCtx, []expression.Expression{cond}, kv.TiKV) {
+			continue
+		}
+		conds = append(conds, cond)
+		rfs.stats.filters[i].pushed++
+		if rf.rfType == variable.In {
+			rfs.stats.filters[i].values = max(rfs.stats.filters[i].values, len(rf.inValues))
+		}
+	}
+	return conds
+}
+
+func (rf *tikvRuntimeFilter) buildCondition(ctx expression.BuildContext) (expression.Expression, error) {
+	retType := types.NewFieldType(mysql.TypeLonglong)
+	if rf.rfType == variable.In {
+		args := make([]expression.Expression, 0, len(rf.inValues)+1)
+		args = append(args, rf.targetColumn)
+		for _, value := range rf.inValues {
+			args = append(args, &expression.Constant{Value: value, RetType: rf.buildKeyType})
+		}
+		return expression.NewFunction(ctx, ast.In, retType, args...)
+	}
+	minCond, err := expression.NewFunction(ctx, ast.GE, retType, rf.targetColumn, &expression.Constant{Value: rf.minValue, RetType: rf.buildKeyType})
+	if err != nil {
+		return nil, err
+	}
+	maxCond, err := expression.NewFunction(ctx, ast.LE, retType, rf.targetColumn, &expression.Constant{Value: rf.maxValue, RetType: rf.buildKeyType})
+	if err != nil {
+		return nil, err
+	}
+	return expression.NewFunction(ctx, ast.LogicAnd, retType, minCond, maxCond)
+}
+
+// send sends the conditions to the reader, it's called exactly once after building the hash table.
+func (rfs *tikvRuntimeFilters) send(conds []expression.Expression) {
+	rfs.condsCh <- conds
+}
+
+// tikvRuntimeFilterReceiver is embedded in the TiKV readers to receive the conditions of the runtime filters
+// from the hash join in TiDB. The reader doesn't send the requests in Open if the runtime filters are pushed down
+// to it, but in the first Next after the conditions are received or the wait times out.
+type tikvRuntimeFilterReceiver struct {
+	runtimeFilters    *tikvRuntimeFilters
+	waitRuntimeFilter bool
+	// executorsWithoutRF are the executors of the DAG request before the conditions of the runtime filters are pushed down.
+	executorsWithoutRF []*tipb.Executor
+	// countRowsFiltered indicates whether the rows filtered in this execution are not counted yet,
+	// scanRows and selectionRows are the rows returned by the scan and the selection of the runtime filters
+	// in the execution summaries before the requests are sent.
+	countRowsFiltered bool
+	scanRows          int64
+	selectionRows     int64
+}
+
+func (r *tikvRuntimeFilterReceiver) setRuntimeFilters(rfs *tikvRuntimeFilters) {
+	r.runtimeFilters = rfs
+}
+
+// resetRuntimeFilter restores the executors of the DAG request when the reader is opened again,
+// the runtime filters of the last execution are not valid anymore.
+func (r *tikvRuntimeFilterReceiver) resetRuntimeFilter(dagPB *tipb.DAGRequest) {
+	if r.executorsWithoutRF != nil {
+		dagPB.Executors, r.executorsWithoutRF = r.executorsWithoutRF, nil
+	}
+	r.waitRuntimeFilter, r.countRowsFiltered = false, false
+}
+
+// receiveRuntimeFilter waits for the conditions of the runtime filters, then pushes them down as a selection
+// right above the scan of the DAG request. The selection is kept apart from the pushed-down one, so the rows
+// filtered by the runtime filters can be told from the execution summaries. The executors are copied since
+// the original ones are reused by the next execution.
+func (r *tikvRuntimeFilterReceiver) receiveRuntimeFilter(ctx context.Context, evalCtx expression.EvalContext,
+	client kv.Client, dagPB *tipb.DAGRequest, statsColl *execdetails.RuntimeStatsColl) error {
+	r.waitRuntimeFilter = false
+	rfs := r.runtimeFilters
+	var conds []expression.Expression
+	start := time.Now()
+	timer := time.NewTimer(rfs.waitTime)
+	defer timer.Stop()
+	select {
+	case conds = <-rfs.condsCh:
+	case <-timer.C:
+		rfs.stats.timeout++
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	rfs.stats.wait += time.Since(start)
+	if len(conds) == 0 {
+		return nil
+	}
+	condsPB, err := expression.ExpressionsToPBList(evalCtx, conds, client)
+	if err != nil {
+		return err
+	}
+	executors := make([]*tipb.Executor, 0, len(dagPB.Executors)+1)
+	executors = append(executors, dagPB.Executors[0])
+	executors = append(executors, &tipb.Executor{Tp: tipb.ExecType_TypeSelection, Selection: &tipb.Selection{Conditions: condsPB}})
+	executors = append(executors, dagPB.Executors[1:]...)
+	r.executorsWithoutRF, dagPB.Executors = dagPB.Executors, executors
+	if statsColl != nil {
+		r.countRowsFiltered = true
+		r.scanRows = copActRows(statsColl, rfs.scanPlanID)
+		r.selectionRows = copActRows(statsColl, rfs.selectionPlanID)
+	}
+	return nil
+}
+
+// copPlanIDs returns the plan IDs of the executors of the DAG request, the ID of the selection of the runtime
+// filters is inserted if it's pushed down.
+func (r *tikvRuntimeFilterReceiver) copPlanIDs(planIDs []int) []int {
+	if r.executorsWithoutRF == nil {
+		return planIDs
+	}
+	return slices.Insert(slices.Clone(planIDs), 1, r.runtimeFilters.selectionPlanID)
+}
+
+// closeRuntimeFilter counts the rows filtered by the runtime filters in this execution,
+// it's called after all the responses are received.
+func (r *tikvRuntimeFilterReceiver) closeRuntimeFilter(statsColl *execdetails.RuntimeStatsColl) {
+	if !r.countRowsFiltered {
+		return
+	}
+	r.countRowsFiltered = false
+	rfs := r.runtimeFilters
+	scanRows := copActRows(statsColl, rfs.scanPlanID) - r.scanRows
+	selectionRows := copActRows(statsColl, rfs.selectionPlanID) - r.selectionRows
+	rfs.stats.rowsFiltered += scanRows - selectionRows
+}
+
+func copActRows(statsColl *execdetails.RuntimeStatsColl, planID int) int64 {
+	copStats := statsColl.GetCopStats(planID)
+	if copStats == nil {
+		return 0
+	}
+	return copStats.GetActRows()
+}
+
+type tikvRuntimeFilterStats struct {
+	id     int
+	rfType variable.RuntimeFilterType
+	// pushed is the number of the executions which the runtime filter is pushed down in.
+	pushed int
+	// givenUp is the number of the executions which the IN runtime filter is given up in
+	// because the build side has too many distinct values.
+	givenUp int
+	// values is the max number of the values of the IN runtime filter.
+	values int
+}
+
+// tikvRuntimeFilterRuntimeStats is the runtime stats of the runtime filters pushed down to TiKV,
+// it's shown in the execution info of the hash join which builds them.
+type tikvRuntimeFilterRuntimeStats struct {
+	// build is the time spent on collecting the values of the build keys and building the conditions.
+	build time.Duration
+	// wait is the time the reader of the probe side waits for the conditions.
+	wait time.Duration
+	// timeout is the number of the executions which the reader stops waiting for the conditions in.
+	timeout int
+	// rowsFiltered is the number of the rows filtered by the runtime filters in TiKV,
+	// it's only counted when the execution summaries are collected.
+	rowsFiltered int64
+	filters      []tikvRuntimeFilterStats
+}
+
+// Tp implements the RuntimeStats interface.
+func (*tikvRuntimeFilterRuntimeStats) Tp() int {
+	return execdetails.TpTiKVRuntimeFilterRuntimeStats
+}
+
+func (e *tikvRuntimeFilterRuntimeStats) String() string {
+	buf := bytes.NewBuffer(make([]byte, 0, 128))
+	buf.WriteString("runtime_filter:{build:")
+	buf.WriteString(execdetails.FormatDuration(e.build))
+	buf.WriteString(", wait:")
+	buf.WriteString(execdetails.FormatDuration(e.wait))
+	if e.timeout > 0 {
+		buf.WriteString(", timeout:")
+		buf.WriteString(strconv.Itoa(e.timeout))
+	}
+	buf.WriteString(", rows_filtered:")
+	buf.WriteString(strconv.FormatInt(e.rowsFiltered, 10))
+	for _, rf := range e.filters {
+		fmt.Fprintf(buf, ", %d[%s]:{pushed:%d", rf.id, rf.rfType, rf.pushed)
+		if rf.rfType == variable.In {
+			buf.WriteString(", values:")
+			buf.WriteString(strconv.Itoa(rf.values))
+		}
+		if rf.givenUp > 0 {
+			buf.WriteString(", given_up:")
+			buf.WriteString(strconv.Itoa(rf.givenUp))
+		}
+		buf.WriteString("}")
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+func (e *tikvRuntimeFilterRuntimeStats) Clone() execdetails.RuntimeStats {
+	newRs := *e
+	newRs.filters = slices.Clone(e.filters)
+	return &newRs
+}
+
+func (e *tikvRuntimeFilterRuntimeStats) Merge(rs execdetails.RuntimeStats) {
+	tmp, ok := rs.(*tikvRuntimeFilterRuntimeStats)
+	if !ok {
+		return
+	}
+	e.build += tmp.build
+	e.wait += tmp.wait
+	e.timeout += tmp.timeout
+	e.rowsFiltered += tmp.rowsFiltered
+	for i := range e.filters {
+		if i >= len(tmp.filters) {
+			break
+		}
+		e.filters[i].pushed += tmp.filters[i].pushed
+		e.filters[i].givenUp += tmp.filters[i].givenUp
+		e.filters[i].values = max(e.filters[i].values, tmp.filters[i].values)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+// findUnionPointGet returns the point get which produces the rows of the child of UnionExec, e.g. the
+// point get under a projection.
+func findUnionPointGet(e exec.Executor) *PointGetExecutor {
+	for {
+		if pointGet, ok := e.(*PointGetExecutor); ok {
+			return pointGet
+		}
+		if len(e.AllChildren()) != 1 {
+			return nil
+		}
+		e = e.AllChildren()[0]
+	}
+}
+
+// canPrefetch returns whether the KV gets of the point get can be served by the values read by BatchGet
+// from its snapshot in advance. The point gets locking the keys or reading from the local caches aren't
+// prefetched.
+func (e *PointGetExecutor) canPrefetch() bool {
+	if e.lock || e.tblInfo.TempTableType != model.TempTableNone || e.tblInfo.TableCacheStatusType == model.TableCacheStatusEnable {
+		return false
+	}
+	if lock := e.tblInfo.Lock; lock != nil && (lock.Tp == model.TableLockRead || lock.Tp == model.TableLockReadOnly) {
+		return false
+	}
+	if e.txnScope != "" && e.txnScope != kv.GlobalTxnScope {
+		return false
+	}
+	if e.idxInfo == nil {
+		return e.handle != nil
+	}
+	return !e.idxInfo.Global
+}
+
+// prefetchKey returns the first key read by the point get.
+func (e *PointGetExecutor) prefetchKey() (key kv.Key, isIdxKey bool, err error) {
+	tblID := GetPhysID(e.tblInfo, e.partitionDefIdx)
+	if e.idxInfo == nil {
+		return tablecodec.EncodeRowKeyWithHandle(tblID, e.handle), false, nil
+	}
+	if isCommonHandleRead(e.tblInfo, e.idxInfo) {
+		handleBytes, err := plannercore.EncodeUniqueIndexValuesForKey(e.Ctx(), e.tblInfo, e.idxInfo, e.idxVals)
+		if err != nil {
+			return nil, false, err
+		}
+		handle, err := kv.NewCommonHandle(handleBytes)
+		if err != nil {
+			return nil, false, err
+		}
+		return tablecodec.EncodeRowKeyWithHandle(tblID, handle), false, nil
+	}
+	key, err = plannercore.EncodeUniqueIndexKey(e.Ctx(), e.tblInfo, e.idxInfo, e.idxVals, tblID)
+	return key, true, err
+}
+
+// prefetchPointGets coalesces the KV gets of the point gets under UnionExec into a BatchGet request
+// per wave: the row keys and the unique index keys are read by the first one, and the row keys pointed
+// by the unique index keys are read by the second one. The children producing the rows of the point gets
+// are opened before prefetching, so the point gets are set up as usual, e.g. the txn scope is verified
+// and the snapshot is tagged for TopSQL, and the RPCs are recorded in the runtime stats of the first
+// point get. The values are only used by the point gets when the keys aren't found in the memory buffer
+// of the transaction, so the point gets return the same rows as reading the keys one by one. It's
+// best-effort, the point gets read the keys by themselves if the keys can't be prefetched.
+func (e *UnionExec) prefetchPointGets(ctx context.Context) error {
+	childIdxes := make([]int, 0, e.ChildrenLen())
+	pointGets := make([]*PointGetExecutor, 0, e.ChildrenLen())
+	for i, child := range e.AllChildren() {
+		if pointGet := findUnionPointGet(child); pointGet != nil && pointGet.canPrefetch() {
+			childIdxes = append(childIdxes, i)
+			pointGets = append(pointGets, pointGet)
+		}
+	}
+	if len(pointGets) < 2 {
+		return nil
+	}
+	e.openedChildren = make([]bool, e.ChildrenLen())
+	for _, i := range childIdxes {
+		if err := exec.Open(ctx, e.Children(i)); err != nil {
+			return err
+		}
+		e.openedChildren[i] = true
+	}
+
+	keys := make([]kv.Key, 0, len(pointGets))
+	isIdxKeys := make([]bool, 0, len(pointGets))
+	prefetchable := pointGets[:0]
+	for _, pointGet := range pointGets {
+		key, isIdxKey, err := pointGet.prefetchKey()
+		if err != nil {
+			continue
+		}
+		prefetchable = append(prefetchable, pointGet)
+		keys = append(keys, key)
+		isIdxKeys = append(isIdxKeys, isIdxKey)
+	}
+	if len(prefetchable) < 2 {
+		return nil
+	}
+	pointGets = prefetchable
+	// the snapshots of the point gets in a statement are at the same ts.
+	snapshot := pointGets[0].snapshot
+	values, err := snapshot.BatchGet(ctx, keys)
+	if err != nil {
+		logutil.Logger(ctx).Debug("prefetch the point gets under union failed", zap.Error(err))
+		return nil
+	}
+	prefetched := make(map[string][]byte, len(keys)*2)
+	for _, key := range keys {
+		prefetched[string(key)] = values[string(key)]
+	}
+	rowKeys := make([]kv.Key, 0, len(keys))
+	for i, pointGet := range pointGets {
+		val := prefetched[string(keys[i])]
+		if !isIdxKeys[i] || len(val) == 0 {
+			continue
+		}
+		handle, err := tablecodec.DecodeHandleInUniqueIndexValue(val, pointGet.tblInfo.IsCommonHandle)
+		if err != nil {
+			continue
+		}
+		rowKeys = append(rowKeys, tablecodec.EncodeRowKeyWithHandle(GetPhysID(pointGet.tblInfo, pointGet.partitionDefIdx), handle))
+	}
+	if len(rowKeys) > 0 {
+		values, err = snapshot.BatchGet(ctx, rowKeys)
+		if err != nil {
+			logutil.Logger(ctx).Debug("prefetch the rows of the point gets under union failed", zap.Error(err))
+		} else {
+			for _, key := range rowKeys {
+				prefetched[string(key)] = values[string(key)]
+			}
+		}
+	}
+	for _, pointGet := range pointGets {
+		pointGet.prefetched = prefetched
+	}
+	return nil
+}
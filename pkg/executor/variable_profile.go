@@ -0,0 +1,245 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sem"
+	"go.uber.org/zap"
+)
+
+const variableProfilesTable = "tidb_variable_profiles"
+
+// variables which only make sense for the next statement or transaction, they
+// can't be put into a variable profile.
+var variablesNotAllowedInProfile = []string{
+	variable.TiDBSnapshot,
+	variable.TiDBTxnReadTS,
+	variable.TxnIsolationOneShot,
+}
+
+func (e *SimpleExec) executeCreateVariableProfile(ctx context.Context, s *ast.CreateVariableProfileStmt) error {
+	sessionVars := e.Ctx().GetSessionVars()
+	vars := make(map[string]string, len(s.Variables))
+	for _, v := range s.Variables {
+		sysVar := variable.GetSysVar(strings.ToLower(v.Name))
+		if sysVar == nil {
+			return variable.ErrUnknownSystemVar.GenWithStackByArgs(v.Name)
+		}
+		if slices.Contains(variablesNotAllowedInProfile, sysVar.Name) {
+			return errors.Errorf("variable '%s' can't be set in a variable profile", sysVar.Name)
+		}
+		if _, ok := vars[sysVar.Name]; ok {
+			return errors.Errorf("variable '%s' is set more than once in variable profile '%s'", sysVar.Name, s.ProfileName.O)
+		}
+		val, err := evalVariableProfileValue(e.Ctx().GetExprCtx(), sysVar.Name, v.Value)
+		if err != nil {
+			return err
+		}
+		// validate the value with session scope, so the profile can be applied
+		// by SET PROFILE later.
+		val, err = sysVar.Validate(sessionVars, strings.Clone(val), variable.ScopeSession)
+		if err != nil {
+			return err
+		}
+		vars[sysVar.Name] = val
+	}
+	var resourceGroup any
+	if s.ResourceGroupName.L != "" {
+		if _, ok := e.is.ResourceGroupByName(s.ResourceGroupName); !ok {
+			return infoschema.ErrResourceGroupNotExists.GenWithStackByArgs(s.ResourceGroupName.O)
+		}
+		resourceGroup = s.ResourceGroupName.L
+	}
+	content, err := json.Marshal(vars)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	exec := e.Ctx().GetRestrictedSQLExecutor()
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, `SELECT profile_name FROM %n.%n WHERE profile_name = %?`,
+		mysql.SystemDB, variableProfilesTable, s.ProfileName.L)
+	if err != nil {
+		return err
+	}
+	if len(rows) > 0 && !s.OrReplace {
+		err := errors.NewNoStackErrorf("variable profile '%s' already exists", s.ProfileName.O)
+		if s.IfNotExists {
+			sessionVars.StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	if resourceGroup != nil {
+		rows, _, err = exec.ExecRestrictedSQL(ctx, nil, `SELECT profile_name FROM %n.%n WHERE resource_group = %? AND profile_name != %?`,
+			mysql.SystemDB, variableProfilesTable, resourceGroup, s.ProfileName.L)
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			return errors.Errorf("resource group '%s' is already bound to variable profile '%s'",
+				s.ResourceGroupName.O, rows[0].GetString(0))
+		}
+	}
+	_, _, err = exec.ExecRestrictedSQL(ctx, nil, `REPLACE INTO %n.%n (profile_name, resource_group, variables) VALUES (%?, %?, %?)`,
+		mysql.SystemDB, variableProfilesTable, s.ProfileName.L, resourceGroup, string(content))
+	return err
+}
+
+// evalVariableProfileValue evaluates the value of a variable in a variable
+// profile. Like SET, a bare identifier such as OFF is taken as a string.
+func evalVariableProfileValue(ctx expression.BuildContext, name string, expr ast.ExprNode) (string, error) {
+	if cn, ok := expr.(*ast.ColumnNameExpr); ok && cn.Name.Table.L == "" {
+		return cn.Name.Name.O, nil
+	}
+	d, err := expression.EvalSimpleAst(ctx, expr)
+	if err != nil {
+		return "", err
+	}
+	if d.IsNull() {
+		return "", variable.ErrWrongValueForVar.GenWithStackByArgs(name, "NULL")
+	}
+	return d.ToString()
+}
+
+func (e *SimpleExec) executeSetVariableProfile(ctx context.Context, s *ast.SetVariableProfileStmt) error {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	rows, _, err := e.Ctx().GetRestrictedSQLExecutor().ExecRestrictedSQL(ctx, nil,
+		`SELECT variables FROM %n.%n WHERE profile_name = %?`, mysql.SystemDB, variableProfilesTable, s.ProfileName.L)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return errors.Errorf("variable profile '%s' doesn't exist", s.ProfileName.O)
+	}
+	content, err := rows[0].GetJSON(0).MarshalJSON()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return e.applyVariableProfile(content)
+}
+
+func (e *SimpleExec) executeDropVariableProfile(ctx context.Context, s *ast.DropVariableProfileStmt) error {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	exec := e.Ctx().GetRestrictedSQLExecutor()
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, `SELECT profile_name FROM %n.%n WHERE profile_name = %?`,
+		mysql.SystemDB, variableProfilesTable, s.ProfileName.L)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		err := errors.NewNoStackErrorf("variable profile '%s' doesn't exist", s.ProfileName.O)
+		if s.IfExists {
+			e.Ctx().GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	_, _, err = exec.ExecRestrictedSQL(ctx, nil, `DELETE FROM %n.%n WHERE profile_name = %?`,
+		mysql.SystemDB, variableProfilesTable, s.ProfileName.L)
+	return err
+}
+
+// applyResourceGroupVariableProfile applies the variable profile bound to the
+// resource group if there is one.
+func (e *SimpleExec) applyResourceGroupVariableProfile(ctx context.Context, resourceGroup string) error {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	rows, _, err := e.Ctx().GetRestrictedSQLExecutor().ExecRestrictedSQL(ctx, nil,
+		`SELECT variables FROM %n.%n WHERE resource_group = %?`, mysql.SystemDB, variableProfilesTable, resourceGroup)
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+	content, err := rows[0].GetJSON(0).MarshalJSON()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return e.applyVariableProfile(content)
+}
+
+// applyVariableProfile sets the session variables of a variable profile, all
+// of them are checked before setting any of them, and the ones already set
+// are restored if we fail in the middle, so either all of them are set or
+// none of them is.
+func (e *SimpleExec) applyVariableProfile(content []byte) error {
+	vars := make(map[string]string)
+	if err := json.Unmarshal(content, &vars); err != nil {
+		return errors.Trace(err)
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	sessionVars := e.Ctx().GetSessionVars()
+	pm := privilege.GetPrivilegeManager(e.Ctx())
+	toSet := names[:0]
+	for _, name := range names {
+		sysVar := variable.GetSysVar(name)
+		if sysVar == nil {
+			if variable.IsRemovedSysVar(name) {
+				continue
+			}
+			return variable.ErrUnknownSystemVar.GenWithStackByArgs(name)
+		}
+		if sysVar.RequireDynamicPrivileges != nil && pm != nil {
+			semEnabled := sem.IsEnabled()
+			for _, priv := range sysVar.RequireDynamicPrivileges(false, semEnabled) {
+				if !pm.RequestDynamicVerification(sessionVars.ActiveRoles, priv, false) {
+					msg := priv
+					if !semEnabled {
+						msg = "SUPER or " + msg
+					}
+					return plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs(msg)
+				}
+			}
+		}
+		if _, err := sysVar.Validate(sessionVars, vars[name], variable.ScopeSession); err != nil {
+			return err
+		}
+		toSet = append(toSet, name)
+	}
+
+	oldVals := make(map[string]string, len(toSet))
+	for _, name := range toSet {
+		oldVal, err := sessionVars.SetSystemVarWithOldValAsRet(name, vars[name])
+		if err != nil {
+			for n, v := range oldVals {
+				if err2 := sessionVars.SetSystemVarWithoutValidation(n, v); err2 != nil {
+					logutil.BgLogger().Warn("failed to restore session variable when applying variable profile",
+						zap.String("name", n), zap.Error(err2))
+				}
+			}
+			return err
+		}
+		oldVals[name] = oldVal
+	}
+	return nil
+}
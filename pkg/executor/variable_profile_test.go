@@ -0,0 +1,74 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariableProfile(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create variable profile olap set tidb_mem_quota_query = 1073741824, " +
+		"TiDB_Isolation_Read_Engines = 'tidb,tiflash', tidb_opt_agg_push_down = ON")
+	tk.MustQuery("select profile_name, resource_group from mysql.tidb_variable_profiles").Check(testkit.Rows("olap <nil>"))
+
+	tk.MustContainErrMsg("create variable profile olap set tidb_opt_agg_push_down = ON", "variable profile 'olap' already exists")
+	tk.MustExec("create variable profile if not exists olap set tidb_opt_agg_push_down = ON")
+	require.Len(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings(), 1)
+
+	tk.MustContainErrMsg("create variable profile p1 set tidb_opt_agg_push_down = 'abc'",
+		"Variable 'tidb_opt_agg_push_down' can't be set to the value of 'abc'")
+	tk.MustContainErrMsg("create variable profile p1 set tidb_snapshot = '2024-01-01 00:00:00'",
+		"variable 'tidb_snapshot' can't be set in a variable profile")
+	tk.MustContainErrMsg("create variable profile p1 set no_such_variable = 1", "Unknown system variable 'no_such_variable'")
+
+	tk.MustQuery("select @@tidb_opt_agg_push_down").Check(testkit.Rows("0"))
+	tk.MustExec("set profile olap")
+	tk.MustQuery("select @@tidb_mem_quota_query, @@tidb_isolation_read_engines, @@tidb_opt_agg_push_down").
+		Check(testkit.Rows("1073741824 tidb,tiflash 1"))
+	tk.MustContainErrMsg("set profile p1", "variable profile 'p1' doesn't exist")
+
+	// none of the variables is set if any of them is invalid.
+	tk.MustExec(`insert into mysql.tidb_variable_profiles (profile_name, variables) values
+		('broken', '{"tidb_opt_agg_push_down": "OFF", "tidb_mem_quota_query": "abc"}')`)
+	require.Error(t, tk.ExecToErr("set profile broken"))
+	tk.MustQuery("select @@tidb_mem_quota_query, @@tidb_opt_agg_push_down").Check(testkit.Rows("1073741824 1"))
+
+	// the profile bound to a resource group is applied when switching to it.
+	tk.MustExec("create resource group rg1 RU_PER_SEC=1000")
+	tk.MustExec("create variable profile oltp for resource group rg1 set tidb_opt_agg_push_down = OFF")
+	tk.MustExec("set resource group rg1")
+	tk.MustQuery("select @@tidb_mem_quota_query, @@tidb_opt_agg_push_down").Check(testkit.Rows("1073741824 0"))
+	tk.MustContainErrMsg("create variable profile oltp2 for resource group rg1 set tidb_opt_agg_push_down = OFF",
+		"resource group 'rg1' is already bound to variable profile 'oltp'")
+	tk.MustContainErrMsg("create variable profile oltp2 for resource group rg2 set tidb_opt_agg_push_down = OFF",
+		"Unknown resource group 'rg2'")
+
+	// the profile isn't applied anymore after it's dropped.
+	tk.MustExec("drop variable profile oltp")
+	tk.MustExec("set tidb_opt_agg_push_down = ON")
+	tk.MustExec("set resource group default")
+	tk.MustExec("set resource group rg1")
+	tk.MustQuery("select @@tidb_opt_agg_push_down").Check(testkit.Rows("1"))
+	tk.MustContainErrMsg("set profile oltp", "variable profile 'oltp' doesn't exist")
+	tk.MustContainErrMsg("drop variable profile oltp", "variable profile 'oltp' doesn't exist")
+	tk.MustExec("drop variable profile if exists oltp")
+	require.Len(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings(), 1)
+	tk.MustQuery("select profile_name from mysql.tidb_variable_profiles order by profile_name").Check(testkit.Rows("broken", "olap"))
+}
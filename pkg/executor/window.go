@@ -25,7 +25,10 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/disk"
+	"github.com/pingcap/tidb/pkg/util/memory"
 )
 
 // WindowExec is the executor for window functions.
@@ -44,10 +47,45 @@ type WindowExec struct {
 
 	numWindowFuncs int
 	processor      windowProcessor
+
+	// rowBuffer buffers the child chunks until the rows of them are returned, since the rows of a partition
+	// are only processed after the whole partition is fetched. They're spilled to disk when the memory quota
+	// is exceeded.
+	rowBuffer   *windowRowBuffer
+	memTracker  *memory.Tracker
+	diskTracker *disk.Tracker
+	spillAction *windowSpillDiskAction
+}
+
+// Open implements the Executor Open interface.
+func (e *WindowExec) Open(ctx context.Context) error {
+	if e.memTracker == nil {
+		e.memTracker = memory.NewTracker(e.ID(), -1)
+		e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+		e.diskTracker = disk.NewTracker(e.ID(), -1)
+		e.diskTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.DiskTracker)
+	}
+	e.rowBuffer = newWindowRowBuffer(exec.RetTypes(e.Children(0)), e.memTracker, e.diskTracker)
+	if variable.EnableTmpStorageOnOOM.Load() {
+		e.spillAction = &windowSpillDiskAction{buffer: e.rowBuffer}
+		e.Ctx().GetSessionVars().MemTracker.FallbackOldAndSetNewAction(e.spillAction)
+	}
+	return e.BaseExecutor.Open(ctx)
 }
 
 // Close implements the Executor Close interface.
 func (e *WindowExec) Close() error {
+	if e.spillAction != nil {
+		e.spillAction.SetFinished()
+		e.spillAction = nil
+	}
+	if e.rowBuffer != nil {
+		e.rowBuffer.close()
+		e.rowBuffer = nil
+	}
+	if e.memTracker != nil {
+		e.memTracker.ReplaceBytesUsed(0)
+	}
 	return errors.Trace(e.BaseExecutor.Close())
 }
 
@@ -62,6 +100,15 @@ func (e *WindowExec) Next(ctx context.Context, chk *chunk.Chunk) error {
 		}
 	}
 	if len(e.resultChunks) > 0 {
+		// the columns of the child are filled when the chunk is returned, so that the chunk can be spilled
+		// before that.
+		childChk, err := e.rowBuffer.popFront()
+		if err != nil {
+			return err
+		}
+		if err = e.copyChk(childChk, e.resultChunks[0]); err != nil {
+			return err
+		}
 		chk.SwapColumns(e.resultChunks[0])
 		e.resultChunks[0] = nil // GC it. TODO: Reuse it.
 		e.resultChunks = e.resultChunks[1:]
@@ -75,7 +122,6 @@ func (e *WindowExec) preparedChunkAvailable() bool {
 }
 
 func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
-	var groupRows []chunk.Row
 	if e.groupChecker.IsExhausted() {
 		eof, err := e.fetchChild(ctx)
 		if err != nil {
@@ -83,7 +129,7 @@ func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
 		}
 		if eof {
 			e.executed = true
-			return e.consumeGroupRows(groupRows)
+			return nil
 		}
 		_, err = e.groupChecker.SplitIntoGroups(e.childResult)
 		if err != nil {
@@ -91,9 +137,9 @@ func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
 		}
 	}
 	begin, end := e.groupChecker.GetNextGroup()
-	for i := begin; i < end; i++ {
-		groupRows = append(groupRows, e.childResult.GetRow(i))
-	}
+	// the rows of e.childResult are the last rows in e.rowBuffer.
+	childBegin := e.rowBuffer.numRows - uint64(e.childResult.NumRows())
+	groupRows := windowRows{buffer: e.rowBuffer, begin: childBegin + uint64(begin), end: childBegin + uint64(end)}
 
 	for meetLastGroup := end == e.childResult.NumRows(); meetLastGroup; {
 		meetLastGroup = false
@@ -113,17 +159,15 @@ func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
 
 		if isFirstGroupSameAsPrev {
 			begin, end = e.groupChecker.GetNextGroup()
-			for i := begin; i < end; i++ {
-				groupRows = append(groupRows, e.childResult.GetRow(i))
-			}
+			groupRows.end += uint64(end - begin)
 			meetLastGroup = end == e.childResult.NumRows()
 		}
 	}
 	return e.consumeGroupRows(groupRows)
 }
 
-func (e *WindowExec) consumeGroupRows(groupRows []chunk.Row) (err error) {
-	remainingRowsInGroup := len(groupRows)
+func (e *WindowExec) consumeGroupRows(groupRows windowRows) (err error) {
+	remainingRowsInGroup := int(groupRows.numRows())
 	if remainingRowsInGroup == 0 {
 		return nil
 	}
@@ -163,11 +207,10 @@ func (e *WindowExec) fetchChild(ctx context.Context) (eof bool, err error) {
 		return true, nil
 	}
 
-	resultChk := e.AllocPool.Alloc(e.RetFieldTypes(), 0, numRows)
-	err = e.copyChk(childResult, resultChk)
-	if err != nil {
+	if err = e.rowBuffer.add(childResult); err != nil {
 		return false, err
 	}
+	resultChk := e.AllocPool.Alloc(e.RetFieldTypes(), 0, numRows)
 	e.resultChunks = append(e.resultChunks, resultChk)
 	e.remainingRowsInChunk = append(e.remainingRowsInChunk, numRows)
 
@@ -189,10 +232,10 @@ func (e *WindowExec) copyChk(src, dst *chunk.Chunk) error {
 type windowProcessor interface {
 	// consumeGroupRows updates the result for an window function using the input rows
 	// which belong to the same partition.
-	consumeGroupRows(ctx sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error)
+	consumeGroupRows(ctx sessionctx.Context, rows windowRows) (windowRows, error)
 	// appendResult2Chunk appends the final results to chunk.
 	// It is called when there are no more rows in current partition.
-	appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error)
+	appendResult2Chunk(ctx sessionctx.Context, rows windowRows, chk *chunk.Chunk, remained int) (windowRows, error)
 	// resetPartialResult resets the partial result to the original state for a specific window function.
 	resetPartialResult()
 }
@@ -202,25 +245,25 @@ type aggWindowProcessor struct {
 	partialResults []aggfuncs.PartialResult
 }
 
-func (p *aggWindowProcessor) consumeGroupRows(ctx sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
+func (p *aggWindowProcessor) consumeGroupRows(ctx sessionctx.Context, rows windowRows) (windowRows, error) {
 	for i, windowFunc := range p.windowFuncs {
 		// @todo Add memory trace
-		_, err := windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), rows, p.partialResults[i])
+		err := rows.updatePartialResult(ctx.GetExprCtx().GetEvalCtx(), windowFunc, p.partialResults[i], 0, rows.numRows())
 		if err != nil {
-			return nil, err
+			return rows, err
 		}
 	}
-	rows = rows[:0]
+	rows.begin = rows.end
 	return rows, nil
 }
 
-func (p *aggWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
+func (p *aggWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows windowRows, chk *chunk.Chunk, remained int) (windowRows, error) {
 	for remained > 0 {
 		for i, windowFunc := range p.windowFuncs {
 			// TODO: We can extend the agg func interface to avoid the `for` loop  here.
 			err := windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return rows, err
 			}
 		}
 		remained--
@@ -288,12 +331,12 @@ func (p *rowFrameWindowProcessor) getEndOffset(numRows uint64) uint64 {
 	return 0
 }
 
-func (*rowFrameWindowProcessor) consumeGroupRows(_ sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
+func (*rowFrameWindowProcessor) consumeGroupRows(_ sessionctx.Context, rows windowRows) (windowRows, error) {
 	return rows, nil
 }
 
-func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
-	numRows := uint64(len(rows))
+func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows windowRows, chk *chunk.Chunk, remained int) (windowRows, error) {
+	numRows := rows.numRows()
 	var (
 		err                      error
 		initializedSlidingWindow bool
@@ -321,16 +364,17 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 			for i, windowFunc := range p.windowFuncs {
 				slidingWindowAggFunc := slidingWindowAggFuncs[i]
 				if slidingWindowAggFunc != nil && initializedSlidingWindow {
-					err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
-						return rows[u]
-					}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+					err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), rows.getRow, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+					if err == nil {
+						err = rows.err()
+					}
 					if err != nil {
-						return nil, err
+						return rows, err
 					}
 				}
 				err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 				if err != nil {
-					return nil, err
+					return rows, err
 				}
 			}
 			continue
@@ -339,9 +383,10 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 		for i, windowFunc := range p.windowFuncs {
 			slidingWindowAggFunc := slidingWindowAggFuncs[i]
 			if slidingWindowAggFunc != nil && initializedSlidingWindow {
-				err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
-					return rows[u]
-				}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+				err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), rows.getRow, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+				if err == nil {
+					err = rows.err()
+				}
 			} else {
 				// For MinMaxSlidingWindowAggFuncs, it needs the absolute value of each start of window, to compare
 				// whether elements inside deque are out of current window.
@@ -349,14 +394,14 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 					// Store start inside MaxMinSlidingWindowAggFunc.windowInfo
 					minMaxSlidingWindowAggFunc.SetWindowStart(start)
 				}
-				_, err = windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), rows[start:end], p.partialResults[i])
+				err = rows.updatePartialResult(ctx.GetExprCtx().GetEvalCtx(), windowFunc, p.partialResults[i], start, end)
 			}
 			if err != nil {
-				return nil, err
+				return rows, err
 			}
 			err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return rows, err
 			}
 			if slidingWindowAggFunc == nil {
 				windowFunc.ResetPartialResult(p.partialResults[i])
@@ -389,16 +434,16 @@ type rangeFrameWindowProcessor struct {
 	expectedCmpResult int64
 }
 
-func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows []chunk.Row) (uint64, error) {
+func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows windowRows) (uint64, error) {
 	if p.start.UnBounded {
 		return 0, nil
 	}
-	numRows := uint64(len(rows))
+	numRows := rows.numRows()
 	for ; p.lastStartOffset < numRows; p.lastStartOffset++ {
 		var res int64
 		var err error
 		for i := range p.orderByCols {
-			res, _, err = p.start.CmpFuncs[i](ctx.GetExprCtx().GetEvalCtx(), p.start.CompareCols[i], p.start.CalcFuncs[i], rows[p.lastStartOffset], rows[p.curRowIdx])
+			res, _, err = p.start.CmpFuncs[i](ctx.GetExprCtx().GetEvalCtx(), p.start.CompareCols[i], p.start.CalcFuncs[i], rows.getRow(p.lastStartOffset), rows.getRow(p.curRowIdx))
 			if err != nil {
 				return 0, err
 			}
@@ -412,11 +457,11 @@ func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows
 			break
 		}
 	}
-	return p.lastStartOffset, nil
+	return p.lastStartOffset, rows.err()
 }
 
-func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows []chunk.Row) (uint64, error) {
-	numRows := uint64(len(rows))
+func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows windowRows) (uint64, error) {
+	numRows := rows.numRows()
 	if p.end.UnBounded {
 		return numRows, nil
 	}
@@ -424,7 +469,7 @@ func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows []
 		var res int64
 		var err error
 		for i := range p.orderByCols {
-			res, _, err = p.end.CmpFuncs[i](ctx.GetExprCtx().GetEvalCtx(), p.end.CalcFuncs[i], p.end.CompareCols[i], rows[p.curRowIdx], rows[p.lastEndOffset])
+			res, _, err = p.end.CmpFuncs[i](ctx.GetExprCtx().GetEvalCtx(), p.end.CalcFuncs[i], p.end.CompareCols[i], rows.getRow(p.curRowIdx), rows.getRow(p.lastEndOffset))
 			if err != nil {
 				return 0, err
 			}
@@ -438,10 +483,10 @@ func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows []
 			break
 		}
 	}
-	return p.lastEndOffset, nil
+	return p.lastEndOffset, rows.err()
 }
 
-func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
+func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows windowRows, chk *chunk.Chunk, remained int) (windowRows, error) {
 	var (
 		err                      error
 		initializedSlidingWindow bool
@@ -461,11 +506,11 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 	for ; remained > 0; lastStart, lastEnd = start, end {
 		start, err = p.getStartOffset(ctx, rows)
 		if err != nil {
-			return nil, err
+			return rows, err
 		}
 		end, err = p.getEndOffset(ctx, rows)
 		if err != nil {
-			return nil, err
+			return rows, err
 		}
 		p.curRowIdx++
 		remained--
@@ -475,16 +520,17 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 			for i, windowFunc := range p.windowFuncs {
 				slidingWindowAggFunc := slidingWindowAggFuncs[i]
 				if slidingWindowAggFunc != nil && initializedSlidingWindow {
-					err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
-						return rows[u]
-					}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+					err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), rows.getRow, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+					if err == nil {
+						err = rows.err()
+					}
 					if err != nil {
-						return nil, err
+						return rows, err
 					}
 				}
 				err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 				if err != nil {
-					return nil, err
+					return rows, err
 				}
 			}
 			continue
@@ -493,21 +539,22 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 		for i, windowFunc := range p.windowFuncs {
 			slidingWindowAggFunc := slidingWindowAggFuncs[i]
 			if slidingWindowAggFunc != nil && initializedSlidingWindow {
-				err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
-					return rows[u]
-				}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+				err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), rows.getRow, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+				if err == nil {
+					err = rows.err()
+				}
 			} else {
 				if minMaxSlidingWindowAggFunc, ok := windowFunc.(aggfuncs.MaxMinSlidingWindowAggFunc); ok {
 					minMaxSlidingWindowAggFunc.SetWindowStart(start)
 				}
-				_, err = windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), rows[start:end], p.partialResults[i])
+				err = rows.updatePartialResult(ctx.GetExprCtx().GetEvalCtx(), windowFunc, p.partialResults[i], start, end)
 			}
 			if err != nil {
-				return nil, err
+				return rows, err
 			}
 			err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return rows, err
 			}
 			if slidingWindowAggFunc == nil {
 				windowFunc.ResetPartialResult(p.partialResults[i])
@@ -523,7 +570,7 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 	return rows, nil
 }
 
-func (*rangeFrameWindowProcessor) consumeGroupRows(_ sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
+func (*rangeFrameWindowProcessor) consumeGroupRows(_ sessionctx.Context, rows windowRows) (windowRows, error) {
 	return rows, nil
 }
 
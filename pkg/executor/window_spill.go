@@ -0,0 +1,303 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/pingcap/tidb/pkg/executor/aggfuncs"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/disk"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"go.uber.org/zap"
+)
+
+// windowSpilledChunkCacheSize is the number of the spilled chunks cached in memory after they're read back.
+// The frame of a window function is accessed by a few cursors, e.g. the start, the end and the current row,
+// so a small cache is enough to avoid reading the same chunk from disk repeatedly.
+const windowSpilledChunkCacheSize = 4
+
+type cachedWindowChunk struct {
+	idx int
+	chk *chunk.Chunk
+}
+
+// windowRowBuffer buffers the child chunks of the window executors until all their rows have been produced.
+// The rows are addressed by their indexes in the output of the child executor. When the memory quota is
+// exceeded, the buffered chunks are spilled to disk, and the spilled chunks are read back on demand.
+type windowRowBuffer struct {
+	fieldTypes []*types.FieldType
+	// chks are the buffered chunks, chks[i] is nil if it has been spilled, and diskIdxes[i] is its index
+	// in inDisk.
+	chks      []*chunk.Chunk
+	diskIdxes []int
+	// rowOffsets[i] is the index of the first row of chks[i].
+	rowOffsets []uint64
+	numRows    uint64
+
+	inDisk *chunk.DataInDiskByChunks
+	// numInDisk is the number of the spilled chunks which haven't been popped, inDisk is closed when
+	// all of them are popped.
+	numInDisk int
+	cache     []cachedWindowChunk
+	rowsBuf   []chunk.Row
+
+	// needSpill is set by windowSpillDiskAction, and the buffered chunks are spilled when the next chunk
+	// is added, so that the spilling always happens in the goroutine of the executor.
+	needSpill atomic.Bool
+	// err is the first error of reading the spilled chunks in getRow, the callers check it after the
+	// rows are used, since the rows are accessed by the window functions through func(uint64) chunk.Row.
+	err     error
+	nullRow *chunk.Chunk
+
+	memTracker  *memory.Tracker
+	diskTracker *disk.Tracker
+}
+
+func newWindowRowBuffer(fieldTypes []*types.FieldType, memTracker *memory.Tracker, diskTracker *disk.Tracker) *windowRowBuffer {
+	return &windowRowBuffer{
+		fieldTypes:  fieldTypes,
+		memTracker:  memTracker,
+		diskTracker: diskTracker,
+	}
+}
+
+// add appends a chunk to the buffer. All the in-memory chunks are spilled if the memory quota has
+// been exceeded.
+func (b *windowRowBuffer) add(chk *chunk.Chunk) error {
+	b.chks = append(b.chks, chk)
+	b.diskIdxes = append(b.diskIdxes, -1)
+	b.rowOffsets = append(b.rowOffsets, b.numRows)
+	b.numRows += uint64(chk.NumRows())
+	b.memTracker.Consume(chk.MemoryUsage())
+	if b.needSpill.Load() {
+		return b.spill()
+	}
+	return nil
+}
+
+func (b *windowRowBuffer) spill() error {
+	b.needSpill.Store(false)
+	spilled := 0
+	for i, chk := range b.chks {
+		if chk == nil {
+			continue
+		}
+		if b.inDisk == nil {
+			b.inDisk = chunk.NewDataInDiskByChunks(b.fieldTypes)
+			b.inDisk.GetDiskTracker().AttachTo(b.diskTracker)
+		}
+		if err := b.inDisk.Add(chk); err != nil {
+			return err
+		}
+		b.memTracker.Consume(-chk.MemoryUsage())
+		b.chks[i] = nil
+		b.diskIdxes[i] = b.inDisk.NumChunks() - 1
+		spilled++
+	}
+	if spilled == 0 {
+		return nil
+	}
+	b.numInDisk += spilled
+	logutil.BgLogger().Info("memory exceeds quota, spill the buffered rows of window to disk",
+		zap.Int("spilledChunks", spilled), zap.Int64("diskBytes", b.inDisk.GetDiskTracker().BytesConsumed()))
+	return nil
+}
+
+// getChunk returns the chunk containing the row at idx and the index of its first row.
+func (b *windowRowBuffer) getChunk(idx uint64) (*chunk.Chunk, uint64, error) {
+	i := sort.Search(len(b.rowOffsets), func(i int) bool {
+		return b.rowOffsets[i] > idx
+	}) - 1
+	if chk := b.chks[i]; chk != nil {
+		return chk, b.rowOffsets[i], nil
+	}
+	diskIdx := b.diskIdxes[i]
+	for _, c := range b.cache {
+		if c.idx == diskIdx {
+			return c.chk, b.rowOffsets[i], nil
+		}
+	}
+	chk, err := b.inDisk.GetChunk(diskIdx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(b.cache) == windowSpilledChunkCacheSize {
+		b.memTracker.Consume(-b.cache[0].chk.MemoryUsage())
+		b.cache = append(b.cache[:0], b.cache[1:]...)
+	}
+	b.cache = append(b.cache, cachedWindowChunk{idx: diskIdx, chk: chk})
+	b.memTracker.Consume(chk.MemoryUsage())
+	return chk, b.rowOffsets[i], nil
+}
+
+// getRow returns the row at idx. If the row can't be read from disk, the error is kept in b.err and a row
+// of NULLs is returned instead.
+func (b *windowRowBuffer) getRow(idx uint64) chunk.Row {
+	chk, begin, err := b.getChunk(idx)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		if b.nullRow == nil {
+			b.nullRow = chunk.NewChunkWithCapacity(b.fieldTypes, 1)
+			for i := range b.fieldTypes {
+				b.nullRow.AppendNull(i)
+			}
+		}
+		return b.nullRow.GetRow(0)
+	}
+	return chk.GetRow(int(idx - begin))
+}
+
+// iterate calls fn with the rows in [begin, end) in batches, and the rows of each batch are in the same chunk.
+func (b *windowRowBuffer) iterate(begin, end uint64, fn func(rows []chunk.Row) error) error {
+	for begin < end {
+		chk, chkBegin, err := b.getChunk(begin)
+		if err != nil {
+			return err
+		}
+		batchEnd := min(end, chkBegin+uint64(chk.NumRows()))
+		b.rowsBuf = b.rowsBuf[:0]
+		for i := begin; i < batchEnd; i++ {
+			b.rowsBuf = append(b.rowsBuf, chk.GetRow(int(i-chkBegin)))
+		}
+		if err := fn(b.rowsBuf); err != nil {
+			return err
+		}
+		begin = batchEnd
+	}
+	return nil
+}
+
+// updatePartialResult updates the partial result of wf with the rows in [begin, end), windowStart is the
+// position of the row at begin in its partition.
+func (b *windowRowBuffer) updatePartialResult(ctx aggfuncs.AggFuncUpdateContext, wf aggfuncs.AggFunc, pr aggfuncs.PartialResult, begin, end, windowStart uint64) error {
+	minMaxSlidingWindowAggFunc, isMinMax := wf.(aggfuncs.MaxMinSlidingWindowAggFunc)
+	return b.iterate(begin, end, func(rows []chunk.Row) error {
+		// MaxMinSlidingWindowAggFunc takes the window start plus the index in rows as the position of a row,
+		// so the window start moves along with the batches.
+		if isMinMax {
+			minMaxSlidingWindowAggFunc.SetWindowStart(windowStart)
+		}
+		windowStart += uint64(len(rows))
+		_, err := wf.UpdatePartialResult(ctx, rows, pr)
+		return err
+	})
+}
+
+// popFront removes the first chunk from the buffer and returns it, it's called after all the rows of the
+// chunk have been produced.
+func (b *windowRowBuffer) popFront() (chk *chunk.Chunk, err error) {
+	if chk = b.chks[0]; chk != nil {
+		b.memTracker.Consume(-chk.MemoryUsage())
+	} else {
+		diskIdx := b.diskIdxes[0]
+		for i, c := range b.cache {
+			if c.idx == diskIdx {
+				chk = c.chk
+				b.memTracker.Consume(-chk.MemoryUsage())
+				b.cache = append(b.cache[:i], b.cache[i+1:]...)
+				break
+			}
+		}
+		if chk == nil {
+			if chk, err = b.inDisk.GetChunk(diskIdx); err != nil {
+				return nil, err
+			}
+		}
+		b.numInDisk--
+		if b.numInDisk == 0 {
+			b.closeDisk()
+		}
+	}
+	b.chks[0] = nil
+	b.chks, b.diskIdxes, b.rowOffsets = b.chks[1:], b.diskIdxes[1:], b.rowOffsets[1:]
+	return chk, nil
+}
+
+func (b *windowRowBuffer) closeDisk() {
+	for _, c := range b.cache {
+		b.memTracker.Consume(-c.chk.MemoryUsage())
+	}
+	b.cache = b.cache[:0]
+	if b.inDisk != nil {
+		b.inDisk.Close()
+		b.inDisk.GetDiskTracker().Detach()
+		b.inDisk = nil
+	}
+}
+
+func (b *windowRowBuffer) close() {
+	b.closeDisk()
+	b.chks, b.diskIdxes, b.rowOffsets = nil, nil, nil
+}
+
+// windowRows is the rows of a partition buffered in windowRowBuffer, its i-th row is the row at begin+i
+// of the buffer.
+type windowRows struct {
+	buffer *windowRowBuffer
+	begin  uint64
+	end    uint64
+}
+
+func (r windowRows) numRows() uint64 {
+	return r.end - r.begin
+}
+
+func (r windowRows) getRow(i uint64) chunk.Row {
+	return r.buffer.getRow(r.begin + i)
+}
+
+// updatePartialResult updates the partial result of wf with the rows in [start, end) of the partition.
+func (r windowRows) updatePartialResult(ctx aggfuncs.AggFuncUpdateContext, wf aggfuncs.AggFunc, pr aggfuncs.PartialResult, start, end uint64) error {
+	return r.buffer.updatePartialResult(ctx, wf, pr, r.begin+start, r.begin+end, start)
+}
+
+// err returns the error of reading the rows returned by getRow.
+func (r windowRows) err() error {
+	return r.buffer.err
+}
+
+// windowSpillDiskAction implements memory.ActionOnExceed for the window executors. It spills the buffered
+// rows to disk when the memory quota of the query is exceeded.
+type windowSpillDiskAction struct {
+	memory.BaseOOMAction
+	buffer *windowRowBuffer
+}
+
+// Action implements memory.ActionOnExceed.
+func (a *windowSpillDiskAction) Action(t *memory.Tracker) {
+	// Spill only if the buffered rows take a considerable part of the quota, to avoid spilling too frequently.
+	if a.buffer.memTracker.BytesConsumed() >= t.GetBytesLimit()/10 {
+		if !a.buffer.needSpill.Swap(true) {
+			logutil.BgLogger().Info("memory exceeds quota, mark the window to spill",
+				zap.Int64("consumed", t.BytesConsumed()), zap.Int64("quota", t.GetBytesLimit()))
+			memory.QueryForceDisk.Add(1)
+		}
+		return
+	}
+	if fallback := a.GetFallback(); fallback != nil {
+		fallback.Action(t)
+	}
+}
+
+// GetPriority implements memory.ActionOnExceed.
+func (*windowSpillDiskAction) GetPriority() int64 {
+	return memory.DefSpillPriority
+}
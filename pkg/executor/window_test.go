@@ -17,10 +17,13 @@ package executor_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWindowFunctions(t *testing.T) {
@@ -470,3 +473,76 @@ func TestIssue45964And46050(t *testing.T) {
 	testReturnColumnNullableAttribute(tk, "cume_dist()", false)
 	testReturnColumnNullableAttribute(tk, "percent_rank()", false)
 }
+
+func TestWindowInDisk(t *testing.T) {
+	defer config.RestoreFunc()()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.TempStoragePath = t.TempDir()
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	defer tk.MustExec("SET GLOBAL tidb_mem_oom_action = DEFAULT")
+	tk.MustExec("SET GLOBAL tidb_mem_oom_action='LOG'")
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_window_concurrency = 1")
+	tk.MustExec("create table t(a int, b int, c int, primary key(a, b) clustered)")
+	// the partition a = 0 is much larger than the others.
+	var values []string
+	for i := 0; i < 2000; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d, %d)", i%10/9, i, i%7))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("set @@tidb_max_chunk_size = 32")
+
+	sqls := []string{
+		"select a, b, row_number() over w, sum(c) over w from t window w as (partition by a order by b)",
+		"select a, b, sum(c) over (partition by a order by b rows between 100 preceding and 50 following) from t",
+		"select a, b, sum(c) over (partition by a order by b range between 30 preceding and 10 following) from t",
+		"select a, b, max(c) over (partition by a), lead(b, 5) over (partition by a order by b) from t",
+		"select a, b, min(c) over (partition by a order by b rows between 40 preceding and 40 following) from t",
+	}
+	expected := make([][][]any, 0, len(sqls))
+	for _, sql := range sqls {
+		expected = append(expected, tk.MustQuery(sql).Rows())
+	}
+
+	tk.MustExec("set @@tidb_mem_quota_query = 1")
+	for _, pipelined := range []string{"ON", "OFF"} {
+		tk.MustExec("set @@tidb_enable_pipelined_window_function = " + pipelined)
+		for i, sql := range sqls {
+			tk.MustQuery(sql).Check(expected[i])
+			require.Equal(t, int64(0), tk.Session().GetSessionVars().StmtCtx.DiskTracker.BytesConsumed())
+			require.Greater(t, tk.Session().GetSessionVars().StmtCtx.DiskTracker.MaxConsumed(), int64(0))
+
+			spilled := false
+			for _, row := range tk.MustQuery("explain analyze " + sql).Rows() {
+				if strings.Contains(fmt.Sprintf("%v", row[0]), "Window") {
+					disk := fmt.Sprintf("%v", row[len(row)-1])
+					require.NotContains(t, disk, "N/A")
+					require.NotEqual(t, "0 Bytes", disk)
+					spilled = true
+				}
+			}
+			require.True(t, spilled)
+		}
+	}
+}
+
+func TestWindowQualify(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, c int)")
+	tk.MustExec("insert into t values (1, 1, 3), (2, 1, 2), (3, 1, 1), (4, 2, 2), (5, 2, 1)")
+
+	tk.MustQuery("select a, row_number() over (partition by b order by c) as rn from t qualify rn = 1 order by a").Check(
+		testkit.Rows("3 1", "5 1"))
+	// the window function only in the QUALIFY clause isn't in the result.
+	tk.MustQuery("select a from t qualify rank() over (partition by b order by c desc) <= 2 and a > 1 order by a").Check(
+		testkit.Rows("2", "4", "5"))
+	tk.MustQuery("select b, sum(c) over w as s from t window w as (partition by b) qualify s > 4 order by a limit 2").Check(
+		testkit.Rows("1 6", "1 6"))
+	tk.MustQuery("select distinct b from t qualify row_number() over (partition by b order by c) = 1 order by b").Check(
+		testkit.Rows("1", "2"))
+	tk.MustContainErrMsg("select a from t qualify a > 1", "QUALIFY without window functions")
+}
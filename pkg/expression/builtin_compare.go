@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/opcode"
 	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/planner/util/fixcontrol"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/collate"
@@ -1593,8 +1594,11 @@ func (c *compareFunctionClass) refineArgs(ctx BuildContext, args []Expression) (
 		return c.refineNumericConstantCmpDatetime(ctx, args, arg1, 1), nil
 	}
 
+	// Compare the int non-constant with the string constant as double values as MySQL does if it's required.
+	cmpStrConstAsReal := fixcontrol.GetBoolWithDefault(ctx.GetSessionVars().GetOptimizerFixControlMap(), fixcontrol.Fix50785, false)
+
 	// int non-constant [cmp] non-int constant
-	if arg0IsInt && !arg0IsCon && !arg1IsInt && arg1IsCon {
+	if arg0IsInt && !arg0IsCon && !arg1IsInt && arg1IsCon && !(cmpStrConstAsReal && arg1EvalType == types.ETString) {
 		arg1, isExceptional = RefineComparedConstant(ctx, *arg0Type, arg1, c.op)
 		// Why check not null flag
 		// eg: int_col > const_val(which is less than min_int32)
@@ -1621,7 +1625,7 @@ func (c *compareFunctionClass) refineArgs(ctx BuildContext, args []Expression) (
 		}
 	}
 	// non-int constant [cmp] int non-constant
-	if arg1IsInt && !arg1IsCon && !arg0IsInt && arg0IsCon {
+	if arg1IsInt && !arg1IsCon && !arg0IsInt && arg0IsCon && !(cmpStrConstAsReal && arg0EvalType == types.ETString) {
 		arg0, isExceptional = RefineComparedConstant(ctx, *arg1Type, arg0, symmetricOp[c.op])
 		if !isExceptional || (isExceptional && mysql.HasNotNullFlag(arg1Type.GetFlag())) {
 			finalArg0 = arg0
@@ -75,13 +75,18 @@ func canFuncBePushed(sf *ScalarFunction, storeType kv.StoreType) bool {
 	}
 
 	if ret {
-		funcFullName := fmt.Sprintf("%s.%s", sf.FuncName.L, strings.ToLower(sf.Function.PbCode().String()))
-		// Aside from checking function name, also check the pb name in case only the specific push down is disabled.
-		ret = IsPushDownEnabled(sf.FuncName.L, storeType) && IsPushDownEnabled(funcFullName, storeType)
+		ret = !isBlockedByExprPushDownBlacklist(sf, storeType)
 	}
 	return ret
 }
 
+// isBlockedByExprPushDownBlacklist returns true if the function is in the expr_pushdown_blacklist of the store.
+func isBlockedByExprPushDownBlacklist(sf *ScalarFunction, storeType kv.StoreType) bool {
+	funcFullName := fmt.Sprintf("%s.%s", sf.FuncName.L, strings.ToLower(sf.Function.PbCode().String()))
+	// Aside from checking function name, also check the pb name in case only the specific push down is disabled.
+	return !IsPushDownEnabled(sf.FuncName.L, storeType) || !IsPushDownEnabled(funcFullName, storeType)
+}
+
 func canScalarFuncPushDown(ctx PushDownContext, scalarFunc *ScalarFunction, storeType kv.StoreType) bool {
 	pbCode := scalarFunc.Function.PbCode()
 	// Check whether this function can be pushed.
@@ -95,7 +100,11 @@ func canScalarFuncPushDown(ctx PushDownContext, scalarFunc *ScalarFunction, stor
 		if storeType == kv.UnSpecified {
 			storageName = "storage layer"
 		}
-		warnErr := errors.NewNoStackError("Scalar function '" + scalarFunc.FuncName.L + "'(signature: " + scalarFunc.Function.PbCode().String() + ", return type: " + scalarFunc.RetType.CompactStr() + ") is not supported to push down to " + storageName + " now.")
+		reason := "is not supported to push down to " + storageName + " now."
+		if !unspecified && isBlockedByExprPushDownBlacklist(scalarFunc, storeType) {
+			reason = "is not pushed down to " + storageName + " because it is in mysql.expr_pushdown_blacklist."
+		}
+		warnErr := errors.NewNoStackError("Scalar function '" + scalarFunc.FuncName.L + "'(signature: " + scalarFunc.Function.PbCode().String() + ", return type: " + scalarFunc.RetType.CompactStr() + ") " + reason)
 
 		ctx.AppendWarning(warnErr)
 		return false
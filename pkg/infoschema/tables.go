@@ -215,6 +215,19 @@ const (
 	TableKeywords = "KEYWORDS"
 	// TableTiDBIndexUsage is a table to show the usage stats of indexes in the current instance.
 	TableTiDBIndexUsage = "TIDB_INDEX_USAGE"
+	// TableTiDBRunningStatementStats is a table to show the runtime stats of the operators of the running statements
+	// in the current instance.
+	TableTiDBRunningStatementStats = "TIDB_RUNNING_STATEMENT_STATS"
+	// TableTiDBIngestDiskUsage is a table to show the temporary disk usage of the ingest jobs in the current instance.
+	TableTiDBIngestDiskUsage = "TIDB_INGEST_DISK_USAGE"
+	// TableDistFrameworkNodes is a table to show the resource slots and usage of the nodes managed by the
+	// distributed execution framework.
+	TableDistFrameworkNodes = "DIST_FRAMEWORK_NODES"
+	// TableTiKVCompactionProgress is a table to show the progress of the TiKV compactions triggered by
+	// ALTER TABLE ... COMPACT TIKV REPLICA in the current instance.
+	TableTiKVCompactionProgress = "TIKV_COMPACTION_PROGRESS"
+	// TableTiDBAccountLocks is a table to show the failed-login tracking and the lock state of the accounts.
+	TableTiDBAccountLocks = "TIDB_ACCOUNT_LOCKS"
 )
 
 const (
@@ -336,6 +349,11 @@ var tableIDMap = map[string]int64{
 	TableKeywords:                        autoid.InformationSchemaDBID + 92,
 	TableTiDBIndexUsage:                  autoid.InformationSchemaDBID + 93,
 	ClusterTableTiDBIndexUsage:           autoid.InformationSchemaDBID + 94,
+	TableTiDBRunningStatementStats:       autoid.InformationSchemaDBID + 95,
+	TableTiDBIngestDiskUsage:             autoid.InformationSchemaDBID + 96,
+	TableDistFrameworkNodes:              autoid.InformationSchemaDBID + 97,
+	TableTiKVCompactionProgress:          autoid.InformationSchemaDBID + 98,
+	TableTiDBAccountLocks:                autoid.InformationSchemaDBID + 99,
 }
 
 // columnInfo represents the basic column information of all kinds of INFORMATION_SCHEMA tables
@@ -951,6 +969,7 @@ var slowQueryCols = []columnInfo{
 	{name: variable.SlowLogBinaryPlan, tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
 	{name: variable.SlowLogPrevStmt, tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
 	{name: variable.SlowLogQuerySQLStr, tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
+	{name: variable.SlowLogQueryAttributesStr, tp: mysql.TypeVarchar, size: 4096},
 }
 
 // TableTiDBHotRegionsCols is TiDB hot region mem table columns.
@@ -1103,6 +1122,8 @@ var tableClusterLogCols = []columnInfo{
 	{name: "INSTANCE", tp: mysql.TypeVarchar, size: 64},
 	{name: "LEVEL", tp: mysql.TypeVarchar, size: 8},
 	{name: "MESSAGE", tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
+	{name: "MODULE", tp: mysql.TypeVarchar, size: 64},
+	{name: "JOB_ID", tp: mysql.TypeLonglong, size: 21},
 }
 
 var tableClusterLoadCols = []columnInfo{
@@ -1268,6 +1289,11 @@ var tableDDLJobsCols = []columnInfo{
 	{name: "END_TIME", tp: mysql.TypeDatetime, size: 19},
 	{name: "STATE", tp: mysql.TypeVarchar, size: 64},
 	{name: "QUERY", tp: mysql.TypeBlob, size: types.UnspecifiedLength},
+	{name: "REORG_PHASE", tp: mysql.TypeVarchar, size: 64},
+	{name: "PROGRESS", tp: mysql.TypeDouble, size: 22, decimal: 2},
+	{name: "WRITTEN_BYTES", tp: mysql.TypeLonglong, size: 21},
+	{name: "ESTIMATED_END_TIME", tp: mysql.TypeDatetime, size: 19},
+	{name: "INGEST_FALLBACK_REASON", tp: mysql.TypeBlob, size: types.UnspecifiedLength},
 }
 
 var tableSequencesCols = []columnInfo{
@@ -1699,6 +1725,64 @@ var tableTiDBIndexUsage = []columnInfo{
 	{name: "LAST_ACCESS_TIME", tp: mysql.TypeDatetime, size: 21},
 }
 
+var tableTiDBRunningStatementStatsCols = []columnInfo{
+	{name: "ID", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag, deflt: 0},
+	{name: "OPERATOR", tp: mysql.TypeVarchar, size: 512},
+	{name: "EST_ROWS", tp: mysql.TypeVarchar, size: 64},
+	{name: "ACT_ROWS", tp: mysql.TypeVarchar, size: 64},
+	{name: "TASK", tp: mysql.TypeVarchar, size: 64},
+	{name: "ACCESS_OBJECT", tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
+	{name: "EXECUTION_INFO", tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
+	{name: "OPERATOR_INFO", tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
+	{name: "MEMORY", tp: mysql.TypeVarchar, size: 64},
+	{name: "DISK", tp: mysql.TypeVarchar, size: 64},
+}
+
+var tableTiDBIngestDiskUsageCols = []columnInfo{
+	{name: "JOB_ID", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "PATH", tp: mysql.TypeVarchar, size: 512},
+	{name: "DISK_USAGE", tp: mysql.TypeLonglong, size: 21, flag: mysql.UnsignedFlag},
+	{name: "DISK_QUOTA", tp: mysql.TypeLonglong, size: 21, flag: mysql.UnsignedFlag},
+}
+
+var tableDistFrameworkNodesCols = []columnInfo{
+	{name: "NODE_ID", tp: mysql.TypeVarchar, size: 261, flag: mysql.NotNullFlag},
+	{name: "ROLE", tp: mysql.TypeVarchar, size: 64},
+	{name: "CPU_SLOTS", tp: mysql.TypeLonglong, size: 21},
+	{name: "USED_SLOTS", tp: mysql.TypeLonglong, size: 21},
+	{name: "MEMORY_BUDGET", tp: mysql.TypeLonglong, size: 21, flag: mysql.UnsignedFlag},
+	{name: "RUNNING_SUBTASKS", tp: mysql.TypeLonglong, size: 21},
+	{name: "PENDING_SUBTASKS", tp: mysql.TypeLonglong, size: 21},
+	{name: "ROWS_PER_SECOND", tp: mysql.TypeDouble, size: 22},
+}
+
+var tableTiKVCompactionProgressCols = []columnInfo{
+	{name: "ID", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "STORE_ADDRESS", tp: mysql.TypeVarchar, size: 64},
+	{name: "STATE", tp: mysql.TypeVarchar, size: 16},
+	{name: "COMPACTED_RANGES", tp: mysql.TypeLonglong, size: 21},
+	{name: "TOTAL_RANGES", tp: mysql.TypeLonglong, size: 21},
+	{name: "START_TIME", tp: mysql.TypeDatetime, size: 19},
+	{name: "END_TIME", tp: mysql.TypeDatetime, size: 19},
+	{name: "ERROR", tp: mysql.TypeBlob, size: types.UnspecifiedLength},
+}
+
+// PASSWORD_LOCK_TIME is -1 if the account is locked until it's unlocked by ALTER USER ... ACCOUNT UNLOCK.
+var tableTiDBAccountLocksCols = []columnInfo{
+	{name: "USER", tp: mysql.TypeVarchar, size: 32, flag: mysql.NotNullFlag},
+	{name: "HOST", tp: mysql.TypeVarchar, size: 255, flag: mysql.NotNullFlag},
+	{name: "ACCOUNT_LOCKED", tp: mysql.TypeVarchar, size: 1, flag: mysql.NotNullFlag},
+	{name: "FAILED_LOGIN_ATTEMPTS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "PASSWORD_LOCK_TIME", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "FAILED_LOGIN_COUNT", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "AUTO_ACCOUNT_LOCKED", tp: mysql.TypeVarchar, size: 1, flag: mysql.NotNullFlag},
+	{name: "AUTO_LOCKED_TIME", tp: mysql.TypeDatetime, size: 19},
+	{name: "AUTO_UNLOCK_TIME", tp: mysql.TypeDatetime, size: 19},
+}
+
 // GetShardingInfo returns a nil or description string for the sharding information of given TableInfo.
 // The returned description string may be:
 //   - "NOT_SHARDED": for tables that SHARD_ROW_ID_BITS is not specified.
@@ -2347,6 +2431,11 @@ var tableNameToColumns = map[string][]columnInfo{
 	TableTiDBCheckConstraints:               tableTiDBCheckConstraintsCols,
 	TableKeywords:                           tableKeywords,
 	TableTiDBIndexUsage:                     tableTiDBIndexUsage,
+	TableTiDBRunningStatementStats:          tableTiDBRunningStatementStatsCols,
+	TableTiDBIngestDiskUsage:                tableTiDBIngestDiskUsageCols,
+	TableDistFrameworkNodes:                 tableDistFrameworkNodesCols,
+	TableTiKVCompactionProgress:             tableTiKVCompactionProgressCols,
+	TableTiDBAccountLocks:                   tableTiDBAccountLocksCols,
 }
 
 func createInfoSchemaTable(_ autoid.Allocators, meta *model.TableInfo) (table.Table, error) {
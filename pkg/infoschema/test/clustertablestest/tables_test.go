@@ -367,6 +367,7 @@ func TestSlowQuery(t *testing.T) {
 			"localhost",
 			"6",
 			"",
+			"",
 			"57",
 			"0.12",
 			"4.895492",
@@ -447,6 +448,7 @@ func TestSlowQuery(t *testing.T) {
 			"172.16.0.0",
 			"40507",
 			"alias123",
+			"",
 			"0",
 			"0",
 			"25.571605962",
@@ -274,7 +274,9 @@ func (e *BaseKVEncoder) getActualDatum(col *table.Column, rowID int64, inputDatu
 		err = col.HandleBadNull(e.SessionCtx.Vars.StmtCtx.ErrCtx(), &value, 0)
 	default:
 		// copy from the following GetColDefaultValue function, when this is true it will use getColDefaultExprValue
-		if col.DefaultIsExpr {
+		// the caller might have set a TxnCtx already, such as IMPORT INTO which
+		// needs it to evaluate the SET clause, we shouldn't reset it.
+		if col.DefaultIsExpr && e.SessionCtx.Vars.TxnCtx == nil {
 			// the expression rewriter requires a non-nil TxnCtx.
 			e.SessionCtx.Vars.TxnCtx = new(variable.TransactionContext)
 			defer func() {
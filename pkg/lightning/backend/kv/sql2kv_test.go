@@ -33,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	_ "github.com/pingcap/tidb/pkg/planner/core" // to setup expression.EvalAstExpr. Otherwise we cannot parse the default value
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
 	"github.com/pingcap/tidb/pkg/tablecodec"
@@ -487,6 +488,14 @@ func TestEncodeExpressionColumn(t *testing.T) {
 	require.Equal(t, types.KindString, actualDatum2.Kind())
 	require.Len(t, actualDatum2.GetString(), 36)
 	require.NotEqual(t, actualDatum.GetString(), actualDatum2.GetString()) // check different uuid
+
+	// TxnCtx set by caller should be kept after evaluating the default expression.
+	txnCtx := new(variable.TransactionContext)
+	lkv.GetEncoderSe(encoder).Vars.TxnCtx = txnCtx
+	actualDatum, err = lkv.GetActualDatum(encoder, tbl.Cols()[0], 70, nil)
+	require.NoError(t, err)
+	require.Len(t, actualDatum.GetString(), 36)
+	require.Same(t, txnCtx, lkv.GetEncoderSe(encoder).Vars.TxnCtx)
 }
 
 func mockTableInfo(t *testing.T, createSQL string) *model.TableInfo {
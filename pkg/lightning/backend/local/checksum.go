@@ -85,6 +85,16 @@ type ChecksumManager interface {
 	Checksum(ctx context.Context, tableInfo *checkpoints.TidbTableInfo) (*RemoteChecksum, error)
 }
 
+// ResumableChecksumManager is a ChecksumManager which can resume the checksum of a table
+// from the progress of the interrupted one.
+type ResumableChecksumManager interface {
+	ChecksumManager
+	// ChecksumFrom skips the finished ranges in the progress and computes the checksum of the
+	// remaining ones, onProgress is called with the new progress after each range is finished.
+	ChecksumFrom(ctx context.Context, tableInfo *checkpoints.TidbTableInfo, progress checkpoints.ChecksumProgress,
+		onProgress func(checkpoints.ChecksumProgress)) (*RemoteChecksum, error)
+}
+
 // fetch checksum for tidb sql client
 type tidbChecksumExecutor struct {
 	db      *sql.DB
@@ -279,7 +289,7 @@ type TiKVChecksumManager struct {
 	explicitRequestSourceType string
 }
 
-var _ ChecksumManager = &TiKVChecksumManager{}
+var _ ResumableChecksumManager = &TiKVChecksumManager{}
 
 // NewTiKVChecksumManager return a new tikv checksum manager
 func NewTiKVChecksumManager(client kv.Client, pdClient pd.Client, distSQLScanConcurrency uint, backoffWeight int, resourceGroupName, explicitRequestSourceType string) *TiKVChecksumManager {
@@ -293,7 +303,8 @@ func NewTiKVChecksumManager(client kv.Client, pdClient pd.Client, distSQLScanCon
 	}
 }
 
-func (e *TiKVChecksumManager) checksumDB(ctx context.Context, tableInfo *checkpoints.TidbTableInfo, ts uint64) (*RemoteChecksum, error) {
+func (e *TiKVChecksumManager) checksumDB(ctx context.Context, tableInfo *checkpoints.TidbTableInfo, ts uint64,
+	progress checkpoints.ChecksumProgress, onProgress func(checkpoints.ChecksumProgress)) (*RemoteChecksum, error) {
 	executor, err := checksum.NewExecutorBuilder(tableInfo.Core, ts).
 		SetConcurrency(e.distSQLScanConcurrency).
 		SetBackoffWeight(e.backoffWeight).
@@ -304,21 +315,36 @@ func (e *TiKVChecksumManager) checksumDB(ctx context.Context, tableInfo *checkpo
 		return nil, errors.Trace(err)
 	}
 
+	if progress.FinishedRanges > int64(executor.Len()) {
+		// the progress doesn't belong to the current ranges of the table.
+		log.FromContext(ctx).Warn("checksum progress doesn't match the table, checksum from the beginning",
+			zap.String("db", tableInfo.DB), zap.String("table", tableInfo.Name),
+			zap.Int64("finished", progress.FinishedRanges), zap.Int("total", executor.Len()))
+		progress = checkpoints.ChecksumProgress{}
+	}
+
 	distSQLScanConcurrency := int(e.distSQLScanConcurrency)
 	for i := 0; i < maxErrorRetryCount; i++ {
 		_ = executor.Each(func(request *kv.Request) error {
 			request.Concurrency = distSQLScanConcurrency
 			return nil
 		})
-		var execRes *tipb.ChecksumResponse
-		execRes, err = executor.Execute(ctx, e.client, func() {})
+		// the finished ranges are skipped when retrying.
+		_, err = executor.ExecuteFrom(ctx, e.client, int(progress.FinishedRanges), func(resp *tipb.ChecksumResponse) {
+			checksum := verification.MakeKVChecksum(resp.TotalBytes, resp.TotalKvs, resp.Checksum)
+			progress.FinishedRanges++
+			progress.Checksum.Add(&checksum)
+			if onProgress != nil {
+				onProgress(progress)
+			}
+		})
 		if err == nil {
 			return &RemoteChecksum{
 				Schema:     tableInfo.DB,
 				Table:      tableInfo.Name,
-				Checksum:   execRes.Checksum,
-				TotalBytes: execRes.TotalBytes,
-				TotalKVs:   execRes.TotalKvs,
+				Checksum:   progress.Checksum.Sum(),
+				TotalBytes: progress.Checksum.SumSize(),
+				TotalKVs:   progress.Checksum.SumKVS(),
 			}, nil
 		}
 
@@ -342,6 +368,12 @@ var retryGetTSInterval = time.Second
 
 // Checksum implements the ChecksumManager interface.
 func (e *TiKVChecksumManager) Checksum(ctx context.Context, tableInfo *checkpoints.TidbTableInfo) (*RemoteChecksum, error) {
+	return e.ChecksumFrom(ctx, tableInfo, checkpoints.ChecksumProgress{}, nil)
+}
+
+// ChecksumFrom implements the ResumableChecksumManager interface.
+func (e *TiKVChecksumManager) ChecksumFrom(ctx context.Context, tableInfo *checkpoints.TidbTableInfo,
+	progress checkpoints.ChecksumProgress, onProgress func(checkpoints.ChecksumProgress)) (*RemoteChecksum, error) {
 	tbl := common.UniqueTable(tableInfo.DB, tableInfo.Name)
 	var (
 		physicalTS, logicalTS int64
@@ -372,7 +404,7 @@ func (e *TiKVChecksumManager) Checksum(ctx context.Context, tableInfo *checkpoin
 	}
 	defer e.manager.removeOneJob(tbl)
 
-	return e.checksumDB(ctx, tableInfo, ts)
+	return e.checksumDB(ctx, tableInfo, ts, progress, onProgress)
 }
 
 type tableChecksumTS struct {
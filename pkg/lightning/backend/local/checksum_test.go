@@ -29,6 +29,7 @@ import (
 	tmysql "github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/kv"
 	. "github.com/pingcap/tidb/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/pkg/lightning/verification"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	pmysql "github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/types"
@@ -227,6 +228,64 @@ func TestDoChecksumWithTikv(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestChecksumFromProgress(t *testing.T) {
+	pdClient := &testPDClient{}
+	resp := tipb.ChecksumResponse{Checksum: 123, TotalKvs: 10, TotalBytes: 1000}
+	kvClient := &mockChecksumKVClient{checksum: resp}
+	sentReqs := 0
+	kvClient.onSendReq = func(*kv.Request) {
+		sentReqs++
+	}
+
+	fieldType := types.NewFieldType(pmysql.TypeLong)
+	tableInfo := &model.TableInfo{
+		ID:   999,
+		Name: model.NewCIStr("t1"),
+		Columns: []*model.ColumnInfo{
+			{ID: 1, Name: model.NewCIStr("c1"), Offset: 0, FieldType: *fieldType},
+		},
+		Indices: []*model.IndexInfo{
+			{
+				ID:      1,
+				Name:    model.NewCIStr("i1"),
+				Columns: []*model.IndexColumn{{Name: model.NewCIStr("c1"), Offset: 0, Length: types.UnspecifiedLength}},
+				State:   model.StatePublic,
+			},
+		},
+	}
+	table := &TidbTableInfo{DB: "test", Name: "t1", Core: tableInfo}
+	checksumExec := &TiKVChecksumManager{manager: newGCTTLManager(pdClient), client: kvClient}
+
+	// the table record range is finished before, only the index range is checked.
+	var progresses []ChecksumProgress
+	cs, err := checksumExec.ChecksumFrom(context.Background(), table, ChecksumProgress{
+		FinishedRanges: 1,
+		Checksum:       verification.MakeKVChecksum(1000, 10, 123),
+	}, func(progress ChecksumProgress) {
+		progresses = append(progresses, progress)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, sentReqs)
+	require.Equal(t, []ChecksumProgress{{
+		FinishedRanges: 2,
+		Checksum:       verification.MakeKVChecksum(2000, 20, 0),
+	}}, progresses)
+	require.Equal(t, &RemoteChecksum{Schema: "test", Table: "t1", Checksum: 0, TotalKVs: 20, TotalBytes: 2000}, cs)
+
+	// all ranges are finished before.
+	sentReqs = 0
+	cs, err = checksumExec.ChecksumFrom(context.Background(), table, progresses[0], nil)
+	require.NoError(t, err)
+	require.Zero(t, sentReqs)
+	require.Equal(t, &RemoteChecksum{Schema: "test", Table: "t1", Checksum: 0, TotalKVs: 20, TotalBytes: 2000}, cs)
+
+	// the progress doesn't match the table, checksum from the beginning.
+	cs, err = checksumExec.ChecksumFrom(context.Background(), table, ChecksumProgress{FinishedRanges: 3}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, sentReqs)
+	require.Equal(t, &RemoteChecksum{Schema: "test", Table: "t1", Checksum: 0, TotalKVs: 20, TotalBytes: 2000}, cs)
+}
+
 func TestDoChecksumWithErrorAndLongOriginalLifetime(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
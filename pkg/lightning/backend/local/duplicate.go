@@ -1113,7 +1113,7 @@ func (local *DupeController) ResolveDuplicateRows(ctx context.Context, tbl table
 	case config.NoneOnDup:
 		logger.Warn("skipping resolution due to selected algorithm. this table will become inconsistent!", zap.String("category", "resolve-dupe"), zap.Stringer("algorithm", algorithm))
 		return nil
-	case config.ReplaceOnDup:
+	case config.ReplaceOnDup, config.ReplaceNewestOnDup:
 	default:
 		panic(fmt.Sprintf("[resolve-dupe] unknown conflict.strategy algorithm %v", algorithm))
 	}
@@ -1127,6 +1127,26 @@ func (local *DupeController) ResolveDuplicateRows(ctx context.Context, tbl table
 	logger.Debug("got tblInfo from tbl",
 		zap.ByteString("tblInfo", tblInfo))
 
+	if algorithm == config.ReplaceNewestOnDup {
+		err = local.errorMgr.ReserveNewestConflictRows(
+			ctx, tbl, tableName,
+			func(ctx context.Context, key []byte) ([]byte, error) {
+				return local.getLatestValue(ctx, logger, key)
+			},
+			func(ctx context.Context, key, value []byte) error {
+				err := local.putLatestValue(ctx, logger, key, value)
+				if err != nil {
+					logger.Warn("reserve newest rows encounter error", log.ShortError(err))
+					return common.ErrResolveDuplicateRows.Wrap(errors.Trace(err)).GenWithStackByArgs(tableName)
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	err = local.errorMgr.ReplaceConflictKeys(
 		ctx, tbl, tableName, pool,
 		func(ctx context.Context, key []byte) ([]byte, error) {
@@ -1166,6 +1186,33 @@ func (local *DupeController) getLatestValue(
 	return value, nil
 }
 
+func (local *DupeController) putLatestValue(
+	ctx context.Context,
+	logger *log.Task,
+	key, value []byte,
+) (err error) {
+	txn, err := local.tikvCli.Begin()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit(ctx)
+		} else {
+			if rollbackErr := txn.Rollback(); rollbackErr != nil {
+				logger.Warn("failed to rollback transaction", zap.Error(rollbackErr))
+			}
+		}
+	}()
+
+	logger.Debug("putLatestValue will overwrite key",
+		zap.String("category", "resolve-dupe"),
+		logutil.Key("key", key))
+	err = txn.Set(key, value)
+
+	return errors.Trace(err)
+}
+
 func (local *DupeController) deleteDuplicateRow(
 	ctx context.Context,
 	logger *log.Task,
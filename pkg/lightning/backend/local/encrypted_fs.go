@@ -0,0 +1,165 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"os"
+
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/encrypt"
+)
+
+// encryptionHeaderSize is the size of the header of an encrypted file, which is the random IV
+// of the file.
+const encryptionHeaderSize = aes.BlockSize
+
+// newEncryptedFS returns a vfs.FS which encrypts the content of the files by AES-CTR with the
+// key, or fs itself if key is empty. Each file starts with a random IV, and the offsets and the
+// sizes of the files seen by the callers exclude it.
+func newEncryptedFS(fs vfs.FS, key []byte) (vfs.FS, error) {
+	if len(key) == 0 {
+		return fs, nil
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &encryptedFS{FS: fs, block: block}, nil
+}
+
+// newEngineFS returns the vfs.FS of the engine files with the encryption config.
+func newEngineFS(method, masterKeyPath string) (vfs.FS, error) {
+	if method == "" {
+		method = encrypt.MethodPlaintext
+	}
+	key, err := encrypt.LoadMasterKey(method, masterKeyPath)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newEncryptedFS(vfs.Default, key)
+}
+
+type encryptedFS struct {
+	vfs.FS
+	block cipher.Block
+}
+
+// Create implements vfs.FS.
+func (fs *encryptedFS) Create(name string) (vfs.File, error) {
+	f, err := fs.FS.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, encryptionHeaderSize)
+	if _, err = rand.Read(iv); err == nil {
+		_, err = f.Write(iv)
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Trace(err)
+	}
+	return &encryptedFile{File: f, block: fs.block, iv: iv}, nil
+}
+
+// Open implements vfs.FS.
+func (fs *encryptedFS) Open(name string, opts ...vfs.OpenOption) (vfs.File, error) {
+	f, err := fs.FS.Open(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, encryptionHeaderSize)
+	if _, err = io.ReadFull(f, iv); err != nil {
+		_ = f.Close()
+		return nil, errors.Annotatef(err, "read the encryption header of %s failed", name)
+	}
+	return &encryptedFile{File: f, block: fs.block, iv: iv}, nil
+}
+
+// ReuseForWrite implements vfs.FS. The file isn't reused, because overwriting it with the same
+// IV reuses the key stream.
+func (fs *encryptedFS) ReuseForWrite(oldname, newname string) (vfs.File, error) {
+	if err := fs.FS.Rename(oldname, newname); err != nil {
+		return nil, err
+	}
+	return fs.Create(newname)
+}
+
+// Stat implements vfs.FS.
+func (fs *encryptedFS) Stat(name string) (os.FileInfo, error) {
+	info, err := fs.FS.Stat(name)
+	if err != nil || info.IsDir() {
+		return info, err
+	}
+	return encryptedFileInfo{FileInfo: info}, nil
+}
+
+type encryptedFile struct {
+	vfs.File
+	block cipher.Block
+	iv    []byte
+	// offset is the offset of the next Read or Write, excluding the header.
+	offset int64
+	buf    []byte
+}
+
+// Read implements vfs.File.
+func (f *encryptedFile) Read(p []byte) (int, error) {
+	n, err := f.File.Read(p)
+	encrypt.XORKeyStreamAt(f.block, f.iv, p[:n], p[:n], f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+// ReadAt implements vfs.File.
+func (f *encryptedFile) ReadAt(p []byte, off int64) (int, error) {
+	n, err := f.File.ReadAt(p, off+encryptionHeaderSize)
+	encrypt.XORKeyStreamAt(f.block, f.iv, p[:n], p[:n], off)
+	return n, err
+}
+
+// Write implements vfs.File.
+func (f *encryptedFile) Write(p []byte) (int, error) {
+	if cap(f.buf) < len(p) {
+		f.buf = make([]byte, len(p))
+	}
+	buf := f.buf[:len(p)]
+	encrypt.XORKeyStreamAt(f.block, f.iv, buf, p, f.offset)
+	n, err := f.File.Write(buf)
+	f.offset += int64(n)
+	return n, err
+}
+
+// Stat implements vfs.File.
+func (f *encryptedFile) Stat() (os.FileInfo, error) {
+	info, err := f.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return encryptedFileInfo{FileInfo: info}, nil
+}
+
+type encryptedFileInfo struct {
+	os.FileInfo
+}
+
+// Size implements os.FileInfo.
+func (i encryptedFileInfo) Size() int64 {
+	return max(i.FileInfo.Size()-encryptionHeaderSize, 0)
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/sstable"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedFS(t *testing.T) {
+	fs, err := newEncryptedFS(vfs.Default, bytes.Repeat([]byte{7}, 32))
+	require.NoError(t, err)
+	dir := t.TempDir()
+	value := []byte("the value should be encrypted")
+
+	// the SST files written by the engine writers are encrypted.
+	sstPath := filepath.Join(dir, "test.sst")
+	w, err := newSSTWriter(fs, sstPath, 4096)
+	require.NoError(t, err)
+	require.NoError(t, w.Add(sstable.InternalKey{UserKey: []byte("key"), Trailer: uint64(sstable.InternalKeyKindSet)}, value))
+	require.NoError(t, w.Close())
+	raw, err := os.ReadFile(sstPath)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(raw, value))
+	info, err := fs.Stat(sstPath)
+	require.NoError(t, err)
+	require.EqualValues(t, len(raw)-encryptionHeaderSize, info.Size())
+
+	// they're ingested into the pebble DB on the same file system.
+	dbPath := filepath.Join(dir, "db")
+	db, err := pebble.Open(dbPath, &pebble.Options{FS: fs})
+	require.NoError(t, err)
+	require.NoError(t, db.Ingest([]string{sstPath}))
+	require.NoError(t, db.Set([]byte("key2"), value, pebble.Sync))
+	require.NoError(t, db.Flush())
+	require.NoError(t, db.Close())
+
+	db, err = pebble.Open(dbPath, &pebble.Options{FS: fs})
+	require.NoError(t, err)
+	for _, key := range []string{"key", "key2"} {
+		val, closer, err := db.Get([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, value, val)
+		require.NoError(t, closer.Close())
+	}
+	require.NoError(t, db.Close())
+
+	// the DB can't be opened without the key.
+	_, err = pebble.Open(dbPath, &pebble.Options{})
+	require.Error(t, err)
+
+	fs, err = newEncryptedFS(vfs.Default, nil)
+	require.NoError(t, err)
+	require.Equal(t, vfs.Default, fs)
+}
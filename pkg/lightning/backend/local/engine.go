@@ -30,6 +30,7 @@ import (
 
 	"github.com/cockroachdb/pebble"
 	"github.com/cockroachdb/pebble/sstable"
+	"github.com/cockroachdb/pebble/vfs"
 	"github.com/google/btree"
 	"github.com/google/uuid"
 	"github.com/pingcap/errors"
@@ -146,6 +147,8 @@ type Engine struct {
 	keyAdapter         common.KeyAdapter
 	duplicateDetection bool
 	duplicateDB        *pebble.DB
+	// fs is the file system of the SST files and the pebble DB of the engine, which may encrypt them.
+	fs vfs.FS
 
 	logger log.Logger
 }
@@ -161,6 +164,13 @@ func (e *Engine) getDB() *pebble.DB {
 	return e.db.Load()
 }
 
+func (e *Engine) getFS() vfs.FS {
+	if e.fs == nil {
+		return vfs.Default
+	}
+	return e.fs
+}
+
 // Close closes the engine and release all resources.
 func (e *Engine) Close() error {
 	e.logger.Debug("closing local engine", zap.Stringer("engine", e.UUID), zap.Stack("stack"))
@@ -1349,7 +1359,7 @@ func (w *Writer) addSST(ctx context.Context, meta *sstMeta) error {
 
 func (w *Writer) createSSTWriter() (*sstWriter, error) {
 	path := filepath.Join(w.engine.sstDir, uuid.New().String()+".sst")
-	writer, err := newSSTWriter(path, w.engine.config.BlockSize)
+	writer, err := newSSTWriter(w.engine.getFS(), path, w.engine.config.BlockSize)
 	if err != nil {
 		return nil, err
 	}
@@ -1365,8 +1375,8 @@ type sstWriter struct {
 	logger log.Logger
 }
 
-func newSSTWriter(path string, blockSize int) (*sstable.Writer, error) {
-	f, err := os.Create(path)
+func newSSTWriter(fs vfs.FS, path string, blockSize int) (*sstable.Writer, error) {
+	f, err := fs.Create(path)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -1528,7 +1538,7 @@ func (i dbSSTIngester) mergeSSTs(metas []*sstMeta, dir string, blockSize int) (*
 	}
 
 	for _, p := range metas {
-		f, err := os.Open(p.path)
+		f, err := i.e.getFS().Open(p.path)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -1561,7 +1571,7 @@ func (i dbSSTIngester) mergeSSTs(metas []*sstMeta, dir string, blockSize int) (*
 	heap.Init(mergeIter)
 
 	name := filepath.Join(dir, fmt.Sprintf("%s.sst", uuid.New()))
-	writer, err := newSSTWriter(name, blockSize)
+	writer, err := newSSTWriter(i.e.getFS(), name, blockSize)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
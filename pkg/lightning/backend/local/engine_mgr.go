@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
 	"github.com/docker/go-units"
 	"github.com/google/uuid"
 	"github.com/pingcap/errors"
@@ -64,6 +65,9 @@ type engineManager struct {
 	duplicateDB    *pebble.DB
 	keyAdapter     common.KeyAdapter
 	logger         log.Logger
+	openFiles      *openFilesBudget
+	// fs is the file system of the engine files, which encrypts them when the encryption is enabled.
+	fs vfs.FS
 }
 
 func newEngineManager(config BackendConfig, storeHelper StoreHelper, logger log.Logger) (_ *engineManager, err error) {
@@ -78,14 +82,25 @@ func newEngineManager(config BackendConfig, storeHelper StoreHelper, logger log.
 		return nil, err
 	}
 
+	fs, err := newEngineFS(config.EncryptionMethod, config.EncryptionMasterKeyPath)
+	if err != nil {
+		return nil, common.ErrInvalidConfig.Wrap(err).GenWithStack("invalid encryption config of the engine files")
+	}
+
 	keyAdapter := common.KeyAdapter(common.NoopKeyAdapter{})
 	if config.DupeDetectEnabled {
-		duplicateDB, err = openDuplicateDB(config.LocalStoreDir)
+		duplicateDB, err = openDuplicateDB(config.LocalStoreDir, fs)
 		if err != nil {
 			return nil, common.ErrOpenDuplicateDB.Wrap(err).GenWithStackByArgs()
 		}
 		keyAdapter = common.DupDetectKeyAdapter{}
 	}
+	rLimit, err := GetSystemRLimit()
+	if err != nil {
+		logger.Warn("failed to get the open-file limit, use the max open files of engine instead", log.ShortError(err))
+		rLimit = RlimT(config.MaxOpenFiles)
+	}
+
 	alloc := manual.Allocator{}
 	if RunInTest {
 		alloc.RefCnt = new(atomic.Int64)
@@ -100,6 +115,8 @@ func newEngineManager(config BackendConfig, storeHelper StoreHelper, logger log.
 		duplicateDB:    duplicateDB,
 		keyAdapter:     keyAdapter,
 		logger:         logger,
+		openFiles:      newOpenFilesBudget(rLimit, RaiseRLimit, logger),
+		fs:             fs,
 	}, nil
 }
 
@@ -199,13 +216,14 @@ func (em *engineManager) openEngineDB(engineUUID uuid.UUID, readOnly bool) (*peb
 		L0CompactionThreshold: math.MaxInt32,
 		L0StopWritesThreshold: math.MaxInt32,
 		LBaseMaxBytes:         16 * units.TiB,
-		MaxOpenFiles:          em.MaxOpenFiles,
+		MaxOpenFiles:          em.openFiles.acquire(engineUUID, em.MaxOpenFiles),
 		DisableWAL:            true,
 		ReadOnly:              readOnly,
 		TablePropertyCollectors: []func() pebble.TablePropertyCollector{
 			newRangePropertiesCollector,
 		},
 		DisableAutomaticCompactions: em.DisableAutomaticCompactions,
+		FS:                          em.fs,
 	}
 	// set level target file size to avoid pebble auto triggering compaction that split ingest SST files into small SST.
 	opt.Levels = []pebble.LevelOptions{
@@ -217,6 +235,9 @@ func (em *engineManager) openEngineDB(engineUUID uuid.UUID, readOnly bool) (*peb
 
 	dbPath := filepath.Join(em.LocalStoreDir, engineUUID.String())
 	db, err := pebble.Open(dbPath, opt)
+	if err != nil {
+		em.openFiles.release(engineUUID)
+	}
 	return db, errors.Trace(err)
 }
 
@@ -252,6 +273,7 @@ func (em *engineManager) openEngine(ctx context.Context, cfg *backend.EngineConf
 		dupDetectOpt:       em.DuplicateDetectOpt,
 		duplicateDB:        em.duplicateDB,
 		keyAdapter:         em.keyAdapter,
+		fs:                 em.fs,
 		logger:             log.FromContext(ctx),
 	})
 	engine := e.(*Engine)
@@ -334,6 +356,7 @@ func (em *engineManager) closeEngine(
 			duplicateDetection: em.DupeDetectEnabled,
 			dupDetectOpt:       em.DuplicateDetectOpt,
 			duplicateDB:        em.duplicateDB,
+			fs:                 em.fs,
 			logger:             log.FromContext(ctx),
 		}
 		engine.db.Store(db)
@@ -468,6 +491,7 @@ func (em *engineManager) cleanupEngine(ctx context.Context, engineUUID uuid.UUID
 	// remains open/usable.)
 	em.engines.Delete(engineUUID)
 	err := localEngine.Close()
+	em.openFiles.release(engineUUID)
 	if err != nil {
 		return err
 	}
@@ -507,6 +531,7 @@ func (em *engineManager) close() {
 	allLocalEngines := em.lockAllEnginesUnless(importMutexStateClose, 0)
 	for _, e := range allLocalEngines {
 		_ = e.Close()
+		em.openFiles.release(e.UUID)
 		e.unlock()
 	}
 	em.engines = sync.Map{}
@@ -578,13 +603,14 @@ func (em *engineManager) getBufferPool() *membuf.Pool {
 	return em.bufferPool
 }
 
-func openDuplicateDB(storeDir string) (*pebble.DB, error) {
+func openDuplicateDB(storeDir string, fs vfs.FS) (*pebble.DB, error) {
 	dbPath := filepath.Join(storeDir, duplicateDBName)
 	// TODO: Optimize the opts for better write.
 	opts := &pebble.Options{
 		TablePropertyCollectors: []func() pebble.TablePropertyCollector{
 			newRangePropertiesCollector,
 		},
+		FS: fs,
 	}
 	return pebble.Open(dbPath, opts)
 }
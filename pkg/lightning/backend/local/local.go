@@ -60,6 +60,7 @@ import (
 	pd "github.com/tikv/pd/client"
 	pdhttp "github.com/tikv/pd/client/http"
 	"github.com/tikv/pd/client/retry"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
@@ -407,6 +408,8 @@ type BackendConfig struct {
 	DuplicateDetectOpt common.DupDetectOpt
 	// max write speed in bytes per second to each store(burst is allowed), 0 means no limit
 	StoreWriteBWLimit int
+	// max number of concurrent write streams to each store, 0 means no limit
+	StoreWriteConcurrency int
 	// When TiKV is in normal mode, ingesting too many SSTs will cause TiKV write stall.
 	// To avoid this, we should check write stall before ingesting SSTs. Note that, we
 	// must check both leader node and followers in client side, because followers will
@@ -427,6 +430,13 @@ type BackendConfig struct {
 	// default true.
 	DisableAutomaticCompactions bool
 	BlockSize                   int
+	// whether take the ownership of the region by a lease in etcd before writing and ingesting it,
+	// it's used when multiple instances import into the same table in parallel.
+	RangeLease bool
+	// the method to encrypt the SST files and the pebble DB files in LocalStoreDir, and the path of
+	// the file of the master key. The files aren't encrypted if the method is empty or plaintext.
+	EncryptionMethod        string
+	EncryptionMasterKeyPath string
 }
 
 // NewBackendConfig creates a new BackendConfig.
@@ -434,7 +444,7 @@ func NewBackendConfig(cfg *config.Config, maxOpenFiles int, keyspaceName, resour
 	return BackendConfig{
 		PDAddr:                      cfg.TiDB.PdAddr,
 		LocalStoreDir:               cfg.TikvImporter.SortedKVDir,
-		MaxConnPerStore:             cfg.TikvImporter.RangeConcurrency,
+		MaxConnPerStore:             cfg.TikvImporter.MaxConnPerStore,
 		ConnCompressType:            cfg.TikvImporter.CompressKVPairs,
 		WorkerConcurrency:           cfg.TikvImporter.RangeConcurrency * 2,
 		BlockSize:                   int(cfg.TikvImporter.BlockSize),
@@ -448,6 +458,7 @@ func NewBackendConfig(cfg *config.Config, maxOpenFiles int, keyspaceName, resour
 		DupeDetectEnabled:           cfg.Conflict.Strategy != config.NoneOnDup,
 		DuplicateDetectOpt:          common.DupDetectOpt{ReportErrOnDup: cfg.Conflict.Strategy == config.ErrorOnDup},
 		StoreWriteBWLimit:           int(cfg.TikvImporter.StoreWriteBWLimit),
+		StoreWriteConcurrency:       cfg.TikvImporter.StoreWriteConcurrency,
 		ShouldCheckWriteStall:       cfg.Cron.SwitchMode.Duration == 0,
 		MaxOpenFiles:                maxOpenFiles,
 		KeyspaceName:                keyspaceName,
@@ -456,6 +467,9 @@ func NewBackendConfig(cfg *config.Config, maxOpenFiles int, keyspaceName, resour
 		TaskType:                    taskType,
 		RaftKV2SwitchModeDuration:   raftKV2SwitchModeDuration,
 		DisableAutomaticCompactions: true,
+		RangeLease:                  cfg.TikvImporter.RangeLease,
+		EncryptionMethod:            cfg.TikvImporter.EncryptionMethod,
+		EncryptionMasterKeyPath:     cfg.TikvImporter.EncryptionMasterKeyPath,
 	}
 }
 
@@ -478,9 +492,12 @@ type Backend struct {
 	supportMultiIngest  bool
 	importClientFactory ImportClientFactory
 
-	metrics      *metric.Common
-	writeLimiter StoreWriteLimiter
-	logger       log.Logger
+	metrics       *metric.Common
+	writeLimiter  StoreWriteLimiter
+	streamLimiter *storeWriteStreamLimiter
+	logger        log.Logger
+	// rangeLease is nil if BackendConfig.RangeLease is false.
+	rangeLease *rangeLeaseManager
 	// This mutex is used to do some mutual exclusion work in the backend, flushKVs() in writer for now.
 	mu sync.Mutex
 }
@@ -556,12 +573,8 @@ func NewBackend(
 	).WithBackoffer(retry.InitialBackoffer(time.Second, time.Second, pdutil.PDRequestRetryTime*time.Second))
 	splitCli := split.NewSplitClient(pdCli, pdHTTPCli, tls.TLSConfig(), false)
 	importClientFactory := newImportClientFactoryImpl(splitCli, tls, config.MaxConnPerStore, config.ConnCompressType)
-	var writeLimiter StoreWriteLimiter
-	if config.StoreWriteBWLimit > 0 {
-		writeLimiter = newStoreWriteLimiter(config.StoreWriteBWLimit)
-	} else {
-		writeLimiter = noopStoreWriteLimiter{}
-	}
+	// The limiter is created even if there is no limit, so the limit can be updated later.
+	writeLimiter := newStoreWriteLimiter(config.StoreWriteBWLimit)
 	local := &Backend{
 		pdCli:     pdCli,
 		pdHTTPCli: pdHTTPCli,
@@ -574,6 +587,7 @@ func NewBackend(
 
 		importClientFactory: importClientFactory,
 		writeLimiter:        writeLimiter,
+		streamLimiter:       newStoreWriteStreamLimiter(config.StoreWriteConcurrency),
 		logger:              log.FromContext(ctx),
 	}
 	engineMgr, err := newEngineManager(config, local, local.logger)
@@ -584,6 +598,17 @@ func NewBackend(
 	if m, ok := metric.GetCommonMetric(ctx); ok {
 		local.metrics = m
 	}
+	if config.RangeLease {
+		etcdCli, err := clientv3.New(clientv3.Config{
+			Endpoints:        pdAddrs,
+			AutoSyncInterval: 30 * time.Second,
+			TLS:              tls.TLSConfig(),
+		})
+		if err != nil {
+			return nil, common.ErrCreateKVClient.Wrap(err).GenWithStackByArgs()
+		}
+		local.rangeLease = newRangeLeaseManager(etcdCli, rangeLeaseTTL)
+	}
 	if err = local.checkMultiIngestSupport(ctx); err != nil {
 		return nil, common.ErrCheckMultiIngest.Wrap(err).GenWithStackByArgs()
 	}
@@ -618,6 +643,13 @@ func (local *Backend) TotalMemoryConsume() int64 {
 	return local.engineMgr.totalMemoryConsume()
 }
 
+// UpdateWriteSpeedLimit updates the write speed limit of each store, the limit <= 0 means no limit.
+func (local *Backend) UpdateWriteSpeedLimit(limit int) {
+	if l, ok := local.writeLimiter.(*storeWriteLimiter); ok {
+		l.UpdateLimit(limit)
+	}
+}
+
 func (local *Backend) checkMultiIngestSupport(ctx context.Context) error {
 	stores, err := local.pdCli.GetAllStores(ctx, pd.WithExcludeTombstone())
 	if err != nil {
@@ -716,6 +748,9 @@ func (local *Backend) Close() {
 	_ = local.tikvCli.Close()
 	local.pdHTTPCli.Close()
 	local.pdCli.Close()
+	if local.rangeLease != nil {
+		_ = local.rangeLease.close()
+	}
 }
 
 // FlushEngine ensure the written data is saved successfully, to make sure no data lose after restart
@@ -1194,6 +1229,14 @@ func (local *Backend) executeJob(
 		}
 	}
 
+	if local.rangeLease != nil {
+		release, err := local.rangeLease.acquire(ctx, job.region.Region.GetId())
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
 	for {
 		err := local.writeToTiKV(ctx, job)
 		if err != nil {
@@ -90,3 +90,29 @@ func VerifyRLimit(estimateMaxFiles RlimT) error {
 		zapRlimT("old", prevLimit), zapRlimT("new", estimateMaxFiles))
 	return nil
 }
+
+// RaiseRLimit tries to raise the soft open-file limit to the target, but no more
+// than the hard limit. Unlike VerifyRLimit, it doesn't fail when the target can't
+// be reached, and returns the limit after raising.
+func RaiseRLimit(target RlimT) (RlimT, error) {
+	target = min(target, maxRLimit)
+	var rLimit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rLimit); err != nil {
+		return 0, errors.Trace(err)
+	}
+	prevLimit := rLimit.Cur
+	newLimit := min(target, rLimit.Max)
+	if newLimit <= prevLimit {
+		return prevLimit, nil
+	}
+	rLimit.Cur = newLimit
+	if err := syscall.Setrlimit(syscall.RLIMIT_NOFILE, &rLimit); err != nil {
+		return prevLimit, errors.Trace(err)
+	}
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rLimit); err != nil {
+		return prevLimit, errors.Trace(err)
+	}
+	log.L().Info("raise the maximum number of open file descriptors(rlimit)",
+		zapRlimT("old", prevLimit), zapRlimT("new", rLimit.Cur), zapRlimT("target", target))
+	return rLimit.Cur, nil
+}
@@ -34,3 +34,8 @@ func GetSystemRLimit() (uint64, error) {
 func VerifyRLimit(estimateMaxFiles uint64) error {
 	return errors.New("local-backend is not tested on Windows. Run with --check-requirements=false to disable this check, but you are on your own risk")
 }
+
+// RaiseRLimit is a no-op on Windows, as the open-file limit is regarded as unlimited.
+func RaiseRLimit(uint64) (uint64, error) {
+	return math.MaxInt32, nil
+}
@@ -355,24 +355,30 @@ type storeWriteLimiter struct {
 	burst    int
 }
 
+// newStoreWriteLimiter creates a storeWriteLimiter, the limit <= 0 means no limit.
 func newStoreWriteLimiter(limit int) *storeWriteLimiter {
-	var burst int
-	// Allow burst of at most 20% of the limit.
-	if limit <= math.MaxInt-limit/5 {
-		burst = limit + limit/5
-	} else {
-		// If overflowed, set burst to math.MaxInt.
-		burst = math.MaxInt
-	}
 	return &storeWriteLimiter{
 		limiters: make(map[uint64]*rate.Limiter),
 		limit:    limit,
-		burst:    burst,
+		burst:    calcStoreWriteBurst(limit),
 	}
 }
 
+func calcStoreWriteBurst(limit int) int {
+	// Allow burst of at most 20% of the limit.
+	if limit <= math.MaxInt-limit/5 {
+		return limit + limit/5
+	}
+	// If overflowed, set burst to math.MaxInt.
+	return math.MaxInt
+}
+
 func (s *storeWriteLimiter) WaitN(ctx context.Context, storeID uint64, n int) error {
 	limiter := s.getLimiter(storeID)
+	if limiter == nil {
+		// There is no limit.
+		return nil
+	}
 	// The original WaitN doesn't allow n > burst,
 	// so we call WaitN with burst multiple times.
 	for n > limiter.Burst() {
@@ -385,11 +391,41 @@ func (s *storeWriteLimiter) WaitN(ctx context.Context, storeID uint64, n int) er
 }
 
 func (s *storeWriteLimiter) Limit() int {
+	s.rwm.RLock()
+	defer s.rwm.RUnlock()
+	if s.limit <= 0 {
+		return math.MaxInt
+	}
 	return s.limit
 }
 
+// UpdateLimit updates the write speed limit of each store, the limit <= 0 means no limit.
+// It takes effect on the writes in progress.
+func (s *storeWriteLimiter) UpdateLimit(limit int) {
+	s.rwm.Lock()
+	defer s.rwm.Unlock()
+	if s.limit == limit {
+		return
+	}
+	s.limit = limit
+	s.burst = calcStoreWriteBurst(limit)
+	if limit <= 0 {
+		s.limiters = make(map[uint64]*rate.Limiter)
+		return
+	}
+	for _, limiter := range s.limiters {
+		limiter.SetLimit(rate.Limit(limit))
+		limiter.SetBurst(s.burst)
+	}
+}
+
+// getLimiter returns the limiter of the store, nil means there is no limit.
 func (s *storeWriteLimiter) getLimiter(storeID uint64) *rate.Limiter {
 	s.rwm.RLock()
+	if s.limit <= 0 {
+		s.rwm.RUnlock()
+		return nil
+	}
 	limiter, ok := s.limiters[storeID]
 	s.rwm.RUnlock()
 	if ok {
@@ -397,6 +433,9 @@ func (s *storeWriteLimiter) getLimiter(storeID uint64) *rate.Limiter {
 	}
 	s.rwm.Lock()
 	defer s.rwm.Unlock()
+	if s.limit <= 0 {
+		return nil
+	}
 	limiter, ok = s.limiters[storeID]
 	if !ok {
 		limiter = rate.NewLimiter(rate.Limit(s.limit), s.burst)
@@ -415,6 +454,67 @@ func (noopStoreWriteLimiter) Limit() int {
 	return math.MaxInt
 }
 
+// storeWriteStreamLimiter is a per-store semaphore on the write streams. a region
+// job holds one slot of each store of the region while it writes, and the jobs
+// beyond the limit wait for a slot instead of opening more streams, which avoids
+// exhausting the gRPC resources of TiKV when there are many concurrent jobs. the
+// streams are not shared between the jobs. a nil limiter means no limit.
+type storeWriteStreamLimiter struct {
+	mu    sync.Mutex
+	limit int
+	slots map[uint64]chan struct{}
+}
+
+func newStoreWriteStreamLimiter(limit int) *storeWriteStreamLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &storeWriteStreamLimiter{
+		limit: limit,
+		slots: make(map[uint64]chan struct{}),
+	}
+}
+
+func (s *storeWriteStreamLimiter) getSlots(storeID uint64) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	slots, ok := s.slots[storeID]
+	if !ok {
+		slots = make(chan struct{}, s.limit)
+		s.slots[storeID] = slots
+	}
+	return slots
+}
+
+// acquire acquires one write stream of each store, it blocks until all of them
+// are acquired or ctx is done. the stores are acquired in ascending order to
+// avoid deadlock between the jobs. the returned function releases the streams.
+func (s *storeWriteStreamLimiter) acquire(ctx context.Context, storeIDs []uint64) (func(), error) {
+	if s == nil {
+		return func() {}, nil
+	}
+	storeIDs = slices.Clone(storeIDs)
+	slices.Sort(storeIDs)
+	storeIDs = slices.Compact(storeIDs)
+	acquired := make([]chan struct{}, 0, len(storeIDs))
+	release := func() {
+		for _, slots := range acquired {
+			<-slots
+		}
+	}
+	for _, storeID := range storeIDs {
+		slots := s.getSlots(storeID)
+		select {
+		case slots <- struct{}{}:
+			acquired = append(acquired, slots)
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+	return release, nil
+}
+
 // compaction threshold
 const (
 	CompactionLowerThreshold = 512 * units.MiB
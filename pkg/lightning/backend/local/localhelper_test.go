@@ -793,3 +793,74 @@ func TestStoreWriteLimiter(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestStoreWriteLimiterUpdateLimit(t *testing.T) {
+	ctx := context.Background()
+	// The limit 0 means no limit.
+	limiter := newStoreWriteLimiter(0)
+	require.Equal(t, math.MaxInt, limiter.Limit())
+	start := time.Now()
+	require.NoError(t, limiter.WaitN(ctx, 1, 1<<30))
+	require.Less(t, time.Since(start), time.Second)
+
+	// Limit the write speed of the running limiter.
+	limiter.UpdateLimit(100)
+	require.Equal(t, 100, limiter.Limit())
+	start = time.Now()
+	require.NoError(t, limiter.WaitN(ctx, 1, 120+120))
+	require.Greater(t, time.Since(start), time.Second)
+
+	// The existing limiters are updated too.
+	limiter.UpdateLimit(1 << 30)
+	require.Equal(t, 1<<30, limiter.getLimiter(1).Burst()-(1<<30)/5)
+	start = time.Now()
+	require.NoError(t, limiter.WaitN(ctx, 1, 1<<20))
+	require.Less(t, time.Since(start), time.Second)
+
+	limiter.UpdateLimit(0)
+	require.Nil(t, limiter.getLimiter(1))
+	require.Equal(t, math.MaxInt, limiter.Limit())
+}
+
+func TestStoreWriteStreamLimiter(t *testing.T) {
+	ctx := context.Background()
+	// nil limiter means no limit.
+	var limiter *storeWriteStreamLimiter
+	require.Nil(t, newStoreWriteStreamLimiter(0))
+	release, err := limiter.acquire(ctx, []uint64{1, 2, 3})
+	require.NoError(t, err)
+	release()
+
+	limiter = newStoreWriteStreamLimiter(1)
+	release1, err := limiter.acquire(ctx, []uint64{3, 1, 1})
+	require.NoError(t, err)
+	// store 2 is not occupied.
+	release2, err := limiter.acquire(ctx, []uint64{2})
+	require.NoError(t, err)
+
+	// store 1 is occupied, and the acquired store 2 should be released when
+	// ctx is done.
+	release2()
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	_, err = limiter.acquire(timeoutCtx, []uint64{2, 1})
+	cancel()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	release2, err = limiter.acquire(ctx, []uint64{2})
+	require.NoError(t, err)
+	release2()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		release, err := limiter.acquire(ctx, []uint64{1, 2, 3})
+		require.NoError(t, err)
+		release()
+	}()
+	select {
+	case <-done:
+		require.Fail(t, "should be blocked by the occupied stores")
+	case <-time.After(100 * time.Millisecond):
+	}
+	release1()
+	<-done
+}
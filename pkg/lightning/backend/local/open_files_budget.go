@@ -0,0 +1,107 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"math"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/tidb/pkg/lightning/log"
+	"go.uber.org/zap"
+)
+
+// openFilesBudget budgets the open files of the pebble DBs of the engines which are
+// opened at the same time. Each pebble DB may keep up to its MaxOpenFiles files open,
+// so without budgeting, the concurrent engines together can exceed the open-file limit
+// of the process, and fail with "too many open files" deep inside pebble.
+//
+// When the remaining budget isn't enough for a new engine, the budget tries to raise
+// the open-file limit first. If it still isn't enough, the engine gets a smaller share,
+// at least openFilesLowerThreshold, and pebble closes the cold files to keep under it,
+// so the engines run slower instead of failing.
+type openFilesBudget struct {
+	// raiseLimit raises the open-file limit to the target, it returns the limit
+	// after raising.
+	raiseLimit func(target RlimT) (RlimT, error)
+	logger     log.Logger
+
+	mu sync.Mutex
+	// limit is the open-file limit of the process.
+	limit int
+	used  int
+	// engines is the open files granted to each engine.
+	engines map[uuid.UUID]int
+}
+
+func newOpenFilesBudget(limit RlimT, raiseLimit func(target RlimT) (RlimT, error), logger log.Logger) *openFilesBudget {
+	return &openFilesBudget{
+		raiseLimit: raiseLimit,
+		logger:     logger,
+		limit:      rlimitToInt(limit),
+		engines:    make(map[uuid.UUID]int),
+	}
+}
+
+func rlimitToInt(limit RlimT) int {
+	return int(min(uint64(limit), math.MaxInt32))
+}
+
+// available returns the open files can be granted to the engines, a quarter of the
+// limit is reserved for the network connections and the other files of the process.
+func (b *openFilesBudget) available() int {
+	return b.limit - b.limit/4 - b.used
+}
+
+// acquire returns the max open files of the pebble DB of the engine, want is the
+// expected value. An engine which is reopened gives back its previous share first.
+func (b *openFilesBudget) acquire(engineUUID uuid.UUID, want int) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.releaseLocked(engineUUID)
+
+	if want > b.available() {
+		target := RlimT((b.used+want)*4/3 + 1)
+		newLimit, err := b.raiseLimit(target)
+		if err != nil {
+			b.logger.Warn("failed to raise the open-file limit", zap.Int("old", b.limit),
+				zap.Uint64("target", uint64(target)), log.ShortError(err))
+		} else {
+			b.limit = max(b.limit, rlimitToInt(newLimit))
+		}
+	}
+	granted := min(want, b.available())
+	if granted < want {
+		granted = max(granted, openFilesLowerThreshold)
+		b.logger.Warn("the open-file limit isn't enough for the engines, reduce the open files of the engine",
+			zap.Stringer("engine", engineUUID), zap.Int("limit", b.limit), zap.Int("used", b.used),
+			zap.Int("expected", want), zap.Int("granted", granted))
+	}
+	b.engines[engineUUID] = granted
+	b.used += granted
+	return granted
+}
+
+// release gives back the open files of the engine.
+func (b *openFilesBudget) release(engineUUID uuid.UUID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.releaseLocked(engineUUID)
+}
+
+func (b *openFilesBudget) releaseLocked(engineUUID uuid.UUID) {
+	b.used -= b.engines[engineUUID]
+	delete(b.engines, engineUUID)
+}
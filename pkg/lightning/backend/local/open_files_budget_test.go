@@ -0,0 +1,63 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/lightning/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenFilesBudget(t *testing.T) {
+	hardLimit := RlimT(4000)
+	var raiseErr error
+	raiseLimit := func(target RlimT) (RlimT, error) {
+		if raiseErr != nil {
+			return 0, raiseErr
+		}
+		return min(target, hardLimit), nil
+	}
+	b := newOpenFilesBudget(1000, raiseLimit, log.L())
+	e1, e2, e3, e4 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// 750 files are available under the limit.
+	require.Equal(t, 500, b.acquire(e1, 500))
+	require.Equal(t, 1000, b.limit)
+	// raise the limit to (500+1000)*4/3+1.
+	require.Equal(t, 1000, b.acquire(e2, 1000))
+	require.Equal(t, 2001, b.limit)
+	// reopening an engine doesn't take more files.
+	require.Equal(t, 1000, b.acquire(e2, 1000))
+	require.Equal(t, 1500, b.used)
+
+	// can't raise over the hard limit, the engine gets the remaining files.
+	require.Equal(t, 1500, b.acquire(e3, 2000))
+	require.Equal(t, 4000, b.limit)
+	// no more files, the engine still gets the lower threshold.
+	raiseErr = errors.New("mock raise error")
+	require.Equal(t, openFilesLowerThreshold, b.acquire(e4, 500))
+	require.Equal(t, 4000, b.limit)
+
+	b.release(e3)
+	b.release(e4)
+	require.Equal(t, 1500, b.used)
+	require.Equal(t, 1000, b.acquire(e3, 1000))
+	// release the unknown engine is a no-op.
+	b.release(uuid.New())
+	require.Equal(t, 2500, b.used)
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/lightning/log"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.uber.org/zap"
+)
+
+const (
+	// rangeLeasePrefix is the etcd key prefix of the region ownership leases.
+	rangeLeasePrefix = "/tidb/lightning/range-lease"
+	// rangeLeaseTTL is the TTL in seconds of the etcd session holding the leases,
+	// the leases of a crashed instance are released after it.
+	rangeLeaseTTL = 30
+)
+
+// rangeLeaseManager coordinates the local backends of multiple lightning instances importing
+// into the same table in parallel. Before writing and ingesting a region, the backend takes
+// the ownership of the region by a lease in etcd, so the instances ingest disjoint key ranges
+// at the same time instead of conflicting on the same region.
+type rangeLeaseManager struct {
+	cli *clientv3.Client
+	ttl int
+
+	mu      sync.Mutex
+	session *concurrency.Session
+}
+
+func newRangeLeaseManager(cli *clientv3.Client, ttl int) *rangeLeaseManager {
+	return &rangeLeaseManager{cli: cli, ttl: ttl}
+}
+
+// getSession returns the etcd session holding the leases, a new session is created
+// if the previous one is expired.
+func (m *rangeLeaseManager) getSession() (*concurrency.Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.session != nil {
+		select {
+		case <-m.session.Done():
+		default:
+			return m.session, nil
+		}
+	}
+	se, err := concurrency.NewSession(m.cli, concurrency.WithTTL(m.ttl))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	m.session = se
+	return se, nil
+}
+
+// acquire blocks until the region is owned by the backend, the returned function releases it.
+func (m *rangeLeaseManager) acquire(ctx context.Context, regionID uint64) (release func(), err error) {
+	se, err := m.getSession()
+	if err != nil {
+		return nil, err
+	}
+	mu := concurrency.NewMutex(se, fmt.Sprintf("%s/%d", rangeLeasePrefix, regionID))
+	if err = mu.Lock(ctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return func() {
+		// The lease is released with the session anyway if unlocking fails.
+		if err := mu.Unlock(context.Background()); err != nil {
+			log.FromContext(ctx).Warn("failed to release the region lease",
+				zap.Uint64("regionID", regionID), log.ShortError(err))
+		}
+	}, nil
+}
+
+func (m *rangeLeaseManager) close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.session != nil {
+		_ = m.session.Close()
+		m.session = nil
+	}
+	return m.cli.Close()
+}
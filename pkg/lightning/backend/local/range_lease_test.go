@@ -0,0 +1,75 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/tests/v3/integration"
+)
+
+func TestRangeLease(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("integration.NewClusterV3 will create file contains a colon which is not allowed on Windows")
+	}
+	integration.BeforeTestExternal(t)
+	cluster := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer cluster.Terminate(t)
+
+	newManager := func() *rangeLeaseManager {
+		cli, err := clientv3.New(clientv3.Config{Endpoints: cluster.Client(0).Endpoints()})
+		require.NoError(t, err)
+		return newRangeLeaseManager(cli, 5)
+	}
+	m1, m2 := newManager(), newManager()
+	defer func() {
+		require.NoError(t, m1.close())
+		require.NoError(t, m2.close())
+	}()
+
+	ctx := context.Background()
+	release1, err := m1.acquire(ctx, 1)
+	require.NoError(t, err)
+	// the other regions can be owned by the other instance at the same time.
+	release2, err := m2.acquire(ctx, 2)
+	require.NoError(t, err)
+	release2()
+
+	// the region owned by the other instance can't be acquired until it's released.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	_, err = m2.acquire(timeoutCtx, 1)
+	cancel()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	acquired := make(chan struct{})
+	go func() {
+		release, err := m2.acquire(ctx, 1)
+		require.NoError(t, err)
+		release()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		require.FailNow(t, "the region is owned by two instances")
+	case <-time.After(200 * time.Millisecond):
+	}
+	release1()
+	<-acquired
+}
@@ -281,6 +281,16 @@ func (local *Backend) doWrite(ctx context.Context, j *regionJob) error {
 		)
 	}
 
+	storeIDs := make([]uint64, 0, len(region.GetPeers()))
+	for _, peer := range region.GetPeers() {
+		storeIDs = append(storeIDs, peer.StoreId)
+	}
+	releaseStreams, err := local.streamLimiter.acquire(ctx, storeIDs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer releaseStreams()
+
 	leaderID := j.region.Leader.GetId()
 	clients := make([]sst.ImportSST_WriteClient, 0, len(region.GetPeers()))
 	allPeers := make([]*metapb.Peer, 0, len(region.GetPeers()))
@@ -0,0 +1,269 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bulkload is a stable API over the local backend of lightning for the
+// programs which encode the KV pairs by themselves and want to ingest them into
+// TiKV directly. The typical usage is:
+//
+//	ingestor, err := bulkload.Open(ctx, cfg)
+//	defer ingestor.Close()
+//	engine, err := ingestor.OpenEngine(ctx, "db.tbl", 0, onProgress)
+//	err = engine.WriteRows(ctx, pairs) // may be called many times
+//	err = engine.ImportAndCleanup(ctx)
+//
+// The internal packages of lightning may change between releases, while the
+// API of this package keeps compatible.
+package bulkload
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/lightning/backend"
+	"github.com/pingcap/tidb/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/pkg/lightning/backend/local"
+	"github.com/pingcap/tidb/pkg/lightning/common"
+	"github.com/pingcap/tidb/pkg/lightning/config"
+	kvutil "github.com/tikv/client-go/v2/util"
+)
+
+// ErrDiskQuotaExceeded is returned by Engine.WriteRows when the size of the
+// sorted KV files of all the engines exceeds Config.DiskQuota. The written
+// engines should be imported to release the disk space before writing more.
+var ErrDiskQuotaExceeded = errors.New("bulkload: disk quota exceeded")
+
+// Config is the configuration of an Ingestor.
+type Config struct {
+	// PDAddrs are the addresses of the PD servers, required.
+	PDAddrs []string
+	// CAPath, CertPath and KeyPath are the TLS files to connect to the
+	// cluster. They're left empty if TLS is disabled.
+	CAPath   string
+	CertPath string
+	KeyPath  string
+	// SortedKVDir is the local directory to sort the KV pairs, required.
+	SortedKVDir string
+	// DiskQuota is the upper bound of the disk space used in SortedKVDir in
+	// bytes, 0 means unlimited.
+	DiskQuota int64
+	// MemoryQuota is the memory in bytes used to cache the KV pairs of each
+	// engine before flushing them to disk, 0 means the default.
+	MemoryQuota int64
+	// Concurrency is the number of the ranges imported concurrently, 0 means
+	// the default.
+	Concurrency int
+	// MaxWriteSpeed is the upper bound of the write speed to each TiKV store in
+	// bytes per second, 0 means unlimited.
+	MaxWriteSpeed int64
+	// KeyspaceName is the keyspace of the KV pairs, empty if the cluster isn't
+	// keyspace enabled.
+	KeyspaceName string
+	// ResourceGroupName is the resource group which the import requests are
+	// charged to.
+	ResourceGroupName string
+	// RegionSplitSize and RegionSplitKeys are the size and the number of keys
+	// of the regions split before importing, 0 means the defaults.
+	RegionSplitSize int64
+	RegionSplitKeys int64
+}
+
+// KVPair is an encoded KV pair to be ingested.
+type KVPair struct {
+	Key []byte
+	Val []byte
+}
+
+// Progress is the progress of an engine.
+type Progress struct {
+	// WrittenKVs and WrittenBytes are the KV pairs written into the engine.
+	WrittenKVs   int64
+	WrittenBytes int64
+	// ImportedKVs is the number of the KV pairs imported into TiKV, it's only
+	// set after the engine is imported.
+	ImportedKVs int64
+}
+
+// ProgressFunc is called with the accumulated progress after each successful
+// write and import of an engine.
+type ProgressFunc func(Progress)
+
+// Ingestor ingests the KV pairs into a TiKV cluster. It's safe for concurrent
+// use, and the engines opened from it can be written in parallel.
+type Ingestor struct {
+	backend       backend.Backend
+	engineMgr     backend.EngineManager
+	diskUsage     func() int64
+	importedKVs   func(*backend.ClosedEngine) int64
+	diskQuota     int64
+	splitSize     int64
+	splitKeys     int64
+	localWriteCfg *backend.LocalWriterConfig
+}
+
+// Open creates an Ingestor connected to the cluster.
+func Open(ctx context.Context, cfg Config) (*Ingestor, error) {
+	if len(cfg.PDAddrs) == 0 {
+		return nil, errors.New("bulkload: PDAddrs is required")
+	}
+	if cfg.SortedKVDir == "" {
+		return nil, errors.New("bulkload: SortedKVDir is required")
+	}
+	lightningCfg := config.NewConfig()
+	lightningCfg.TikvImporter.Backend = config.BackendLocal
+	lightningCfg.TikvImporter.SortedKVDir = cfg.SortedKVDir
+	if cfg.Concurrency > 0 {
+		lightningCfg.TikvImporter.RangeConcurrency = cfg.Concurrency
+	}
+	if cfg.MemoryQuota > 0 {
+		lightningCfg.TikvImporter.LocalWriterMemCacheSize = config.ByteSize(cfg.MemoryQuota)
+	}
+	if cfg.MaxWriteSpeed > 0 {
+		lightningCfg.TikvImporter.StoreWriteBWLimit = config.ByteSize(cfg.MaxWriteSpeed)
+	}
+	lightningCfg.TiDB.PdAddr = strings.Join(cfg.PDAddrs, ",")
+	lightningCfg.Security.CAPath = cfg.CAPath
+	lightningCfg.Security.CertPath = cfg.CertPath
+	lightningCfg.Security.KeyPath = cfg.KeyPath
+	if err := lightningCfg.AdjustForDDL(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	tls, err := common.NewTLS(cfg.CAPath, cfg.CertPath, cfg.KeyPath, "", nil, nil, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	maxOpenFiles, err := local.GetSystemRLimit()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	backendCfg := local.NewBackendConfig(lightningCfg, int(maxOpenFiles), cfg.KeyspaceName,
+		cfg.ResourceGroupName, kvutil.ExplicitTypeLightning, 0)
+	localBackend, err := local.NewBackend(ctx, tls, backendCfg, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	ingestor := newIngestor(localBackend, cfg)
+	ingestor.diskUsage = func() int64 {
+		var total int64
+		for _, size := range localBackend.EngineFileSizes() {
+			total += size.DiskSize + size.MemSize
+		}
+		return total
+	}
+	ingestor.importedKVs = func(engine *backend.ClosedEngine) int64 {
+		return localBackend.GetImportedKVCount(engine.GetUUID())
+	}
+	return ingestor, nil
+}
+
+func newIngestor(b backend.Backend, cfg Config) *Ingestor {
+	splitSize, splitKeys := cfg.RegionSplitSize, cfg.RegionSplitKeys
+	if splitSize <= 0 {
+		splitSize = int64(config.SplitRegionSize)
+	}
+	if splitKeys <= 0 {
+		splitKeys = int64(config.SplitRegionKeys)
+	}
+	return &Ingestor{
+		backend:       b,
+		engineMgr:     backend.MakeEngineManager(b),
+		diskUsage:     func() int64 { return 0 },
+		importedKVs:   func(*backend.ClosedEngine) int64 { return 0 },
+		diskQuota:     cfg.DiskQuota,
+		splitSize:     splitSize,
+		splitKeys:     splitKeys,
+		localWriteCfg: &backend.LocalWriterConfig{},
+	}
+}
+
+// OpenEngine opens an engine to write the KV pairs. The name and the id
+// identify the engine, an engine with the same name and id must not be opened
+// twice at the same time. onProgress can be nil.
+func (i *Ingestor) OpenEngine(ctx context.Context, name string, id int32, onProgress ProgressFunc) (*Engine, error) {
+	opened, err := i.engineMgr.OpenEngine(ctx, &backend.EngineConfig{}, name, id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	writer, err := opened.LocalWriter(ctx, i.localWriteCfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if onProgress == nil {
+		onProgress = func(Progress) {}
+	}
+	return &Engine{
+		ingestor:   i,
+		opened:     opened,
+		writer:     writer,
+		onProgress: onProgress,
+	}, nil
+}
+
+// Close closes the connections to the cluster. The engines which are not
+// imported are left in SortedKVDir.
+func (i *Ingestor) Close() {
+	i.backend.Close()
+}
+
+// Engine sorts the written KV pairs locally and imports them into TiKV at
+// once. An Engine isn't safe for concurrent use, open more engines to write
+// in parallel.
+type Engine struct {
+	ingestor   *Ingestor
+	opened     *backend.OpenedEngine
+	writer     backend.EngineWriter
+	onProgress ProgressFunc
+
+	progress Progress
+}
+
+// WriteRows writes the KV pairs into the engine.
+func (e *Engine) WriteRows(ctx context.Context, pairs []KVPair) error {
+	if quota := e.ingestor.diskQuota; quota > 0 && e.ingestor.diskUsage() > quota {
+		return ErrDiskQuotaExceeded
+	}
+	kvPairs := make([]common.KvPair, 0, len(pairs))
+	var size int64
+	for _, p := range pairs {
+		kvPairs = append(kvPairs, common.KvPair{Key: p.Key, Val: p.Val})
+		size += int64(len(p.Key) + len(p.Val))
+	}
+	if err := e.writer.AppendRows(ctx, nil, kv.MakeRowsFromKvPairs(kvPairs)); err != nil {
+		return errors.Trace(err)
+	}
+	e.progress.WrittenKVs += int64(len(pairs))
+	e.progress.WrittenBytes += size
+	e.onProgress(e.progress)
+	return nil
+}
+
+// ImportAndCleanup imports all the written KV pairs into TiKV and removes the
+// local files of the engine. The engine can't be used afterwards.
+func (e *Engine) ImportAndCleanup(ctx context.Context) error {
+	if _, err := e.writer.Close(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	closed, err := e.opened.Close(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err = closed.Import(ctx, e.ingestor.splitSize, e.ingestor.splitKeys); err != nil {
+		return errors.Trace(err)
+	}
+	e.progress.ImportedKVs = e.ingestor.importedKVs(closed)
+	e.onProgress(e.progress)
+	return errors.Trace(closed.Cleanup(ctx))
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkload
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/mock"
+	"github.com/pingcap/tidb/pkg/lightning/backend"
+	"github.com/pingcap/tidb/pkg/lightning/backend/encode"
+	"github.com/pingcap/tidb/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/pkg/lightning/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEngineWriteAndImport(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockBackend := mock.NewMockBackend(controller)
+	mockWriter := mock.NewMockEngineWriter(controller)
+	ctx := context.Background()
+	_, engineUUID := backend.MakeUUID("`db`.`t`", 1)
+
+	ingestor := newIngestor(mockBackend, Config{DiskQuota: 100})
+	var diskUsage int64
+	ingestor.diskUsage = func() int64 { return diskUsage }
+	ingestor.importedKVs = func(*backend.ClosedEngine) int64 { return 3 }
+
+	mockBackend.EXPECT().OpenEngine(ctx, &backend.EngineConfig{}, engineUUID).Return(nil)
+	mockBackend.EXPECT().LocalWriter(ctx, gomock.Any(), engineUUID).Return(mockWriter, nil)
+	var progresses []Progress
+	engine, err := ingestor.OpenEngine(ctx, "`db`.`t`", 1, func(p Progress) {
+		progresses = append(progresses, p)
+	})
+	require.NoError(t, err)
+
+	mockWriter.EXPECT().AppendRows(ctx, gomock.Nil(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ []string, rows encode.Rows) error {
+			require.Len(t, kv.Rows2KvPairs(rows), 2)
+			return nil
+		})
+	err = engine.WriteRows(ctx, []KVPair{{Key: []byte("a"), Val: []byte("1")}, {Key: []byte("b"), Val: []byte("22")}})
+	require.NoError(t, err)
+	mockWriter.EXPECT().AppendRows(ctx, gomock.Nil(), gomock.Any()).Return(nil)
+	err = engine.WriteRows(ctx, []KVPair{{Key: []byte("c"), Val: []byte("333")}})
+	require.NoError(t, err)
+
+	// no more rows are accepted when the disk quota is exceeded.
+	diskUsage = 101
+	err = engine.WriteRows(ctx, []KVPair{{Key: []byte("d"), Val: []byte("4")}})
+	require.ErrorIs(t, err, ErrDiskQuotaExceeded)
+
+	mockWriter.EXPECT().Close(ctx).Return(nil, nil)
+	mockBackend.EXPECT().CloseEngine(ctx, &backend.EngineConfig{}, engineUUID).Return(nil)
+	mockBackend.EXPECT().ImportEngine(ctx, engineUUID, int64(config.SplitRegionSize), int64(config.SplitRegionKeys)).Return(nil)
+	mockBackend.EXPECT().CleanupEngine(ctx, engineUUID).Return(nil)
+	require.NoError(t, engine.ImportAndCleanup(ctx))
+
+	require.Equal(t, []Progress{
+		{WrittenKVs: 2, WrittenBytes: 5},
+		{WrittenKVs: 3, WrittenBytes: 9},
+		{WrittenKVs: 3, WrittenBytes: 9, ImportedKVs: 3},
+	}, progresses)
+}
@@ -71,7 +71,7 @@ const WholeTableEngineID = math.MaxInt32
 // remember to increase the version number in case of incompatible change.
 const (
 	CheckpointTableNameTask   = "task_v2"
-	CheckpointTableNameTable  = "table_v9"
+	CheckpointTableNameTable  = "table_v10"
 	CheckpointTableNameEngine = "engine_v5"
 	CheckpointTableNameChunk  = "chunk_v5"
 )
@@ -113,6 +113,10 @@ const (
 			kv_bytes bigint unsigned NOT NULL DEFAULT 0,
 			kv_kvs bigint unsigned NOT NULL DEFAULT 0,
 			kv_checksum bigint unsigned NOT NULL DEFAULT 0,
+			checksum_ranges bigint NOT NULL DEFAULT 0,
+			checksum_kv_bytes bigint unsigned NOT NULL DEFAULT 0,
+			checksum_kv_kvs bigint unsigned NOT NULL DEFAULT 0,
+			checksum_kv_checksum bigint unsigned NOT NULL DEFAULT 0,
 			INDEX(task_id)
 		);`
 	CreateEngineTableTemplate = `
@@ -168,7 +172,9 @@ const (
 		FROM %s.%s WHERE table_name = ?
 		ORDER BY engine_id, path, offset;`
 	ReadTableRemainTemplate = `
-		SELECT status, alloc_base, table_id, table_info, kv_bytes, kv_kvs, kv_checksum FROM %s.%s WHERE table_name = ?;`
+		SELECT status, alloc_base, table_id, table_info, kv_bytes, kv_kvs, kv_checksum,
+			checksum_ranges, checksum_kv_bytes, checksum_kv_kvs, checksum_kv_checksum
+		FROM %s.%s WHERE table_name = ?;`
 	ReplaceEngineTemplate = `
 		REPLACE INTO %s.%s (table_name, engine_id, status) VALUES (?, ?, ?);`
 	ReplaceChunkTemplate = `
@@ -190,8 +196,11 @@ const (
 		UPDATE %s.%s SET alloc_base = GREATEST(?, alloc_base) WHERE table_name = ?;`
 	UpdateTableStatusTemplate = `
 		UPDATE %s.%s SET status = ? WHERE table_name = ?;`
-	UpdateTableChecksumTemplate = `UPDATE %s.%s SET kv_bytes = ?, kv_kvs = ?, kv_checksum = ? WHERE table_name = ?;`
-	UpdateEngineTemplate        = `
+	UpdateTableChecksumTemplate         = `UPDATE %s.%s SET kv_bytes = ?, kv_kvs = ?, kv_checksum = ? WHERE table_name = ?;`
+	UpdateTableChecksumProgressTemplate = `
+		UPDATE %s.%s SET checksum_ranges = ?, checksum_kv_bytes = ?, checksum_kv_kvs = ?, checksum_kv_checksum = ?
+		WHERE table_name = ?;`
+	UpdateEngineTemplate = `
 		UPDATE %s.%s SET status = ? WHERE (table_name, engine_id) = (?, ?);`
 	DeleteCheckpointRecordTemplate = "DELETE FROM %s.%s WHERE table_name = ?;"
 )
@@ -348,6 +357,16 @@ type TableCheckpoint struct {
 	TableInfo *model.TableInfo
 	// remote checksum before restore
 	Checksum verify.KVChecksum
+	// ChecksumProgress is the progress of the remote checksum after restore.
+	ChecksumProgress ChecksumProgress
+}
+
+// ChecksumProgress is the progress of the remote checksum of a table. The checksum requests
+// of a table are built and sent in a fixed order, so the progress is the number of the
+// finished ranges and the checksum of them, the resumed checksum skips these ranges.
+type ChecksumProgress struct {
+	FinishedRanges int64
+	Checksum       verify.KVChecksum
 }
 
 // DeepCopy returns a deep copy of the table checkpoint.
@@ -357,11 +376,12 @@ func (cp *TableCheckpoint) DeepCopy() *TableCheckpoint {
 		engines[engineID] = engine.DeepCopy()
 	}
 	return &TableCheckpoint{
-		Status:    cp.Status,
-		AllocBase: cp.AllocBase,
-		Engines:   engines,
-		TableID:   cp.TableID,
-		Checksum:  cp.Checksum,
+		Status:           cp.Status,
+		AllocBase:        cp.AllocBase,
+		Engines:          engines,
+		TableID:          cp.TableID,
+		Checksum:         cp.Checksum,
+		ChecksumProgress: cp.ChecksumProgress,
 	}
 }
 
@@ -389,13 +409,15 @@ type engineCheckpointDiff struct {
 
 // TableCheckpointDiff is the difference between two table checkpoints.
 type TableCheckpointDiff struct {
-	hasStatus   bool
-	hasRebase   bool
-	hasChecksum bool
-	status      CheckpointStatus
-	allocBase   int64
-	engines     map[int32]engineCheckpointDiff
-	checksum    verify.KVChecksum
+	hasStatus           bool
+	hasRebase           bool
+	hasChecksum         bool
+	hasChecksumProgress bool
+	status              CheckpointStatus
+	allocBase           int64
+	engines             map[int32]engineCheckpointDiff
+	checksum            verify.KVChecksum
+	checksumProgress    ChecksumProgress
 }
 
 // NewTableCheckpointDiff returns a new TableCheckpointDiff.
@@ -435,6 +457,9 @@ func (cp *TableCheckpoint) Apply(cpd *TableCheckpointDiff) {
 	if cpd.hasRebase {
 		cp.AllocBase = cpd.allocBase
 	}
+	if cpd.hasChecksumProgress {
+		cp.ChecksumProgress = cpd.checksumProgress
+	}
 	for engineID, engineDiff := range cpd.engines {
 		engine := cp.Engines[engineID]
 		if engine == nil {
@@ -534,6 +559,17 @@ func (m *TableChecksumMerger) MergeInto(cpd *TableCheckpointDiff) {
 	cpd.checksum = m.Checksum
 }
 
+// ChecksumProgressMerger is the merger for the progress of the remote checksum.
+type ChecksumProgressMerger struct {
+	Progress ChecksumProgress
+}
+
+// MergeInto implements TableCheckpointMerger.MergeInto.
+func (m *ChecksumProgressMerger) MergeInto(cpd *TableCheckpointDiff) {
+	cpd.hasChecksumProgress = true
+	cpd.checksumProgress = m.Progress
+}
+
 // RebaseCheckpointMerger is the merger for rebasing the auto-increment ID.
 type RebaseCheckpointMerger struct {
 	AllocBase int64
@@ -927,8 +963,10 @@ func (cpdb *MySQLCheckpointsDB) Get(ctx context.Context, tableName string) (*Tab
 
 		var status uint8
 		var kvs, bytes, checksum uint64
+		var progressKVs, progressBytes, progressChecksum uint64
 		var rawTableInfo []byte
-		if err := tableRow.Scan(&status, &cp.AllocBase, &cp.TableID, &rawTableInfo, &bytes, &kvs, &checksum); err != nil {
+		if err := tableRow.Scan(&status, &cp.AllocBase, &cp.TableID, &rawTableInfo, &bytes, &kvs, &checksum,
+			&cp.ChecksumProgress.FinishedRanges, &progressBytes, &progressKVs, &progressChecksum); err != nil {
 			if err == sql.ErrNoRows {
 				return errors.NotFoundf("checkpoint for table %s", tableName)
 			}
@@ -937,6 +975,7 @@ func (cpdb *MySQLCheckpointsDB) Get(ctx context.Context, tableName string) (*Tab
 			return errors.Trace(err)
 		}
 		cp.Checksum = verify.MakeKVChecksum(bytes, kvs, checksum)
+		cp.ChecksumProgress.Checksum = verify.MakeKVChecksum(progressBytes, progressKVs, progressChecksum)
 		cp.Status = CheckpointStatus(status)
 		return nil
 	})
@@ -1005,6 +1044,7 @@ func (cpdb *MySQLCheckpointsDB) Update(taskCtx context.Context, checkpointDiffs
 	rebaseQuery := common.SprintfWithIdentifiers(UpdateTableRebaseTemplate, cpdb.schema, CheckpointTableNameTable)
 	tableStatusQuery := common.SprintfWithIdentifiers(UpdateTableStatusTemplate, cpdb.schema, CheckpointTableNameTable)
 	tableChecksumQuery := common.SprintfWithIdentifiers(UpdateTableChecksumTemplate, cpdb.schema, CheckpointTableNameTable)
+	tableChecksumProgressQuery := common.SprintfWithIdentifiers(UpdateTableChecksumProgressTemplate, cpdb.schema, CheckpointTableNameTable)
 	engineStatusQuery := common.SprintfWithIdentifiers(UpdateEngineTemplate, cpdb.schema, CheckpointTableNameEngine)
 
 	s := common.SQLWithRetry{DB: cpdb.db, Logger: log.FromContext(taskCtx)}
@@ -1033,6 +1073,12 @@ func (cpdb *MySQLCheckpointsDB) Update(taskCtx context.Context, checkpointDiffs
 		}
 		//nolint: errcheck
 		defer tableChecksumStmt.Close()
+		tableChecksumProgressStmt, e := tx.PrepareContext(c, tableChecksumProgressQuery)
+		if e != nil {
+			return errors.Trace(e)
+		}
+		//nolint: errcheck
+		defer tableChecksumProgressStmt.Close()
 		engineStatusStmt, e := tx.PrepareContext(c, engineStatusQuery)
 		if e != nil {
 			return errors.Trace(e)
@@ -1055,6 +1101,13 @@ func (cpdb *MySQLCheckpointsDB) Update(taskCtx context.Context, checkpointDiffs
 					return errors.Trace(e)
 				}
 			}
+			if cpd.hasChecksumProgress {
+				progress := cpd.checksumProgress
+				if _, e := tableChecksumProgressStmt.ExecContext(c, progress.FinishedRanges,
+					progress.Checksum.SumSize(), progress.Checksum.SumKVS(), progress.Checksum.Sum(), tableName); e != nil {
+					return errors.Trace(e)
+				}
+			}
 			for engineID, engineDiff := range cpd.engines {
 				if engineDiff.hasStatus {
 					if _, e := engineStatusStmt.ExecContext(c, engineDiff.status, tableName, engineID); e != nil {
@@ -1319,6 +1372,10 @@ func (cpdb *FileCheckpointsDB) Get(_ context.Context, tableName string) (*TableC
 		TableID:   tableModel.TableID,
 		TableInfo: tableInfo,
 		Checksum:  verify.MakeKVChecksum(tableModel.KvBytes, tableModel.KvKvs, tableModel.KvChecksum),
+		ChecksumProgress: ChecksumProgress{
+			FinishedRanges: tableModel.ChecksumRanges,
+			Checksum:       verify.MakeKVChecksum(tableModel.ChecksumKvBytes, tableModel.ChecksumKvKvs, tableModel.ChecksumKvChecksum),
+		},
 	}
 
 	for engineID, engineModel := range tableModel.Engines {
@@ -1424,6 +1481,12 @@ func (cpdb *FileCheckpointsDB) Update(_ context.Context, checkpointDiffs map[str
 			tableModel.KvKvs = cpd.checksum.SumKVS()
 			tableModel.KvChecksum = cpd.checksum.Sum()
 		}
+		if cpd.hasChecksumProgress {
+			tableModel.ChecksumRanges = cpd.checksumProgress.FinishedRanges
+			tableModel.ChecksumKvBytes = cpd.checksumProgress.Checksum.SumSize()
+			tableModel.ChecksumKvKvs = cpd.checksumProgress.Checksum.SumKVS()
+			tableModel.ChecksumKvChecksum = cpd.checksumProgress.Checksum.Sum()
+		}
 		for engineID, engineDiff := range cpd.engines {
 			engineModel := tableModel.Engines[engineID]
 			if engineDiff.hasStatus {
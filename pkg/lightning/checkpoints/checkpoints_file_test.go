@@ -130,6 +130,13 @@ func newFileCheckpointsDB(t *testing.T) *checkpoints.FileCheckpointsDB {
 		Checksum: verification.MakeKVChecksum(4492, 686, 486070148910),
 	}
 	cksum.MergeInto(cpd)
+	cpm := checkpoints.ChecksumProgressMerger{
+		Progress: checkpoints.ChecksumProgress{
+			FinishedRanges: 2,
+			Checksum:       verification.MakeKVChecksum(2048, 100, 123456789),
+		},
+	}
+	cpm.MergeInto(cpd)
 	ccm := checkpoints.ChunkCheckpointMerger{
 		EngineID: 0,
 		Key:      checkpoints.ChunkCheckpointKey{Path: "/tmp/path/1.sql", Offset: 0},
@@ -174,6 +181,10 @@ func TestGet(t *testing.T) {
 		Status:    checkpoints.CheckpointStatusAllWritten,
 		AllocBase: 132861,
 		Checksum:  verification.MakeKVChecksum(4492, 686, 486070148910),
+		ChecksumProgress: checkpoints.ChecksumProgress{
+			FinishedRanges: 2,
+			Checksum:       verification.MakeKVChecksum(2048, 100, 123456789),
+		},
 		Engines: map[int32]*checkpoints.EngineCheckpoint{
 			-1: {
 				Status: checkpoints.CheckpointStatusLoaded,
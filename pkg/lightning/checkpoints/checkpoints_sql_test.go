@@ -226,6 +226,13 @@ func TestNormalOperations(t *testing.T) {
 		Checksum: verification.MakeKVChecksum(4492, 686, 486070148910),
 	}
 	cksum.MergeInto(cpd)
+	cpm := checkpoints.ChecksumProgressMerger{
+		Progress: checkpoints.ChecksumProgress{
+			FinishedRanges: 2,
+			Checksum:       verification.MakeKVChecksum(2048, 100, 123456789),
+		},
+	}
+	cpm.MergeInto(cpd)
 	ccm := checkpoints.ChunkCheckpointMerger{
 		EngineID: 0,
 		Key:      checkpoints.ChunkCheckpointKey{Path: "/tmp/path/1.sql", Offset: 0},
@@ -264,6 +271,11 @@ func TestNormalOperations(t *testing.T) {
 		ExpectExec().
 		WithArgs(4492, 686, 486070148910, "`db1`.`t2`").
 		WillReturnResult(sqlmock.NewResult(15, 1))
+	s.mock.
+		ExpectPrepare("UPDATE `mock-schema`\\.`table_v\\d+` SET checksum_ranges = .+").
+		ExpectExec().
+		WithArgs(2, 2048, 100, 123456789, "`db1`.`t2`").
+		WillReturnResult(sqlmock.NewResult(16, 1))
 
 	s.mock.ExpectCommit()
 
@@ -302,8 +314,10 @@ func TestNormalOperations(t *testing.T) {
 		ExpectQuery("SELECT .+ FROM `mock-schema`\\.`table_v\\d+`").
 		WithArgs("`db1`.`t2`").
 		WillReturnRows(
-			sqlmock.NewRows([]string{"status", "alloc_base", "table_id", "table_info", "kv_bytes", "kv_kvs", "kv_checksum"}).
-				AddRow(60, 132861, int64(2), t2Info, uint64(4492), uint64(686), uint64(486070148910)),
+			sqlmock.NewRows([]string{"status", "alloc_base", "table_id", "table_info", "kv_bytes", "kv_kvs", "kv_checksum",
+				"checksum_ranges", "checksum_kv_bytes", "checksum_kv_kvs", "checksum_kv_checksum"}).
+				AddRow(60, 132861, int64(2), t2Info, uint64(4492), uint64(686), uint64(486070148910),
+					int64(2), uint64(2048), uint64(100), uint64(123456789)),
 		)
 	s.mock.ExpectCommit()
 
@@ -343,6 +357,10 @@ func TestNormalOperations(t *testing.T) {
 			},
 		},
 		Checksum: verification.MakeKVChecksum(4492, 686, 486070148910),
+		ChecksumProgress: checkpoints.ChecksumProgress{
+			FinishedRanges: 2,
+			Checksum:       verification.MakeKVChecksum(2048, 100, 123456789),
+		},
 	}, cp)
 	require.Nil(t, s.mock.ExpectationsWereMet())
 }
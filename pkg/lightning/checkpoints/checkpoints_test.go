@@ -234,6 +234,10 @@ func TestApplyDiff(t *testing.T) {
 	(&StatusCheckpointMerger{EngineID: WholeTableEngineID, Status: CheckpointStatusAllWritten}).MergeInto(cpd)
 	(&StatusCheckpointMerger{EngineID: 1234, Status: CheckpointStatusAnalyzeSkipped}).MergeInto(cpd)
 	(&RebaseCheckpointMerger{AllocBase: 11111}).MergeInto(cpd)
+	(&ChecksumProgressMerger{Progress: ChecksumProgress{
+		FinishedRanges: 3,
+		Checksum:       verification.MakeKVChecksum(1111, 2222, 3333),
+	}}).MergeInto(cpd)
 	(&ChunkCheckpointMerger{
 		EngineID: 0,
 		Key:      ChunkCheckpointKey{Path: "/tmp/01.sql"},
@@ -265,6 +269,10 @@ func TestApplyDiff(t *testing.T) {
 	require.Equal(t, TableCheckpoint{
 		Status:    CheckpointStatusAllWritten,
 		AllocBase: 11111,
+		ChecksumProgress: ChecksumProgress{
+			FinishedRanges: 3,
+			Checksum:       verification.MakeKVChecksum(1111, 2222, 3333),
+		},
 		Engines: map[int32]*EngineCheckpoint{
 			-1: {
 				Status: CheckpointStatusImported,
@@ -119,6 +119,11 @@ type TableCheckpointModel struct {
 	KvKvs      uint64                           `protobuf:"varint,11,opt,name=kv_kvs,json=kvKvs,proto3" json:"kv_kvs,omitempty"`
 	KvChecksum uint64                           `protobuf:"fixed64,12,opt,name=kv_checksum,json=kvChecksum,proto3" json:"kv_checksum,omitempty"`
 	TableInfo  []byte                           `protobuf:"bytes,13,opt,name=table_info,json=tableInfo,proto3" json:"table_info,omitempty"`
+	// the progress of the remote checksum: the number of the finished ranges and the checksum of them
+	ChecksumRanges     int64  `protobuf:"varint,14,opt,name=checksum_ranges,json=checksumRanges,proto3" json:"checksum_ranges,omitempty"`
+	ChecksumKvBytes    uint64 `protobuf:"varint,15,opt,name=checksum_kv_bytes,json=checksumKvBytes,proto3" json:"checksum_kv_bytes,omitempty"`
+	ChecksumKvKvs      uint64 `protobuf:"varint,16,opt,name=checksum_kv_kvs,json=checksumKvKvs,proto3" json:"checksum_kv_kvs,omitempty"`
+	ChecksumKvChecksum uint64 `protobuf:"fixed64,17,opt,name=checksum_kv_checksum,json=checksumKvChecksum,proto3" json:"checksum_kv_checksum,omitempty"`
 }
 
 func (m *TableCheckpointModel) Reset()         { *m = TableCheckpointModel{} }
@@ -258,62 +263,66 @@ func init() {
 func init() { proto.RegisterFile("file_checkpoints.proto", fileDescriptor_975f281c215c00f7) }
 
 var fileDescriptor_975f281c215c00f7 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0x1c, 0x45,
-	0x10, 0xf6, 0x78, 0xbc, 0x7f, 0x35, 0xbb, 0xce, 0xba, 0xb1, 0x9d, 0xc6, 0xc0, 0xb2, 0x6c, 0x38,
-	0xac, 0x14, 0xb2, 0x91, 0xc2, 0x05, 0x45, 0x70, 0xc0, 0x76, 0x24, 0x22, 0x2b, 0xc2, 0x6a, 0x02,
-	0x07, 0x2e, 0xa3, 0xf9, 0xe9, 0xdd, 0x1d, 0xf5, 0xce, 0xf4, 0x68, 0xba, 0x67, 0xc8, 0xe6, 0x29,
-	0x78, 0x0c, 0x5e, 0x82, 0x7b, 0xc4, 0x29, 0x47, 0x8e, 0x60, 0xdf, 0x79, 0x05, 0x50, 0x57, 0x8f,
-	0xbd, 0xe3, 0x68, 0x15, 0x71, 0xeb, 0xfa, 0xea, 0xeb, 0xaf, 0xab, 0x6a, 0xbe, 0xee, 0x81, 0xe3,
-	0x79, 0xb2, 0xe2, 0x7e, 0xb4, 0xe4, 0x91, 0xc8, 0x65, 0x92, 0x69, 0x35, 0xcb, 0x0b, 0xa9, 0xe5,
-	0xc9, 0xa3, 0x45, 0xa2, 0x97, 0x65, 0x38, 0x8b, 0x64, 0xfa, 0x78, 0x21, 0x17, 0xf2, 0x31, 0xc2,
-	0x61, 0x39, 0xc7, 0x08, 0x03, 0x5c, 0x59, 0xfa, 0xe4, 0x1f, 0x07, 0x86, 0x67, 0x1b, 0x91, 0x17,
-	0x32, 0xe6, 0x2b, 0x72, 0x0e, 0x5e, 0x43, 0x98, 0x3a, 0x63, 0x77, 0xea, 0x3d, 0x99, 0xcc, 0xde,
-	0xe5, 0x35, 0x81, 0x67, 0x99, 0x2e, 0xd6, 0xac, 0xb9, 0x8d, 0x7c, 0x03, 0xf7, 0x74, 0xa0, 0x44,
-	0xa3, 0x46, 0xba, 0x3b, 0x76, 0xa6, 0xde, 0x93, 0xc3, 0xd9, 0xcb, 0x40, 0x89, 0xcd, 0x66, 0x14,
-	0x63, 0xfb, 0xfa, 0x0e, 0x78, 0xf2, 0xe3, 0x9d, 0xc2, 0x50, 0x9f, 0x0c, 0xc1, 0x15, 0x7c, 0x4d,
-	0x9d, 0xb1, 0x33, 0xed, 0x31, 0xb3, 0x24, 0x0f, 0xa1, 0x55, 0x05, 0xab, 0x92, 0xd7, 0xd2, 0x47,
-	0xb3, 0x97, 0x41, 0xb8, 0xe2, 0xef, 0x6a, 0x5b, 0xce, 0xd3, 0xdd, 0xaf, 0x9c, 0xc9, 0x6f, 0xbb,
-	0xf0, 0xc1, 0x96, 0xe3, 0xc9, 0x7d, 0xe8, 0x60, 0xb5, 0x49, 0x8c, 0xf2, 0x2e, 0x6b, 0x9b, 0xf0,
-	0x79, 0x4c, 0x3e, 0x01, 0x50, 0xb2, 0x2c, 0x22, 0xee, 0xc7, 0x49, 0x81, 0xc7, 0xf4, 0x58, 0xcf,
-	0x22, 0xe7, 0x49, 0x41, 0x28, 0x74, 0xc2, 0x20, 0x12, 0x3c, 0x8b, 0xa9, 0x8b, 0xb9, 0x9b, 0x90,
-	0x3c, 0x80, 0x41, 0x92, 0xe6, 0xb2, 0xd0, 0xbc, 0xf0, 0x83, 0x38, 0x2e, 0xe8, 0x1e, 0xe6, 0xfb,
-	0x37, 0xe0, 0xb7, 0x71, 0x5c, 0x90, 0x8f, 0xa0, 0xa7, 0x93, 0x38, 0xf4, 0x97, 0x52, 0x69, 0xda,
-	0x42, 0x42, 0xd7, 0x00, 0xdf, 0x49, 0xa5, 0x6f, 0x93, 0x86, 0x4f, 0xdb, 0x63, 0x67, 0xda, 0xb2,
-	0xc9, 0x4b, 0x59, 0x68, 0x53, 0x70, 0x1e, 0x5b, 0xe1, 0x0e, 0xee, 0x6b, 0xe7, 0x31, 0x4a, 0x4e,
-	0x60, 0xa0, 0xcc, 0x01, 0xb1, 0x2f, 0x2a, 0xac, 0xb9, 0x8b, 0x69, 0xcf, 0x82, 0x17, 0x95, 0xa9,
-	0xfa, 0x01, 0x0c, 0x56, 0xc9, 0x62, 0xa9, 0xb3, 0x24, 0x5b, 0xf8, 0x15, 0x2f, 0x68, 0xcf, 0xd6,
-	0x76, 0x0b, 0xfe, 0xc4, 0x8b, 0xc9, 0xbf, 0xbb, 0x70, 0xb8, 0x6d, 0x9c, 0x84, 0xc0, 0xde, 0x32,
-	0x50, 0x4b, 0x1c, 0x54, 0x9f, 0xe1, 0x9a, 0x1c, 0x43, 0x5b, 0xe9, 0x40, 0x97, 0x0a, 0xc7, 0x30,
-	0x60, 0x75, 0x64, 0xc6, 0x17, 0xac, 0x56, 0x32, 0xf2, 0xc3, 0x40, 0x71, 0x1c, 0x81, 0xcb, 0x7a,
-	0x88, 0x9c, 0x06, 0x8a, 0x93, 0xaf, 0xa1, 0xc3, 0xb3, 0x45, 0x92, 0x71, 0x45, 0xbb, 0xb5, 0xcd,
-	0xb6, 0x1d, 0x39, 0x7b, 0x66, 0x49, 0xd6, 0x66, 0x37, 0x5b, 0xcc, 0xf0, 0xb5, 0x61, 0x3f, 0x3f,
-	0xc7, 0x06, 0x5c, 0x76, 0x13, 0x92, 0x0f, 0xa1, 0x2b, 0x2a, 0x3f, 0x5c, 0x6b, 0xae, 0x28, 0x8c,
-	0x9d, 0xe9, 0x1e, 0xeb, 0x88, 0xea, 0xd4, 0x84, 0xe4, 0x08, 0xda, 0xa2, 0xf2, 0x45, 0xa5, 0xa8,
-	0x87, 0x89, 0x96, 0xa8, 0x2e, 0x2a, 0x45, 0x3e, 0x05, 0x4f, 0x54, 0xd6, 0xac, 0xaa, 0x4c, 0x69,
-	0x7f, 0xec, 0x4c, 0xdb, 0x0c, 0x44, 0x75, 0x56, 0x23, 0xa6, 0x13, 0x54, 0xf7, 0x93, 0x6c, 0x2e,
-	0xe9, 0x00, 0x7b, 0xef, 0xd9, 0xf3, 0xb2, 0xb9, 0x3c, 0x61, 0xd0, 0x6f, 0x16, 0xd9, 0xf4, 0xea,
-	0x81, 0xf5, 0xea, 0x17, 0x77, 0xbd, 0x7a, 0x5c, 0x37, 0xf5, 0x1e, 0xb3, 0xfe, 0xee, 0xc0, 0xd1,
-	0x56, 0x52, 0x63, 0xdc, 0xce, 0x9d, 0x71, 0x3f, 0x85, 0x76, 0xb4, 0x2c, 0x33, 0xa1, 0xe8, 0x6e,
-	0x3d, 0xce, 0xad, 0xfb, 0x67, 0x67, 0x48, 0xb2, 0xe3, 0xac, 0x77, 0x9c, 0x5c, 0x82, 0xd7, 0x80,
-	0xff, 0xcf, 0x65, 0x43, 0xfa, 0x7b, 0xea, 0xff, 0xc3, 0x85, 0xc3, 0x6d, 0x1c, 0xe3, 0xa0, 0x3c,
-	0xd0, 0xcb, 0x5a, 0x1c, 0xd7, 0xa6, 0x25, 0x39, 0x9f, 0x2b, 0x6e, 0x9f, 0x09, 0x97, 0xd5, 0x11,
-	0x79, 0x04, 0x24, 0x92, 0xab, 0x32, 0xcd, 0xfc, 0x9c, 0x17, 0x69, 0xa9, 0x03, 0x9d, 0xc8, 0x8c,
-	0xf6, 0xc7, 0xee, 0xb4, 0xc5, 0x0e, 0x6c, 0xe6, 0x72, 0x93, 0x30, 0x9f, 0x89, 0x67, 0xb1, 0x5f,
-	0x4b, 0xb5, 0xac, 0xe1, 0x78, 0x16, 0x7f, 0x6f, 0xd5, 0x86, 0xe0, 0xe6, 0x52, 0xe1, 0x6d, 0x72,
-	0x99, 0x59, 0x92, 0xcf, 0x61, 0x3f, 0x2f, 0x78, 0xe5, 0x17, 0xf2, 0x97, 0x24, 0xf6, 0xd3, 0xe0,
-	0x15, 0xde, 0x27, 0x97, 0xf5, 0x0d, 0xca, 0x0c, 0xf8, 0x22, 0x78, 0x65, 0xee, 0xe2, 0x86, 0xd0,
-	0x45, 0x42, 0xb7, 0x68, 0x24, 0x45, 0x15, 0xd5, 0x76, 0xeb, 0xa1, 0xab, 0xba, 0xa2, 0x8a, 0xac,
-	0xdf, 0xee, 0x43, 0xc7, 0x24, 0x8d, 0xe1, 0xac, 0x13, 0xdb, 0xa2, 0x8a, 0x8c, 0xe3, 0x3e, 0x83,
-	0xbe, 0x49, 0xdc, 0x5a, 0xce, 0x43, 0xcb, 0x79, 0xa2, 0x8a, 0x6e, 0x3d, 0xf7, 0xb1, 0x79, 0x01,
-	0x52, 0xae, 0x74, 0x90, 0xe6, 0x68, 0xb9, 0x21, 0xdb, 0x00, 0x66, 0x8a, 0x7a, 0x9d, 0x73, 0xba,
-	0x8f, 0x4f, 0x03, 0xae, 0xc9, 0x18, 0xbc, 0x48, 0xa6, 0x79, 0xc1, 0x95, 0x32, 0x63, 0xba, 0x87,
-	0xa9, 0x26, 0x64, 0xae, 0x86, 0x79, 0x0a, 0x7c, 0xf3, 0x71, 0x87, 0xf6, 0xc9, 0x32, 0xf1, 0x05,
-	0x5f, 0x9b, 0x3e, 0xf0, 0xb7, 0xa2, 0x92, 0xd7, 0x9c, 0x1e, 0xd8, 0x26, 0x0d, 0xf0, 0x43, 0xf2,
-	0x9a, 0x9f, 0x3e, 0x7c, 0xf3, 0xf7, 0x68, 0xe7, 0xcd, 0xd5, 0xc8, 0x79, 0x7b, 0x35, 0x72, 0xfe,
-	0xba, 0x1a, 0x39, 0xbf, 0x5e, 0x8f, 0x76, 0xde, 0x5e, 0x8f, 0x76, 0xfe, 0xbc, 0x1e, 0xed, 0xfc,
-	0x3c, 0x68, 0x3c, 0xfd, 0x79, 0x18, 0xb6, 0xf1, 0xf7, 0xf2, 0xe5, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xd2, 0x2d, 0x6f, 0xe5, 0xa7, 0x06, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0x64, 0xe2, 0xbf, 0x1a, 0x3b, 0x71, 0x9a, 0x24, 0x3b, 0x04, 0x30, 0xc6, 0x8b, 0xc0,
+	0x62, 0x59, 0x2f, 0x5a, 0x2e, 0x68, 0x05, 0x07, 0x92, 0xac, 0xc4, 0x2a, 0x5a, 0x11, 0x35, 0x0b,
+	0x07, 0x2e, 0xa3, 0xf1, 0x4c, 0xdb, 0x1e, 0xb5, 0x3d, 0x3d, 0x9a, 0xee, 0x69, 0xd6, 0xfb, 0x14,
+	0x3c, 0x06, 0x27, 0xde, 0x80, 0xfb, 0x8a, 0xd3, 0x1e, 0x39, 0x42, 0x72, 0xe7, 0x19, 0x50, 0x57,
+	0x8f, 0xed, 0x49, 0x64, 0xad, 0xb8, 0x75, 0x7d, 0xf5, 0xd5, 0xd7, 0x55, 0x35, 0x55, 0x3d, 0x70,
+	0x32, 0x49, 0xe6, 0x2c, 0x88, 0x66, 0x2c, 0xe2, 0x99, 0x48, 0x52, 0x25, 0x47, 0x59, 0x2e, 0x94,
+	0x38, 0x7d, 0x38, 0x4d, 0xd4, 0xac, 0x18, 0x8f, 0x22, 0xb1, 0x78, 0x34, 0x15, 0x53, 0xf1, 0x08,
+	0xe1, 0x71, 0x31, 0x41, 0x0b, 0x0d, 0x3c, 0x59, 0xfa, 0xe0, 0x5f, 0x07, 0xba, 0xe7, 0x1b, 0x91,
+	0xe7, 0x22, 0x66, 0x73, 0x72, 0x01, 0x5e, 0x45, 0xd8, 0x77, 0xfa, 0xee, 0xd0, 0x7b, 0x3c, 0x18,
+	0xdd, 0xe5, 0x55, 0x81, 0xa7, 0xa9, 0xca, 0x97, 0xb4, 0x1a, 0x46, 0xbe, 0x81, 0x03, 0x15, 0x4a,
+	0x5e, 0xc9, 0xd1, 0xdf, 0xed, 0x3b, 0x43, 0xef, 0xf1, 0xd1, 0xe8, 0x45, 0x28, 0xf9, 0x26, 0x18,
+	0xc5, 0xe8, 0xbe, 0xba, 0x05, 0x9e, 0xfe, 0x08, 0xdd, 0xbb, 0xfa, 0xa4, 0x0b, 0x2e, 0x67, 0x4b,
+	0xdf, 0xe9, 0x3b, 0xc3, 0x16, 0x35, 0x47, 0xf2, 0x00, 0x6a, 0x3a, 0x9c, 0x17, 0xac, 0x94, 0x3e,
+	0x1e, 0xbd, 0x08, 0xc7, 0x73, 0x76, 0x57, 0xdb, 0x72, 0x9e, 0xec, 0x7e, 0xe5, 0x0c, 0x7e, 0xdb,
+	0x85, 0x77, 0xb6, 0x5c, 0x4f, 0xee, 0x41, 0x03, 0xb3, 0x4d, 0x62, 0x94, 0x77, 0x69, 0xdd, 0x98,
+	0xcf, 0x62, 0xf2, 0x01, 0x80, 0x14, 0x45, 0x1e, 0xb1, 0x20, 0x4e, 0x72, 0xbc, 0xa6, 0x45, 0x5b,
+	0x16, 0xb9, 0x48, 0x72, 0xe2, 0x43, 0x63, 0x1c, 0x46, 0x9c, 0xa5, 0xb1, 0xef, 0xa2, 0x6f, 0x65,
+	0x92, 0xfb, 0xd0, 0x49, 0x16, 0x99, 0xc8, 0x15, 0xcb, 0x83, 0x30, 0x8e, 0x73, 0x7f, 0x0f, 0xfd,
+	0xed, 0x15, 0xf8, 0x6d, 0x1c, 0xe7, 0xe4, 0x3d, 0x68, 0xa9, 0x24, 0x1e, 0x07, 0x33, 0x21, 0x95,
+	0x5f, 0x43, 0x42, 0xd3, 0x00, 0xdf, 0x09, 0xa9, 0xd6, 0x4e, 0xc3, 0xf7, 0xeb, 0x7d, 0x67, 0x58,
+	0xb3, 0xce, 0x2b, 0x91, 0x2b, 0x93, 0x70, 0x16, 0x5b, 0xe1, 0x06, 0xc6, 0xd5, 0xb3, 0x18, 0x25,
+	0x07, 0xd0, 0x91, 0xe6, 0x82, 0x38, 0xe0, 0x1a, 0x73, 0x6e, 0xa2, 0xdb, 0xb3, 0xe0, 0xa5, 0x36,
+	0x59, 0xdf, 0x87, 0xce, 0x3c, 0x99, 0xce, 0x54, 0x9a, 0xa4, 0xd3, 0x40, 0xb3, 0xdc, 0x6f, 0xd9,
+	0xdc, 0xd6, 0xe0, 0x4f, 0x2c, 0x1f, 0xfc, 0xbe, 0x07, 0x47, 0xdb, 0xda, 0x49, 0x08, 0xec, 0xcd,
+	0x42, 0x39, 0xc3, 0x46, 0xb5, 0x29, 0x9e, 0xc9, 0x09, 0xd4, 0xa5, 0x0a, 0x55, 0x21, 0xb1, 0x0d,
+	0x1d, 0x5a, 0x5a, 0xa6, 0x7d, 0xe1, 0x7c, 0x2e, 0xa2, 0x60, 0x1c, 0x4a, 0x86, 0x2d, 0x70, 0x69,
+	0x0b, 0x91, 0xb3, 0x50, 0x32, 0xf2, 0x35, 0x34, 0x58, 0x3a, 0x4d, 0x52, 0x26, 0xfd, 0x66, 0x39,
+	0x66, 0xdb, 0xae, 0x1c, 0x3d, 0xb5, 0x24, 0x3b, 0x66, 0xab, 0x10, 0xd3, 0x7c, 0x65, 0xd8, 0xcf,
+	0x2e, 0xb0, 0x00, 0x97, 0xae, 0x4c, 0xf2, 0x2e, 0x34, 0xb9, 0x0e, 0xc6, 0x4b, 0xc5, 0xa4, 0x0f,
+	0x7d, 0x67, 0xb8, 0x47, 0x1b, 0x5c, 0x9f, 0x19, 0x93, 0x1c, 0x43, 0x9d, 0xeb, 0x80, 0x6b, 0xe9,
+	0x7b, 0xe8, 0xa8, 0x71, 0x7d, 0xa9, 0x25, 0xf9, 0x10, 0x3c, 0xae, 0xed, 0xb0, 0xca, 0x62, 0xe1,
+	0xb7, 0xfb, 0xce, 0xb0, 0x4e, 0x81, 0xeb, 0xf3, 0x12, 0x31, 0x95, 0xa0, 0x7a, 0x90, 0xa4, 0x13,
+	0xe1, 0x77, 0xb0, 0xf6, 0x96, 0xbd, 0x2f, 0x9d, 0x08, 0xf2, 0x29, 0x1c, 0xac, 0x82, 0x83, 0x3c,
+	0x4c, 0xa7, 0x4c, 0xfa, 0xfb, 0x98, 0xd3, 0xfe, 0x0a, 0xa6, 0x88, 0x92, 0xcf, 0xe0, 0x70, 0x4d,
+	0x5c, 0xe7, 0x78, 0x80, 0xa9, 0xac, 0x15, 0x2e, 0xcb, 0x5c, 0x3f, 0xa9, 0x88, 0x96, 0x49, 0x77,
+	0x91, 0xd9, 0xd9, 0x30, 0x4d, 0xf2, 0x5f, 0xc0, 0x51, 0x95, 0xb7, 0xae, 0xe2, 0x10, 0xab, 0x20,
+	0x1b, 0xf2, 0xaa, 0x9a, 0x53, 0x0a, 0xed, 0x6a, 0x4f, 0xab, 0xab, 0x75, 0x68, 0x57, 0xeb, 0xf3,
+	0xdb, 0xab, 0x75, 0x52, 0x7e, 0x83, 0xb7, 0xec, 0xd6, 0x1f, 0x0e, 0x1c, 0x6f, 0x25, 0x55, 0xa6,
+	0xc3, 0xb9, 0x35, 0x1d, 0x4f, 0xa0, 0x1e, 0xcd, 0x8a, 0x94, 0x4b, 0x7f, 0xb7, 0xfc, 0xfa, 0x5b,
+	0xe3, 0x47, 0xe7, 0x48, 0xb2, 0x5f, 0xbf, 0x8c, 0x38, 0xbd, 0x02, 0xaf, 0x02, 0xff, 0x9f, 0xb7,
+	0x01, 0xe9, 0x6f, 0xc9, 0xff, 0x4f, 0x17, 0x8e, 0xb6, 0x71, 0xcc, 0xc0, 0x67, 0xa1, 0x9a, 0x95,
+	0xe2, 0x78, 0x36, 0x25, 0x89, 0xc9, 0x44, 0x32, 0xfb, 0xaa, 0xb9, 0xb4, 0xb4, 0xc8, 0x43, 0x20,
+	0x91, 0x98, 0x17, 0x8b, 0x34, 0xc8, 0x58, 0xbe, 0x28, 0x54, 0xa8, 0x12, 0x91, 0xfa, 0xed, 0xbe,
+	0x3b, 0xac, 0xd1, 0x43, 0xeb, 0xb9, 0xda, 0x38, 0xcc, 0x54, 0xb1, 0x34, 0x0e, 0x4a, 0xa9, 0x9a,
+	0xdd, 0x0f, 0x96, 0xc6, 0xdf, 0x5b, 0xb5, 0x2e, 0xb8, 0x99, 0x90, 0xb8, 0xfc, 0x2e, 0x35, 0x47,
+	0xf2, 0x31, 0xec, 0x67, 0x39, 0xd3, 0x41, 0x2e, 0x7e, 0x49, 0xe2, 0x60, 0x11, 0xbe, 0xc4, 0xf5,
+	0x77, 0x69, 0xdb, 0xa0, 0xd4, 0x80, 0xcf, 0xc3, 0x97, 0xe6, 0xe9, 0xd8, 0x10, 0x9a, 0x48, 0x68,
+	0xe6, 0x15, 0x27, 0xd7, 0x51, 0x39, 0x79, 0x2d, 0x9c, 0xa7, 0x26, 0xd7, 0x91, 0x1d, 0xb9, 0x7b,
+	0xd0, 0x30, 0x4e, 0xae, 0x57, 0x8b, 0x53, 0xe7, 0x3a, 0x32, 0x33, 0xf6, 0x11, 0xb4, 0x8d, 0x63,
+	0x3d, 0x5b, 0x1e, 0xce, 0x96, 0xc7, 0x75, 0xb4, 0x5e, 0x91, 0xf7, 0xcd, 0x83, 0xb5, 0x60, 0x52,
+	0x85, 0x8b, 0x0c, 0x37, 0xa4, 0x4b, 0x37, 0x80, 0xe9, 0xa2, 0x5a, 0x66, 0x0c, 0xd7, 0xa2, 0x46,
+	0xf1, 0x4c, 0xfa, 0xe0, 0x45, 0x62, 0x91, 0xe5, 0x4c, 0x4a, 0xd3, 0xa6, 0x03, 0x74, 0x55, 0x21,
+	0xb3, 0xc9, 0xe6, 0xe5, 0x0a, 0xcc, 0xc7, 0xed, 0xda, 0x17, 0xd6, 0xd8, 0x97, 0x6c, 0x69, 0xea,
+	0xc0, 0xbf, 0xa0, 0x4c, 0x5e, 0x31, 0x1c, 0x75, 0x97, 0x36, 0x0d, 0xf0, 0x43, 0xf2, 0x8a, 0x9d,
+	0x3d, 0x78, 0xfd, 0x4f, 0x6f, 0xe7, 0xf5, 0x75, 0xcf, 0x79, 0x73, 0xdd, 0x73, 0xfe, 0xbe, 0xee,
+	0x39, 0xbf, 0xde, 0xf4, 0x76, 0xde, 0xdc, 0xf4, 0x76, 0xfe, 0xba, 0xe9, 0xed, 0xfc, 0xdc, 0xa9,
+	0xfc, 0xa9, 0xb2, 0xf1, 0xb8, 0x8e, 0x7f, 0xc3, 0x2f, 0xff, 0x1b, 0x00, 0x5c, 0xe1, 0x6b, 0xb4,
+	0x56, 0x07, 0x00, 0x00,
 }
 
 func (m *CheckpointsModel) Marshal() (dAtA []byte, err error) {
@@ -479,6 +488,31 @@ func (m *TableCheckpointModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChecksumKvChecksum != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ChecksumKvChecksum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x89
+	}
+	if m.ChecksumKvKvs != 0 {
+		i = encodeVarintFileCheckpoints(dAtA, i, uint64(m.ChecksumKvKvs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ChecksumKvBytes != 0 {
+		i = encodeVarintFileCheckpoints(dAtA, i, uint64(m.ChecksumKvBytes))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ChecksumRanges != 0 {
+		i = encodeVarintFileCheckpoints(dAtA, i, uint64(m.ChecksumRanges))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.TableInfo) > 0 {
 		i -= len(m.TableInfo)
 		copy(dAtA[i:], m.TableInfo)
@@ -852,6 +886,18 @@ func (m *TableCheckpointModel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFileCheckpoints(uint64(l))
 	}
+	if m.ChecksumRanges != 0 {
+		n += 1 + sovFileCheckpoints(uint64(m.ChecksumRanges))
+	}
+	if m.ChecksumKvBytes != 0 {
+		n += 1 + sovFileCheckpoints(uint64(m.ChecksumKvBytes))
+	}
+	if m.ChecksumKvKvs != 0 {
+		n += 2 + sovFileCheckpoints(uint64(m.ChecksumKvKvs))
+	}
+	if m.ChecksumKvChecksum != 0 {
+		n += 10
+	}
 	return n
 }
 
@@ -1793,6 +1839,73 @@ func (m *TableCheckpointModel) Unmarshal(dAtA []byte) error {
 				m.TableInfo = []byte{}
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumRanges", wireType)
+			}
+			m.ChecksumRanges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFileCheckpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksumRanges |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumKvBytes", wireType)
+			}
+			m.ChecksumKvBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFileCheckpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksumKvBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumKvKvs", wireType)
+			}
+			m.ChecksumKvKvs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFileCheckpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksumKvKvs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumKvChecksum", wireType)
+			}
+			m.ChecksumKvChecksum = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumKvChecksum = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFileCheckpoints(dAtA[iNdEx:])
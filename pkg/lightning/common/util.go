@@ -36,6 +36,8 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/lightning/log"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	tmysql "github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
@@ -505,6 +507,54 @@ func GetAutoRandomColumn(tblInfo *model.TableInfo) *model.ColumnInfo {
 	return nil
 }
 
+// SequenceColumn is a column whose default value is the next value of a sequence.
+type SequenceColumn struct {
+	Column *model.ColumnInfo
+	// SequenceSchema is empty if the sequence is referenced without schema, i.e. in the same schema as the table.
+	SequenceSchema string
+	SequenceName   string
+}
+
+// SequenceTable returns the quoted name of the sequence, tableSchema is the schema of the table.
+func (c *SequenceColumn) SequenceTable(tableSchema string) string {
+	if c.SequenceSchema == "" {
+		return UniqueTable(tableSchema, c.SequenceName)
+	}
+	return UniqueTable(c.SequenceSchema, c.SequenceName)
+}
+
+// GetSequenceColumns returns the columns whose default value is `nextval(seq)`.
+func GetSequenceColumns(tblInfo *model.TableInfo) []SequenceColumn {
+	var result []SequenceColumn
+	for _, col := range tblInfo.Columns {
+		if !col.DefaultIsExpr {
+			continue
+		}
+		defaultValue, ok := col.GetDefaultValue().(string)
+		if !ok || !strings.HasPrefix(strings.ToLower(defaultValue), ast.NextVal) {
+			continue
+		}
+		stmt, err := parser.New().ParseOneStmt("select "+defaultValue, "", "")
+		if err != nil {
+			continue
+		}
+		fn, ok := stmt.(*ast.SelectStmt).Fields.Fields[0].Expr.(*ast.FuncCallExpr)
+		if !ok || fn.FnName.L != ast.NextVal || len(fn.Args) != 1 {
+			continue
+		}
+		name, ok := fn.Args[0].(*ast.TableNameExpr)
+		if !ok {
+			continue
+		}
+		result = append(result, SequenceColumn{
+			Column:         col,
+			SequenceSchema: name.Name.Schema.O,
+			SequenceName:   name.Name.Name.O,
+		})
+	}
+	return result
+}
+
 // GetDropIndexInfos returns the index infos that need to be dropped and the remain indexes.
 func GetDropIndexInfos(
 	tblInfo *model.TableInfo,
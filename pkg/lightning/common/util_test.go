@@ -194,6 +194,23 @@ func TestGetAutoRandomColumn(t *testing.T) {
 	}
 }
 
+func TestGetSequenceColumns(t *testing.T) {
+	p := parser.New()
+	tableInfo, err := dbutiltest.GetTableInfoBySQL(
+		"create table t(a bigint default nextval(seq1), b int default next value for s2.seq2, c int default 1, d varchar(36) default (uuid()))", p)
+	require.NoError(t, err)
+	seqCols := common.GetSequenceColumns(tableInfo)
+	require.Len(t, seqCols, 2)
+	require.Equal(t, "a", seqCols[0].Column.Name.L)
+	require.Equal(t, "`db`.`seq1`", seqCols[0].SequenceTable("db"))
+	require.Equal(t, "b", seqCols[1].Column.Name.L)
+	require.Equal(t, "`s2`.`seq2`", seqCols[1].SequenceTable("db"))
+
+	tableInfo, err = dbutiltest.GetTableInfoBySQL("create table t(a int auto_increment primary key)", p)
+	require.NoError(t, err)
+	require.Empty(t, common.GetSequenceColumns(tableInfo))
+}
+
 func TestBuildAddIndexSQL(t *testing.T) {
 	tests := []struct {
 		table     string
@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/tidb/pkg/lightning/log"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/encrypt"
 	filter "github.com/pingcap/tidb/pkg/util/table-filter"
 	router "github.com/pingcap/tidb/pkg/util/table-router"
 	"go.uber.org/atomic"
@@ -100,6 +101,9 @@ const (
 	defaultCSVDataCharacterSet       = "binary"
 	defaultCSVDataInvalidCharReplace = utf8.RuneError
 
+	// DefaultInferSchemaSampleRows is the default number of rows sampled to infer table schema.
+	DefaultInferSchemaSampleRows = 1000
+
 	DefaultSwitchTiKVModeInterval = 5 * time.Minute
 )
 
@@ -299,6 +303,11 @@ type Lightning struct {
 	IOConcurrency     int    `toml:"io-concurrency" json:"io-concurrency"`
 	CheckRequirements bool   `toml:"check-requirements" json:"check-requirements"`
 	MetaSchemaName    string `toml:"meta-schema-name" json:"meta-schema-name"`
+	// CheckOnly runs the prechecks without importing, and prints a JSON report.
+	CheckOnly bool `toml:"check-only" json:"check-only"`
+	// PrecheckAutoFix fixes the failed precheck items which support fixing
+	// themselves, e.g. lowers the disk quota to fit the local disk.
+	PrecheckAutoFix bool `toml:"precheck-auto-fix" json:"precheck-auto-fix"`
 
 	MaxError MaxError `toml:"max-error" json:"max-error"`
 	// deprecated, use Conflict.MaxRecordRows instead
@@ -604,6 +613,10 @@ const (
 	// ErrorOnDup indicates using INSERT INTO to insert data for TiDB backend, which would violate PK or UNIQUE constraint when detecting duplicate.
 	// ErrorOnDup reports an error after detecting the first conflict and stops the import process for local backend.
 	ErrorOnDup
+	// ReplaceNewestOnDup is the same as ReplaceOnDup for local backend, except that the row with the greatest
+	// value of conflict.version-column is reserved among the conflicting rows, instead of an arbitrary one.
+	// It's useful to merge the snapshots from multiple upstream shards.
+	ReplaceNewestOnDup
 )
 
 // UnmarshalTOML implements the toml.Unmarshaler interface.
@@ -611,7 +624,7 @@ func (dra *DuplicateResolutionAlgorithm) UnmarshalTOML(v any) error {
 	if val, ok := v.(string); ok {
 		return dra.FromStringValue(val)
 	}
-	return errors.Errorf("invalid conflict.strategy '%v', please choose valid option between ['', 'replace', 'ignore', 'error']", v)
+	return errors.Errorf("invalid conflict.strategy '%v', please choose valid option between ['', 'replace', 'replace-newest', 'ignore', 'error']", v)
 }
 
 // MarshalText implements the encoding.TextMarshaler interface.
@@ -626,6 +639,8 @@ func (dra *DuplicateResolutionAlgorithm) FromStringValue(s string) error {
 		*dra = NoneOnDup
 	case "replace":
 		*dra = ReplaceOnDup
+	case "replace-newest":
+		*dra = ReplaceNewestOnDup
 	case "ignore":
 		*dra = IgnoreOnDup
 	case "error":
@@ -634,7 +649,7 @@ func (dra *DuplicateResolutionAlgorithm) FromStringValue(s string) error {
 		log.L().Warn("\"conflict.strategy '%s' is no longer supported, has been converted to 'replace'")
 		*dra = ReplaceOnDup
 	default:
-		return errors.Errorf("invalid conflict.strategy '%s', please choose valid option between ['', 'replace', 'ignore', 'error']", s)
+		return errors.Errorf("invalid conflict.strategy '%s', please choose valid option between ['', 'replace', 'replace-newest', 'ignore', 'error']", s)
 	}
 	return nil
 }
@@ -656,6 +671,8 @@ func (dra DuplicateResolutionAlgorithm) String() string {
 		return ""
 	case ReplaceOnDup:
 		return "replace"
+	case ReplaceNewestOnDup:
+		return "replace-newest"
 	case IgnoreOnDup:
 		return "ignore"
 	case ErrorOnDup:
@@ -867,12 +884,54 @@ type MydumperRuntime struct {
 	// DataInvalidCharReplace is the replacement characters for non-compatible characters, which shouldn't duplicate with the separators or line breaks.
 	// Changing the default value will result in increased parsing time. Non-compatible characters do not cause an increase in error.
 	DataInvalidCharReplace string `toml:"data-invalid-char-replace" json:"data-invalid-char-replace"`
+	// InferSchema controls whether to infer the table schema from the CSV data
+	// files when the schema file of a table is missing.
+	InferSchema InferSchemaConfig `toml:"infer-schema" json:"infer-schema"`
+}
+
+// InferSchemaConfig is the config for inferring table schema from headered CSV
+// files, it's used for exploratory loads into scratch schemas.
+type InferSchemaConfig struct {
+	Enable bool `toml:"enable" json:"enable"`
+	// SampleRows is the max number of rows read from the first data file of the
+	// table to infer the column types.
+	SampleRows int `toml:"sample-rows" json:"sample-rows"`
+	// ColumnTypes overrides the inferred type of columns, the key is the column
+	// name in the CSV header, and the value is the column definition, such as
+	// "varchar(255) not null".
+	ColumnTypes map[string]string `toml:"column-types" json:"column-types"`
+}
+
+func (i *InferSchemaConfig) adjust(csv *CSVConfig) error {
+	if !i.Enable {
+		return nil
+	}
+	if !csv.Header || !csv.HeaderSchemaMatch {
+		return common.ErrInvalidConfig.GenWithStack(
+			"`mydumper.infer-schema` requires `mydumper.csv.header` and `mydumper.csv.header-schema-match` to be true")
+	}
+	if i.SampleRows <= 0 {
+		i.SampleRows = DefaultInferSchemaSampleRows
+	}
+	columnTypes := make(map[string]string, len(i.ColumnTypes))
+	for col, tp := range i.ColumnTypes {
+		if strings.TrimSpace(tp) == "" {
+			return common.ErrInvalidConfig.GenWithStack("empty type of column '%s' in `mydumper.infer-schema.column-types`", col)
+		}
+		// column names are case-insensitive in TiDB.
+		columnTypes[strings.ToLower(col)] = tp
+	}
+	i.ColumnTypes = columnTypes
+	return nil
 }
 
 func (m *MydumperRuntime) adjust() error {
 	if err := m.CSV.adjust(); err != nil {
 		return err
 	}
+	if err := m.InferSchema.adjust(&m.CSV); err != nil {
+		return err
+	}
 	for _, rule := range m.FileRouters {
 		if filepath.IsAbs(rule.Path) {
 			relPath, err := filepath.Rel(m.SourceDir, rule.Path)
@@ -1075,10 +1134,21 @@ type TikvImporter struct {
 	StoreWriteBWLimit       ByteSize `toml:"store-write-bwlimit" json:"store-write-bwlimit"`
 	LogicalImportBatchSize  ByteSize `toml:"logical-import-batch-size" json:"logical-import-batch-size"`
 	LogicalImportBatchRows  int      `toml:"logical-import-batch-rows" json:"logical-import-batch-rows"`
+	// max number of cached gRPC connections to a store, 0 means using range-concurrency.
+	MaxConnPerStore int `toml:"max-conn-per-store" json:"max-conn-per-store"`
+	// max number of concurrent write streams to a store, 0 means no limit.
+	StoreWriteConcurrency int `toml:"store-write-concurrency" json:"store-write-concurrency"`
 
 	// default is PausePDSchedulerScopeTable to compatible with previous version(>= 6.1)
 	PausePDSchedulerScope PausePDSchedulerScope `toml:"pause-pd-scheduler-scope" json:"pause-pd-scheduler-scope"`
 	BlockSize             ByteSize              `toml:"block-size" json:"block-size"`
+	// whether the parallel import instances take the ownership of the regions by the leases in etcd,
+	// so they ingest disjoint key ranges of the same table at the same time.
+	RangeLease bool `toml:"range-lease" json:"range-lease"`
+	// the method to encrypt the sorted KV files in sorted-kv-dir, "plaintext" means they're not encrypted.
+	EncryptionMethod string `toml:"encryption-method" json:"encryption-method"`
+	// the path of the file which contains the hex encoded master key of the encryption.
+	EncryptionMasterKeyPath string `toml:"encryption-master-key-path" json:"encryption-master-key-path"`
 }
 
 func (t *TikvImporter) adjust() error {
@@ -1116,6 +1186,17 @@ func (t *TikvImporter) adjust() error {
 		if t.RangeConcurrency == 0 {
 			t.RangeConcurrency = DefaultRangeConcurrency
 		}
+		if t.MaxConnPerStore < 0 {
+			return common.ErrInvalidConfig.GenWithStack(
+				"`tikv-importer.max-conn-per-store` got %d, should not be negative", t.MaxConnPerStore)
+		}
+		if t.MaxConnPerStore == 0 {
+			t.MaxConnPerStore = t.RangeConcurrency
+		}
+		if t.StoreWriteConcurrency < 0 {
+			return common.ErrInvalidConfig.GenWithStack(
+				"`tikv-importer.store-write-concurrency` got %d, should not be negative", t.StoreWriteConcurrency)
+		}
 		if t.EngineMemCacheSize == 0 {
 			t.EngineMemCacheSize = DefaultEngineMemCacheSize
 		}
@@ -1130,6 +1211,17 @@ func (t *TikvImporter) adjust() error {
 			return common.ErrInvalidConfig.
 				GenWithStack("tikv-importer.add-index-using-ddl cannot be used with tikv-importer.parallel-import")
 		}
+		if t.RangeLease && !t.ParallelImport {
+			return common.ErrInvalidConfig.
+				GenWithStack("tikv-importer.range-lease can only be used with tikv-importer.parallel-import")
+		}
+		if t.EncryptionMethod == "" {
+			t.EncryptionMethod = encrypt.MethodPlaintext
+		}
+		t.EncryptionMethod = strings.ToLower(t.EncryptionMethod)
+		if _, err := encrypt.LoadMasterKey(t.EncryptionMethod, t.EncryptionMasterKeyPath); err != nil {
+			return common.ErrInvalidConfig.Wrap(err).GenWithStack("invalid tikv-importer.encryption-method")
+		}
 
 		if len(t.SortedKVDir) == 0 {
 			return common.ErrInvalidConfig.GenWithStack("tikv-importer.sorted-kv-dir must not be empty!")
@@ -1335,6 +1427,8 @@ type Conflict struct {
 	PrecheckConflictBeforeImport bool                         `toml:"precheck-conflict-before-import" json:"precheck-conflict-before-import"`
 	Threshold                    int64                        `toml:"threshold" json:"threshold"`
 	MaxRecordRows                int64                        `toml:"max-record-rows" json:"max-record-rows"`
+	// VersionColumn is the column to compare the conflicting rows when Strategy is ReplaceNewestOnDup.
+	VersionColumn string `toml:"version-column" json:"version-column"`
 }
 
 // adjust assigns default values and check illegal values. The arguments must be
@@ -1356,7 +1450,7 @@ func (c *Conflict) adjust(i *TikvImporter, l *Lightning) error {
 		strategyFromDuplicateResolution = true
 	}
 	switch c.Strategy {
-	case ReplaceOnDup, IgnoreOnDup, ErrorOnDup, NoneOnDup:
+	case ReplaceOnDup, ReplaceNewestOnDup, IgnoreOnDup, ErrorOnDup, NoneOnDup:
 	default:
 		return common.ErrInvalidConfig.GenWithStack(
 			"unsupported `%s` (%s)", strategyConfigFrom, c.Strategy)
@@ -1371,6 +1465,25 @@ func (c *Conflict) adjust(i *TikvImporter, l *Lightning) error {
 			`%s cannot be set to "ignore" when use tikv-importer.backend = "local"`,
 			strategyConfigFrom)
 	}
+	if c.Strategy == ReplaceNewestOnDup {
+		if i.Backend != BackendLocal {
+			return common.ErrInvalidConfig.GenWithStack(
+				`%s can be set to "replace-newest" only when use tikv-importer.backend = "local"`,
+				strategyConfigFrom)
+		}
+		if c.PrecheckConflictBeforeImport {
+			return common.ErrInvalidConfig.GenWithStack(
+				`conflict.precheck-conflict-before-import cannot be set to true when use %s = "replace-newest"`,
+				strategyConfigFrom)
+		}
+		if c.VersionColumn == "" {
+			return common.ErrInvalidConfig.GenWithStack(
+				`conflict.version-column must be set when use %s = "replace-newest"`, strategyConfigFrom)
+		}
+	} else if c.VersionColumn != "" {
+		return common.ErrInvalidConfig.GenWithStack(
+			`conflict.version-column can only be set when use %s = "replace-newest"`, strategyConfigFrom)
+	}
 	if c.PrecheckConflictBeforeImport && i.Backend == BackendTiDB {
 		return common.ErrInvalidConfig.GenWithStack(
 			`conflict.precheck-conflict-before-import cannot be set to true when use tikv-importer.backend = "tidb"`)
@@ -1380,7 +1493,7 @@ func (c *Conflict) adjust(i *TikvImporter, l *Lightning) error {
 		switch c.Strategy {
 		case ErrorOnDup:
 			c.Threshold = 0
-		case IgnoreOnDup, ReplaceOnDup:
+		case IgnoreOnDup, ReplaceOnDup, ReplaceNewestOnDup:
 			c.Threshold = math.MaxInt64
 		case NoneOnDup:
 			c.Threshold = 0
@@ -1525,6 +1638,7 @@ func (cfg *Config) LoadFromGlobal(global *GlobalConfig) error {
 	cfg.PostRestore.Checksum = global.PostRestore.Checksum
 	cfg.PostRestore.Analyze = global.PostRestore.Analyze
 	cfg.App.CheckRequirements = global.App.CheckRequirements
+	cfg.App.CheckOnly = global.App.CheckOnly
 	cfg.Security = global.Security
 	cfg.Mydumper.IgnoreColumns = global.Mydumper.IgnoreColumns
 	return nil
@@ -315,6 +315,31 @@ func TestAdjustWillBatchImportRatioInvalid(t *testing.T) {
 	require.Equal(t, 0.75, cfg.Mydumper.BatchImportRatio)
 }
 
+func TestAdjustInferSchema(t *testing.T) {
+	cfg := NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	cfg.Mydumper.InferSchema.Enable = true
+	cfg.Mydumper.InferSchema.ColumnTypes = map[string]string{"ID": "bigint primary key"}
+	require.NoError(t, cfg.Adjust(context.Background()))
+	require.Equal(t, DefaultInferSchemaSampleRows, cfg.Mydumper.InferSchema.SampleRows)
+	require.Equal(t, map[string]string{"id": "bigint primary key"}, cfg.Mydumper.InferSchema.ColumnTypes)
+
+	cfg = NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	cfg.Mydumper.InferSchema.Enable = true
+	cfg.Mydumper.CSV.Header = false
+	require.ErrorContains(t, cfg.Adjust(context.Background()), "requires `mydumper.csv.header` and `mydumper.csv.header-schema-match` to be true")
+
+	cfg = NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	cfg.Mydumper.InferSchema.Enable = true
+	cfg.Mydumper.InferSchema.ColumnTypes = map[string]string{"a": " "}
+	require.ErrorContains(t, cfg.Adjust(context.Background()), "empty type of column 'a'")
+}
+
 func TestAdjustSecuritySection(t *testing.T) {
 	testCases := []struct {
 		input          string
@@ -1242,6 +1267,12 @@ func TestAdjustTikvImporter(t *testing.T) {
 	cfg.TikvImporter.ParallelImport = true
 	cfg.TikvImporter.AddIndexBySQL = true
 	require.ErrorContains(t, cfg.TikvImporter.adjust(), "tikv-importer.add-index-using-ddl cannot be used with tikv-importer.parallel-import")
+
+	cfg.TikvImporter.AddIndexBySQL = false
+	cfg.TikvImporter.RangeLease = true
+	require.NoError(t, cfg.TikvImporter.adjust())
+	cfg.TikvImporter.ParallelImport = false
+	require.ErrorContains(t, cfg.TikvImporter.adjust(), "tikv-importer.range-lease can only be used with tikv-importer.parallel-import")
 }
 
 func TestCreateSeveralConfigsWithDifferentFilters(t *testing.T) {
@@ -1319,6 +1350,24 @@ func TestAdjustConflict(t *testing.T) {
 	cfg.Conflict.Threshold = 1
 	cfg.Conflict.MaxRecordRows = 1
 	require.ErrorContains(t, cfg.Conflict.adjust(&cfg.TikvImporter, &cfg.App), `cannot record duplication (conflict.max-record-rows > 0) when use tikv-importer.backend = "tidb" and conflict.strategy = "replace"`)
+
+	require.NoError(t, dra.FromStringValue("REPLACE-NEWEST"))
+	require.Equal(t, ReplaceNewestOnDup, dra)
+	require.Equal(t, "replace-newest", dra.String())
+	cfg.Conflict.Strategy = dra
+	cfg.Conflict.Threshold = -1
+	cfg.Conflict.MaxRecordRows = -1
+	require.ErrorContains(t, cfg.Conflict.adjust(&cfg.TikvImporter, &cfg.App), `conflict.strategy can be set to "replace-newest" only when use tikv-importer.backend = "local"`)
+	cfg.TikvImporter.Backend = BackendLocal
+	require.ErrorContains(t, cfg.Conflict.adjust(&cfg.TikvImporter, &cfg.App), `conflict.version-column must be set when use conflict.strategy = "replace-newest"`)
+	cfg.Conflict.VersionColumn = "updated_at"
+	cfg.Conflict.PrecheckConflictBeforeImport = true
+	require.ErrorContains(t, cfg.Conflict.adjust(&cfg.TikvImporter, &cfg.App), `conflict.precheck-conflict-before-import cannot be set to true when use conflict.strategy = "replace-newest"`)
+	cfg.Conflict.PrecheckConflictBeforeImport = false
+	require.NoError(t, cfg.Conflict.adjust(&cfg.TikvImporter, &cfg.App))
+	require.Equal(t, int64(math.MaxInt64), cfg.Conflict.Threshold)
+	cfg.Conflict.Strategy = ReplaceOnDup
+	require.ErrorContains(t, cfg.Conflict.adjust(&cfg.TikvImporter, &cfg.App), `conflict.version-column can only be set when use conflict.strategy = "replace-newest"`)
 }
 
 func TestAdjustBlockSize(t *testing.T) {
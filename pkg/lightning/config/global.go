@@ -35,6 +35,7 @@ type GlobalLightning struct {
 	StatusAddr        string `toml:"status-addr" json:"status-addr"`
 	ServerMode        bool   `toml:"server-mode" json:"server-mode"`
 	CheckRequirements bool   `toml:"check-requirements" json:"check-requirements"`
+	CheckOnly         bool   `toml:"check-only" json:"check-only"`
 
 	// The legacy alias for setting "status-addr". The value should always the
 	// same as StatusAddr, and will not be published in the JSON encoding.
@@ -167,6 +168,7 @@ func LoadGlobalConfig(args []string, extraFlags func(*flag.FlagSet)) (*GlobalCon
 	checksum := flagext.ChoiceVar(fs, "checksum", "", "compare checksum after importing.", "", "required", "optional", "off", "true", "false")
 	analyze := flagext.ChoiceVar(fs, "analyze", "", "analyze table after importing", "", "required", "optional", "off", "true", "false")
 	checkRequirements := fs.Bool("check-requirements", true, "check cluster version before starting")
+	checkOnly := fs.Bool("check-only", false, "only run the prechecks without importing, and print the result as JSON")
 	tlsCAPath := fs.String("ca", "", "CA certificate path for TLS connection")
 	tlsCertPath := fs.String("cert", "", "certificate path for TLS connection")
 	tlsKeyPath := fs.String("key", "", "private key path for TLS connection")
@@ -261,6 +263,9 @@ func LoadGlobalConfig(args []string, extraFlags func(*flag.FlagSet)) (*GlobalCon
 	if !*checkRequirements {
 		cfg.App.CheckRequirements = false
 	}
+	if *checkOnly {
+		cfg.App.CheckOnly = true
+	}
 	if *tlsCAPath != "" {
 		cfg.Security.CAPath = *tlsCAPath
 	}
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/redact"
 	tikverr "github.com/tikv/client-go/v2/error"
 	"go.uber.org/atomic"
@@ -756,6 +757,211 @@ func (em *ErrorManager) ReplaceConflictKeys(
 	return errors.Trace(g.Wait())
 }
 
+// ReserveNewestConflictRows makes the row with the greatest value of conflict.version-column the latest one
+// among the conflicting rows in the downstream TiKV, so that it's reserved by ReplaceConflictKeys, which
+// reserves the latest rows. The conflicting data KVs are handled before the index KVs, since the rows of
+// the conflicting index KVs are read from the data KVs.
+func (em *ErrorManager) ReserveNewestConflictRows(
+	ctx context.Context,
+	tbl tidbtbl.Table,
+	tableName string,
+	fnGetLatest func(ctx context.Context, key []byte) ([]byte, error),
+	fnPutKey func(ctx context.Context, key, value []byte) error,
+) error {
+	if em.db == nil {
+		return nil
+	}
+	versionCol := tidbtbl.FindColLowerCase(tbl.Cols(), strings.ToLower(em.configConflict.VersionColumn))
+	if versionCol == nil {
+		return common.ErrInvalidConfig.GenWithStack(
+			"conflict.version-column %s is not found in table %s", em.configConflict.VersionColumn, tableName)
+	}
+	encoder, err := kv.NewBaseKVEncoder(&encode.EncodingConfig{
+		Table:          tbl,
+		SessionOptions: encode.SessionOptions{SQLMode: mysql.ModeStrictAllTables},
+		Logger:         em.logger,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	collator := collate.GetCollator(versionCol.GetCollate())
+	isNewer := func(row, newestRow []types.Datum) (bool, error) {
+		if newestRow == nil {
+			return true, nil
+		}
+		cmp, err := row[versionCol.Offset].Compare(types.DefaultStmtNoWarningContext, &newestRow[versionCol.Offset], collator)
+		return cmp > 0, errors.Trace(err)
+	}
+
+	// reserve the newest value of each conflicting row key.
+	err = em.forEachConflictGroup(ctx, selectDataConflictKeysReplace, tableName,
+		func(rawKey []byte, rawValues, _ [][]byte) error {
+			latestValue, err := fnGetLatest(ctx, rawKey)
+			if tikverr.IsErrNotFound(err) {
+				// the row has been deleted, don't bring it back.
+				return nil
+			}
+			if err != nil {
+				return errors.Trace(err)
+			}
+			var (
+				newestValue []byte
+				newestRow   []types.Datum
+			)
+			// the current latest value is kept if the versions are the same.
+			for _, value := range append([][]byte{latestValue}, rawValues...) {
+				row, _, err := decodeConflictRow(encoder, tbl, rawKey, value)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				newer, err := isNewer(row, newestRow)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				if newer {
+					newestValue, newestRow = value, row
+				}
+			}
+			if bytes.Equal(newestValue, latestValue) {
+				return nil
+			}
+			return fnPutKey(ctx, rawKey, newestValue)
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// reserve the index KV of the newest row for each conflicting unique key.
+	return em.forEachConflictGroup(ctx, selectIndexConflictKeysReplace, tableName,
+		func(rawKey []byte, rawValues, rawHandles [][]byte) error {
+			latestValue, err := fnGetLatest(ctx, rawKey)
+			if tikverr.IsErrNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return errors.Trace(err)
+			}
+			latestHandle, err := tablecodec.DecodeHandleInUniqueIndexValue(latestValue, tbl.Meta().IsCommonHandle)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			// the current latest index KV is kept if the versions are the same.
+			rawValues = append([][]byte{latestValue}, rawValues...)
+			rawHandles = append([][]byte{tablecodec.EncodeRowKeyWithHandle(tablecodec.DecodeTableID(rawKey), latestHandle)}, rawHandles...)
+			var (
+				newestValue []byte
+				newestRow   []types.Datum
+			)
+			for i, rowKey := range rawHandles {
+				rowValue, err := fnGetLatest(ctx, rowKey)
+				if tikverr.IsErrNotFound(err) {
+					continue
+				}
+				if err != nil {
+					return errors.Trace(err)
+				}
+				row, pairs, err := decodeConflictRow(encoder, tbl, rowKey, rowValue)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				// the row may have been replaced by another row with the same handle, which doesn't own the index KV.
+				ownsIndexKV := slices.ContainsFunc(pairs, func(pair common.KvPair) bool {
+					return bytes.Equal(pair.Key, rawKey) && bytes.Equal(pair.Val, rawValues[i])
+				})
+				if !ownsIndexKV {
+					continue
+				}
+				newer, err := isNewer(row, newestRow)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				if newer {
+					newestValue, newestRow = rawValues[i], row
+				}
+			}
+			if newestRow == nil || bytes.Equal(newestValue, latestValue) {
+				return nil
+			}
+			return fnPutKey(ctx, rawKey, newestValue)
+		})
+}
+
+// forEachConflictGroup calls fn with the conflict records of the same raw key queried by the query, which
+// must select raw_key and raw_value, and optionally index_name and raw_handle, ordered by raw_key.
+func (em *ErrorManager) forEachConflictGroup(
+	ctx context.Context,
+	query string,
+	tableName string,
+	fn func(rawKey []byte, rawValues, rawHandles [][]byte) error,
+) error {
+	rows, err := em.db.QueryContext(ctx, common.SprintfWithIdentifiers(query, em.schema), tableName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var (
+		groupKey                  []byte
+		groupValues, groupHandles [][]byte
+	)
+	for rows.Next() {
+		var (
+			rawKey, rawValue, rawHandle []byte
+			indexName                   string
+		)
+		if len(columns) == 2 {
+			err = rows.Scan(&rawKey, &rawValue)
+		} else {
+			err = rows.Scan(&rawKey, &indexName, &rawValue, &rawHandle)
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(groupValues) > 0 && !bytes.Equal(rawKey, groupKey) {
+			if err := fn(groupKey, groupValues, groupHandles); err != nil {
+				return errors.Trace(err)
+			}
+			groupValues, groupHandles = nil, nil
+		}
+		groupKey = rawKey
+		groupValues = append(groupValues, rawValue)
+		groupHandles = append(groupHandles, rawHandle)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Trace(err)
+	}
+	if len(groupValues) > 0 {
+		return errors.Trace(fn(groupKey, groupValues, groupHandles))
+	}
+	return nil
+}
+
+// decodeConflictRow decodes the row value of the row key, and returns the datums of the row and the KV
+// pairs encoded from it.
+func decodeConflictRow(encoder *kv.BaseKVEncoder, tbl tidbtbl.Table, rowKey, rowValue []byte) ([]types.Datum, []common.KvPair, error) {
+	handle, err := tablecodec.DecodeRowKey(rowKey)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	decodedData, _, err := tables.DecodeRawRowData(encoder.SessionCtx,
+		tbl.Meta(), handle, tbl.Cols(), rowValue)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if !tbl.Meta().HasClusteredIndex() {
+		// for nonclustered PK, need to append handle to decodedData for AddRecord
+		decodedData = append(decodedData, types.NewIntDatum(handle.IntValue()))
+	}
+	if _, err = encoder.Table.AddRecord(encoder.SessionCtx.GetTableCtx(), decodedData); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return decodedData, encoder.SessionCtx.TakeKvPairs().Pairs, nil
+}
+
 // RecordDuplicateCount reduce the counter of "duplicate entry" errors.
 // Currently, the count will not be shared for multiple lightning instances.
 func (em *ErrorManager) RecordDuplicateCount(cnt int64) error {
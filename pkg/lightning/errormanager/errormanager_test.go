@@ -547,6 +547,121 @@ func TestReplaceConflictOneUniqueKey(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestReserveNewestConflictRows(t *testing.T) {
+	column1 := &model.ColumnInfo{
+		ID:           1,
+		Name:         model.NewCIStr("a"),
+		Offset:       0,
+		DefaultValue: 0,
+		FieldType:    *types.NewFieldType(mysql.TypeLong),
+		Hidden:       true,
+		State:        model.StatePublic,
+	}
+	column1.AddFlag(mysql.PriKeyFlag)
+
+	column2 := &model.ColumnInfo{
+		ID:           2,
+		Name:         model.NewCIStr("updated_at"),
+		Offset:       1,
+		DefaultValue: 0,
+		FieldType:    *types.NewFieldType(mysql.TypeLong),
+		Hidden:       true,
+		State:        model.StatePublic,
+	}
+
+	table := &model.TableInfo{
+		ID:         104,
+		Name:       model.NewCIStr("a"),
+		Charset:    "utf8mb4",
+		Collate:    "utf8mb4_bin",
+		Columns:    []*model.ColumnInfo{column1, column2},
+		PKIsHandle: true,
+		State:      model.StatePublic,
+	}
+
+	tbl, err := tables.TableFromMeta(tidbkv.NewPanickingAllocators(0), table)
+	require.NoError(t, err)
+
+	encoder, err := tidbkv.NewBaseKVEncoder(&encode.EncodingConfig{
+		Table: tbl,
+		SessionOptions: encode.SessionOptions{
+			SQLMode:   mysql.ModeStrictAllTables,
+			Timestamp: 1234567890,
+		},
+		Logger: log.L(),
+	})
+	require.NoError(t, err)
+	encoder.SessionCtx.GetSessionVars().RowEncoder.Enable = true
+
+	tctx := encoder.SessionCtx.GetTableCtx()
+	for _, version := range []int64{2, 3, 1} {
+		_, err = encoder.Table.AddRecord(tctx, []types.Datum{types.NewIntDatum(1), types.NewIntDatum(version)})
+		require.NoError(t, err)
+	}
+	kvPairs := encoder.SessionCtx.TakeKvPairs()
+	rowKey := kvPairs.Pairs[0].Key
+	version2Value := kvPairs.Pairs[0].Val
+	version3Value := kvPairs.Pairs[1].Val
+	version1Value := kvPairs.Pairs[2].Val
+
+	db, mockDB, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockDB.ExpectExec("CREATE SCHEMA IF NOT EXISTS `lightning_task_info`").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mockDB.ExpectExec("CREATE TABLE IF NOT EXISTS `lightning_task_info`\\.conflict_error_v2.*").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mockDB.ExpectQuery("\\QSELECT raw_key, raw_value FROM `lightning_task_info`.conflict_error_v2 WHERE table_name = ? AND is_data_kv = 1 ORDER BY raw_key\\E").
+		WillReturnRows(sqlmock.NewRows([]string{"raw_key", "raw_value"}).
+			AddRow(rowKey, version2Value).
+			AddRow(rowKey, version3Value).
+			AddRow(rowKey, version1Value))
+	mockDB.ExpectQuery("\\QSELECT raw_key, index_name, raw_value, raw_handle FROM `lightning_task_info`.conflict_error_v2 WHERE table_name = ? AND is_data_kv = 0 ORDER BY raw_key\\E").
+		WillReturnRows(sqlmock.NewRows([]string{"raw_key", "index_name", "raw_value", "raw_handle"}))
+
+	cfg := config.NewConfig()
+	cfg.Conflict.Strategy = config.ReplaceNewestOnDup
+	cfg.Conflict.VersionColumn = "UPDATED_AT"
+	cfg.TikvImporter.Backend = config.BackendLocal
+	cfg.App.TaskInfoSchemaName = "lightning_task_info"
+	em := New(db, cfg, log.L())
+	require.NoError(t, em.Init(ctx))
+
+	// the row of version 1 is the latest one in TiKV, it's replaced by the row of version 3.
+	var putValue []byte
+	err = em.ReserveNewestConflictRows(
+		ctx, tbl, "test",
+		func(ctx context.Context, key []byte) ([]byte, error) {
+			if !bytes.Equal(key, rowKey) {
+				return nil, fmt.Errorf("key %v is not expected", key)
+			}
+			return version1Value, nil
+		},
+		func(ctx context.Context, key, value []byte) error {
+			if !bytes.Equal(key, rowKey) {
+				return fmt.Errorf("key %v is not expected", key)
+			}
+			putValue = value
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, version3Value, putValue)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+
+	// the version column must exist.
+	cfg.Conflict.VersionColumn = "not_exist"
+	em = New(db, cfg, log.L())
+	err = em.ReserveNewestConflictRows(ctx, tbl, "test", nil, nil)
+	require.ErrorContains(t, err, "conflict.version-column not_exist is not found in table test")
+}
+
 func TestErrorMgrHasError(t *testing.T) {
 	cfg := &config.Config{}
 	cfg.App.MaxError = config.MaxError{
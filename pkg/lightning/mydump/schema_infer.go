@@ -0,0 +1,254 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/lightning/common"
+	"github.com/pingcap/tidb/pkg/lightning/config"
+	"github.com/pingcap/tidb/pkg/lightning/log"
+	"github.com/pingcap/tidb/pkg/lightning/worker"
+	"github.com/pingcap/tidb/pkg/types"
+	"go.uber.org/zap"
+)
+
+// inferredType is the type of a column inferred from the sampled values, a
+// larger value can hold all values of a smaller one in the same group, i.e.
+// bigint < double < varchar, date < datetime < varchar.
+type inferredType int
+
+const (
+	inferredUnknown inferredType = iota
+	inferredBigInt
+	inferredDouble
+	inferredDate
+	inferredDatetime
+	inferredVarchar
+)
+
+const (
+	inferredMinVarcharLen = 255
+	inferredMaxVarcharLen = 16383
+	inferredDateLayout    = "2006-01-02"
+)
+
+var inferredDatetimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05.999999",
+}
+
+func inferValueType(val string) inferredType {
+	if _, err := strconv.ParseInt(val, 10, 64); err == nil {
+		return inferredBigInt
+	}
+	if _, err := strconv.ParseFloat(val, 64); err == nil {
+		return inferredDouble
+	}
+	if _, err := time.Parse(inferredDateLayout, val); err == nil {
+		return inferredDate
+	}
+	for _, layout := range inferredDatetimeLayouts {
+		if _, err := time.Parse(layout, val); err == nil {
+			return inferredDatetime
+		}
+	}
+	return inferredVarchar
+}
+
+func isNumericType(tp inferredType) bool {
+	return tp == inferredBigInt || tp == inferredDouble
+}
+
+func isTimeType(tp inferredType) bool {
+	return tp == inferredDate || tp == inferredDatetime
+}
+
+// mergeInferredType returns the type which can hold values of both types.
+func mergeInferredType(a, b inferredType) inferredType {
+	switch {
+	case a == inferredUnknown:
+		return b
+	case b == inferredUnknown:
+		return a
+	case isNumericType(a) && isNumericType(b), isTimeType(a) && isTimeType(b):
+		return max(a, b)
+	default:
+		return inferredVarchar
+	}
+}
+
+// columnTypeInferrer infers the type of a column from the sampled values.
+type columnTypeInferrer struct {
+	tp inferredType
+	// max length of the values in characters.
+	maxLen int
+}
+
+func (c *columnTypeInferrer) observe(d types.Datum) {
+	if d.IsNull() {
+		return
+	}
+	val := d.GetString()
+	c.maxLen = max(c.maxLen, utf8.RuneCountInString(val))
+	c.tp = mergeInferredType(c.tp, inferValueType(val))
+}
+
+func (c *columnTypeInferrer) String() string {
+	switch c.tp {
+	case inferredBigInt:
+		return "bigint"
+	case inferredDouble:
+		return "double"
+	case inferredDate:
+		return "date"
+	case inferredDatetime:
+		return "datetime(6)"
+	}
+	// columns with only NULL values are inferred as varchar too.
+	if c.maxLen > inferredMaxVarcharLen {
+		return "longtext"
+	}
+	size := inferredMinVarcharLen
+	for size < c.maxLen {
+		size *= 2
+	}
+	return fmt.Sprintf("varchar(%d)", min(size, inferredMaxVarcharLen))
+}
+
+// buildInferredCreateTableSQL builds the CREATE TABLE statement from the
+// header and the sampled rows, columnTypes overrides the inferred types, its
+// key must be in lower case.
+func buildInferredCreateTableSQL(
+	dbName, tblName string,
+	columns []string,
+	rows [][]types.Datum,
+	columnTypes map[string]string,
+) (string, error) {
+	if len(columns) == 0 {
+		return "", common.ErrInvalidSchemaFile.GenWithStack(
+			"cannot infer schema of table '%s.%s', the header of CSV file is empty", dbName, tblName)
+	}
+	inferrers := make([]columnTypeInferrer, len(columns))
+	for _, row := range rows {
+		for i := 0; i < len(row) && i < len(inferrers); i++ {
+			inferrers[i].observe(row[i])
+		}
+	}
+
+	var sb strings.Builder
+	seen := make(map[string]struct{}, len(columns))
+	sb.WriteString("CREATE TABLE ")
+	common.WriteMySQLIdentifier(&sb, tblName)
+	sb.WriteString(" (")
+	for i, col := range columns {
+		if col == "" {
+			return "", common.ErrInvalidSchemaFile.GenWithStack(
+				"cannot infer schema of table '%s.%s', the name of column #%d is empty", dbName, tblName, i+1)
+		}
+		if _, ok := seen[col]; ok {
+			return "", common.ErrInvalidSchemaFile.GenWithStack(
+				"cannot infer schema of table '%s.%s', duplicated column '%s'", dbName, tblName, col)
+		}
+		seen[col] = struct{}{}
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		common.WriteMySQLIdentifier(&sb, col)
+		sb.WriteByte(' ')
+		if tp, ok := columnTypes[col]; ok {
+			sb.WriteString(tp)
+		} else {
+			sb.WriteString(inferrers[i].String())
+		}
+	}
+	sb.WriteString(");")
+	return sb.String(), nil
+}
+
+// InferCSVTableSchema infers the CREATE TABLE statement of the table from the
+// header and the first rows of its first CSV data file, it's used when the
+// schema file of the table is missing and `mydumper.infer-schema` is enabled.
+func InferCSVTableSchema(
+	ctx context.Context,
+	store storage.ExternalStorage,
+	tblMeta *MDTableMeta,
+	cfg *config.MydumperRuntime,
+) (_ string, err error) {
+	var fileMeta *SourceFileMeta
+	for i := range tblMeta.DataFiles {
+		if tblMeta.DataFiles[i].FileMeta.Type == SourceTypeCSV {
+			fileMeta = &tblMeta.DataFiles[i].FileMeta
+			break
+		}
+	}
+	if fileMeta == nil {
+		return "", common.ErrSchemaNotExists.GenWithStackByArgs(tblMeta.DB, tblMeta.Name)
+	}
+
+	reader, err := OpenReader(ctx, fileMeta, store, storage.DecompressConfig{})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	charsetConvertor, err := NewCharsetConvertor(cfg.DataCharacterSet, cfg.DataInvalidCharReplace)
+	if err != nil {
+		_ = reader.Close()
+		return "", errors.Trace(err)
+	}
+	ioWorkers := worker.NewPool(ctx, 1, "infer-schema")
+	parser, err := NewCSVParser(ctx, &cfg.CSV, reader, int64(cfg.ReadBlockSize), ioWorkers, true, charsetConvertor)
+	if err != nil {
+		_ = reader.Close()
+		return "", errors.Trace(err)
+	}
+	defer func() {
+		if err2 := parser.Close(); err2 != nil && err == nil {
+			err = errors.Trace(err2)
+		}
+	}()
+
+	rows := make([][]types.Datum, 0, cfg.InferSchema.SampleRows)
+	for i := 0; i < cfg.InferSchema.SampleRows; i++ {
+		if err = parser.ReadRow(); err != nil {
+			if errors.Cause(err) == io.EOF {
+				err = nil
+				break
+			}
+			return "", errors.Trace(err)
+		}
+		lastRow := parser.LastRow()
+		rows = append(rows, append([]types.Datum{}, lastRow.Row...))
+		parser.RecycleRow(lastRow)
+	}
+
+	sql, err := buildInferredCreateTableSQL(tblMeta.DB, tblMeta.Name, parser.Columns(), rows, cfg.InferSchema.ColumnTypes)
+	if err != nil {
+		return "", err
+	}
+	log.FromContext(ctx).Info("inferred table schema from CSV file",
+		zap.String("db", tblMeta.DB), zap.String("table", tblMeta.Name),
+		zap.String("path", fileMeta.Path), zap.Int("sampledRows", len(rows)), zap.String("sql", sql))
+	return sql, nil
+}
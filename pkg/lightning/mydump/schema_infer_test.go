@@ -0,0 +1,119 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/lightning/common"
+	"github.com/pingcap/tidb/pkg/lightning/config"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeInferredType(t *testing.T) {
+	cases := []struct {
+		vals     []string
+		expected string
+	}{
+		{nil, "varchar(255)"},
+		{[]string{"1", "-2", "3"}, "bigint"},
+		{[]string{"1", "2.5"}, "double"},
+		{[]string{"1e10", "2"}, "double"},
+		{[]string{"2024-01-02", "2024-01-03"}, "date"},
+		{[]string{"2024-01-02", "2024-01-03 10:00:00"}, "datetime(6)"},
+		{[]string{"2024-01-02T10:00:00.123"}, "datetime(6)"},
+		{[]string{"1", "2024-01-02"}, "varchar(255)"},
+		{[]string{"1", "abc"}, "varchar(255)"},
+		{[]string{strings.Repeat("a", 300)}, "varchar(512)"},
+		{[]string{strings.Repeat("a", 10000)}, "varchar(16383)"},
+		{[]string{strings.Repeat("a", 20000)}, "longtext"},
+	}
+	for _, c := range cases {
+		var inferrer columnTypeInferrer
+		inferrer.observe(types.NewDatum(nil))
+		for _, v := range c.vals {
+			inferrer.observe(types.NewStringDatum(v))
+		}
+		require.Equal(t, c.expected, inferrer.String(), "values: %v", c.vals)
+	}
+}
+
+func TestBuildInferredCreateTableSQL(t *testing.T) {
+	rows := [][]types.Datum{
+		{types.NewStringDatum("1"), types.NewStringDatum("a"), types.NewDatum(nil)},
+		{types.NewStringDatum("2"), types.NewStringDatum("1.5"), types.NewStringDatum("2024-01-01")},
+	}
+	sql, err := buildInferredCreateTableSQL("db", "t`1", []string{"id", "name", "d"}, rows, nil)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `t``1` (`id` bigint, `name` varchar(255), `d` date);", sql)
+
+	sql, err = buildInferredCreateTableSQL("db", "t", []string{"id", "name", "d"}, rows,
+		map[string]string{"id": "int primary key", "name": "varchar(10)"})
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `t` (`id` int primary key, `name` varchar(10), `d` date);", sql)
+
+	_, err = buildInferredCreateTableSQL("db", "t", nil, rows, nil)
+	require.ErrorIs(t, err, common.ErrInvalidSchemaFile)
+	_, err = buildInferredCreateTableSQL("db", "t", []string{"a", ""}, rows, nil)
+	require.ErrorIs(t, err, common.ErrInvalidSchemaFile)
+	_, err = buildInferredCreateTableSQL("db", "t", []string{"a", "a"}, rows, nil)
+	require.ErrorContains(t, err, "duplicated column 'a'")
+}
+
+func TestInferCSVTableSchema(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	content := "ID,Name,Score,Created\n" +
+		"1,alice,9.5,2024-01-01 00:00:00\n" +
+		"2,bob,\\N,2024-01-02 00:00:00\n" +
+		"3,carol,7,2024-01-03 00:00:00\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db.t.csv"), []byte(content), 0o644))
+	store, err := storage.NewLocalStorage(dir)
+	require.NoError(t, err)
+
+	cfg := config.NewConfig()
+	cfg.Mydumper.InferSchema.Enable = true
+	cfg.Mydumper.InferSchema.SampleRows = config.DefaultInferSchemaSampleRows
+	tblMeta := &MDTableMeta{
+		DB:   "db",
+		Name: "t",
+		DataFiles: []FileInfo{{
+			TableName: filter.Table{Schema: "db", Name: "t"},
+			FileMeta:  SourceFileMeta{Path: "db.t.csv", Type: SourceTypeCSV, FileSize: int64(len(content))},
+		}},
+	}
+	sql, err := InferCSVTableSchema(ctx, store, tblMeta, &cfg.Mydumper)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `t` (`id` bigint, `name` varchar(255), `score` double, `created` datetime(6));", sql)
+
+	// only the first rows are sampled.
+	cfg.Mydumper.InferSchema.SampleRows = 1
+	cfg.Mydumper.InferSchema.ColumnTypes = map[string]string{"score": "decimal(5,2)"}
+	sql, err = InferCSVTableSchema(ctx, store, tblMeta, &cfg.Mydumper)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `t` (`id` bigint, `name` varchar(255), `score` decimal(5,2), `created` datetime(6));", sql)
+
+	// no CSV data file.
+	tblMeta.DataFiles[0].FileMeta.Type = SourceTypeSQL
+	_, err = InferCSVTableSchema(ctx, store, tblMeta, &cfg.Mydumper)
+	require.ErrorIs(t, err, common.ErrSchemaNotExists)
+}
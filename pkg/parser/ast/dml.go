@@ -1163,6 +1163,8 @@ type SelectStmt struct {
 	Having *HavingClause
 	// WindowSpecs is the window specification list.
 	WindowSpecs []WindowSpec
+	// Qualify is the condition to filter the results of the window functions.
+	Qualify ExprNode
 	// OrderBy is the ordering expression list.
 	OrderBy *OrderByClause
 	// Limit is the limit clause.
@@ -1359,6 +1361,13 @@ func (n *SelectStmt) Restore(ctx *format.RestoreCtx) error {
 				}
 			}
 		}
+
+		if n.Qualify != nil {
+			ctx.WriteKeyWord(" QUALIFY ")
+			if err := n.Qualify.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore SelectStmt.Qualify")
+			}
+		}
 	case SelectStmtKindTable:
 		if err := n.From.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore SelectStmt.From")
@@ -1543,6 +1552,14 @@ func (n *SelectStmt) Accept(v Visitor) (Node, bool) {
 		n.WindowSpecs[i] = *node.(*WindowSpec)
 	}
 
+	if n.Qualify != nil {
+		node, ok := n.Qualify.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Qualify = node.(ExprNode)
+	}
+
 	if n.OrderBy != nil {
 		node, ok := n.OrderBy.Accept(v)
 		if !ok {
@@ -1887,9 +1904,12 @@ const (
 type LoadDataStmt struct {
 	dmlNode
 
-	LowPriority       bool
-	FileLocRef        FileLocRefTp
-	Path              string
+	LowPriority bool
+	FileLocRef  FileLocRefTp
+	Path        string
+	// PathExpr is not nil when the path is a parameter marker of a prepared
+	// statement, and Path is empty in this case.
+	PathExpr          ExprNode
 	Format            *string
 	OnDuplicate       OnDuplicateKeyHandlingType
 	Table             *TableName
@@ -1916,7 +1936,13 @@ func (n *LoadDataStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("LOCAL ")
 	}
 	ctx.WriteKeyWord("INFILE ")
-	ctx.WriteString(n.Path)
+	if n.PathExpr != nil {
+		if err := n.PathExpr.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore LoadDataStmt.PathExpr")
+		}
+	} else {
+		ctx.WriteString(n.Path)
+	}
 	if n.Format != nil {
 		ctx.WriteKeyWord(" FORMAT ")
 		ctx.WriteString(*n.Format)
@@ -1993,6 +2019,13 @@ func (n *LoadDataStmt) Accept(v Visitor) (Node, bool) {
 		return v.Leave(newNode)
 	}
 	n = newNode.(*LoadDataStmt)
+	if n.PathExpr != nil {
+		node, ok := n.PathExpr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.PathExpr = node.(ExprNode)
+	}
 	if n.Table != nil {
 		node, ok := n.Table.Accept(v)
 		if !ok {
@@ -2022,16 +2055,34 @@ func (n *LoadDataStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.ColumnsAndUserVars[i] = node.(*ColumnNameOrUserVar)
 	}
+	if ok := acceptLoadDataOptions(v, n.Options); !ok {
+		return n, false
+	}
 	return v.Leave(n)
 }
 
 type LoadDataOpt struct {
 	// Name is the name of the option, will be converted to lower case during parse.
 	Name string
-	// only literal is allowed, we use ExprNode to support negative number
+	// only literal or parameter marker of prepared statement is allowed, we
+	// use ExprNode to support negative number
 	Value ExprNode
 }
 
+func acceptLoadDataOptions(v Visitor, options []*LoadDataOpt) bool {
+	for _, opt := range options {
+		if opt.Value == nil {
+			continue
+		}
+		node, ok := opt.Value.Accept(v)
+		if !ok {
+			return false
+		}
+		opt.Value = node.(ExprNode)
+	}
+	return true
+}
+
 func (l *LoadDataOpt) Restore(ctx *format.RestoreCtx) error {
 	if l.Value == nil {
 		ctx.WritePlain(l.Name)
@@ -2132,9 +2183,12 @@ type ImportIntoStmt struct {
 	ColumnsAndUserVars []*ColumnNameOrUserVar
 	ColumnAssignments  []*Assignment
 	Path               string
-	Format             *string
-	Options            []*LoadDataOpt
-	Select             ResultSetNode
+	// PathExpr is not nil when the path is a parameter marker of a prepared
+	// statement, and Path is empty in this case.
+	PathExpr ExprNode
+	Format   *string
+	Options  []*LoadDataOpt
+	Select   ResultSetNode
 }
 
 var _ SensitiveStmtNode = &ImportIntoStmt{}
@@ -2176,7 +2230,13 @@ func (n *ImportIntoStmt) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotate(err, "An error occurred while restore ImportIntoStmt.Select")
 		}
 	} else {
-		ctx.WriteString(n.Path)
+		if n.PathExpr != nil {
+			if err := n.PathExpr.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore ImportIntoStmt.PathExpr")
+			}
+		} else {
+			ctx.WriteString(n.Path)
+		}
 		if n.Format != nil {
 			ctx.WriteKeyWord(" FORMAT ")
 			ctx.WriteString(*n.Format)
@@ -2227,6 +2287,13 @@ func (n *ImportIntoStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.ColumnAssignments[i] = node.(*Assignment)
 	}
+	if n.PathExpr != nil {
+		node, ok := n.PathExpr.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.PathExpr = node.(ExprNode)
+	}
 	if n.Select != nil {
 		node, ok := n.Select.Accept(v)
 		if !ok {
@@ -2234,6 +2301,9 @@ func (n *ImportIntoStmt) Accept(v Visitor) (Node, bool) {
 		}
 		n.Select = node.(ResultSetNode)
 	}
+	if ok := acceptLoadDataOptions(v, n.Options); !ok {
+		return n, false
+	}
 	return v.Leave(n)
 }
 
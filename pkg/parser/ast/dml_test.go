@@ -15,6 +15,7 @@ package ast_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/parser"
@@ -650,3 +651,77 @@ func TestImportIntoFromSelectInvalidStmt(t *testing.T) {
 	_, err = p.ParseOneStmt("IMPORT INTO t1(a) set a=1 FROM select a from t2;", "", "")
 	require.ErrorContains(t, err, "Cannot use SET clause in IMPORT INTO FROM SELECT statement.")
 }
+
+type paramMarkerCollector struct {
+	markers []ParamMarkerExpr
+}
+
+func (*paramMarkerCollector) Enter(in Node) (Node, bool) {
+	return in, false
+}
+
+func (c *paramMarkerCollector) Leave(in Node) (Node, bool) {
+	if x, ok := in.(ParamMarkerExpr); ok {
+		c.markers = append(c.markers, x)
+	}
+	return in, true
+}
+
+func TestLoadDataParamMarker(t *testing.T) {
+	p := parser.New()
+	node, err := p.ParseOneStmt("IMPORT INTO t FROM ? WITH thread=?, detached", "", "")
+	require.NoError(t, err)
+	importInto := node.(*ImportIntoStmt)
+	require.Empty(t, importInto.Path)
+
+	node, err = p.ParseOneStmt("LOAD DATA INFILE ? INTO TABLE t WITH thread=?", "", "")
+	require.NoError(t, err)
+	loadData := node.(*LoadDataStmt)
+	require.Empty(t, loadData.Path)
+
+	for _, tc := range []struct {
+		node     StmtNode
+		expected string
+	}{
+		{importInto, "IMPORT INTO `t` FROM ? WITH thread=?, detached"},
+		{loadData, "LOAD DATA INFILE ? INTO TABLE `t` WITH thread=?"},
+	} {
+		var sb strings.Builder
+		require.NoError(t, tc.node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+		require.Equal(t, tc.expected, sb.String())
+
+		collector := &paramMarkerCollector{}
+		tc.node.Accept(collector)
+		require.Len(t, collector.markers, 2)
+	}
+}
+
+func TestSelectQualifyRestore(t *testing.T) {
+	p := parser.New()
+	node, err := p.ParseOneStmt("select a, row_number() over w as rn from t window w as (partition by b order by c) qualify rn = 1 order by a limit 10", "", "")
+	require.NoError(t, err)
+	sel := node.(*SelectStmt)
+
+	var sb strings.Builder
+	require.NoError(t, sel.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "SELECT `a`,ROW_NUMBER() OVER `w` AS `rn` FROM `t` WINDOW `w` AS (PARTITION BY `b` ORDER BY `c`) QUALIFY `rn`=1 ORDER BY `a` LIMIT 10", sb.String())
+
+	collector := &columnNameCollector{}
+	sel.Accept(collector)
+	require.Contains(t, collector.names, "rn")
+}
+
+type columnNameCollector struct {
+	names []string
+}
+
+func (*columnNameCollector) Enter(in Node) (Node, bool) {
+	return in, false
+}
+
+func (c *columnNameCollector) Leave(in Node) (Node, bool) {
+	if x, ok := in.(*ColumnNameExpr); ok {
+		c.names = append(c.names, x.Name.Name.L)
+	}
+	return in, true
+}
@@ -61,6 +61,9 @@ var (
 	_ StmtNode = &PlanReplayerStmt{}
 	_ StmtNode = &CompactTableStmt{}
 	_ StmtNode = &SetResourceGroupStmt{}
+	_ StmtNode = &CreateVariableProfileStmt{}
+	_ StmtNode = &SetVariableProfileStmt{}
+	_ StmtNode = &DropVariableProfileStmt{}
 
 	_ Node = &PrivElem{}
 	_ Node = &VariableAssignment{}
@@ -2333,6 +2336,12 @@ const (
 	AdminSetBDRRole
 	AdminShowBDRRole
 	AdminUnsetBDRRole
+	AdminInjectFault
+	AdminReclaimTable
+	AdminPinGCSafePoint
+	AdminUnpinGCSafePoint
+	AdminReorganizeIndex
+	AdminAlterDDLJob
 )
 
 // HandleRange represents a range where handle value >= Begin and < End.
@@ -2341,6 +2350,32 @@ type HandleRange struct {
 	End   int64
 }
 
+// FaultInjection is the fault injected by `ADMIN INJECT FAULT`.
+type FaultInjection struct {
+	Point string
+	Term  string
+	// TTL is the seconds the fault lasts, 0 means it lasts until it's removed
+	// or the session ends.
+	TTL uint64
+}
+
+// AlterJobOption is the option of `ADMIN ALTER DDL JOBS`, such as `THREAD = 8`.
+type AlterJobOption struct {
+	// Name is the lower-case name of the option.
+	Name  string
+	Value ExprNode
+}
+
+// Restore implements Node interface.
+func (n *AlterJobOption) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord(n.Name)
+	ctx.WritePlain(" = ")
+	if err := n.Value.Restore(ctx); err != nil {
+		return errors.Annotatef(err, "An error occurred while restore AlterJobOption.Value")
+	}
+	return nil
+}
+
 // BDRRole represents the role of the cluster in BDR mode.
 type BDRRole string
 
@@ -2472,6 +2507,11 @@ type AdminStmt struct {
 	StatementScope StatementScope
 	LimitSimple    LimitSimple
 	BDRRole        BDRRole
+	FaultInjection *FaultInjection
+	// PinDuration is the seconds the GC safe point of the cluster is pinned for.
+	PinDuration uint64
+	// AlterJobOptions are the options changed by `ADMIN ALTER DDL JOBS`.
+	AlterJobOptions []*AlterJobOption
 }
 
 // Restore implements Node interface.
@@ -2635,6 +2675,44 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("SHOW BDR ROLE")
 	case AdminUnsetBDRRole:
 		ctx.WriteKeyWord("UNSET BDR ROLE")
+	case AdminInjectFault:
+		ctx.WriteKeyWord("INJECT FAULT ")
+		ctx.WriteString(n.FaultInjection.Point)
+		ctx.WritePlain(" ")
+		ctx.WriteString(n.FaultInjection.Term)
+		if n.FaultInjection.TTL > 0 {
+			ctx.WriteKeyWord(" TTL ")
+			ctx.WritePlainf("%d", n.FaultInjection.TTL)
+		}
+	case AdminReclaimTable:
+		ctx.WriteKeyWord("RECLAIM TABLE ")
+		if err := restoreTables(); err != nil {
+			return err
+		}
+	case AdminPinGCSafePoint:
+		ctx.WriteKeyWord("PIN GC SAFEPOINT DURATION ")
+		ctx.WritePlainf("%d", n.PinDuration)
+	case AdminUnpinGCSafePoint:
+		ctx.WriteKeyWord("UNPIN GC SAFEPOINT")
+	case AdminReorganizeIndex:
+		ctx.WriteKeyWord("REORGANIZE INDEX ")
+		if err := restoreTables(); err != nil {
+			return err
+		}
+		ctx.WritePlainf(" %s", n.Index)
+	case AdminAlterDDLJob:
+		ctx.WriteKeyWord("ALTER DDL JOBS ")
+		restoreJobIDs()
+		for i, opt := range n.AlterJobOptions {
+			if i == 0 {
+				ctx.WritePlain(" ")
+			} else {
+				ctx.WritePlain(", ")
+			}
+			if err := opt.Restore(ctx); err != nil {
+				return err
+			}
+		}
 	default:
 		return errors.New("Unsupported AdminStmt type")
 	}
@@ -2665,6 +2743,14 @@ func (n *AdminStmt) Accept(v Visitor) (Node, bool) {
 		n.Where = node.(ExprNode)
 	}
 
+	for _, opt := range n.AlterJobOptions {
+		node, ok := opt.Value.Accept(v)
+		if !ok {
+			return n, false
+		}
+		opt.Value = node.(ExprNode)
+	}
+
 	return v.Leave(n)
 }
 
@@ -3282,6 +3368,7 @@ const (
 	BRIEOptionLastBackupTS
 	BRIEOptionLastBackupTSO
 	BRIEOptionGCTTL
+	BRIEOptionEstimateOnly
 	// restore options
 	BRIEOptionOnline
 	BRIEOptionFullBackupStorage
@@ -3405,6 +3492,8 @@ func (kind BRIEOptionType) String() string {
 		return "UNTIL_TS"
 	case BRIEOptionGCTTL:
 		return "GC_TTL"
+	case BRIEOptionEstimateOnly:
+		return "ESTIMATE_ONLY"
 	default:
 		return ""
 	}
@@ -3683,6 +3772,13 @@ type HintSetVar struct {
 	Value   string
 }
 
+// HintRuntimeFilter is the payload of `RUNTIME_FILTER` hint, which forces the runtime filter
+// built from the Build column of the hash join and applied to the scan of the Target column.
+type HintRuntimeFilter struct {
+	Build  *ColumnName
+	Target *ColumnName
+}
+
 // HintTable is table in the hint. It may have query block info.
 type HintTable struct {
 	DBName        model.CIStr
@@ -3732,9 +3828,15 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	}
 	// Hints without args except query block.
 	switch n.HintName.L {
-	case "mpp_1phase_agg", "mpp_2phase_agg", "hash_agg", "stream_agg", "agg_to_cop", "read_consistent_replica", "no_index_merge", "ignore_plan_cache", "limit_to_cop", "straight_join", "merge", "no_decorrelate":
+	case "mpp_1phase_agg", "mpp_2phase_agg", "hash_agg", "stream_agg", "agg_to_cop", "read_consistent_replica", "no_index_merge", "ignore_plan_cache", "limit_to_cop", "straight_join", "merge", "no_decorrelate", "no_runtime_filter":
 		ctx.WritePlain(")")
 		return nil
+	case "runtime_filter":
+		// RUNTIME_FILTER() without the columns forces all the runtime filters.
+		if n.HintData == nil {
+			ctx.WritePlain(")")
+			return nil
+		}
 	}
 	if n.QBName.L != "" {
 		ctx.WritePlain(" ")
@@ -3808,6 +3910,15 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain(hintData.VarName)
 		ctx.WritePlain(" = ")
 		ctx.WriteString(hintData.Value)
+	case "runtime_filter":
+		hintData := n.HintData.(HintRuntimeFilter)
+		if err := hintData.Build.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore TableOptimizerHint.HintData.Build")
+		}
+		ctx.WritePlain(" -> ")
+		if err := hintData.Target.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore TableOptimizerHint.HintData.Target")
+		}
 	}
 	ctx.WritePlain(")")
 	return nil
@@ -3864,6 +3975,117 @@ func (n *SetResourceGroupStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// CreateVariableProfileStmt is a statement to create a named bundle of session variables.
+// See SetVariableProfileStmt for how the bundle is applied.
+type CreateVariableProfileStmt struct {
+	stmtNode
+
+	OrReplace   bool
+	IfNotExists bool
+	ProfileName model.CIStr
+	// ResourceGroupName is optional, if it's set, the profile is applied when
+	// a session switches to the resource group.
+	ResourceGroupName model.CIStr
+	Variables         []*VariableAssignment
+}
+
+// Restore implements Node interface.
+func (n *CreateVariableProfileStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	if n.OrReplace {
+		ctx.WriteKeyWord("OR REPLACE ")
+	}
+	ctx.WriteKeyWord("VARIABLE PROFILE ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.ProfileName.O)
+	if n.ResourceGroupName.O != "" {
+		ctx.WriteKeyWord(" FOR RESOURCE GROUP ")
+		ctx.WriteName(n.ResourceGroupName.O)
+	}
+	ctx.WriteKeyWord(" SET ")
+	for i, v := range n.Variables {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		ctx.WritePlain(v.Name)
+		ctx.WritePlain(" = ")
+		if err := v.Value.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore CreateVariableProfileStmt.Variables[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateVariableProfileStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateVariableProfileStmt)
+	for i, val := range n.Variables {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Variables[i] = node.(*VariableAssignment)
+	}
+	return v.Leave(n)
+}
+
+// SetVariableProfileStmt is a statement to apply all session variables of a
+// variable profile to current session at once.
+type SetVariableProfileStmt struct {
+	stmtNode
+	ProfileName model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *SetVariableProfileStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("SET PROFILE ")
+	ctx.WriteName(n.ProfileName.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *SetVariableProfileStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*SetVariableProfileStmt)
+	return v.Leave(n)
+}
+
+// DropVariableProfileStmt is a statement to drop a variable profile.
+type DropVariableProfileStmt struct {
+	stmtNode
+	IfExists    bool
+	ProfileName model.CIStr
+}
+
+// Restore implements Node interface.
+func (n *DropVariableProfileStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP VARIABLE PROFILE ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.ProfileName.O)
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropVariableProfileStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropVariableProfileStmt)
+	return v.Leave(n)
+}
+
 // CalibrateResourceType is the type for CalibrateResource statement.
 type CalibrateResourceType int
 
@@ -15,11 +15,13 @@ package ast_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/stretchr/testify/assert"
@@ -300,6 +302,8 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"STRAIGHT_JOIN()", "STRAIGHT_JOIN()"},
 		{"NO_INDEX_MERGE()", "NO_INDEX_MERGE()"},
 		{"NO_INDEX_MERGE(@sel1)", "NO_INDEX_MERGE(@`sel1`)"},
+		{"RUNTIME_FILTER()", "RUNTIME_FILTER()"},
+		{"NO_RUNTIME_FILTER(@sel1)", "NO_RUNTIME_FILTER(@`sel1`)"},
 		{"READ_CONSISTENT_REPLICA()", "READ_CONSISTENT_REPLICA()"},
 		{"READ_CONSISTENT_REPLICA(@sel1)", "READ_CONSISTENT_REPLICA(@`sel1`)"},
 		{"QB_NAME(sel1)", "QB_NAME(`sel1`)"},
@@ -813,3 +817,128 @@ func TestDeniedByBDR(t *testing.T) {
 		assert.Equal(t, tc.expected, ast.DeniedByBDR(tc.role, tc.action, tc.job), fmt.Sprintf("role: %v, action: %v", tc.role, tc.action))
 	}
 }
+
+func TestAdminReclaimTableRestore(t *testing.T) {
+	stmt := &ast.AdminStmt{
+		Tp: ast.AdminReclaimTable,
+		Tables: []*ast.TableName{
+			{Name: model.NewCIStr("t1")},
+			{Schema: model.NewCIStr("test"), Name: model.NewCIStr("t2")},
+		},
+	}
+	var sb strings.Builder
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "ADMIN RECLAIM TABLE `t1`, `test`.`t2`", sb.String())
+}
+
+func TestAdminReorganizeIndexRestore(t *testing.T) {
+	stmt := &ast.AdminStmt{
+		Tp:     ast.AdminReorganizeIndex,
+		Tables: []*ast.TableName{{Schema: model.NewCIStr("test"), Name: model.NewCIStr("t")}},
+		Index:  "idx",
+	}
+	var sb strings.Builder
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "ADMIN REORGANIZE INDEX `test`.`t` idx", sb.String())
+}
+
+func TestAdminAlterDDLJobRestore(t *testing.T) {
+	stmt := &ast.AdminStmt{
+		Tp:     ast.AdminAlterDDLJob,
+		JobIDs: []int64{10},
+		AlterJobOptions: []*ast.AlterJobOption{
+			{Name: "thread", Value: ast.NewValueExpr(8, "", "")},
+			{Name: "batch_size", Value: ast.NewValueExpr(256, "", "")},
+		},
+	}
+	var sb strings.Builder
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "ADMIN ALTER DDL JOBS 10 THREAD = 8, BATCH_SIZE = 256", sb.String())
+}
+
+func TestAdminPinGCSafePointRestore(t *testing.T) {
+	var sb strings.Builder
+	stmt := &ast.AdminStmt{Tp: ast.AdminPinGCSafePoint, PinDuration: 3600}
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "ADMIN PIN GC SAFEPOINT DURATION 3600", sb.String())
+
+	sb.Reset()
+	stmt = &ast.AdminStmt{Tp: ast.AdminUnpinGCSafePoint}
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "ADMIN UNPIN GC SAFEPOINT", sb.String())
+}
+
+func TestAdminInjectFaultRestore(t *testing.T) {
+	testCases := []struct {
+		fault    *ast.FaultInjection
+		expected string
+	}{
+		{&ast.FaultInjection{Point: "txn/before-commit", Term: "return"}, "ADMIN INJECT FAULT 'txn/before-commit' 'return'"},
+		{&ast.FaultInjection{Point: "ddl/before-submit-job", Term: "2*sleep(100)", TTL: 60}, "ADMIN INJECT FAULT 'ddl/before-submit-job' '2*sleep(100)' TTL 60"},
+	}
+	for _, tc := range testCases {
+		var sb strings.Builder
+		stmt := &ast.AdminStmt{Tp: ast.AdminInjectFault, FaultInjection: tc.fault}
+		require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+		require.Equal(t, tc.expected, sb.String())
+	}
+}
+
+func TestVariableProfileRestore(t *testing.T) {
+	var sb strings.Builder
+	stmt := &ast.CreateVariableProfileStmt{
+		IfNotExists:       true,
+		ProfileName:       model.NewCIStr("olap"),
+		ResourceGroupName: model.NewCIStr("rg1"),
+		Variables: []*ast.VariableAssignment{
+			{Name: "tidb_mem_quota_query", Value: ast.NewValueExpr(1073741824, "", "")},
+			{Name: "tidb_isolation_read_engines", Value: ast.NewValueExpr("tiflash,tidb", mysql.DefaultCharset, mysql.DefaultCollationName)},
+		},
+	}
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "CREATE VARIABLE PROFILE IF NOT EXISTS `olap` FOR RESOURCE GROUP `rg1` SET tidb_mem_quota_query = 1073741824, tidb_isolation_read_engines = _UTF8MB4'tiflash,tidb'", sb.String())
+
+	sb.Reset()
+	stmt = &ast.CreateVariableProfileStmt{
+		OrReplace:   true,
+		ProfileName: model.NewCIStr("oltp"),
+		Variables: []*ast.VariableAssignment{
+			{Name: "tidb_opt_agg_push_down", Value: ast.NewValueExpr(1, "", "")},
+		},
+	}
+	require.NoError(t, stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "CREATE OR REPLACE VARIABLE PROFILE `oltp` SET tidb_opt_agg_push_down = 1", sb.String())
+
+	sb.Reset()
+	setStmt := &ast.SetVariableProfileStmt{ProfileName: model.NewCIStr("olap")}
+	require.NoError(t, setStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "SET PROFILE `olap`", sb.String())
+
+	sb.Reset()
+	dropStmt := &ast.DropVariableProfileStmt{IfExists: true, ProfileName: model.NewCIStr("olap")}
+	require.NoError(t, dropStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "DROP VARIABLE PROFILE IF EXISTS `olap`", sb.String())
+}
+
+func TestRuntimeFilterHintRestore(t *testing.T) {
+	hint := &ast.TableOptimizerHint{
+		HintName: model.NewCIStr("RUNTIME_FILTER"),
+		HintData: ast.HintRuntimeFilter{
+			Build:  &ast.ColumnName{Table: model.NewCIStr("t1"), Name: model.NewCIStr("k1")},
+			Target: &ast.ColumnName{Schema: model.NewCIStr("test"), Table: model.NewCIStr("t2"), Name: model.NewCIStr("k1")},
+		},
+	}
+	var sb strings.Builder
+	require.NoError(t, hint.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "RUNTIME_FILTER(`t1`.`k1` -> `test`.`t2`.`k1`)", sb.String())
+
+	sb.Reset()
+	hint.QBName = model.NewCIStr("sel_1")
+	require.NoError(t, hint.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "RUNTIME_FILTER(@`sel_1` `t1`.`k1` -> `test`.`t2`.`k1`)", sb.String())
+
+	sb.Reset()
+	hint.HintData = nil
+	require.NoError(t, hint.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)))
+	require.Equal(t, "RUNTIME_FILTER(@`sel_1`)", sb.String())
+}
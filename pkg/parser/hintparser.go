@@ -33,6 +33,7 @@ type yyhintSymType struct {
 	hint        *ast.TableOptimizerHint
 	hints       []*ast.TableOptimizerHint
 	table       ast.HintTable
+	column      *ast.ColumnName
 	modelIdents []model.CIStr
 }
 
@@ -41,18 +42,19 @@ type yyhintXError struct {
 }
 
 const (
-	yyhintDefault             = 57433
+	yyhintDefault             = 57436
 	yyhintEOFCode             = 57344
 	yyhintErrCode             = 57345
 	hintAggToCop              = 57379
+	hintArrow                 = 57435
 	hintBCJoin                = 57401
 	hintBKA                   = 57355
 	hintBNL                   = 57357
-	hintDupsWeedOut           = 57429
-	hintFalse                 = 57425
-	hintFirstMatch            = 57430
+	hintDupsWeedOut           = 57431
+	hintFalse                 = 57427
+	hintFirstMatch            = 57432
 	hintForceIndex            = 57415
-	hintGB                    = 57428
+	hintGB                    = 57430
 	hintHashAgg               = 57381
 	hintHashJoin              = 57359
 	hintHashJoinBuild         = 57360
@@ -75,10 +77,10 @@ const (
 	hintJoinSuffix            = 57354
 	hintLeading               = 57417
 	hintLimitToCop            = 57414
-	hintLooseScan             = 57431
-	hintMB                    = 57427
+	hintLooseScan             = 57433
+	hintMB                    = 57429
 	hintMRR                   = 57367
-	hintMaterialization       = 57432
+	hintMaterialization       = 57434
 	hintMaxExecutionTime      = 57375
 	hintMemoryQuota           = 57394
 	hintMerge                 = 57363
@@ -97,20 +99,22 @@ const (
 	hintNoMerge               = 57364
 	hintNoOrderIndex          = 57408
 	hintNoRangeOptimization   = 57370
+	hintNoRuntimeFilter       = 57421
 	hintNoSMJoin              = 57400
 	hintNoSemijoin            = 57374
 	hintNoSkipScan            = 57372
 	hintNoSwapJoinInputs      = 57395
 	hintNthPlan               = 57413
-	hintOLAP                  = 57420
-	hintOLTP                  = 57421
+	hintOLAP                  = 57422
+	hintOLTP                  = 57423
 	hintOrderIndex            = 57407
-	hintPartition             = 57422
+	hintPartition             = 57424
 	hintQBName                = 57378
 	hintQueryType             = 57396
 	hintReadConsistentReplica = 57397
 	hintReadFromStorage       = 57398
 	hintResourceGroup         = 57377
+	hintRuntimeFilter         = 57420
 	hintSMJoin                = 57399
 	hintSemiJoinRewrite       = 57418
 	hintSemijoin              = 57373
@@ -122,10 +126,10 @@ const (
 	hintStreamAgg             = 57403
 	hintStringLit             = 57350
 	hintSwapJoinInputs        = 57404
-	hintTiFlash               = 57424
-	hintTiKV                  = 57423
+	hintTiFlash               = 57426
+	hintTiKV                  = 57425
 	hintTimeRange             = 57411
-	hintTrue                  = 57426
+	hintTrue                  = 57428
 	hintUseCascades           = 57412
 	hintUseIndex              = 57406
 	hintUseIndexMerge         = 57405
@@ -133,145 +137,149 @@ const (
 	hintUseToja               = 57410
 
 	yyhintMaxDepth = 200
-	yyhintTabOfs   = -217
+	yyhintTabOfs   = -225
 )
 
 var (
 	yyhintXLAT = map[int]int{
-		41:    0,   // ')' (162x)
-		57379: 1,   // hintAggToCop (151x)
-		57401: 2,   // hintBCJoin (151x)
-		57355: 3,   // hintBKA (151x)
-		57357: 4,   // hintBNL (151x)
-		57415: 5,   // hintForceIndex (151x)
-		57381: 6,   // hintHashAgg (151x)
-		57359: 7,   // hintHashJoin (151x)
-		57360: 8,   // hintHashJoinBuild (151x)
-		57361: 9,   // hintHashJoinProbe (151x)
-		57347: 10,  // hintIdentifier (151x)
-		57384: 11,  // hintIgnoreIndex (151x)
-		57380: 12,  // hintIgnorePlanCache (151x)
-		57388: 13,  // hintIndexHashJoin (151x)
-		57385: 14,  // hintIndexJoin (151x)
-		57365: 15,  // hintIndexMerge (151x)
-		57392: 16,  // hintIndexMergeJoin (151x)
-		57387: 17,  // hintInlHashJoin (151x)
-		57390: 18,  // hintInlJoin (151x)
-		57391: 19,  // hintInlMergeJoin (151x)
-		57351: 20,  // hintJoinFixedOrder (151x)
-		57352: 21,  // hintJoinOrder (151x)
-		57353: 22,  // hintJoinPrefix (151x)
-		57354: 23,  // hintJoinSuffix (151x)
-		57417: 24,  // hintLeading (151x)
-		57414: 25,  // hintLimitToCop (151x)
-		57375: 26,  // hintMaxExecutionTime (151x)
-		57394: 27,  // hintMemoryQuota (151x)
-		57363: 28,  // hintMerge (151x)
-		57382: 29,  // hintMpp1PhaseAgg (151x)
-		57383: 30,  // hintMpp2PhaseAgg (151x)
-		57367: 31,  // hintMRR (151x)
-		57356: 32,  // hintNoBKA (151x)
-		57358: 33,  // hintNoBNL (151x)
-		57419: 34,  // hintNoDecorrelate (151x)
-		57362: 35,  // hintNoHashJoin (151x)
-		57369: 36,  // hintNoICP (151x)
-		57389: 37,  // hintNoIndexHashJoin (151x)
-		57386: 38,  // hintNoIndexJoin (151x)
-		57366: 39,  // hintNoIndexMerge (151x)
-		57393: 40,  // hintNoIndexMergeJoin (151x)
-		57364: 41,  // hintNoMerge (151x)
-		57368: 42,  // hintNoMRR (151x)
-		57408: 43,  // hintNoOrderIndex (151x)
-		57370: 44,  // hintNoRangeOptimization (151x)
-		57374: 45,  // hintNoSemijoin (151x)
-		57372: 46,  // hintNoSkipScan (151x)
-		57400: 47,  // hintNoSMJoin (151x)
-		57395: 48,  // hintNoSwapJoinInputs (151x)
-		57413: 49,  // hintNthPlan (151x)
-		57407: 50,  // hintOrderIndex (151x)
-		57378: 51,  // hintQBName (151x)
-		57396: 52,  // hintQueryType (151x)
-		57397: 53,  // hintReadConsistentReplica (151x)
-		57398: 54,  // hintReadFromStorage (151x)
-		57377: 55,  // hintResourceGroup (151x)
-		57373: 56,  // hintSemijoin (151x)
-		57418: 57,  // hintSemiJoinRewrite (151x)
-		57376: 58,  // hintSetVar (151x)
-		57402: 59,  // hintShuffleJoin (151x)
-		57371: 60,  // hintSkipScan (151x)
-		57399: 61,  // hintSMJoin (151x)
-		57416: 62,  // hintStraightJoin (151x)
-		57403: 63,  // hintStreamAgg (151x)
-		57404: 64,  // hintSwapJoinInputs (151x)
-		57411: 65,  // hintTimeRange (151x)
-		57412: 66,  // hintUseCascades (151x)
-		57406: 67,  // hintUseIndex (151x)
-		57405: 68,  // hintUseIndexMerge (151x)
-		57409: 69,  // hintUsePlanCache (151x)
-		57410: 70,  // hintUseToja (151x)
-		44:    71,  // ',' (145x)
-		57429: 72,  // hintDupsWeedOut (124x)
-		57430: 73,  // hintFirstMatch (124x)
-		57431: 74,  // hintLooseScan (124x)
-		57432: 75,  // hintMaterialization (124x)
-		57424: 76,  // hintTiFlash (124x)
-		57423: 77,  // hintTiKV (124x)
-		57425: 78,  // hintFalse (123x)
-		57420: 79,  // hintOLAP (123x)
-		57421: 80,  // hintOLTP (123x)
-		57426: 81,  // hintTrue (123x)
-		57428: 82,  // hintGB (122x)
-		57427: 83,  // hintMB (122x)
-		57349: 84,  // hintSingleAtIdentifier (103x)
-		57346: 85,  // hintIntLit (100x)
-		93:    86,  // ']' (93x)
-		46:    87,  // '.' (92x)
-		57422: 88,  // hintPartition (87x)
-		61:    89,  // '=' (84x)
-		40:    90,  // '(' (79x)
-		57344: 91,  // $end (29x)
-		57453: 92,  // QueryBlockOpt (21x)
-		57445: 93,  // Identifier (18x)
-		57350: 94,  // hintStringLit (6x)
-		57435: 95,  // CommaOpt (5x)
-		57441: 96,  // HintTable (4x)
-		57442: 97,  // HintTableList (4x)
-		91:    98,  // '[' (3x)
-		43:    99,  // '+' (2x)
-		45:    100, // '-' (2x)
-		57434: 101, // BooleanHintName (2x)
-		57436: 102, // HintIndexList (2x)
-		57438: 103, // HintStorageType (2x)
-		57439: 104, // HintStorageTypeAndTable (2x)
-		57443: 105, // HintTableListOpt (2x)
-		57448: 106, // JoinOrderOptimizerHintName (2x)
-		57449: 107, // NullaryHintName (2x)
-		57451: 108, // PartitionList (2x)
-		57452: 109, // PartitionListOpt (2x)
-		57455: 110, // StorageOptimizerHintOpt (2x)
-		57456: 111, // SubqueryOptimizerHintName (2x)
-		57459: 112, // SubqueryStrategy (2x)
-		57460: 113, // SupportedIndexLevelOptimizerHintName (2x)
-		57461: 114, // SupportedTableLevelOptimizerHintName (2x)
-		57462: 115, // TableOptimizerHintOpt (2x)
-		57464: 116, // UnsupportedIndexLevelOptimizerHintName (2x)
-		57465: 117, // UnsupportedTableLevelOptimizerHintName (2x)
-		57466: 118, // Value (2x)
-		57467: 119, // ViewName (2x)
-		57437: 120, // HintQueryType (1x)
-		57440: 121, // HintStorageTypeAndTableList (1x)
-		57444: 122, // HintTrueOrFalse (1x)
-		57446: 123, // IndexNameList (1x)
-		57447: 124, // IndexNameListOpt (1x)
-		57450: 125, // OptimizerHintList (1x)
-		57454: 126, // Start (1x)
-		57457: 127, // SubqueryStrategies (1x)
-		57458: 128, // SubqueryStrategiesOpt (1x)
-		57463: 129, // UnitOfBytes (1x)
-		57468: 130, // ViewNameList (1x)
-		57433: 131, // $default (0x)
-		57345: 132, // error (0x)
-		57348: 133, // hintInvalid (0x)
+		41:    0,   // ')' (170x)
+		57379: 1,   // hintAggToCop (160x)
+		57401: 2,   // hintBCJoin (160x)
+		57355: 3,   // hintBKA (160x)
+		57357: 4,   // hintBNL (160x)
+		57415: 5,   // hintForceIndex (160x)
+		57381: 6,   // hintHashAgg (160x)
+		57359: 7,   // hintHashJoin (160x)
+		57360: 8,   // hintHashJoinBuild (160x)
+		57361: 9,   // hintHashJoinProbe (160x)
+		57347: 10,  // hintIdentifier (160x)
+		57384: 11,  // hintIgnoreIndex (160x)
+		57380: 12,  // hintIgnorePlanCache (160x)
+		57388: 13,  // hintIndexHashJoin (160x)
+		57385: 14,  // hintIndexJoin (160x)
+		57365: 15,  // hintIndexMerge (160x)
+		57392: 16,  // hintIndexMergeJoin (160x)
+		57387: 17,  // hintInlHashJoin (160x)
+		57390: 18,  // hintInlJoin (160x)
+		57391: 19,  // hintInlMergeJoin (160x)
+		57351: 20,  // hintJoinFixedOrder (160x)
+		57352: 21,  // hintJoinOrder (160x)
+		57353: 22,  // hintJoinPrefix (160x)
+		57354: 23,  // hintJoinSuffix (160x)
+		57417: 24,  // hintLeading (160x)
+		57414: 25,  // hintLimitToCop (160x)
+		57375: 26,  // hintMaxExecutionTime (160x)
+		57394: 27,  // hintMemoryQuota (160x)
+		57363: 28,  // hintMerge (160x)
+		57382: 29,  // hintMpp1PhaseAgg (160x)
+		57383: 30,  // hintMpp2PhaseAgg (160x)
+		57367: 31,  // hintMRR (160x)
+		57356: 32,  // hintNoBKA (160x)
+		57358: 33,  // hintNoBNL (160x)
+		57419: 34,  // hintNoDecorrelate (160x)
+		57362: 35,  // hintNoHashJoin (160x)
+		57369: 36,  // hintNoICP (160x)
+		57389: 37,  // hintNoIndexHashJoin (160x)
+		57386: 38,  // hintNoIndexJoin (160x)
+		57366: 39,  // hintNoIndexMerge (160x)
+		57393: 40,  // hintNoIndexMergeJoin (160x)
+		57364: 41,  // hintNoMerge (160x)
+		57368: 42,  // hintNoMRR (160x)
+		57408: 43,  // hintNoOrderIndex (160x)
+		57370: 44,  // hintNoRangeOptimization (160x)
+		57421: 45,  // hintNoRuntimeFilter (160x)
+		57374: 46,  // hintNoSemijoin (160x)
+		57372: 47,  // hintNoSkipScan (160x)
+		57400: 48,  // hintNoSMJoin (160x)
+		57395: 49,  // hintNoSwapJoinInputs (160x)
+		57413: 50,  // hintNthPlan (160x)
+		57407: 51,  // hintOrderIndex (160x)
+		57378: 52,  // hintQBName (160x)
+		57396: 53,  // hintQueryType (160x)
+		57397: 54,  // hintReadConsistentReplica (160x)
+		57398: 55,  // hintReadFromStorage (160x)
+		57377: 56,  // hintResourceGroup (160x)
+		57420: 57,  // hintRuntimeFilter (160x)
+		57373: 58,  // hintSemijoin (160x)
+		57418: 59,  // hintSemiJoinRewrite (160x)
+		57376: 60,  // hintSetVar (160x)
+		57402: 61,  // hintShuffleJoin (160x)
+		57371: 62,  // hintSkipScan (160x)
+		57399: 63,  // hintSMJoin (160x)
+		57416: 64,  // hintStraightJoin (160x)
+		57403: 65,  // hintStreamAgg (160x)
+		57404: 66,  // hintSwapJoinInputs (160x)
+		57411: 67,  // hintTimeRange (160x)
+		57412: 68,  // hintUseCascades (160x)
+		57406: 69,  // hintUseIndex (160x)
+		57405: 70,  // hintUseIndexMerge (160x)
+		57409: 71,  // hintUsePlanCache (160x)
+		57410: 72,  // hintUseToja (160x)
+		44:    73,  // ',' (149x)
+		57431: 74,  // hintDupsWeedOut (131x)
+		57432: 75,  // hintFirstMatch (131x)
+		57433: 76,  // hintLooseScan (131x)
+		57434: 77,  // hintMaterialization (131x)
+		57426: 78,  // hintTiFlash (131x)
+		57425: 79,  // hintTiKV (131x)
+		57427: 80,  // hintFalse (130x)
+		57422: 81,  // hintOLAP (130x)
+		57423: 82,  // hintOLTP (130x)
+		57428: 83,  // hintTrue (130x)
+		57430: 84,  // hintGB (129x)
+		57429: 85,  // hintMB (129x)
+		57349: 86,  // hintSingleAtIdentifier (106x)
+		57346: 87,  // hintIntLit (102x)
+		46:    88,  // '.' (96x)
+		93:    89,  // ']' (95x)
+		57424: 90,  // hintPartition (89x)
+		57435: 91,  // hintArrow (88x)
+		61:    92,  // '=' (86x)
+		40:    93,  // '(' (81x)
+		57344: 94,  // $end (31x)
+		57449: 95,  // Identifier (22x)
+		57457: 96,  // QueryBlockOpt (22x)
+		57350: 97,  // hintStringLit (6x)
+		57438: 98,  // CommaOpt (5x)
+		57445: 99,  // HintTable (4x)
+		57446: 100, // HintTableList (4x)
+		91:    101, // '[' (3x)
+		43:    102, // '+' (2x)
+		45:    103, // '-' (2x)
+		57437: 104, // BooleanHintName (2x)
+		57439: 105, // HintColumnName (2x)
+		57440: 106, // HintIndexList (2x)
+		57442: 107, // HintStorageType (2x)
+		57443: 108, // HintStorageTypeAndTable (2x)
+		57447: 109, // HintTableListOpt (2x)
+		57452: 110, // JoinOrderOptimizerHintName (2x)
+		57453: 111, // NullaryHintName (2x)
+		57455: 112, // PartitionList (2x)
+		57456: 113, // PartitionListOpt (2x)
+		57459: 114, // StorageOptimizerHintOpt (2x)
+		57460: 115, // SubqueryOptimizerHintName (2x)
+		57463: 116, // SubqueryStrategy (2x)
+		57464: 117, // SupportedIndexLevelOptimizerHintName (2x)
+		57465: 118, // SupportedTableLevelOptimizerHintName (2x)
+		57466: 119, // TableOptimizerHintOpt (2x)
+		57468: 120, // UnsupportedIndexLevelOptimizerHintName (2x)
+		57469: 121, // UnsupportedTableLevelOptimizerHintName (2x)
+		57470: 122, // Value (2x)
+		57471: 123, // ViewName (2x)
+		57441: 124, // HintQueryType (1x)
+		57444: 125, // HintStorageTypeAndTableList (1x)
+		57448: 126, // HintTrueOrFalse (1x)
+		57450: 127, // IndexNameList (1x)
+		57451: 128, // IndexNameListOpt (1x)
+		57454: 129, // OptimizerHintList (1x)
+		57458: 130, // Start (1x)
+		57461: 131, // SubqueryStrategies (1x)
+		57462: 132, // SubqueryStrategiesOpt (1x)
+		57467: 133, // UnitOfBytes (1x)
+		57472: 134, // ViewNameList (1x)
+		57436: 135, // $default (0x)
+		57345: 136, // error (0x)
+		57348: 137, // hintInvalid (0x)
 	}
 
 	yyhintSymNames = []string{
@@ -320,6 +328,7 @@ var (
 		"hintNoMRR",
 		"hintNoOrderIndex",
 		"hintNoRangeOptimization",
+		"hintNoRuntimeFilter",
 		"hintNoSemijoin",
 		"hintNoSkipScan",
 		"hintNoSMJoin",
@@ -331,6 +340,7 @@ var (
 		"hintReadConsistentReplica",
 		"hintReadFromStorage",
 		"hintResourceGroup",
+		"hintRuntimeFilter",
 		"hintSemijoin",
 		"hintSemiJoinRewrite",
 		"hintSetVar",
@@ -361,14 +371,15 @@ var (
 		"hintMB",
 		"hintSingleAtIdentifier",
 		"hintIntLit",
-		"']'",
 		"'.'",
+		"']'",
 		"hintPartition",
+		"hintArrow",
 		"'='",
 		"'('",
 		"$end",
-		"QueryBlockOpt",
 		"Identifier",
+		"QueryBlockOpt",
 		"hintStringLit",
 		"CommaOpt",
 		"HintTable",
@@ -377,6 +388,7 @@ var (
 		"'+'",
 		"'-'",
 		"BooleanHintName",
+		"HintColumnName",
 		"HintIndexList",
 		"HintStorageType",
 		"HintStorageTypeAndTable",
@@ -413,607 +425,634 @@ var (
 
 	yyhintReductions = []struct{ xsym, components int }{
 		{0, 1},
-		{126, 1},
-		{125, 1},
-		{125, 3},
+		{130, 1},
+		{129, 1},
+		{129, 3},
+		{129, 1},
+		{129, 3},
+		{119, 4},
+		{119, 4},
+		{119, 4},
+		{119, 4},
+		{119, 4},
+		{119, 4},
+		{119, 5},
+		{119, 5},
+		{119, 5},
+		{119, 6},
+		{119, 4},
+		{119, 4},
+		{119, 6},
+		{119, 6},
+		{119, 6},
+		{119, 5},
+		{119, 4},
+		{119, 4},
+		{119, 7},
+		{119, 5},
+		{119, 5},
+		{119, 4},
+		{119, 6},
+		{119, 6},
+		{114, 5},
 		{125, 1},
 		{125, 3},
-		{115, 4},
-		{115, 4},
-		{115, 4},
-		{115, 4},
-		{115, 4},
-		{115, 4},
-		{115, 5},
-		{115, 5},
-		{115, 5},
-		{115, 6},
-		{115, 4},
-		{115, 4},
-		{115, 6},
-		{115, 6},
-		{115, 6},
-		{115, 5},
-		{115, 4},
-		{115, 5},
-		{115, 5},
-		{115, 4},
-		{115, 6},
-		{115, 6},
-		{110, 5},
-		{121, 1},
-		{121, 3},
-		{104, 4},
-		{92, 0},
-		{92, 1},
-		{95, 0},
-		{95, 1},
-		{109, 0},
-		{109, 4},
-		{108, 1},
-		{108, 3},
-		{105, 1},
+		{108, 4},
+		{96, 0},
+		{96, 1},
+		{98, 0},
+		{98, 1},
+		{113, 0},
+		{113, 4},
+		{112, 1},
+		{112, 3},
+		{109, 1},
+		{109, 1},
+		{100, 2},
+		{100, 3},
+		{99, 3},
+		{99, 5},
 		{105, 1},
-		{97, 2},
-		{97, 3},
-		{96, 3},
-		{96, 5},
-		{130, 3},
-		{130, 1},
-		{119, 2},
-		{119, 1},
-		{102, 4},
-		{124, 0},
-		{124, 1},
+		{105, 3},
+		{105, 5},
+		{134, 3},
+		{134, 1},
+		{123, 2},
 		{123, 1},
-		{123, 3},
+		{106, 4},
 		{128, 0},
 		{128, 1},
 		{127, 1},
 		{127, 3},
+		{132, 0},
+		{132, 1},
+		{131, 1},
+		{131, 3},
+		{122, 1},
+		{122, 1},
+		{122, 1},
+		{122, 2},
+		{122, 2},
+		{133, 1},
+		{133, 1},
+		{126, 1},
+		{126, 1},
+		{110, 1},
+		{110, 1},
+		{110, 1},
+		{121, 1},
+		{121, 1},
+		{121, 1},
+		{121, 1},
+		{121, 1},
 		{118, 1},
 		{118, 1},
 		{118, 1},
-		{118, 2},
-		{118, 2},
-		{129, 1},
-		{129, 1},
-		{122, 1},
-		{122, 1},
-		{106, 1},
-		{106, 1},
-		{106, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{118, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
+		{120, 1},
 		{117, 1},
 		{117, 1},
 		{117, 1},
 		{117, 1},
 		{117, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{116, 1},
-		{116, 1},
-		{116, 1},
+		{117, 1},
+		{115, 1},
+		{115, 1},
 		{116, 1},
 		{116, 1},
 		{116, 1},
 		{116, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
+		{104, 1},
+		{104, 1},
 		{111, 1},
 		{111, 1},
-		{112, 1},
-		{112, 1},
-		{112, 1},
-		{112, 1},
-		{101, 1},
-		{101, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{111, 1},
+		{124, 1},
+		{124, 1},
 		{107, 1},
 		{107, 1},
-		{120, 1},
-		{120, 1},
-		{103, 1},
-		{103, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
+		{95, 1},
 	}
 
 	yyhintXErrors = map[yyhintXError]string{}
 
-	yyhintParseTab = [316][]uint16{
+	yyhintParseTab = [332][]uint16{
 		// 0
-		{1: 292, 251, 244, 246, 280, 288, 265, 267, 268, 239, 278, 296, 258, 254, 270, 263, 257, 253, 262, 222, 241, 242, 243, 269, 293, 229, 234, 256, 289, 290, 271, 245, 247, 299, 266, 273, 259, 255, 294, 264, 248, 272, 282, 274, 284, 276, 250, 261, 230, 281, 233, 238, 295, 240, 232, 283, 298, 231, 252, 275, 249, 297, 291, 260, 235, 286, 277, 279, 287, 285, 101: 236, 106: 223, 237, 110: 221, 228, 113: 227, 225, 220, 226, 224, 125: 219, 218},
-		{91: 217},
-		{1: 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 404, 91: 216, 95: 530},
-		{1: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 91: 215},
-		{1: 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 91: 213},
+		{1: 301, 260, 253, 255, 289, 297, 274, 276, 277, 248, 287, 305, 267, 263, 279, 272, 266, 262, 271, 230, 250, 251, 252, 278, 302, 237, 242, 265, 298, 299, 280, 254, 256, 308, 275, 282, 268, 264, 303, 273, 257, 281, 291, 283, 309, 293, 285, 259, 270, 238, 290, 241, 247, 304, 249, 240, 246, 292, 307, 239, 261, 284, 258, 306, 300, 269, 243, 295, 286, 288, 296, 294, 104: 244, 110: 231, 245, 114: 229, 236, 117: 235, 233, 228, 234, 232, 129: 227, 226},
+		{94: 225},
+		{1: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 416, 94: 224, 98: 554},
+		{1: 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 94: 223},
+		{1: 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 94: 221},
 		// 5
-		{90: 527},
-		{90: 524},
-		{90: 521},
-		{90: 516},
-		{90: 513},
+		{93: 551},
+		{93: 548},
+		{93: 545},
+		{93: 540},
+		{93: 537},
 		// 10
-		{90: 502},
-		{90: 490},
-		{90: 486},
-		{90: 482},
-		{90: 477},
+		{93: 526},
+		{93: 514},
+		{93: 510},
+		{93: 506},
+		{93: 501},
 		// 15
-		{90: 474},
-		{90: 462},
-		{90: 455},
-		{90: 450},
-		{90: 444},
+		{93: 498},
+		{93: 486},
+		{93: 479},
+		{93: 474},
+		{93: 468},
 		// 20
-		{90: 441},
-		{90: 435},
-		{90: 415},
-		{90: 300},
-		{90: 149},
+		{93: 465},
+		{93: 453},
+		{93: 447},
+		{93: 427},
+		{93: 310},
 		// 25
-		{90: 148},
-		{90: 147},
-		{90: 146},
-		{90: 145},
-		{90: 144},
+		{93: 152},
+		{93: 151},
+		{93: 150},
+		{93: 149},
+		{93: 148},
 		// 30
-		{90: 143},
-		{90: 142},
-		{90: 141},
-		{90: 140},
-		{90: 139},
+		{93: 147},
+		{93: 146},
+		{93: 145},
+		{93: 144},
+		{93: 143},
 		// 35
-		{90: 138},
-		{90: 137},
-		{90: 136},
-		{90: 135},
-		{90: 134},
+		{93: 142},
+		{93: 141},
+		{93: 140},
+		{93: 139},
+		{93: 138},
 		// 40
-		{90: 133},
-		{90: 132},
-		{90: 131},
-		{90: 130},
-		{90: 129},
+		{93: 137},
+		{93: 136},
+		{93: 135},
+		{93: 134},
+		{93: 133},
 		// 45
-		{90: 128},
-		{90: 127},
-		{90: 126},
-		{90: 125},
-		{90: 124},
+		{93: 132},
+		{93: 131},
+		{93: 130},
+		{93: 129},
+		{93: 128},
 		// 50
-		{90: 123},
-		{90: 122},
-		{90: 121},
-		{90: 120},
-		{90: 119},
+		{93: 127},
+		{93: 126},
+		{93: 125},
+		{93: 124},
+		{93: 123},
 		// 55
-		{90: 118},
-		{90: 117},
-		{90: 116},
-		{90: 115},
-		{90: 114},
+		{93: 122},
+		{93: 121},
+		{93: 120},
+		{93: 119},
+		{93: 118},
 		// 60
-		{90: 113},
-		{90: 112},
-		{90: 111},
-		{90: 110},
-		{90: 109},
+		{93: 117},
+		{93: 116},
+		{93: 115},
+		{93: 114},
+		{93: 113},
 		// 65
-		{90: 108},
-		{90: 107},
-		{90: 106},
-		{90: 101},
-		{90: 100},
+		{93: 112},
+		{93: 111},
+		{93: 110},
+		{93: 109},
+		{93: 104},
 		// 70
-		{90: 99},
-		{90: 98},
-		{90: 97},
-		{90: 96},
-		{90: 95},
+		{93: 103},
+		{93: 102},
+		{93: 101},
+		{93: 100},
+		{93: 99},
 		// 75
-		{90: 94},
-		{90: 93},
-		{90: 92},
-		{90: 91},
-		{90: 90},
+		{93: 98},
+		{93: 97},
+		{93: 96},
+		{93: 95},
+		{93: 94},
 		// 80
-		{90: 89},
-		{90: 88},
-		{90: 87},
-		{76: 185, 185, 84: 302, 92: 301},
-		{76: 307, 306, 103: 305, 304, 121: 303},
+		{93: 93},
+		{93: 92},
+		{93: 91},
+		{93: 90},
+		{93: 89},
 		// 85
-		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 85: 184, 184, 184, 184},
-		{412, 71: 413},
-		{188, 71: 188},
-		{98: 308},
-		{98: 84},
+		{78: 191, 191, 86: 312, 96: 311},
+		{78: 317, 316, 107: 315, 314, 125: 313},
+		{190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 87: 190, 190, 190, 190},
+		{424, 73: 425},
+		{194, 73: 194},
 		// 90
-		{98: 83},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 310, 97: 309},
-		{71: 410, 86: 409},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 312, 96: 311},
-		{175, 71: 175, 86: 175},
+		{101: 318},
+		{101: 86},
+		{101: 85},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 74: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 96: 320, 100: 319},
+		{73: 422, 89: 421},
 		// 95
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 86: 185, 396, 185, 92: 395},
-		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
-		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
-		{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80},
-		{79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 322, 99: 321},
+		{181, 73: 181, 89: 181},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 88: 408, 191, 191, 96: 407},
+		{84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84},
+		{83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83},
 		// 100
-		{78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78},
-		{77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77},
-		{76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76},
-		{75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75},
-		{74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74},
+		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
+		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
+		{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80},
+		{79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79},
+		{78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78},
 		// 105
-		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73},
-		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
-		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
-		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
-		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69},
+		{77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77},
+		{76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76},
+		{75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75},
+		{74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74},
+		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73},
 		// 110
-		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
-		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
-		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
-		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
-		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
+		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
+		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
+		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
+		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69},
+		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
 		// 115
-		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
-		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
-		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
-		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
-		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
+		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
+		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
+		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
+		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
 		// 120
-		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
-		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
-		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
-		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
-		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
+		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
+		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
+		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
+		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
 		// 125
-		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
-		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
-		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
-		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
-		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
+		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
+		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
+		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
+		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
+		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
 		// 130
-		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
-		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
-		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
-		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
-		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
+		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
+		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
+		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
+		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
 		// 135
-		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
-		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
-		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
-		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
+		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
+		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
 		// 140
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38},
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36},
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35},
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38},
 		// 145
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33},
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31},
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29},
+		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37},
+		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36},
+		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35},
+		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33},
 		// 150
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27},
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26},
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25},
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24},
+		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32},
+		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31},
+		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29},
+		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
 		// 155
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23},
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22},
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21},
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27},
+		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26},
+		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25},
+		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24},
+		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23},
 		// 160
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18},
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17},
-		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
-		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
-		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22},
+		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18},
 		// 165
-		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
-		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
-		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
-		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
+		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
 		// 170
-		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
-		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
-		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
-		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
-		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
+		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
+		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
 		// 175
-		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
-		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
-		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 86: 181, 88: 399, 109: 408},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 397},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
+		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
 		// 180
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 86: 185, 88: 185, 92: 398},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 86: 181, 88: 399, 109: 400},
-		{90: 401},
-		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 86: 172},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 403, 108: 402},
+		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 89: 187, 411, 113: 420},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 409},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 89: 191, 191, 96: 410},
 		// 185
-		{405, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 404, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 95: 406},
-		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179},
-		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 72: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 85: 182, 94: 182},
-		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 86: 180},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 407},
+		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 89: 187, 411, 113: 412},
+		{93: 413},
+		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 89: 178},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 415, 112: 414},
+		{417, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 416, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 98: 418},
 		// 190
-		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 85: 178},
-		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 86: 173},
-		{186, 71: 186},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 312, 96: 411},
-		{174, 71: 174, 86: 174},
+		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185},
+		{188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 74: 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 87: 188, 97: 188},
+		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 89: 186},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 419},
+		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 87: 184},
 		// 195
-		{1: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 91: 189},
-		{76: 307, 306, 103: 305, 414},
-		{187, 71: 187},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 302, 185, 92: 416, 418, 108: 417},
-		{85: 433},
+		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 89: 179},
+		{192, 73: 192},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 322, 99: 423},
+		{180, 73: 180, 89: 180},
+		{1: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 94: 195},
 		// 200
-		{429, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 404, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 85: 183, 95: 430},
-		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 85: 179, 89: 419},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 85: 423, 93: 422, 421, 99: 424, 425, 118: 420},
-		{428},
-		{158},
+		{78: 317, 316, 107: 315, 426},
+		{193, 73: 193},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 312, 191, 95: 430, 428, 112: 429},
+		{87: 445},
+		{441, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 416, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 87: 189, 98: 442},
 		// 205
-		{157},
-		{156},
-		{85: 427},
-		{85: 426},
-		{154},
+		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 87: 185, 92: 431},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 87: 435, 95: 434, 97: 433, 102: 436, 437, 122: 432},
+		{440},
+		{161},
+		{160},
 		// 210
-		{155},
-		{1: 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 91: 190},
-		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 91: 192},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 85: 431, 93: 407},
-		{432},
+		{159},
+		{87: 439},
+		{87: 438},
+		{157},
+		{158},
 		// 215
-		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 91: 191},
-		{434},
-		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 91: 193},
-		{79: 185, 185, 84: 302, 92: 436},
-		{79: 438, 439, 120: 437},
+		{1: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 94: 196},
+		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 94: 198},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 87: 443, 95: 419},
+		{444},
+		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 94: 197},
 		// 220
-		{440},
-		{86},
-		{85},
-		{1: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 91: 194},
-		{185, 84: 302, 92: 442},
+		{446},
+		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 94: 199},
+		{81: 191, 191, 86: 312, 96: 448},
+		{81: 450, 451, 124: 449},
+		{452},
 		// 225
-		{443},
-		{1: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 91: 195},
-		{78: 185, 81: 185, 84: 302, 92: 445},
-		{78: 448, 81: 447, 122: 446},
-		{449},
+		{88},
+		{87},
+		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 94: 200},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 74: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 96: 454},
+		{455, 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 457, 105: 456},
 		// 230
-		{151},
-		{150},
-		{1: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 91: 196},
-		{94: 451},
-		{71: 404, 94: 183, 452},
+		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 94: 202},
+		{91: 462},
+		{177, 88: 458, 91: 177},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 459},
+		{176, 88: 460, 91: 176},
 		// 235
-		{94: 453},
-		{454},
-		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 91: 197},
-		{84: 302, 185, 92: 456},
-		{85: 457},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 461},
+		{175, 91: 175},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 457, 105: 463},
+		{464},
+		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 94: 201},
 		// 240
-		{82: 460, 459, 129: 458},
-		{461},
-		{153},
-		{152},
-		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 91: 198},
+		{191, 86: 312, 96: 466},
+		{467},
+		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 94: 203},
+		{80: 191, 83: 191, 86: 312, 96: 469},
+		{80: 472, 83: 471, 126: 470},
 		// 245
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 463},
-		{464, 71: 465},
-		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 91: 200},
-		{185, 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 302, 87: 185, 92: 469, 468, 119: 467, 130: 466},
-		{471, 87: 472},
+		{473},
+		{154},
+		{153},
+		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 94: 204},
+		{97: 475},
 		// 250
-		{170, 87: 170},
-		{185, 84: 302, 87: 185, 92: 470},
-		{168, 87: 168},
-		{169, 87: 169},
-		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 91: 199},
+		{73: 416, 97: 189, 476},
+		{97: 477},
+		{478},
+		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 94: 205},
+		{86: 312, 191, 96: 480},
 		// 255
-		{185, 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 302, 87: 185, 92: 469, 468, 119: 473},
-		{171, 87: 171},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 475},
-		{476},
-		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 91: 201},
+		{87: 481},
+		{84: 484, 483, 133: 482},
+		{485},
+		{156},
+		{155},
 		// 260
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 478},
-		{89: 479},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 85: 423, 93: 422, 421, 99: 424, 425, 118: 480},
-		{481},
-		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 91: 202},
+		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 94: 206},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 487},
+		{488, 73: 489},
+		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 94: 208},
+		{191, 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 312, 88: 191, 95: 492, 493, 123: 491, 134: 490},
 		// 265
-		{84: 302, 185, 92: 483},
-		{85: 484},
-		{485},
-		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 91: 203},
-		{84: 302, 185, 92: 487},
+		{495, 88: 496},
+		{173, 88: 173},
+		{191, 86: 312, 88: 191, 96: 494},
+		{171, 88: 171},
+		{172, 88: 172},
 		// 270
-		{85: 488},
-		{489},
-		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 91: 204},
-		{185, 72: 185, 185, 185, 185, 84: 302, 92: 491},
-		{162, 72: 495, 496, 497, 498, 112: 494, 127: 493, 492},
+		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 94: 207},
+		{191, 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 312, 88: 191, 95: 492, 493, 123: 497},
+		{174, 88: 174},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 499},
+		{500},
 		// 275
-		{501},
-		{161, 71: 499},
-		{160, 71: 160},
-		{105, 71: 105},
-		{104, 71: 104},
+		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 94: 209},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 502},
+		{92: 503},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 87: 435, 95: 434, 97: 433, 102: 436, 437, 122: 504},
+		{505},
 		// 280
-		{103, 71: 103},
-		{102, 71: 102},
-		{72: 495, 496, 497, 498, 112: 500},
-		{159, 71: 159},
-		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 91: 205},
+		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 94: 210},
+		{86: 312, 191, 96: 507},
+		{87: 508},
+		{509},
+		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 94: 211},
 		// 285
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 504, 102: 503},
-		{512},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 312, 96: 505},
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 404, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 95: 506},
-		{166, 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 509, 123: 508, 507},
+		{86: 312, 191, 96: 511},
+		{87: 512},
+		{513},
+		{1: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 94: 212},
+		{191, 74: 191, 191, 191, 191, 86: 312, 96: 515},
 		// 290
-		{167},
-		{165, 71: 510},
-		{164, 71: 164},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 511},
-		{163, 71: 163},
+		{165, 74: 519, 520, 521, 522, 116: 518, 131: 517, 516},
+		{525},
+		{164, 73: 523},
+		{163, 73: 163},
+		{108, 73: 108},
 		// 295
-		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 91: 206},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 504, 102: 514},
-		{515},
-		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 91: 207},
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 519, 97: 518, 105: 517},
+		{107, 73: 107},
+		{106, 73: 106},
+		{105, 73: 105},
+		{74: 519, 520, 521, 522, 116: 524},
+		{162, 73: 162},
 		// 300
-		{520},
-		{177, 71: 410},
-		{176, 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 312, 96: 311},
-		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 91: 208},
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 519, 97: 518, 105: 522},
+		{1: 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 94: 213},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 74: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 96: 528, 106: 527},
+		{536},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 322, 99: 529},
+		{189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 416, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 98: 530},
 		// 305
-		{523},
-		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 91: 209},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 310, 97: 525},
-		{526, 71: 410},
-		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 91: 210},
+		{169, 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 533, 127: 532, 531},
+		{170},
+		{168, 73: 534},
+		{167, 73: 167},
+		{1: 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 535},
 		// 310
-		{185, 84: 302, 92: 528},
-		{529},
-		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 91: 211},
-		{1: 292, 251, 244, 246, 280, 288, 265, 267, 268, 239, 278, 296, 258, 254, 270, 263, 257, 253, 262, 222, 241, 242, 243, 269, 293, 229, 234, 256, 289, 290, 271, 245, 247, 299, 266, 273, 259, 255, 294, 264, 248, 272, 282, 274, 284, 276, 250, 261, 230, 281, 233, 238, 295, 240, 232, 283, 298, 231, 252, 275, 249, 297, 291, 260, 235, 286, 277, 279, 287, 285, 101: 236, 106: 223, 237, 110: 532, 228, 113: 227, 225, 531, 226, 224},
-		{1: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 91: 214},
+		{166, 73: 166},
+		{1: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 94: 214},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 74: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 96: 528, 106: 538},
+		{539},
+		{1: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 94: 215},
 		// 315
-		{1: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 91: 212},
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 74: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 96: 543, 100: 542, 109: 541},
+		{544},
+		{183, 73: 422},
+		{182, 352, 375, 328, 330, 388, 355, 332, 333, 334, 323, 358, 354, 360, 363, 338, 366, 359, 362, 365, 324, 325, 326, 327, 390, 353, 348, 368, 336, 356, 357, 340, 329, 331, 392, 335, 342, 361, 364, 339, 367, 337, 341, 382, 343, 394, 347, 345, 374, 369, 387, 381, 351, 370, 371, 372, 350, 393, 346, 391, 349, 376, 344, 373, 389, 377, 378, 385, 386, 380, 379, 383, 384, 74: 403, 404, 405, 406, 398, 397, 399, 395, 396, 400, 402, 401, 95: 322, 99: 321},
+		{1: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 94: 216},
+		// 320
+		{191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 74: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 96: 543, 100: 542, 109: 546},
+		{547},
+		{1: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 94: 217},
+		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 74: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 312, 96: 320, 100: 549},
+		{550, 73: 422},
+		// 325
+		{1: 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 94: 218},
+		{191, 86: 312, 96: 552},
+		{553},
+		{1: 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 94: 219},
+		{1: 301, 260, 253, 255, 289, 297, 274, 276, 277, 248, 287, 305, 267, 263, 279, 272, 266, 262, 271, 230, 250, 251, 252, 278, 302, 237, 242, 265, 298, 299, 280, 254, 256, 308, 275, 282, 268, 264, 303, 273, 257, 281, 291, 283, 309, 293, 285, 259, 270, 238, 290, 241, 247, 304, 249, 240, 246, 292, 307, 239, 261, 284, 258, 306, 300, 269, 243, 295, 286, 288, 296, 294, 104: 244, 110: 231, 245, 114: 556, 236, 117: 235, 233, 555, 234, 232},
+		// 330
+		{1: 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 94: 222},
+		{1: 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 94: 220},
 	}
 )
 
@@ -1053,7 +1092,7 @@ func yyhintlex1(yylex yyhintLexer, lval *yyhintSymType) (n int) {
 }
 
 func yyhintParse(yylex yyhintLexer, parser *hintParser) int {
-	const yyError = 132
+	const yyError = 136
 
 	yyEx, _ := yylex.(yyhintLexerEx)
 	var yyn int
@@ -1379,6 +1418,24 @@ yynewstate:
 			}
 		}
 	case 23:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-3].ident),
+				QBName:   model.NewCIStr(yyS[yypt-1].ident),
+			}
+		}
+	case 24:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-6].ident),
+				QBName:   model.NewCIStr(yyS[yypt-4].ident),
+				HintData: ast.HintRuntimeFilter{
+					Build:  yyS[yypt-3].column,
+					Target: yyS[yypt-1].column,
+				},
+			}
+		}
+	case 25:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-4].ident),
@@ -1386,27 +1443,27 @@ yynewstate:
 				HintData: model.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 24:
+	case 26:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-4].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 25:
+	case 27:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-3].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 26:
+	case 28:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-5].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 27:
+	case 29:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-5].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 28:
+	case 30:
 		{
 			hs := yyS[yypt-1].hints
 			name := model.NewCIStr(yyS[yypt-4].ident)
@@ -1417,60 +1474,60 @@ yynewstate:
 			}
 			parser.yyVAL.hints = hs
 		}
-	case 29:
+	case 31:
 		{
 			parser.yyVAL.hints = []*ast.TableOptimizerHint{yyS[yypt-0].hint}
 		}
-	case 30:
+	case 32:
 		{
 			parser.yyVAL.hints = append(yyS[yypt-2].hints, yyS[yypt-0].hint)
 		}
-	case 31:
+	case 33:
 		{
 			h := yyS[yypt-1].hint
 			h.HintData = model.NewCIStr(yyS[yypt-3].ident)
 			parser.yyVAL.hint = h
 		}
-	case 32:
+	case 34:
 		{
 			parser.yyVAL.ident = ""
 		}
-	case 36:
+	case 38:
 		{
 			parser.yyVAL.modelIdents = nil
 		}
-	case 37:
+	case 39:
 		{
 			parser.yyVAL.modelIdents = yyS[yypt-1].modelIdents
 		}
-	case 38:
+	case 40:
 		{
 			parser.yyVAL.modelIdents = []model.CIStr{model.NewCIStr(yyS[yypt-0].ident)}
 		}
-	case 39:
+	case 41:
 		{
 			parser.yyVAL.modelIdents = append(yyS[yypt-2].modelIdents, model.NewCIStr(yyS[yypt-0].ident))
 		}
-	case 41:
+	case 43:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				QBName: model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 42:
+	case 44:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Tables: []ast.HintTable{yyS[yypt-0].table},
 				QBName: model.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 43:
+	case 45:
 		{
 			h := yyS[yypt-2].hint
 			h.Tables = append(h.Tables, yyS[yypt-0].table)
 			parser.yyVAL.hint = h
 		}
-	case 44:
+	case 46:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				TableName:     model.NewCIStr(yyS[yypt-2].ident),
@@ -1478,7 +1535,7 @@ yynewstate:
 				PartitionList: yyS[yypt-0].modelIdents,
 			}
 		}
-	case 45:
+	case 47:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				DBName:        model.NewCIStr(yyS[yypt-4].ident),
@@ -1487,63 +1544,75 @@ yynewstate:
 				PartitionList: yyS[yypt-0].modelIdents,
 			}
 		}
-	case 46:
+	case 48:
+		{
+			parser.yyVAL.column = &ast.ColumnName{Name: model.NewCIStr(yyS[yypt-0].ident)}
+		}
+	case 49:
+		{
+			parser.yyVAL.column = &ast.ColumnName{Table: model.NewCIStr(yyS[yypt-2].ident), Name: model.NewCIStr(yyS[yypt-0].ident)}
+		}
+	case 50:
+		{
+			parser.yyVAL.column = &ast.ColumnName{Schema: model.NewCIStr(yyS[yypt-4].ident), Table: model.NewCIStr(yyS[yypt-2].ident), Name: model.NewCIStr(yyS[yypt-0].ident)}
+		}
+	case 51:
 		{
 			h := yyS[yypt-2].hint
 			h.Tables = append(h.Tables, yyS[yypt-0].table)
 			parser.yyVAL.hint = h
 		}
-	case 47:
+	case 52:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Tables: []ast.HintTable{yyS[yypt-0].table},
 			}
 		}
-	case 48:
+	case 53:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				TableName: model.NewCIStr(yyS[yypt-1].ident),
 				QBName:    model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 49:
+	case 54:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				QBName: model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 50:
+	case 55:
 		{
 			h := yyS[yypt-0].hint
 			h.Tables = []ast.HintTable{yyS[yypt-2].table}
 			h.QBName = model.NewCIStr(yyS[yypt-3].ident)
 			parser.yyVAL.hint = h
 		}
-	case 51:
+	case 56:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{}
 		}
-	case 53:
+	case 58:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Indexes: []model.CIStr{model.NewCIStr(yyS[yypt-0].ident)},
 			}
 		}
-	case 54:
+	case 59:
 		{
 			h := yyS[yypt-2].hint
 			h.Indexes = append(h.Indexes, model.NewCIStr(yyS[yypt-0].ident))
 			parser.yyVAL.hint = h
 		}
-	case 61:
+	case 66:
 		{
 			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
 		}
-	case 62:
+	case 67:
 		{
 			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
 		}
-	case 63:
+	case 68:
 		{
 			if yyS[yypt-0].number > 9223372036854775808 {
 				yylex.AppendError(yylex.Errorf("the Signed Value should be at the range of [-9223372036854775808, 9223372036854775807]."))
@@ -1555,19 +1624,19 @@ yynewstate:
 				parser.yyVAL.ident = strconv.FormatInt(-int64(yyS[yypt-0].number), 10)
 			}
 		}
-	case 64:
+	case 69:
 		{
 			parser.yyVAL.number = 1024 * 1024
 		}
-	case 65:
+	case 70:
 		{
 			parser.yyVAL.number = 1024 * 1024 * 1024
 		}
-	case 66:
+	case 71:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: true}
 		}
-	case 67:
+	case 72:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: false}
 		}
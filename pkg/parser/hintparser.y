@@ -31,6 +31,7 @@ import (
 	hint    *ast.TableOptimizerHint
 	hints []*ast.TableOptimizerHint
 	table 	ast.HintTable
+	column  *ast.ColumnName
 	modelIdents []model.CIStr
 }
 
@@ -123,6 +124,8 @@ import (
 	hintLeading               "LEADING"
 	hintSemiJoinRewrite       "SEMI_JOIN_REWRITE"
 	hintNoDecorrelate         "NO_DECORRELATE"
+	hintRuntimeFilter         "RUNTIME_FILTER"
+	hintNoRuntimeFilter       "NO_RUNTIME_FILTER"
 
 	/* Other keywords */
 	hintOLAP            "OLAP"
@@ -139,6 +142,9 @@ import (
 	hintLooseScan       "LOOSESCAN"
 	hintMaterialization "MATERIALIZATION"
 
+	/* Punctuations */
+	hintArrow "->"
+
 %type	<ident>
 	Identifier                             "identifier (including keywords)"
 	QueryBlockOpt                          "Query block identifier optional"
@@ -177,6 +183,9 @@ import (
 	HintTrueOrFalse         "true or false in optimizer hint"
 	HintStorageTypeAndTable "storage type and tables in optimizer hint"
 
+%type	<column>
+	HintColumnName "column name in optimizer hint"
+
 %type	<table>
 	HintTable "Table in optimizer hint"
 	ViewName  "View name in optimizer hint"
@@ -345,6 +354,24 @@ TableOptimizerHintOpt:
 			QBName:   model.NewCIStr($3),
 		}
 	}
+|	"RUNTIME_FILTER" '(' QueryBlockOpt ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			QBName:   model.NewCIStr($3),
+		}
+	}
+|	"RUNTIME_FILTER" '(' QueryBlockOpt HintColumnName "->" HintColumnName ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			QBName:   model.NewCIStr($3),
+			HintData: ast.HintRuntimeFilter{
+				Build:  $4,
+				Target: $6,
+			},
+		}
+	}
 |	"QUERY_TYPE" '(' QueryBlockOpt HintQueryType ')'
 	{
 		$$ = &ast.TableOptimizerHint{
@@ -489,6 +516,20 @@ HintTable:
 		}
 	}
 
+HintColumnName:
+	Identifier
+	{
+		$$ = &ast.ColumnName{Name: model.NewCIStr($1)}
+	}
+|	Identifier '.' Identifier
+	{
+		$$ = &ast.ColumnName{Table: model.NewCIStr($1), Name: model.NewCIStr($3)}
+	}
+|	Identifier '.' Identifier '.' Identifier
+	{
+		$$ = &ast.ColumnName{Schema: model.NewCIStr($1), Table: model.NewCIStr($3), Name: model.NewCIStr($5)}
+	}
+
 ViewNameList:
 	ViewNameList '.' ViewName
 	{
@@ -693,6 +734,7 @@ NullaryHintName:
 |	"STRAIGHT_JOIN"
 |	"SEMI_JOIN_REWRITE"
 |	"NO_DECORRELATE"
+|	"NO_RUNTIME_FILTER"
 
 HintQueryType:
 	"OLAP"
@@ -775,6 +817,8 @@ Identifier:
 |	"LEADING"
 |	"SEMI_JOIN_REWRITE"
 |	"NO_DECORRELATE"
+|	"RUNTIME_FILTER"
+|	"NO_RUNTIME_FILTER"
 /* other keywords */
 |	"OLAP"
 |	"OLTP"
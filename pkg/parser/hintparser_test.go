@@ -300,6 +300,42 @@ func TestParseHint(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: "RUNTIME_FILTER(t1.k1 -> t2.k1) runtime_filter(@sel_2 k1->test.t2.k2)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("RUNTIME_FILTER"),
+					HintData: ast.HintRuntimeFilter{
+						Build:  &ast.ColumnName{Table: model.NewCIStr("t1"), Name: model.NewCIStr("k1")},
+						Target: &ast.ColumnName{Table: model.NewCIStr("t2"), Name: model.NewCIStr("k1")},
+					},
+				},
+				{
+					HintName: model.NewCIStr("runtime_filter"),
+					QBName:   model.NewCIStr("sel_2"),
+					HintData: ast.HintRuntimeFilter{
+						Build:  &ast.ColumnName{Name: model.NewCIStr("k1")},
+						Target: &ast.ColumnName{Schema: model.NewCIStr("test"), Table: model.NewCIStr("t2"), Name: model.NewCIStr("k2")},
+					},
+				},
+			},
+		},
+		{
+			input: "RUNTIME_FILTER() no_runtime_filter(@sel_2)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("RUNTIME_FILTER"),
+				},
+				{
+					HintName: model.NewCIStr("no_runtime_filter"),
+					QBName:   model.NewCIStr("sel_2"),
+				},
+			},
+		},
+		{
+			input: "RUNTIME_FILTER(t1.k1, t2.k1)",
+			errs:  []string{`Optimizer hint syntax error at line 1 `},
+		},
 		{
 			input: "unknown_hint()",
 			errs:  []string{`Optimizer hint syntax error at line 1 `},
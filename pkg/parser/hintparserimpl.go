@@ -98,6 +98,9 @@ func (hs *hintScanner) Lex(lval *yyhintSymType) int {
 	case eq:
 		return '='
 
+	case jss:
+		return hintArrow
+
 	case floatLit:
 		errorTokenType = "floating point number"
 	case decLit:
@@ -181,6 +181,7 @@ var Keywords = []KeywordsType{
 	{"PRECISION", true, "reserved"},
 	{"PRIMARY", true, "reserved"},
 	{"PROCEDURE", true, "reserved"},
+	{"QUALIFY", true, "reserved"},
 	{"RANGE", true, "reserved"},
 	{"RANK", true, "reserved"},
 	{"READ", true, "reserved"},
@@ -371,6 +372,7 @@ var Keywords = []KeywordsType{
 	{"EXPIRE", false, "unreserved"},
 	{"EXTENDED", false, "unreserved"},
 	{"FAILED_LOGIN_ATTEMPTS", false, "unreserved"},
+	{"FAULT", false, "unreserved"},
 	{"FAULTS", false, "unreserved"},
 	{"FIELDS", false, "unreserved"},
 	{"FILE", false, "unreserved"},
@@ -382,6 +384,7 @@ var Keywords = []KeywordsType{
 	{"FOUND", false, "unreserved"},
 	{"FULL", false, "unreserved"},
 	{"FUNCTION", false, "unreserved"},
+	{"GC", false, "unreserved"},
 	{"GENERAL", false, "unreserved"},
 	{"GLOBAL", false, "unreserved"},
 	{"GRANTS", false, "unreserved"},
@@ -399,6 +402,7 @@ var Keywords = []KeywordsType{
 	{"INCREMENT", false, "unreserved"},
 	{"INCREMENTAL", false, "unreserved"},
 	{"INDEXES", false, "unreserved"},
+	{"INJECT", false, "unreserved"},
 	{"INSERT_METHOD", false, "unreserved"},
 	{"INSTANCE", false, "unreserved"},
 	{"INVISIBLE", false, "unreserved"},
@@ -479,6 +483,7 @@ var Keywords = []KeywordsType{
 	{"PERCENT", false, "unreserved"},
 	{"PER_DB", false, "unreserved"},
 	{"PER_TABLE", false, "unreserved"},
+	{"PIN", false, "unreserved"},
 	{"PLUGINS", false, "unreserved"},
 	{"POINT", false, "unreserved"},
 	{"POLICY", false, "unreserved"},
@@ -499,6 +504,7 @@ var Keywords = []KeywordsType{
 	{"QUICK", false, "unreserved"},
 	{"RATE_LIMIT", false, "unreserved"},
 	{"REBUILD", false, "unreserved"},
+	{"RECLAIM", false, "unreserved"},
 	{"RECOVER", false, "unreserved"},
 	{"REDUNDANT", false, "unreserved"},
 	{"RELOAD", false, "unreserved"},
@@ -525,6 +531,7 @@ var Keywords = []KeywordsType{
 	{"ROW_COUNT", false, "unreserved"},
 	{"ROW_FORMAT", false, "unreserved"},
 	{"RTREE", false, "unreserved"},
+	{"SAFEPOINT", false, "unreserved"},
 	{"SAN", false, "unreserved"},
 	{"SAVEPOINT", false, "unreserved"},
 	{"SECOND", false, "unreserved"},
@@ -611,10 +618,12 @@ var Keywords = []KeywordsType{
 	{"UNDEFINED", false, "unreserved"},
 	{"UNICODE", false, "unreserved"},
 	{"UNKNOWN", false, "unreserved"},
+	{"UNPIN", false, "unreserved"},
 	{"UNSET", false, "unreserved"},
 	{"USER", false, "unreserved"},
 	{"VALIDATION", false, "unreserved"},
 	{"VALUE", false, "unreserved"},
+	{"VARIABLE", false, "unreserved"},
 	{"VARIABLES", false, "unreserved"},
 	{"VIEW", false, "unreserved"},
 	{"VISIBLE", false, "unreserved"},
@@ -666,6 +675,7 @@ var Keywords = []KeywordsType{
 	{"STATS_TOPN", false, "tidb"},
 	{"TIDB", false, "tidb"},
 	{"TIFLASH", false, "tidb"},
+	{"TIKV", false, "tidb"},
 	{"TOPN", false, "tidb"},
 	{"WIDTH", false, "tidb"},
 }
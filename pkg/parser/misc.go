@@ -360,6 +360,7 @@ var tokenMap = map[string]int{
 	"ERRORS":                   identSQLErrors,
 	"ESCAPE":                   escape,
 	"ESCAPED":                  escaped,
+	"ESTIMATE_ONLY":            estimateOnly,
 	"EVENT":                    event,
 	"EVENTS":                   events,
 	"EVOLVE":                   evolve,
@@ -378,6 +379,7 @@ var tokenMap = map[string]int{
 	"EXTENDED":                 extended,
 	"EXTRACT":                  extract,
 	"FALSE":                    falseKwd,
+	"FAULT":                    fault,
 	"FAULTS":                   faultsSym,
 	"FETCH":                    fetch,
 	"FIELDS":                   fields,
@@ -403,6 +405,7 @@ var tokenMap = map[string]int{
 	"FULL_BACKUP_STORAGE":      fullBackupStorage,
 	"FULLTEXT":                 fulltext,
 	"FUNCTION":                 function,
+	"GC":                       gc,
 	"GC_TTL":                   gcTTL,
 	"GENERAL":                  general,
 	"GENERATED":                generated,
@@ -436,6 +439,7 @@ var tokenMap = map[string]int{
 	"INDEX":                    index,
 	"INDEXES":                  indexes,
 	"INFILE":                   infile,
+	"INJECT":                   inject,
 	"INNER":                    inner,
 	"INOUT":                    inout,
 	"INPLACE":                  inplace,
@@ -605,6 +609,7 @@ var tokenMap = map[string]int{
 	"PER_DB":                   per_db,
 	"PER_TABLE":                per_table,
 	"PESSIMISTIC":              pessimistic,
+	"PIN":                      pin,
 	"PLACEMENT":                placement,
 	"PLAN":                     plan,
 	"PLAN_CACHE":               planCache,
@@ -629,6 +634,7 @@ var tokenMap = map[string]int{
 	"PROXY":                    proxy,
 	"PUMP":                     pump,
 	"PURGE":                    purge,
+	"QUALIFY":                  qualify,
 	"QUARTER":                  quarter,
 	"QUERIES":                  queries,
 	"QUERY":                    query,
@@ -640,6 +646,7 @@ var tokenMap = map[string]int{
 	"REAL":                     realType,
 	"REBUILD":                  rebuild,
 	"RECENT":                   recent,
+	"RECLAIM":                  reclaim,
 	"RECOVER":                  recover,
 	"RECURSIVE":                recursive,
 	"REDUNDANT":                redundant,
@@ -688,6 +695,7 @@ var tokenMap = map[string]int{
 	"RUN":                      run,
 	"RUNNING":                  running,
 	"S3":                       s3,
+	"SAFEPOINT":                safepoint,
 	"SAMPLES":                  samples,
 	"SAMPLERATE":               sampleRate,
 	"SAN":                      san,
@@ -809,6 +817,7 @@ var tokenMap = map[string]int{
 	"TIDB_CURRENT_TSO":         tidbCurrentTSO,
 	"TIDB_JSON":                tidbJson,
 	"TIFLASH":                  tiFlash,
+	"TIKV":                     tiKV,
 	"TIKV_IMPORTER":            tikvImporter,
 	"TIME":                     timeType,
 	"TIMESTAMP":                timestampType,
@@ -855,6 +864,7 @@ var tokenMap = map[string]int{
 	"UNIQUE":                   unique,
 	"UNKNOWN":                  unknown,
 	"UNLOCK":                   unlock,
+	"UNPIN":                    unpin,
 	"UNLIMITED":                unlimited,
 	"UNSET":                    unset,
 	"UNSIGNED":                 unsigned,
@@ -876,6 +886,7 @@ var tokenMap = map[string]int{
 	"VARBINARY":                varbinaryType,
 	"VARCHAR":                  varcharType,
 	"VARCHARACTER":             varcharacter,
+	"VARIABLE":                 variable,
 	"VARIABLES":                variables,
 	"VARIANCE":                 varPop,
 	"VARYING":                  varying,
@@ -1058,6 +1069,8 @@ var hintTokenMap = map[string]int{
 	"LEADING":                 hintLeading,
 	"SEMI_JOIN_REWRITE":       hintSemiJoinRewrite,
 	"NO_DECORRELATE":          hintNoDecorrelate,
+	"RUNTIME_FILTER":          hintRuntimeFilter,
+	"NO_RUNTIME_FILTER":       hintNoRuntimeFilter,
 
 	// TiDB hint aliases
 	"TIDB_HJ":   hintHashJoin,
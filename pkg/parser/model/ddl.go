@@ -106,6 +106,7 @@ const (
 	ActionDropResourceGroup      ActionType = 70
 	ActionAlterTablePartitioning ActionType = 71
 	ActionRemovePartitioning     ActionType = 72
+	ActionReorganizeIndex        ActionType = 73
 )
 
 // ActionMap is the map of DDL ActionType to string.
@@ -177,6 +178,7 @@ var ActionMap = map[ActionType]string{
 	ActionDropResourceGroup:             "drop resource group",
 	ActionAlterTablePartitioning:        "alter table partition by",
 	ActionRemovePartitioning:            "alter table remove partitioning",
+	ActionReorganizeIndex:               "reorganize index",
 
 	// `ActionAlterTableAlterPartition` is removed and will never be used.
 	// Just left a tombstone here for compatibility.
@@ -266,6 +268,7 @@ var BDRActionMap = map[DDLBDRType][]ActionType{
 		ActionReorganizePartition,
 		ActionAlterTablePartitioning,
 		ActionRemovePartitioning,
+		ActionReorganizeIndex,
 	},
 	UnmanagementDDL: {
 		ActionCreatePlacementPolicy,
@@ -942,7 +945,7 @@ func (job *Job) NotStarted() bool {
 func (job *Job) MayNeedReorg() bool {
 	switch job.Type {
 	case ActionAddIndex, ActionAddPrimaryKey, ActionReorganizePartition,
-		ActionRemovePartitioning, ActionAlterTablePartitioning:
+		ActionRemovePartitioning, ActionAlterTablePartitioning, ActionReorganizeIndex:
 		return true
 	case ActionModifyColumn:
 		if len(job.CtxVars) > 0 {
@@ -995,6 +998,9 @@ func (job *Job) IsRollbackable() bool {
 			job.SchemaState == StateWriteOnly {
 			return false
 		}
+	case ActionReorganizeIndex:
+		// The new index takes the place of the old one in StatePublic, the old one is being dropped then.
+		return job.SchemaState != StatePublic
 	}
 	return true
 }
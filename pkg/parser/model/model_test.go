@@ -831,3 +831,33 @@ func TestClearReorgIntermediateInfo(t *testing.T) {
 	require.Equal(t, true, ptInfo.DDLColumns == nil)
 	require.Equal(t, int64(0), ptInfo.NewTableID)
 }
+
+func TestReorgProgress(t *testing.T) {
+	start := time.Now()
+	p := &ReorgProgress{}
+	p.Update(ReorgPhaseScan, 0, 1000, 0, start)
+	require.Equal(t, float64(0), p.Percentage(0))
+	_, ok := p.EstimatedEndTime(0)
+	require.False(t, ok)
+
+	p.Update(ReorgPhaseScan, 100, 1000, 4096, start.Add(10*time.Second))
+	require.Equal(t, float64(10), p.RowsPerSecond)
+	require.Equal(t, int64(4096), p.WrittenBytes)
+	require.Equal(t, float64(10), p.Percentage(100))
+	endTime, ok := p.EstimatedEndTime(100)
+	require.True(t, ok)
+	require.Equal(t, start.Add(100*time.Second), endTime)
+
+	// the throughput is smoothed.
+	p.Update(ReorgPhaseScan, 300, 1000, 8192, start.Add(20*time.Second))
+	require.InDelta(t, 13, p.RowsPerSecond, 1e-9)
+
+	// the estimated total rows may be less than the scanned rows.
+	require.Equal(t, float64(99), p.Percentage(2000))
+
+	p.Update(ReorgPhaseImport, 1000, 1000, 8192, start.Add(30*time.Second))
+	require.Equal(t, float64(0), p.RowsPerSecond)
+	require.Equal(t, float64(100), p.Percentage(1000))
+	_, ok = p.EstimatedEndTime(1000)
+	require.False(t, ok)
+}
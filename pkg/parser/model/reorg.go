@@ -15,6 +15,7 @@ package model
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -33,6 +34,21 @@ type DDLReorgMeta struct {
 	UseCloudStorage   bool                             `json:"use_cloud_storage"`
 	ResourceGroupName string                           `json:"resource_group_name"`
 	Version           int64                            `json:"version"`
+	// Progress is only maintained for the ingest reorganization.
+	Progress *ReorgProgress `json:"progress,omitempty"`
+	// Concurrency, BatchSize and MaxWriteSpeed are the reorg parameters changed by `ADMIN ALTER DDL JOBS`
+	// for the running job, 0 means using the value of the global variable.
+	Concurrency   int   `json:"concurrency,omitempty"`
+	BatchSize     int   `json:"batch_size,omitempty"`
+	MaxWriteSpeed int64 `json:"max_write_speed,omitempty"`
+	// IngestFallbackReason is the reason why the job falls back from the ingest to the txn-merge backfill.
+	IngestFallbackReason string `json:"ingest_fallback_reason,omitempty"`
+	// PreSplitRegions is the number of regions to pre-split for each index before the ingest backfill.
+	PreSplitRegions int `json:"pre_split_regions,omitempty"`
+	// CloudStorageURI is the URI of the cloud storage which the sorted KV of the job is staged on.
+	// It's kept in the job so the job keeps using it when the owner changes, and it's redacted
+	// when the job is finished.
+	CloudStorageURI string `json:"cloud_storage_uri,omitempty"`
 }
 
 const (
@@ -43,6 +59,84 @@ const (
 	CurrentReorgMetaVersion = int64(1)
 )
 
+// ReorgPhase is the phase of the data reorganization.
+type ReorgPhase string
+
+const (
+	// ReorgPhaseScan means the table records are being scanned and the new KVs are written to the local engines.
+	ReorgPhaseScan ReorgPhase = "scan"
+	// ReorgPhaseImport means the scan is finished and the KVs in the local engines are being imported to the storage.
+	ReorgPhaseImport ReorgPhase = "import"
+	// ReorgPhaseMerge means the temporary index records are being merged back to the origin index.
+	ReorgPhaseMerge ReorgPhase = "merge"
+)
+
+// ReorgProgress is the progress of the data reorganization.
+type ReorgProgress struct {
+	Phase ReorgPhase `json:"phase"`
+	// TotalRows is the estimated count of the rows to be scanned.
+	TotalRows int64 `json:"total_rows"`
+	// WrittenBytes is the size of the KVs written to the local engines.
+	WrittenBytes int64 `json:"written_bytes"`
+	// RowsPerSecond is the recent scan throughput.
+	RowsPerSecond float64 `json:"rows_per_second"`
+	// LastRowCount and LastUpdateTime are the row count and the time of the last update,
+	// they are used to compute the throughput.
+	LastRowCount   int64     `json:"last_row_count"`
+	LastUpdateTime time.Time `json:"last_update_time"`
+}
+
+// reorgThroughputWeight is the weight of the latest sample when smoothing the throughput.
+const reorgThroughputWeight = 0.3
+
+// Update updates the progress with the rows scanned so far.
+func (p *ReorgProgress) Update(phase ReorgPhase, rowCount, totalRows, writtenBytes int64, now time.Time) {
+	if phase != p.Phase || p.LastUpdateTime.IsZero() || rowCount < p.LastRowCount {
+		p.RowsPerSecond = 0
+	} else if elapsed := now.Sub(p.LastUpdateTime).Seconds(); elapsed > 0 {
+		sample := float64(rowCount-p.LastRowCount) / elapsed
+		if p.RowsPerSecond == 0 {
+			p.RowsPerSecond = sample
+		} else {
+			p.RowsPerSecond = reorgThroughputWeight*sample + (1-reorgThroughputWeight)*p.RowsPerSecond
+		}
+	}
+	p.Phase = phase
+	p.TotalRows = totalRows
+	p.WrittenBytes = writtenBytes
+	p.LastRowCount = rowCount
+	p.LastUpdateTime = now
+}
+
+// Percentage returns the percentage of the rows scanned.
+func (p *ReorgProgress) Percentage(rowCount int64) float64 {
+	if p.Phase != ReorgPhaseScan {
+		return 100
+	}
+	if p.TotalRows <= 0 {
+		return 0
+	}
+	// The total rows is estimated by the statistics, don't report 100% before the scan is finished.
+	percentage := float64(rowCount) * 100 / float64(p.TotalRows)
+	if percentage > 99 {
+		percentage = 99
+	}
+	return percentage
+}
+
+// EstimatedEndTime returns the estimated time when the scan is finished.
+// It returns false if the time can't be estimated.
+func (p *ReorgProgress) EstimatedEndTime(rowCount int64) (time.Time, bool) {
+	if p.Phase != ReorgPhaseScan || p.RowsPerSecond <= 0 || p.TotalRows <= 0 {
+		return time.Time{}, false
+	}
+	remaining := p.TotalRows - rowCount
+	if remaining < 0 {
+		remaining = 0
+	}
+	return p.LastUpdateTime.Add(time.Duration(float64(remaining) / p.RowsPerSecond * float64(time.Second))), true
+}
+
 // ReorgType indicates which process is used for the data reorganization.
 type ReorgType int8
 
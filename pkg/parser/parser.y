@@ -234,6 +234,7 @@ import (
 	precisionType     "PRECISION"
 	primary           "PRIMARY"
 	procedure         "PROCEDURE"
+	qualify           "QUALIFY"
 	rangeKwd          "RANGE"
 	rank              "RANK"
 	read              "READ"
@@ -426,6 +427,7 @@ import (
 	expire                "EXPIRE"
 	extended              "EXTENDED"
 	failedLoginAttempts   "FAILED_LOGIN_ATTEMPTS"
+	fault                 "FAULT"
 	faultsSym             "FAULTS"
 	fields                "FIELDS"
 	file                  "FILE"
@@ -437,6 +439,7 @@ import (
 	found                 "FOUND"
 	full                  "FULL"
 	function              "FUNCTION"
+	gc                    "GC"
 	general               "GENERAL"
 	global                "GLOBAL"
 	grants                "GRANTS"
@@ -454,6 +457,7 @@ import (
 	increment             "INCREMENT"
 	incremental           "INCREMENTAL"
 	indexes               "INDEXES"
+	inject                "INJECT"
 	insertMethod          "INSERT_METHOD"
 	instance              "INSTANCE"
 	invisible             "INVISIBLE"
@@ -535,6 +539,7 @@ import (
 	per_db                "PER_DB"
 	per_table             "PER_TABLE"
 	pipesAsOr
+	pin                   "PIN"
 	plugins               "PLUGINS"
 	point                 "POINT"
 	policy                "POLICY"
@@ -555,6 +560,7 @@ import (
 	quick                 "QUICK"
 	rateLimit             "RATE_LIMIT"
 	rebuild               "REBUILD"
+	reclaim               "RECLAIM"
 	recover               "RECOVER"
 	redundant             "REDUNDANT"
 	reload                "RELOAD"
@@ -581,6 +587,7 @@ import (
 	rowCount              "ROW_COUNT"
 	rowFormat             "ROW_FORMAT"
 	rtree                 "RTREE"
+	safepoint             "SAFEPOINT"
 	san                   "SAN"
 	savepoint             "SAVEPOINT"
 	second                "SECOND"
@@ -667,10 +674,12 @@ import (
 	undefined             "UNDEFINED"
 	unicodeSym            "UNICODE"
 	unknown               "UNKNOWN"
+	unpin                 "UNPIN"
 	unset                 "UNSET"
 	user                  "USER"
 	validation            "VALIDATION"
 	value                 "VALUE"
+	variable              "VARIABLE"
 	variables             "VARIABLES"
 	view                  "VIEW"
 	visible               "VISIBLE"
@@ -708,6 +717,7 @@ import (
 	dryRun                "DRYRUN"
 	dump                  "DUMP"
 	endTime               "END_TIME"
+	estimateOnly          "ESTIMATE_ONLY"
 	exact                 "EXACT"
 	execElapsed           "EXEC_ELAPSED"
 	exprPushdownBlacklist "EXPR_PUSHDOWN_BLACKLIST"
@@ -870,6 +880,7 @@ import (
 	statsTopN                  "STATS_TOPN"
 	tidb                       "TIDB"
 	tiFlash                    "TIFLASH"
+	tiKV                       "TIKV"
 	topn                       "TOPN"
 	width                      "WIDTH"
 
@@ -965,6 +976,7 @@ import (
 	CreateProcedureStmt        "CREATE PROCEDURE statement"
 	AddQueryWatchStmt          "ADD QUERY WATCH statement"
 	CreateResourceGroupStmt    "CREATE RESOURCE GROUP statement"
+	CreateVariableProfileStmt  "CREATE VARIABLE PROFILE statement"
 	CreateSequenceStmt         "CREATE SEQUENCE statement"
 	CreateStatisticsStmt       "CREATE STATISTICS statement"
 	DoStmt                     "Do statement"
@@ -976,6 +988,7 @@ import (
 	DropStatisticsStmt         "DROP STATISTICS statement"
 	DropStatsStmt              "DROP STATS statement"
 	DropTableStmt              "DROP TABLE statement"
+	DropVariableProfileStmt    "DROP VARIABLE PROFILE statement"
 	DropSequenceStmt           "DROP SEQUENCE statement"
 	DropUserStmt               "DROP USER"
 	DropRoleStmt               "DROP ROLE"
@@ -1078,6 +1091,8 @@ import (
 	AdminStmtLimitOpt                      "Admin show ddl jobs limit option"
 	AllOrPartitionNameList                 "All or partition name list"
 	AlgorithmClause                        "Alter table algorithm"
+	AlterJobOption                         "Admin alter ddl jobs option"
+	AlterJobOptionList                     "Admin alter ddl jobs option list"
 	AlterTableSpecSingleOpt                "Alter table single option"
 	AlterTableSpec                         "Alter table specification"
 	AlterTableSpecList                     "Alter table specification list"
@@ -1099,6 +1114,8 @@ import (
 	OptionalBraces                         "optional braces"
 	CastType                               "Cast function target type"
 	CharsetOpt                             "CHARACTER SET option in LOAD DATA"
+	ChecksumTableName                      "Table name with optional partitions and snapshot of ADMIN CHECKSUM TABLE"
+	ChecksumTableNameList                  "Table name list of ADMIN CHECKSUM TABLE"
 	ColumnDef                              "table column definition"
 	ColumnDefList                          "table column definition list"
 	ColumnName                             "column name"
@@ -1206,6 +1223,7 @@ import (
 	LoadDataOptionListOpt                  "Optional load data option list"
 	LoadDataOptionList                     "Load data option list"
 	LoadDataOption                         "Load data option"
+	LoadDataPath                           "Load data path, a string or a parameter marker"
 	LoadDataSetList                        "Load data specifications"
 	LoadDataSetItem                        "Single load data specification"
 	LocalOpt                               "Local opt"
@@ -1234,6 +1252,7 @@ import (
 	ByList                                 "BY list"
 	AlterOrderItem                         "Alter Order item"
 	AlterOrderList                         "Alter Order list"
+	QualifyClauseOptional                  "Optional QUALIFY clause"
 	QuickOptional                          "QUICK or empty"
 	PartitionDefinition                    "Partition definition"
 	PartitionDefinitionList                "Partition definition list"
@@ -1369,6 +1388,8 @@ import (
 	ValuesStmtList                         "VALUES statement field list"
 	VariableAssignment                     "set variable value"
 	VariableAssignmentList                 "set variable value list"
+	VariableProfileAssignment              "variable assignment of variable profile"
+	VariableProfileAssignmentList          "variable assignment list of variable profile"
 	ViewAlgorithm                          "view algorithm"
 	ViewCheckOption                        "view check option"
 	ViewDefiner                            "view definer"
@@ -1568,6 +1589,7 @@ import (
 	DBName                          "Database Name"
 	PolicyName                      "Placement Policy Name"
 	ResourceGroupName               "Resource Group Name"
+	VariableProfileResourceGroupOpt "Optional resource group of variable profile"
 	ExplainFormatType               "explain format type"
 	FieldAsName                     "Field alias name"
 	FieldAsNameOpt                  "Field alias name opt"
@@ -1706,6 +1728,13 @@ AlterTableStmt:
 			ReplicaKind: ast.CompactReplicaKindTiFlash,
 		}
 	}
+|	"ALTER" IgnoreOptional "TABLE" TableName "COMPACT" "TIKV" "REPLICA"
+	{
+		$$ = &ast.CompactTableStmt{
+			Table:       $4.(*ast.TableName),
+			ReplicaKind: ast.CompactReplicaKindTiKV,
+		}
+	}
 |	"ALTER" IgnoreOptional "TABLE" TableName "COMPACT" "PARTITION" PartitionNameList
 	{
 		$$ = &ast.CompactTableStmt{
@@ -1722,6 +1751,14 @@ AlterTableStmt:
 			ReplicaKind:    ast.CompactReplicaKindTiFlash,
 		}
 	}
+|	"ALTER" IgnoreOptional "TABLE" TableName "COMPACT" "PARTITION" PartitionNameList "TIKV" "REPLICA"
+	{
+		$$ = &ast.CompactTableStmt{
+			Table:          $4.(*ast.TableName),
+			PartitionNames: $7.([]model.CIStr),
+			ReplicaKind:    ast.CompactReplicaKindTiKV,
+		}
+	}
 
 ResourceGroupOptionList:
 	DirectResourceGroupOption
@@ -5615,6 +5652,10 @@ BRIEBooleanOptionName:
 	{
 		$$ = ast.BRIEOptionCSVTrimLastSeparators
 	}
+|	"ESTIMATE_ONLY"
+	{
+		$$ = ast.BRIEOptionEstimateOnly
+	}
 
 BRIEStringOptionName:
 	"TIKV_IMPORTER"
@@ -6909,6 +6950,14 @@ UnReservedKeyword:
 |	"OLTP_READ_ONLY"
 |	"OLTP_WRITE_ONLY"
 |	"TPCH_10"
+|	"FAULT"
+|	"INJECT"
+|	"RECLAIM"
+|	"GC"
+|	"PIN"
+|	"SAFEPOINT"
+|	"UNPIN"
+|	"VARIABLE"
 
 TiDBKeyword:
 	"ADMIN"
@@ -6953,6 +7002,7 @@ TiDBKeyword:
 |	"RESET"
 |	"DRY"
 |	"RUN"
+|	"TIKV"
 
 NotKeywordToken:
 	"ADDDATE"
@@ -7072,6 +7122,7 @@ NotKeywordToken:
 |	"BACKGROUND"
 |	"TASK_TYPES"
 |	"UNLIMITED"
+|	"ESTIMATE_ONLY"
 
 /************************************************************************************
  *
@@ -9028,13 +9079,13 @@ SelectStmtFromDualTable:
 	}
 
 SelectStmtFromTable:
-	SelectStmtBasic "FROM" TableRefsClause WhereClauseOptional SelectStmtGroup HavingClause WindowClauseOptional
+	SelectStmtBasic "FROM" TableRefsClause WhereClauseOptional SelectStmtGroup HavingClause WindowClauseOptional QualifyClauseOptional
 	{
 		st := $1.(*ast.SelectStmt)
 		st.From = $3.(*ast.TableRefsClause)
 		lastField := st.Fields.Fields[len(st.Fields.Fields)-1]
 		if lastField.Expr != nil && lastField.AsName.O == "" {
-			lastEnd := parser.endOffset(&yyS[yypt-5])
+			lastEnd := parser.endOffset(&yyS[yypt-6])
 			lastField.SetText(parser.lexer.client, parser.src[lastField.Offset:lastEnd])
 		}
 		if $4 != nil {
@@ -9049,6 +9100,9 @@ SelectStmtFromTable:
 		if $7 != nil {
 			st.WindowSpecs = ($7.([]ast.WindowSpec))
 		}
+		if $8 != nil {
+			st.Qualify = $8.(ast.ExprNode)
+		}
 		$$ = st
 	}
 
@@ -10532,6 +10586,10 @@ SetStmt:
 	{
 		$$ = &ast.SetResourceGroupStmt{Name: model.NewCIStr($4)}
 	}
+|	"SET" "PROFILE" Identifier
+	{
+		$$ = &ast.SetVariableProfileStmt{ProfileName: model.NewCIStr($3)}
+	}
 
 SetRoleStmt:
 	"SET" "ROLE" SetRoleOpt
@@ -11010,7 +11068,7 @@ AdminStmt:
 			HandleRanges: $6.([]ast.HandleRange),
 		}
 	}
-|	"ADMIN" "CHECKSUM" "TABLE" TableNameList
+|	"ADMIN" "CHECKSUM" "TABLE" ChecksumTableNameList
 	{
 		$$ = &ast.AdminStmt{
 			Tp:     ast.AdminChecksumTable,
@@ -11038,6 +11096,14 @@ AdminStmt:
 			JobIDs: $5.([]int64),
 		}
 	}
+|	"ADMIN" "ALTER" "DDL" "JOBS" Int64Num AlterJobOptionList
+	{
+		$$ = &ast.AdminStmt{
+			Tp:              ast.AdminAlterDDLJob,
+			JobIDs:          []int64{$5.(int64)},
+			AlterJobOptions: $6.([]*ast.AlterJobOption),
+		}
+	}
 |	"ADMIN" "SHOW" "DDL" "JOB" "QUERIES" NumList
 	{
 		$$ = &ast.AdminStmt{
@@ -11162,6 +11228,69 @@ AdminStmt:
 			Tp: ast.AdminUnsetBDRRole,
 		}
 	}
+|	"ADMIN" "RECLAIM" "TABLE" TableNameList
+	{
+		$$ = &ast.AdminStmt{
+			Tp:     ast.AdminReclaimTable,
+			Tables: $4.([]*ast.TableName),
+		}
+	}
+|	"ADMIN" "PIN" "GC" "SAFEPOINT" "DURATION" LengthNum
+	{
+		$$ = &ast.AdminStmt{
+			Tp:          ast.AdminPinGCSafePoint,
+			PinDuration: $6.(uint64),
+		}
+	}
+|	"ADMIN" "UNPIN" "GC" "SAFEPOINT"
+	{
+		$$ = &ast.AdminStmt{
+			Tp: ast.AdminUnpinGCSafePoint,
+		}
+	}
+|	"ADMIN" "REORGANIZE" "INDEX" TableName Identifier
+	{
+		$$ = &ast.AdminStmt{
+			Tp:     ast.AdminReorganizeIndex,
+			Tables: []*ast.TableName{$4.(*ast.TableName)},
+			Index:  string($5),
+		}
+	}
+|	"ADMIN" "INJECT" "FAULT" stringLit stringLit
+	{
+		$$ = &ast.AdminStmt{
+			Tp:             ast.AdminInjectFault,
+			FaultInjection: &ast.FaultInjection{Point: $4, Term: $5},
+		}
+	}
+|	"ADMIN" "INJECT" "FAULT" stringLit stringLit "TTL" LengthNum
+	{
+		$$ = &ast.AdminStmt{
+			Tp:             ast.AdminInjectFault,
+			FaultInjection: &ast.FaultInjection{Point: $4, Term: $5, TTL: $7.(uint64)},
+		}
+	}
+
+ChecksumTableNameList:
+	ChecksumTableName
+	{
+		$$ = []*ast.TableName{$1.(*ast.TableName)}
+	}
+|	ChecksumTableNameList ',' ChecksumTableName
+	{
+		$$ = append($1.([]*ast.TableName), $3.(*ast.TableName))
+	}
+
+ChecksumTableName:
+	TableName PartitionNameListOpt AsOfClauseOpt
+	{
+		tn := $1.(*ast.TableName)
+		tn.PartitionNames = $2.([]model.CIStr)
+		if $3 != nil {
+			tn.AsOf = $3.(*ast.AsOfClause)
+		}
+		$$ = tn
+	}
 
 AdminShowSlow:
 	"RECENT" NUM
@@ -11222,6 +11351,25 @@ NumList:
 		$$ = append($1.([]int64), $3.(int64))
 	}
 
+AlterJobOptionList:
+	AlterJobOption
+	{
+		$$ = []*ast.AlterJobOption{$1.(*ast.AlterJobOption)}
+	}
+|	AlterJobOptionList ',' AlterJobOption
+	{
+		$$ = append($1.([]*ast.AlterJobOption), $3.(*ast.AlterJobOption))
+	}
+
+AlterJobOption:
+	Identifier "=" Expression
+	{
+		$$ = &ast.AlterJobOption{
+			Name:  strings.ToLower($1),
+			Value: $3.(ast.ExprNode),
+		}
+	}
+
 /****************************Show Statement*******************************/
 ShowStmt:
 	"SHOW" ShowTargetFilterable ShowLikeOrWhereOpt
@@ -12006,6 +12154,7 @@ Statement:
 |	CreatePolicyStmt
 |	CreateProcedureStmt
 |	CreateResourceGroupStmt
+|	CreateVariableProfileStmt
 |	AddQueryWatchStmt
 |	CreateSequenceStmt
 |	CreateStatisticsStmt
@@ -12019,6 +12168,7 @@ Statement:
 |	DropViewStmt
 |	DropUserStmt
 |	DropResourceGroupStmt
+|	DropVariableProfileStmt
 |	DropQueryWatchStmt
 |	DropRoleStmt
 |	DropStatisticsStmt
@@ -13305,6 +13455,15 @@ WhereClauseOptional:
 	}
 |	WhereClause
 
+QualifyClauseOptional:
+	{
+		$$ = nil
+	}
+|	"QUALIFY" Expression
+	{
+		$$ = $2
+	}
+
 CommaOpt:
 	{}
 |	','
@@ -14332,12 +14491,11 @@ RevokeRoleStmt:
  * for load stmt with format see https://github.com/pingcap/tidb/issues/40499
  *******************************************************************************************/
 LoadDataStmt:
-	"LOAD" "DATA" LowPriorityOpt LocalOpt "INFILE" stringLit FormatOpt DuplicateOpt "INTO" "TABLE" TableName CharsetOpt Fields Lines IgnoreLines ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt LoadDataOptionListOpt
+	"LOAD" "DATA" LowPriorityOpt LocalOpt "INFILE" LoadDataPath FormatOpt DuplicateOpt "INTO" "TABLE" TableName CharsetOpt Fields Lines IgnoreLines ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt LoadDataOptionListOpt
 	{
 		x := &ast.LoadDataStmt{
 			LowPriority:        $3.(bool),
 			FileLocRef:         ast.FileLocServerOrRemote,
-			Path:               $6,
 			Format:             $7.(*string),
 			OnDuplicate:        $8.(ast.OnDuplicateKeyHandlingType),
 			Table:              $11.(*ast.TableName),
@@ -14349,6 +14507,11 @@ LoadDataStmt:
 			ColumnAssignments:  $17.([]*ast.Assignment),
 			Options:            $18.([]*ast.LoadDataOpt),
 		}
+		if path, ok := $6.(string); ok {
+			x.Path = path
+		} else {
+			x.PathExpr = $6.(ast.ExprNode)
+		}
 		if $4 != nil {
 			x.FileLocRef = ast.FileLocClient
 			// See https://dev.mysql.com/doc/refman/5.7/en/load-data.html#load-data-duplicate-key-handling
@@ -14368,6 +14531,16 @@ LoadDataStmt:
 		$$ = x
 	}
 
+LoadDataPath:
+	stringLit
+	{
+		$$ = $1
+	}
+|	paramMarker
+	{
+		$$ = ast.NewParamMarkerExpr(yyS[yypt].offset)
+	}
+
 LowPriorityOpt:
 	{
 		$$ = false
@@ -14616,18 +14789,27 @@ LoadDataOption:
 	{
 		$$ = &ast.LoadDataOpt{Name: strings.ToLower($1), Value: $3.(ast.ExprNode)}
 	}
+|	identifier "=" paramMarker
+	{
+		$$ = &ast.LoadDataOpt{Name: strings.ToLower($1), Value: ast.NewParamMarkerExpr(yyS[yypt].offset)}
+	}
 
 ImportIntoStmt:
-	"IMPORT" "INTO" TableName ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt "FROM" stringLit FormatOpt LoadDataOptionListOpt
+	"IMPORT" "INTO" TableName ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt "FROM" LoadDataPath FormatOpt LoadDataOptionListOpt
 	{
-		$$ = &ast.ImportIntoStmt{
+		x := &ast.ImportIntoStmt{
 			Table:              $3.(*ast.TableName),
 			ColumnsAndUserVars: $4.([]*ast.ColumnNameOrUserVar),
 			ColumnAssignments:  $5.([]*ast.Assignment),
-			Path:               $7,
 			Format:             $8.(*string),
 			Options:            $9.([]*ast.LoadDataOpt),
 		}
+		if path, ok := $7.(string); ok {
+			x.Path = path
+		} else {
+			x.PathExpr = $7.(ast.ExprNode)
+		}
+		$$ = x
 	}
 |	"IMPORT" "INTO" TableName ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt "FROM" ImportFromSelectStmt LoadDataOptionListOpt
 	/* LoadDataSetSpecOpt is used to avoid shift/reduce conflict, we don't support it actually */
@@ -14918,6 +15100,71 @@ CreateResourceGroupStmt:
 		}
 	}
 
+/*******************************************************************
+ *
+ *  Create Variable Profile Statement
+ *
+ *  Example:
+ *      CREATE [OR REPLACE] VARIABLE PROFILE [IF NOT EXISTS] profile_name
+ *          [FOR RESOURCE GROUP resource_group_name]
+ *          SET variable_name = value [, variable_name = value] ...
+ *
+ *******************************************************************/
+CreateVariableProfileStmt:
+	"CREATE" OrReplace "VARIABLE" "PROFILE" IfNotExists Identifier VariableProfileResourceGroupOpt "SET" VariableProfileAssignmentList
+	{
+		$$ = &ast.CreateVariableProfileStmt{
+			OrReplace:         $2.(bool),
+			IfNotExists:       $5.(bool),
+			ProfileName:       model.NewCIStr($6),
+			ResourceGroupName: model.NewCIStr($7),
+			Variables:         $9.([]*ast.VariableAssignment),
+		}
+	}
+
+VariableProfileResourceGroupOpt:
+	/* EMPTY */
+	{
+		$$ = ""
+	}
+|	"FOR" "RESOURCE" "GROUP" ResourceGroupName
+	{
+		$$ = $4
+	}
+
+VariableProfileAssignmentList:
+	VariableProfileAssignment
+	{
+		$$ = []*ast.VariableAssignment{$1.(*ast.VariableAssignment)}
+	}
+|	VariableProfileAssignmentList ',' VariableProfileAssignment
+	{
+		$$ = append($1.([]*ast.VariableAssignment), $3.(*ast.VariableAssignment))
+	}
+
+VariableProfileAssignment:
+	Identifier EqOrAssignmentEq SetExpr
+	{
+		$$ = &ast.VariableAssignment{Name: $1, Value: $3, IsSystem: true}
+	}
+
+/*******************************************************************
+ *
+ *  Drop Variable Profile Statement
+ *
+ *  Example:
+ *      DROP VARIABLE PROFILE [IF EXISTS] profile_name
+ *
+ *******************************************************************/
+DropVariableProfileStmt:
+	"DROP" "VARIABLE" "PROFILE" IfExists Identifier
+	{
+		$$ = &ast.DropVariableProfileStmt{
+			IfExists:    $4.(bool),
+			ProfileName: model.NewCIStr($5),
+		}
+	}
+
 AlterResourceGroupStmt:
 	"ALTER" "RESOURCE" "GROUP" IfExists ResourceGroupName ResourceGroupOptionList
 	{
@@ -481,6 +481,10 @@ func TestAdminStmt(t *testing.T) {
 		{"admin check index tableName idxName;", true, "ADMIN CHECK INDEX `tableName` idxName"},
 		{"admin check index tableName idxName (1, 2), (4, 5);", true, "ADMIN CHECK INDEX `tableName` idxName (1,2), (4,5)"},
 		{"admin checksum table t1, t2;", true, "ADMIN CHECKSUM TABLE `t1`, `t2`"},
+		{"admin checksum table t1 partition (p0, p1), t2;", true, "ADMIN CHECKSUM TABLE `t1` PARTITION(`p0`, `p1`), `t2`"},
+		{"admin checksum table t1 as of timestamp '2024-01-01 00:00:00';", true, "ADMIN CHECKSUM TABLE `t1` AS OF TIMESTAMP _UTF8MB4'2024-01-01 00:00:00'"},
+		{"admin checksum table t1 partition (p0) as of timestamp now() - interval 10 second;", true, "ADMIN CHECKSUM TABLE `t1` PARTITION(`p0`) AS OF TIMESTAMP DATE_SUB(NOW(), INTERVAL 10 SECOND)"},
+		{"admin checksum table t1 as of timestamp '2024-01-01 00:00:00' partition (p0);", false, ""},
 		{"admin cancel ddl jobs 1", true, "ADMIN CANCEL DDL JOBS 1"},
 		{"admin cancel ddl jobs 1, 2", true, "ADMIN CANCEL DDL JOBS 1, 2"},
 		{"admin pause ddl jobs 1, 3", true, "ADMIN PAUSE DDL JOBS 1, 3"},
@@ -517,6 +521,32 @@ func TestAdminStmt(t *testing.T) {
 		{"admin set bdr role secondary", true, "ADMIN SET BDR ROLE SECONDARY"},
 		{"admin unset bdr role", true, "ADMIN UNSET BDR ROLE"},
 		{"admin show bdr role", true, "ADMIN SHOW BDR ROLE"},
+		// for reorganize index
+		{"admin reorganize index t1 idx", true, "ADMIN REORGANIZE INDEX `t1` idx"},
+		{"admin reorganize index test.t1 `idx`", true, "ADMIN REORGANIZE INDEX `test`.`t1` idx"},
+		{"admin alter ddl jobs 1 thread = 8", true, "ADMIN ALTER DDL JOBS 1 THREAD = 8"},
+		{"admin alter ddl jobs 1 thread = 8, batch_size = 256, max_write_speed = 1024", true, "ADMIN ALTER DDL JOBS 1 THREAD = 8, BATCH_SIZE = 256, MAX_WRITE_SPEED = 1024"},
+		{"admin alter ddl jobs 1 THREAD = 4 * 2", true, "ADMIN ALTER DDL JOBS 1 THREAD = 4*2"},
+		{"admin alter ddl jobs 1 priority = 'high'", true, "ADMIN ALTER DDL JOBS 1 PRIORITY = _UTF8MB4'high'"},
+		{"admin alter ddl jobs 1", false, ""},
+		{"admin alter ddl jobs 1, 2 thread = 8", false, ""},
+		{"admin reorganize index t1", false, ""},
+		{"admin reorganize index t1, t2 idx", false, ""},
+		// for inject fault
+		{"admin inject fault 'txn/before-commit' 'return'", true, "ADMIN INJECT FAULT 'txn/before-commit' 'return'"},
+		{"admin inject fault 'ddl/before-submit-job' '2*sleep(100)' ttl 60", true, "ADMIN INJECT FAULT 'ddl/before-submit-job' '2*sleep(100)' TTL 60"},
+		{"admin inject fault 'txn/before-commit'", false, ""},
+		{"admin inject fault 'txn/before-commit' 'return' ttl", false, ""},
+		// for reclaim table
+		{"admin reclaim table t1", true, "ADMIN RECLAIM TABLE `t1`"},
+		{"admin reclaim table test.t1, t2", true, "ADMIN RECLAIM TABLE `test`.`t1`, `t2`"},
+		{"admin reclaim table", false, ""},
+		// for pin gc safepoint
+		{"admin pin gc safepoint duration 3600", true, "ADMIN PIN GC SAFEPOINT DURATION 3600"},
+		{"admin pin gc safepoint", false, ""},
+		{"admin pin gc safepoint for table t1 duration 60", false, ""},
+		{"admin unpin gc safepoint", true, "ADMIN UNPIN GC SAFEPOINT"},
+		{"admin unpin gc safepoint duration 60", false, ""},
 	}
 	RunTest(t, table, false)
 }
@@ -744,6 +774,10 @@ func TestDMLStmt(t *testing.T) {
 		// we must add "`" to table name, since the offset of restored sql might be different with the source
 		{"load data infile '/tmp/t.csv' into table `t` with threads=10", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` WITH threads=10"},
 		{"load data infile '/tmp/t.csv' into table `t` with threads=10, detached", true, "LOAD DATA INFILE '/tmp/t.csv' INTO TABLE `t` WITH threads=10, detached"},
+		// parameter markers of prepared statement
+		{"load data infile ? into table `t` with threads=?, detached", true, "LOAD DATA INFILE ? INTO TABLE `t` WITH threads=?, detached"},
+		{"load data local infile ? into table `t`", true, "LOAD DATA LOCAL INFILE ? IGNORE INTO TABLE `t`"},
+		{"load data infile '/tmp/t.csv' into table ?", false, ""},
 
 		// IMPORT INTO
 		{"import into t from '/file.csv'", true, "IMPORT INTO `t` FROM '/file.csv'"},
@@ -754,6 +788,8 @@ func TestDMLStmt(t *testing.T) {
 		{"import into t from '/file.csv' with detached", true, "IMPORT INTO `t` FROM '/file.csv' WITH detached"},
 		{"import into `t` from '/file.csv' with thread=1", true, "IMPORT INTO `t` FROM '/file.csv' WITH thread=1"},
 		{"import into `t` from '/file.csv' with detached, thread=1", true, "IMPORT INTO `t` FROM '/file.csv' WITH detached, thread=1"},
+		{"import into `t` from ? format 'parquet' with detached, thread=?", true, "IMPORT INTO `t` FROM ? FORMAT 'parquet' WITH detached, thread=?"},
+		{"import into `t` from '/file.csv' with ?", false, ""},
 
 		// select for update/share
 		{"select * from t for update", true, "SELECT * FROM `t` FOR UPDATE"},
@@ -2648,6 +2684,9 @@ func TestDDL(t *testing.T) {
 		{`alter table t compact tiflash replica;`, true, "ALTER TABLE `t` COMPACT TIFLASH REPLICA"},
 		{`alter table t compact partition p1,p2;`, true, "ALTER TABLE `t` COMPACT PARTITION `p1`,`p2`"},
 		{`alter table t compact partition p1,p2 tiflash replica;`, true, "ALTER TABLE `t` COMPACT PARTITION `p1`,`p2` TIFLASH REPLICA"},
+		{`alter table t compact tikv replica;`, true, "ALTER TABLE `t` COMPACT TIKV REPLICA"},
+		{`alter table t compact partition p1,p2 tikv replica;`, true, "ALTER TABLE `t` COMPACT PARTITION `p1`,`p2` TIKV REPLICA"},
+		{`create table tikv (tikv int);`, true, "CREATE TABLE `tikv` (`tikv` INT)"},
 		// 3. create db
 		{`create database t primary_region="us";`, false, ""},
 		{`create database t regions="us,3";`, false, ""},
@@ -3883,6 +3922,20 @@ func TestDDL(t *testing.T) {
 		{"set resource group default;", true, "SET RESOURCE GROUP `default`"},
 		{"set resource group x y", false, ""},
 
+		// for variable profile
+		{"create variable profile olap set tidb_mem_quota_query = 1073741824, tidb_isolation_read_engines = 'tiflash,tidb'", true, "CREATE VARIABLE PROFILE `olap` SET tidb_mem_quota_query = 1073741824, tidb_isolation_read_engines = _UTF8MB4'tiflash,tidb'"},
+		{"create or replace variable profile if not exists olap for resource group rg1 set tidb_opt_agg_push_down = on", true, "CREATE OR REPLACE VARIABLE PROFILE IF NOT EXISTS `olap` FOR RESOURCE GROUP `rg1` SET tidb_opt_agg_push_down = _UTF8MB4'ON'"},
+		{"create variable profile olap for resource group default set tidb_opt_agg_push_down := 1", true, "CREATE VARIABLE PROFILE `olap` FOR RESOURCE GROUP `default` SET tidb_opt_agg_push_down = 1"},
+		{"create variable profile olap", false, ""},
+		{"create variable profile olap set", false, ""},
+		{"create variable profile olap set @@global.tidb_opt_agg_push_down = 1", false, ""},
+		{"drop variable profile olap", true, "DROP VARIABLE PROFILE `olap`"},
+		{"drop variable profile if exists olap", true, "DROP VARIABLE PROFILE IF EXISTS `olap`"},
+		{"drop variable profile", false, ""},
+		{"set profile olap", true, "SET PROFILE `olap`"},
+		{"set profile = 1", true, "SET @@SESSION.`profile`=1"},
+		{"set profile olap, oltp", false, ""},
+
 		{"CREATE ROLE `RESOURCE`", true, "CREATE ROLE `RESOURCE`@`%`"},
 		{"CREATE ROLE RESOURCE", false, ""},
 
@@ -4509,6 +4562,21 @@ func TestOptimizerHints(t *testing.T) {
 	require.Equal(t, "query_type", hints[1].HintName.L)
 	require.Equal(t, "oltp", hints[1].HintData.(model.CIStr).L)
 
+	// Test RUNTIME_FILTER
+	stmt, _, err = p.Parse("select /*+ RUNTIME_FILTER(t1.c1 -> t2.c1), runtime_filter(@sel_1 c1->test.t2.c1) */ c1, c2 from t1, t2 where t1.c1 = t2.c1", "", "")
+	require.NoError(t, err)
+	selectStmt = stmt[0].(*ast.SelectStmt)
+
+	hints = selectStmt.TableHints
+	require.Len(t, hints, 2)
+	require.Equal(t, "runtime_filter", hints[0].HintName.L)
+	require.Equal(t, "t1.c1", hints[0].HintData.(ast.HintRuntimeFilter).Build.String())
+	require.Equal(t, "t2.c1", hints[0].HintData.(ast.HintRuntimeFilter).Target.String())
+	require.Equal(t, "runtime_filter", hints[1].HintName.L)
+	require.Equal(t, "sel_1", hints[1].QBName.L)
+	require.Equal(t, "c1", hints[1].HintData.(ast.HintRuntimeFilter).Build.String())
+	require.Equal(t, "test.t2.c1", hints[1].HintData.(ast.HintRuntimeFilter).Target.String())
+
 	// Test MEMORY_QUOTA
 	stmt, _, err = p.Parse("select /*+ MEMORY_QUOTA(1 MB), memory_quota(1 GB) */ c1, c2 from t1, t2 where t1.c1 = t2.c1", "", "")
 	require.NoError(t, err)
@@ -6377,6 +6445,13 @@ func TestWindowFunctions(t *testing.T) {
 		{`SELECT RANK() OVER (w1) FROM t WINDOW w1 AS (w2), w2 AS (), w3 AS (w1);`, true, "SELECT RANK() OVER (`w1`) FROM `t` WINDOW `w1` AS (`w2`),`w2` AS (),`w3` AS (`w1`)"},
 		{`SELECT RANK() OVER w1 FROM t WINDOW w1 AS (w2), w2 AS (w3), w3 AS (w1);`, true, "SELECT RANK() OVER `w1` FROM `t` WINDOW `w1` AS (`w2`),`w2` AS (`w3`),`w3` AS (`w1`)"},
 
+		// For the QUALIFY clause.
+		{`SELECT a, ROW_NUMBER() OVER (PARTITION BY b ORDER BY c) AS rn FROM t QUALIFY rn = 1;`, true, "SELECT `a`,ROW_NUMBER() OVER (PARTITION BY `b` ORDER BY `c`) AS `rn` FROM `t` QUALIFY `rn`=1"},
+		{`SELECT a FROM t WHERE a > 1 GROUP BY a HAVING COUNT(*) > 1 WINDOW w AS (ORDER BY a) QUALIFY RANK() OVER w <= 2 ORDER BY a LIMIT 10;`, true, "SELECT `a` FROM `t` WHERE `a`>1 GROUP BY `a` HAVING COUNT(1)>1 WINDOW `w` AS (ORDER BY `a`) QUALIFY RANK() OVER `w`<=2 ORDER BY `a` LIMIT 10"},
+		{`SELECT a FROM t QUALIFY ROW_NUMBER() OVER (ORDER BY a) = 1 FOR UPDATE;`, true, "SELECT `a` FROM `t` QUALIFY ROW_NUMBER() OVER (ORDER BY `a`)=1 FOR UPDATE"},
+		{`SELECT a FROM t qualify QUALIFY a = 1;`, false, ""},
+		{`SELECT 1 QUALIFY 1;`, false, ""},
+
 		// For TSO functions
 		{`select tidb_parse_tso(1)`, true, "SELECT TIDB_PARSE_TSO(1)"},
 		{`select tidb_parse_tso_logical(1)`, true, "SELECT TIDB_PARSE_TSO_LOGICAL(1)"},
@@ -6876,6 +6951,9 @@ func TestBRIE(t *testing.T) {
 		{"RESTORE DATABASE * FROM 's3://bucket/path/'", true, "RESTORE DATABASE * FROM 's3://bucket/path/'"},
 
 		{"BACKUP DATABASE * TO 'noop://' LAST_BACKUP = '2020-02-02 14:14:14'", true, "BACKUP DATABASE * TO 'noop://' LAST_BACKUP = '2020-02-02 14:14:14'"},
+		{"BACKUP DATABASE * TO 'noop://' ESTIMATE_ONLY = 1", true, "BACKUP DATABASE * TO 'noop://' ESTIMATE_ONLY = 1"},
+		{"BACKUP TABLE a.b TO 'noop://' estimate_only true", true, "BACKUP TABLE `a`.`b` TO 'noop://' ESTIMATE_ONLY = 1"},
+		{"create table estimate_only (estimate_only int)", true, "CREATE TABLE `estimate_only` (`estimate_only` INT)"},
 		{"BACKUP DATABASE * TO 'noop://' LAST_BACKUP = 1234567890", true, "BACKUP DATABASE * TO 'noop://' LAST_BACKUP = 1234567890"},
 
 		{"backup database * to 'noop://' rate_limit 500 MB/second snapshot 5 minute ago", true, "BACKUP DATABASE * TO 'noop://' RATE_LIMIT = 500 MB/SECOND SNAPSHOT = 300000000 MICROSECOND AGO"},
@@ -0,0 +1,281 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/planner/util"
+	"github.com/pingcap/tidb/pkg/util/execdetails"
+	"github.com/pingcap/tidb/pkg/util/hack"
+	"github.com/pingcap/tidb/pkg/util/kvcache"
+)
+
+const (
+	// cardinalityFeedbackCacheCapacity is the max number of the statements whose feedback is kept.
+	cardinalityFeedbackCacheCapacity = 1024
+	// cardinalityFeedbackMinRatio is the min ratio between the actual and the estimated row count
+	// to be treated as a misestimate.
+	cardinalityFeedbackMinRatio = 2.0
+	// cardinalityFeedbackMaxFactor bounds the correction factor.
+	cardinalityFeedbackMaxFactor = 1e4
+)
+
+// CardinalityFeedback keeps the row count corrections of the data sources learned from the
+// execution of the slow queries, the corrections are consulted when the statements with the
+// same digest are optimized again.
+var CardinalityFeedback = newCardinalityFeedbackCache(cardinalityFeedbackCacheCapacity)
+
+type cardinalityFeedbackCache struct {
+	mu    sync.Mutex
+	cache *kvcache.SimpleLRUCache
+}
+
+type sqlDigestKey string
+
+// Hash implements kvcache.Key interface.
+func (k sqlDigestKey) Hash() []byte {
+	return hack.Slice(string(k))
+}
+
+func newCardinalityFeedbackCache(capacity uint) *cardinalityFeedbackCache {
+	return &cardinalityFeedbackCache{cache: kvcache.NewSimpleLRUCache(capacity, 0, 0)}
+}
+
+// Get returns the correction factor of the data source in the statement.
+func (c *cardinalityFeedbackCache) Get(sqlDigest, dataSource string) (float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.cache.Get(sqlDigestKey(sqlDigest))
+	if !ok {
+		return 0, false
+	}
+	factor, ok := v.(map[string]float64)[dataSource]
+	return factor, ok
+}
+
+// Update adjusts the correction factor of the data source according to the estimated and the actual row count.
+// The estimated row count may have been corrected by the current factor, so the new factor is accumulated on it.
+func (c *cardinalityFeedbackCache) Update(sqlDigest, dataSource string, estRows, actRows float64) {
+	ratio := math.Max(actRows, 1) / math.Max(estRows, 1)
+	if ratio < cardinalityFeedbackMinRatio && ratio > 1/cardinalityFeedbackMinRatio {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var factors map[string]float64
+	if v, ok := c.cache.Get(sqlDigestKey(sqlDigest)); ok {
+		factors = v.(map[string]float64)
+	} else {
+		factors = make(map[string]float64)
+		c.cache.Put(sqlDigestKey(sqlDigest), factors)
+	}
+	factor, ok := factors[dataSource]
+	if !ok {
+		factor = 1
+	}
+	factor *= ratio
+	factor = math.Min(math.Max(factor, 1/cardinalityFeedbackMaxFactor), cardinalityFeedbackMaxFactor)
+	factors[dataSource] = factor
+}
+
+// Clear removes all the corrections.
+func (c *cardinalityFeedbackCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.DeleteAll()
+}
+
+func cardinalityFeedbackKey(tblInfo *model.TableInfo, asName *model.CIStr) string {
+	name := tblInfo.Name.L
+	if asName != nil && asName.L != "" {
+		name = asName.L
+	}
+	return fmt.Sprintf("%d:%s", tblInfo.ID, name)
+}
+
+// accessPathFeedbackKey returns the key of the access path of the data source, idx is nil for the table path.
+func accessPathFeedbackKey(tblInfo *model.TableInfo, asName *model.CIStr, idx *model.IndexInfo) string {
+	key := cardinalityFeedbackKey(tblInfo, asName) + ":"
+	if idx != nil {
+		key += idx.Name.L
+	}
+	return key
+}
+
+// applyCardinalityFeedback corrects the row count of the data source with the feedback of the previous executions.
+func (ds *DataSource) applyCardinalityFeedback() {
+	sessVars := ds.SCtx().GetSessionVars()
+	if !sessVars.EnableCardinalityFeedback || len(ds.pushedDownConds) == 0 || ds.StatsInfo().RowCount <= 0 {
+		return
+	}
+	_, digest := sessVars.StmtCtx.SQLDigest()
+	if digest == nil {
+		return
+	}
+	factor, ok := CardinalityFeedback.Get(digest.String(), cardinalityFeedbackKey(ds.tableInfo, ds.TableAsName))
+	if !ok {
+		return
+	}
+	// The corrected row count can't exceed the row count of the table.
+	factor = math.Min(factor, ds.tableStats.RowCount/ds.StatsInfo().RowCount)
+	ds.SetStats(ds.StatsInfo().Scale(factor))
+}
+
+// applyAccessPathFeedback corrects the row count read by the access path with the feedback of the previous
+// executions, so that a path whose ranges were underestimated is not chosen again.
+func (ds *DataSource) applyAccessPathFeedback(path *util.AccessPath) {
+	sessVars := ds.SCtx().GetSessionVars()
+	if !sessVars.EnableCardinalityFeedback || len(path.AccessConds) == 0 {
+		return
+	}
+	_, digest := sessVars.StmtCtx.SQLDigest()
+	if digest == nil {
+		return
+	}
+	var idx *model.IndexInfo
+	if !path.IsTablePath() {
+		idx = path.Index
+	}
+	factor, ok := CardinalityFeedback.Get(digest.String(), accessPathFeedbackKey(ds.tableInfo, ds.TableAsName, idx))
+	if !ok {
+		return
+	}
+	// The corrected row count can't exceed the row count of the table, and the index filters keep their selectivity.
+	countAfterAccess := math.Min(math.Max(path.CountAfterAccess, 1)*factor, ds.tableStats.RowCount)
+	if path.CountAfterAccess > 0 {
+		path.CountAfterIndex *= countAfterAccess / path.CountAfterAccess
+	}
+	path.CountAfterAccess = countAfterAccess
+}
+
+// RecordCardinalityFeedback compares the estimated row count with the actual row count of the
+// data sources in the executed plan, and records the misestimates into CardinalityFeedback.
+func RecordCardinalityFeedback(sqlDigest string, p Plan, coll *execdetails.RuntimeStatsColl) {
+	if coll == nil || sqlDigest == "" {
+		return
+	}
+	switch x := p.(type) {
+	case *Insert:
+		p = x.SelectPlan
+	case *Update:
+		p = x.SelectPlan
+	case *Delete:
+		p = x.SelectPlan
+	}
+	if pp, ok := p.(PhysicalPlan); ok {
+		recordCardinalityFeedback(sqlDigest, pp, coll)
+	}
+}
+
+func recordCardinalityFeedback(sqlDigest string, p PhysicalPlan, coll *execdetails.RuntimeStatsColl) {
+	switch x := p.(type) {
+	case *PhysicalTableReader:
+		recordDataSourceFeedback(sqlDigest, x.tablePlan, coll)
+		recordAccessPathFeedback(sqlDigest, x.tablePlan, coll)
+		return
+	case *PhysicalIndexReader:
+		recordDataSourceFeedback(sqlDigest, x.indexPlan, coll)
+		recordAccessPathFeedback(sqlDigest, x.indexPlan, coll)
+		return
+	case *PhysicalIndexLookUpReader:
+		recordDataSourceFeedback(sqlDigest, x.tablePlan, coll)
+		recordAccessPathFeedback(sqlDigest, x.indexPlan, coll)
+		return
+	case *PhysicalIndexJoin:
+		// The estimated row count of the inner side is for each lookup, skip it.
+		recordCardinalityFeedback(sqlDigest, x.children[1-x.InnerChildIdx], coll)
+		return
+	case *PhysicalIndexHashJoin:
+		recordCardinalityFeedback(sqlDigest, x.children[1-x.InnerChildIdx], coll)
+		return
+	case *PhysicalIndexMergeJoin:
+		recordCardinalityFeedback(sqlDigest, x.children[1-x.InnerChildIdx], coll)
+		return
+	case *PhysicalApply:
+		recordCardinalityFeedback(sqlDigest, x.children[1-x.InnerChildIdx], coll)
+		return
+	}
+	for _, child := range p.Children() {
+		recordCardinalityFeedback(sqlDigest, child, coll)
+	}
+}
+
+// recordDataSourceFeedback records the feedback of the data source read by the coprocessor plan.
+// The operators above the filters, like Limit and Aggregation, are skipped because they change
+// the row count after the data source.
+func recordDataSourceFeedback(sqlDigest string, p PhysicalPlan, coll *execdetails.RuntimeStatsColl) {
+	for p != nil {
+		switch x := p.(type) {
+		case *PhysicalSelection:
+			if len(x.children) == 0 {
+				return
+			}
+			recordScanFeedback(sqlDigest, x, x.children[0], coll)
+			return
+		case *PhysicalTableScan, *PhysicalIndexScan:
+			recordScanFeedback(sqlDigest, x, x, coll)
+			return
+		case *PhysicalLimit, *PhysicalTopN, *PhysicalHashAgg, *PhysicalStreamAgg, *PhysicalProjection:
+			if len(x.Children()) == 0 {
+				return
+			}
+			p = x.Children()[0]
+		default:
+			return
+		}
+	}
+}
+
+func recordScanFeedback(sqlDigest string, top, scan PhysicalPlan, coll *execdetails.RuntimeStatsColl) {
+	if !coll.ExistsCopStats(top.ID()) {
+		return
+	}
+	var key string
+	switch x := scan.(type) {
+	case *PhysicalTableScan:
+		key = cardinalityFeedbackKey(x.Table, x.TableAsName)
+	case *PhysicalIndexScan:
+		key = cardinalityFeedbackKey(x.Table, x.TableAsName)
+	default:
+		return
+	}
+	actRows := coll.GetCopStats(top.ID()).GetActRows()
+	CardinalityFeedback.Update(sqlDigest, key, top.StatsCount(), float64(actRows))
+}
+
+// recordAccessPathFeedback records the feedback of the access path by the scan at the bottom of the coprocessor plan.
+func recordAccessPathFeedback(sqlDigest string, p PhysicalPlan, coll *execdetails.RuntimeStatsColl) {
+	for p != nil && len(p.Children()) > 0 {
+		p = p.Children()[0]
+	}
+	if p == nil || !coll.ExistsCopStats(p.ID()) {
+		return
+	}
+	var key string
+	switch x := p.(type) {
+	case *PhysicalTableScan:
+		key = accessPathFeedbackKey(x.Table, x.TableAsName, nil)
+	case *PhysicalIndexScan:
+		key = accessPathFeedbackKey(x.Table, x.TableAsName, x.Index)
+	default:
+		return
+	}
+	actRows := coll.GetCopStats(p.ID()).GetActRows()
+	CardinalityFeedback.Update(sqlDigest, key, p.StatsCount(), float64(actRows))
+}
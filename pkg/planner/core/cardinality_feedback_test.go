@@ -0,0 +1,100 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinalityFeedback(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	core.CardinalityFeedback.Clear()
+	defer core.CardinalityFeedback.Clear()
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (1, 2), (1, 3), (1, 4), (1, 5), (1, 6), (1, 7), (1, 8)")
+	for i := 0; i < 6; i++ {
+		tk.MustExec("insert into t select * from t")
+	}
+	tk.MustExec("set @@tidb_opt_enable_cardinality_feedback = 1")
+	tk.MustExec("set @@tidb_slow_log_threshold = 0")
+	defer tk.MustExec("set @@tidb_slow_log_threshold = 300")
+
+	sql := "select * from t where a = 1"
+	estRows := func() float64 {
+		require.Len(t, tk.MustQuery(sql).Rows(), 512)
+		return tk.Session().GetSessionVars().StmtCtx.GetPlan().(core.PhysicalPlan).StatsCount()
+	}
+	// the table has no statistics, the pseudo estimation is far less than the actual 512 rows.
+	before := estRows()
+	require.Less(t, before, 256.0)
+
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	_, digest := parser.NormalizeDigest(sql)
+	factor, ok := core.CardinalityFeedback.Get(digest.String(), fmt.Sprintf("%d:t", tbl.Meta().ID))
+	require.True(t, ok)
+	require.Greater(t, factor, 2.0)
+
+	// the next optimization is corrected by the feedback.
+	after := estRows()
+	require.Greater(t, after, before)
+
+	// the feedback is not used when it's disabled.
+	tk.MustExec("set @@tidb_opt_enable_cardinality_feedback = 0")
+	require.Equal(t, before, estRows())
+}
+
+func TestCardinalityFeedbackOfAccessPath(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	core.CardinalityFeedback.Clear()
+	defer core.CardinalityFeedback.Clear()
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, index ia(a), index ib(b))")
+	values := make([]string, 0, 1000)
+	for i := 1; i <= 1000; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i%100))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("analyze table t")
+	// the statistics are outdated, a = 1 is estimated to be 1 row but there are 1001 rows.
+	values = values[:0]
+	for i := 0; i < 1000; i++ {
+		values = append(values, "(1, 2)")
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+	tk.MustExec("set @@tidb_opt_enable_cardinality_feedback = 1")
+	tk.MustExec("set @@tidb_slow_log_threshold = 0")
+	defer tk.MustExec("set @@tidb_slow_log_threshold = 300")
+
+	plan := func() string {
+		tk.MustQuery("select * from t where a = 1 and b = 1").Check(testkit.Rows("1 1"))
+		return core.ToString(tk.Session().GetSessionVars().StmtCtx.GetPlan().(core.Plan))
+	}
+	// the row count of the data source is estimated well, only the rows read by the index ia are underestimated.
+	require.Contains(t, plan(), "t.ia")
+	// the next optimization chooses the other index by the corrected row count of the access path.
+	require.Contains(t, plan(), "t.ib")
+}
@@ -21,8 +21,10 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/testdata"
+	"github.com/pingcap/tidb/pkg/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -464,3 +466,95 @@ func TestIssue52023(t *testing.T) {
 		"└─Selection 1.00 cop[tikv]  or(eq(test.t.a, \"0x05\"), eq(cast(test.t.a, double BINARY), 55))",
 		"  └─TableFullScan 1.00 cop[tikv] table:t keep order:false"))
 }
+
+func TestSharedSubplanInSetOperation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	sql := "select x.a from (select a, b from t where b > 1) x union all select y.b from (select a, b from t where b > 1) y where y.a < 3"
+
+	hasCTE := func(sql string) bool {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "CTEFullScan") {
+				return true
+			}
+		}
+		return false
+	}
+	require.False(t, hasCTE(sql))
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("2", "2", "3"))
+
+	tk.MustExec("set @@tidb_opt_enable_shared_subplan = 1")
+	require.True(t, hasCTE(sql))
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("2", "2", "3"))
+
+	// the derived tables with non-deterministic functions are not shared.
+	require.False(t, hasCTE("select x.a from (select a, rand() r from t) x union all select y.a from (select a, rand() r from t) y"))
+
+	// the identical filtered scans of a table are shared, also with the derived tables selecting all the columns of them.
+	for _, c := range []struct {
+		sql    string
+		result []string
+	}{
+		{"select a from t where b > 1 union all select b from t where b > 1", []string{"2", "2", "3", "3"}},
+		{"select t.a from t where t.b > 1 union all select t.b + 10 from t where t.b > 1 order by 1", []string{"12", "13", "2", "3"}},
+		{"select a from t x where x.b > 1 union select x.b from t x where x.b > 1 group by x.b", []string{"2", "3"}},
+		{"select a from t where b > 1 union all select x.b from (select * from t where b > 1) x", []string{"2", "2", "3", "3"}},
+		{"with c as (select 1 as k) select a from t where b > 1 union all select b from t where b > 1 union all select k from c", []string{"1", "2", "2", "3", "3"}},
+	} {
+		require.True(t, hasCTE(c.sql), c.sql)
+		tk.MustQuery(c.sql).Sort().Check(testkit.Rows(c.result...))
+	}
+	// the scans referring to the handle column or locking the rows are not shared.
+	require.False(t, hasCTE("select _tidb_rowid from t where b > 1 union all select a from t where b > 1"))
+	require.False(t, hasCTE("select a from t where b > 1 union all select b from t where b > 1 for update"))
+	require.False(t, hasCTE("select a from t where b > 1 union all select b from t where b > 2"))
+}
+
+func TestSharedSubqueryResult(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+	sql := "select a from t where a < (select max(b) from t) and b > (select max(b) from t) - 2 and exists (select 1 from t where b > 2) and exists (select 1 from t where b > 2)"
+
+	cachedResults := func() int {
+		cache := tk.Session().GetSessionVars().StmtCtx.GetOrStoreStmtCache(stmtctx.StmtSubqueryResultCacheKey, map[string][]types.Datum{})
+		return len(cache.(map[string][]types.Datum))
+	}
+	inSQLs := []string{
+		"select a, a in (select b from t where b > 1) from t where a in (select b from t where b > 1) or a not in (select b from t where b > 1)",
+		"select count(*) from t where a not in (select if(b = 3, null, b) from t) or a in (select if(b = 3, null, b) from t)",
+	}
+	tk.MustQuery(sql).Check(testkit.Rows("2"))
+	require.Equal(t, 0, cachedResults())
+	tk.MustExec("set @@tidb_opt_enable_shared_subplan = 1")
+	tk.MustQuery(sql).Check(testkit.Rows("2"))
+	require.Equal(t, 0, cachedResults())
+	tk.MustExec("analyze table t")
+	inResults := make([][][]any, 0, len(inSQLs))
+	for _, inSQL := range inSQLs {
+		inResults = append(inResults, tk.MustQuery(inSQL).Rows())
+	}
+
+	tk.MustExec("set @@tidb_opt_enable_subquery_result_reuse = 1")
+	tk.MustQuery(sql).Check(testkit.Rows("2"))
+	// One result for the scalar subquery and one for the exists subquery.
+	require.Equal(t, 2, cachedResults())
+	// The in and not in subqueries reading small tables share one result.
+	for i, inSQL := range inSQLs {
+		tk.MustQuery(inSQL).Check(inResults[i])
+		require.Equal(t, 1, cachedResults())
+	}
+	tk.MustQuery(inSQLs[0]).Check(testkit.Rows("1 0", "2 1", "3 1"))
+	tk.MustQuery(inSQLs[1]).Check(testkit.Rows("2"))
+
+	// the subqueries with non-deterministic functions are not shared.
+	tk.MustQuery("select (select uuid()) = (select uuid())").Check(testkit.Rows("0"))
+	require.Equal(t, 0, cachedResults())
+}
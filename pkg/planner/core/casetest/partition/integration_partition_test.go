@@ -15,6 +15,7 @@
 package partition
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -246,3 +247,46 @@ func TestBatchPointGetPartitionForAccessObject(t *testing.T) {
 		tk.MustQuery(tt).Check(testkit.Rows(output[i].Plan...))
 	}
 }
+
+func TestInSubqueryPartitionPruning(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("create table t (a int, b int) partition by range (a) (" +
+		"partition p0 values less than (10), " +
+		"partition p1 values less than (20), " +
+		"partition p2 values less than (30))")
+	tk.MustExec("insert into t values (1, 1), (15, 15), (25, 25)")
+	tk.MustExec("create table s (a int)")
+	tk.MustExec("insert into s values (15), (16)")
+	tk.MustExec("analyze table t, s")
+
+	// the small uncorrelated subquery is evaluated to prune the partitions statically.
+	tk.MustQuery("explain format = 'brief' select * from t where a in (select a from s)").CheckContain("partition:p1")
+	tk.MustQuery("explain format = 'brief' select * from t where a in (select a from s)").CheckNotContain("Join")
+	tk.MustQuery("select * from t where a in (select a from s)").Check(testkit.Rows("15 15"))
+	tk.MustQuery("select * from t where a in (select a from s where a > 100)").Check(testkit.Rows())
+
+	// `not in` still needs the anti semi join.
+	tk.MustQuery("explain format = 'brief' select * from t where a not in (select a from s)").CheckContain("partition:all")
+	tk.MustQuery("select * from t where a not in (select a from s) order by a").Check(testkit.Rows("1 1", "25 25"))
+
+	// the subquery isn't evaluated if it's switched off.
+	tk.MustExec("set @@tidb_opt_prune_partitions_by_subquery = off")
+	tk.MustQuery("explain format = 'brief' select * from t where a in (select a from s)").CheckContain("Join")
+	tk.MustQuery("select * from t where a in (select a from s)").Check(testkit.Rows("15 15"))
+	tk.MustExec("set @@tidb_opt_prune_partitions_by_subquery = default")
+
+	// the subquery returning too many rows by the outdated statistics is pruned at runtime.
+	tk.MustExec("create table s2 (a int)")
+	tk.MustExec("insert into s2 values (15)")
+	tk.MustExec("analyze table s2")
+	values := make([]string, 0, 2000)
+	for i := 0; i < 2000; i++ {
+		values = append(values, fmt.Sprintf("(%d)", i%10+10))
+	}
+	tk.MustExec("insert into s2 values " + strings.Join(values, ","))
+	tk.MustQuery("explain format = 'brief' select * from t where a in (select a from s2)").CheckContain("Join")
+	tk.MustQuery("select * from t where a in (select a from s2)").Check(testkit.Rows("15 15"))
+}
@@ -120,6 +120,14 @@ type ChecksumTable struct {
 	Tables []*ast.TableName
 }
 
+// ReclaimTable is used for reclaiming the space of tables after mass deletes,
+// built from the `admin reclaim table` statement.
+type ReclaimTable struct {
+	baseSchemaProducer
+
+	Tables []*ast.TableName
+}
+
 // CancelDDLJobs represents a cancel DDL jobs plan.
 type CancelDDLJobs struct {
 	baseSchemaProducer
@@ -640,6 +648,7 @@ type IndexAdvise struct {
 type SplitRegion struct {
 	baseSchemaProducer
 
+	DBName         model.CIStr
 	TableInfo      *model.TableInfo
 	PartitionNames []model.CIStr
 	IndexInfo      *model.IndexInfo
@@ -662,6 +671,7 @@ type CompactTable struct {
 	baseSchemaProducer
 
 	ReplicaKind    ast.CompactReplicaKind
+	DBName         model.CIStr
 	TableInfo      *model.TableInfo
 	PartitionNames []model.CIStr
 }
@@ -671,6 +681,12 @@ type DDL struct {
 	baseSchemaProducer
 
 	Statement ast.DDLNode
+
+	// CTASCols and CTASFieldNames are only set for CREATE TABLE ... SELECT.
+	// CTASCols are the column definitions of the new table, including the columns derived from the select fields,
+	// and CTASFieldNames are the names of the columns which the select fields are imported into.
+	CTASCols       []*ast.ColumnDef
+	CTASFieldNames []model.CIStr
 }
 
 // SelectInto represents a select-into plan.
@@ -51,6 +51,19 @@ import (
 // EvalSubqueryFirstRow evaluates incorrelated subqueries once, and get first row.
 var EvalSubqueryFirstRow func(ctx context.Context, p PhysicalPlan, is infoschema.InfoSchema, sctx PlanContext) (row []types.Datum, err error)
 
+// EvalSubqueryRows evaluates incorrelated subqueries once, and get at most maxRows+1 rows, so the caller can
+// tell whether the subquery returns more than maxRows rows.
+var EvalSubqueryRows func(ctx context.Context, p PhysicalPlan, is infoschema.InfoSchema, sctx PlanContext, maxRows int) (rows [][]types.Datum, err error)
+
+const (
+	// maxEvaluableInSubqueryTableRows is the max row count of each table read by an uncorrelated `in (SUBQUERY)`
+	// which can be evaluated at optimize time.
+	maxEvaluableInSubqueryTableRows = 1024
+	// maxEvaluableInSubqueryRows is the max row count of an uncorrelated `in (SUBQUERY)` evaluated at optimize
+	// time. The statistics may be outdated, so the subquery which returns more rows is pruned at runtime instead.
+	maxEvaluableInSubqueryRows = 1024
+)
+
 // evalAstExprWithPlanCtx evaluates ast expression with plan context.
 // Different with expression.EvalSimpleAst, it uses planner context and is more powerful to build some special expressions
 // like subquery, window function, etc.
@@ -1034,6 +1047,13 @@ func (er *expressionRewriter) handleExistSubquery(ctx context.Context, planCtx *
 		}
 		er.ctxStackAppend(planCtx.plan.Schema().Columns[planCtx.plan.Schema().Len()-1], planCtx.plan.OutputNames()[planCtx.plan.Schema().Len()-1])
 	} else {
+		cacheKey, cacheable := subqueryResultCacheKey(planCtx, "exists", subq)
+		if cacheable {
+			if row, ok := getSubqueryResultCache(b.ctx)[cacheKey]; ok {
+				er.appendExistsResult(row, v.Not)
+				return v, true
+			}
+		}
 		// We don't want nth_plan hint to affect separately executed subqueries here, so disable nth_plan temporarily.
 		nthPlanBackup := er.sctx.GetSessionVars().StmtCtx.StmtHints.ForceNthPlan
 		er.sctx.GetSessionVars().StmtCtx.StmtHints.ForceNthPlan = -1
@@ -1075,15 +1095,23 @@ func (er *expressionRewriter) handleExistSubquery(ctx context.Context, planCtx *
 			er.err = err
 			return v, true
 		}
-		if (row != nil && !v.Not) || (row == nil && v.Not) {
-			er.ctxStackAppend(expression.NewOne(), types.EmptyName)
-		} else {
-			er.ctxStackAppend(expression.NewZero(), types.EmptyName)
+		if cacheable {
+			getSubqueryResultCache(b.ctx)[cacheKey] = row
 		}
+		er.appendExistsResult(row, v.Not)
 	}
 	return v, true
 }
 
+// appendExistsResult appends the result of the evaluated EXISTS subquery by its first row.
+func (er *expressionRewriter) appendExistsResult(row []types.Datum, not bool) {
+	if (row != nil && !not) || (row == nil && not) {
+		er.ctxStackAppend(expression.NewOne(), types.EmptyName)
+	} else {
+		er.ctxStackAppend(expression.NewZero(), types.EmptyName)
+	}
+}
+
 // popExistsSubPlan will remove the useless plan in exist's child.
 // See comments inside the method for more details.
 func (*expressionRewriter) popExistsSubPlan(planCtx *exprRewriterPlanCtx, p LogicalPlan) LogicalPlan {
@@ -1134,6 +1162,21 @@ func (er *expressionRewriter) handleInSubquery(ctx context.Context, planCtx *exp
 		er.err = expression.ErrOperandColumns.GenWithStackByArgs(lLen)
 		return v, true
 	}
+	// If the subquery is small and uncorrelated, evaluate it to a constant list, so that the partitions of the
+	// outer table can be pruned statically, or the result can be reused by its identical occurrences in the
+	// statement. The subquery is optimized by the evaluation, so it's built again to be executed as a join if
+	// it returns too many rows.
+	if lLen == 1 && collate.CompatibleCollate(lexpr.GetType().GetCollate(), np.Schema().Columns[0].GetType().GetCollate()) &&
+		((!v.Not && !asScalar && er.canEvalInSubqueryForPartitionPruning(planCtx, lexpr, np)) || er.canEvalInSubqueryForReuse(planCtx, subq, np)) {
+		if er.evalInSubqueryToConstants(ctx, planCtx, subq, lexpr, np, v.Not) || er.err != nil {
+			return v, true
+		}
+		np, hintFlags, err = er.buildSubquery(ctx, planCtx, subq, handlingInSubquery)
+		if err != nil {
+			er.err = err
+			return v, true
+		}
+	}
 	var rexpr expression.Expression
 	if np.Schema().Len() == 1 {
 		rexpr = np.Schema().Columns[0]
@@ -1239,6 +1282,158 @@ func (er *expressionRewriter) handleInSubquery(ctx context.Context, planCtx *exp
 	return v, true
 }
 
+// canEvalInSubqueryForPartitionPruning checks whether the `lexpr in (SUBQUERY)` can be evaluated at optimize time
+// to prune the partitions of the outer table, which is only worthwhile when lexpr is a column of a partitioned table
+// in the dynamic prune mode, where the partitions can't be pruned by the join, and the subquery only reads a few
+// small tables.
+func (er *expressionRewriter) canEvalInSubqueryForPartitionPruning(planCtx *exprRewriterPlanCtx, lexpr expression.Expression, np LogicalPlan) bool {
+	b := planCtx.builder
+	if b.disableSubQueryPreprocessing || len(ExtractCorrelatedCols4LogicalPlan(np)) > 0 || hasCTEConsumerInSubPlan(np) {
+		return false
+	}
+	sessVars := b.ctx.GetSessionVars()
+	if !sessVars.PrunePartitionsBySubquery || !sessVars.StmtCtx.UseDynamicPartitionPrune() {
+		return false
+	}
+	if sessVars.StmtCtx.InExplainStmt && !sessVars.StmtCtx.InExplainAnalyzeStmt && sessVars.ExplainNonEvaledSubQuery {
+		return false
+	}
+	col, ok := lexpr.(*expression.Column)
+	if !ok || !isColumnOfPartitionedTable(planCtx.plan, col) {
+		return false
+	}
+	return readsOnlySmallTables(np)
+}
+
+func isColumnOfPartitionedTable(p LogicalPlan, col *expression.Column) bool {
+	if ds, ok := p.(*DataSource); ok {
+		return ds.tableInfo.GetPartitionInfo() != nil && ds.schema.Contains(col)
+	}
+	for _, child := range p.Children() {
+		if isColumnOfPartitionedTable(child, col) {
+			return true
+		}
+	}
+	return false
+}
+
+// readsOnlySmallTables checks whether all the tables read by the plan are small by the statistics, the plans
+// with joins are excluded since their results may be much larger than the tables.
+func readsOnlySmallTables(p LogicalPlan) bool {
+	switch x := p.(type) {
+	case *LogicalJoin, *LogicalApply, *LogicalCTE, *LogicalCTETable:
+		return false
+	case *DataSource:
+		// The statistics of the data source are loaded when deriving the stats, which hasn't happened yet.
+		statsTbl := x.statisticTable
+		if statsTbl == nil {
+			statsTbl = getStatsTable(x.SCtx(), x.tableInfo, x.physicalTableID)
+		}
+		return !statsTbl.Pseudo && statsTbl.RealtimeCount <= maxEvaluableInSubqueryTableRows
+	case *LogicalTableDual:
+		return true
+	}
+	// The other data sources like the memory tables are not counted in the statistics.
+	if len(p.Children()) == 0 {
+		return false
+	}
+	for _, child := range p.Children() {
+		if !readsOnlySmallTables(child) {
+			return false
+		}
+	}
+	return true
+}
+
+// canEvalInSubqueryForReuse checks whether the `lexpr in (SUBQUERY)` can be evaluated at optimize time, so that
+// the result is reused by the identical occurrences of the subquery in the statement.
+func (er *expressionRewriter) canEvalInSubqueryForReuse(planCtx *exprRewriterPlanCtx, subq *ast.SubqueryExpr, np LogicalPlan) bool {
+	b := planCtx.builder
+	if _, ok := subqueryResultCacheKey(planCtx, "in", subq); !ok {
+		return false
+	}
+	if b.disableSubQueryPreprocessing || len(ExtractCorrelatedCols4LogicalPlan(np)) > 0 || hasCTEConsumerInSubPlan(np) {
+		return false
+	}
+	sessVars := b.ctx.GetSessionVars()
+	if sessVars.StmtCtx.InExplainStmt && !sessVars.StmtCtx.InExplainAnalyzeStmt && sessVars.ExplainNonEvaledSubQuery {
+		return false
+	}
+	return readsOnlySmallTables(np)
+}
+
+// evalInSubqueryToConstants evaluates the uncorrelated `lexpr [not] in (SUBQUERY)` to `lexpr [not] in (c1, c2, ...)`.
+// It returns false if the subquery returns more than maxEvaluableInSubqueryRows rows.
+func (er *expressionRewriter) evalInSubqueryToConstants(ctx context.Context, planCtx *exprRewriterPlanCtx, subq *ast.SubqueryExpr, lexpr expression.Expression, np LogicalPlan, not bool) bool {
+	b := planCtx.builder
+	var (
+		values []types.Datum
+		cached bool
+	)
+	cacheKey, cacheable := subqueryResultCacheKey(planCtx, "in", subq)
+	if cacheable {
+		values, cached = getSubqueryResultCache(b.ctx)[cacheKey]
+	}
+	if !cached {
+		// We don't want nth_plan hint to affect separately executed subqueries here, so disable nth_plan temporarily.
+		nthPlanBackup := er.sctx.GetSessionVars().StmtCtx.StmtHints.ForceNthPlan
+		er.sctx.GetSessionVars().StmtCtx.StmtHints.ForceNthPlan = -1
+		physicalPlan, _, err := DoOptimize(ctx, b.ctx, b.optFlag, np)
+		er.sctx.GetSessionVars().StmtCtx.StmtHints.ForceNthPlan = nthPlanBackup
+		if err != nil {
+			er.err = err
+			return false
+		}
+		rows, err := EvalSubqueryRows(ctx, physicalPlan, b.is, b.ctx, maxEvaluableInSubqueryRows)
+		if err != nil {
+			er.err = err
+			return false
+		}
+		// The nil values are cached for the subquery returning too many rows, the empty result is not nil.
+		if len(rows) <= maxEvaluableInSubqueryRows {
+			values = make([]types.Datum, 0, len(rows))
+			for _, row := range rows {
+				values = append(values, row[0])
+			}
+		}
+		if cacheable {
+			getSubqueryResultCache(b.ctx)[cacheKey] = values
+		}
+	}
+
+	if values == nil {
+		return false
+	}
+	var expr expression.Expression
+	if len(values) == 0 {
+		expr = expression.NewZero()
+		if not {
+			expr = expression.NewOne()
+		}
+	} else {
+		rCol := np.Schema().Columns[0]
+		args := make([]expression.Expression, 0, len(values)+1)
+		args = append(args, lexpr)
+		for _, value := range values {
+			constant := &expression.Constant{Value: value, RetType: rCol.GetType()}
+			constant.SetCoercibility(rCol.Coercibility())
+			args = append(args, constant)
+		}
+		var err error
+		expr, err = er.newFunction(ast.In, types.NewFieldType(mysql.TypeLonglong), args...)
+		if err == nil && not {
+			expr, err = er.newFunction(ast.UnaryNot, types.NewFieldType(mysql.TypeLonglong), expr)
+		}
+		if err != nil {
+			er.err = err
+			return false
+		}
+	}
+	er.ctxStackPop(1)
+	er.ctxStackAppend(expr, types.EmptyName)
+	return true
+}
+
 func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx *exprRewriterPlanCtx, v *ast.SubqueryExpr) (ast.Node, bool) {
 	intest.AssertNotNil(planCtx)
 	ci := planCtx.builder.prepareCTECheckForSubQuery()
@@ -1275,6 +1470,13 @@ func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx
 		}
 		return v, true
 	}
+	cacheKey, cacheable := subqueryResultCacheKey(planCtx, "scalar", v)
+	if cacheable {
+		if row, ok := getSubqueryResultCache(planCtx.builder.ctx)[cacheKey]; ok {
+			er.appendScalarSubqueryResult(np, row)
+			return v, true
+		}
+	}
 	// We don't want nth_plan hint to affect separately executed subqueries here, so disable nth_plan temporarily.
 	nthPlanBackup := er.sctx.GetSessionVars().StmtCtx.StmtHints.ForceNthPlan
 	er.sctx.GetSessionVars().StmtCtx.StmtHints.ForceNthPlan = -1
@@ -1323,6 +1525,15 @@ func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx
 		er.err = err
 		return v, true
 	}
+	if cacheable {
+		getSubqueryResultCache(planCtx.builder.ctx)[cacheKey] = row
+	}
+	er.appendScalarSubqueryResult(np, row)
+	return v, true
+}
+
+// appendScalarSubqueryResult appends the evaluated row of the scalar subquery as constants.
+func (er *expressionRewriter) appendScalarSubqueryResult(np LogicalPlan, row []types.Datum) {
 	if np.Schema().Len() > 1 {
 		newCols := make([]expression.Expression, 0, np.Schema().Len())
 		for i, data := range row {
@@ -1332,10 +1543,10 @@ func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx
 			constant.SetCoercibility(np.Schema().Columns[i].Coercibility())
 			newCols = append(newCols, constant)
 		}
-		expr, err1 := er.newFunction(ast.RowFunc, newCols[0].GetType(), newCols...)
-		if err1 != nil {
-			er.err = err1
-			return v, true
+		expr, err := er.newFunction(ast.RowFunc, newCols[0].GetType(), newCols...)
+		if err != nil {
+			er.err = err
+			return
 		}
 		er.ctxStackAppend(expr, types.EmptyName)
 	} else {
@@ -1346,7 +1557,6 @@ func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx
 		constant.SetCoercibility(np.Schema().Columns[0].Coercibility())
 		er.ctxStackAppend(constant, types.EmptyName)
 	}
-	return v, true
 }
 
 func hasCTEConsumerInSubPlan(p LogicalPlan) bool {
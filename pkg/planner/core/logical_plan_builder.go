@@ -1352,6 +1352,30 @@ func (b *PlanBuilder) buildSelection(ctx context.Context, p LogicalPlan, where a
 	return selection, nil
 }
 
+// buildQualify builds the selection of the QUALIFY clause above the projection of the window functions.
+// The window functions in the condition are resolved to the projected columns by the windowMapper.
+func (b *PlanBuilder) buildQualify(ctx context.Context, p LogicalPlan, qualify ast.ExprNode,
+	aggMapper map[*ast.AggregateFuncExpr]int, windowMapper map[*ast.WindowFuncExpr]int) (LogicalPlan, error) {
+	b.optFlag |= flagPredicatePushDown
+	b.optFlag |= flagDeriveTopNFromWindow
+	b.optFlag |= flagPredicateSimplification
+	b.curClause = qualifyClause
+
+	conditions := splitWhere(qualify)
+	cnfExprs := make([]expression.Expression, 0, len(conditions))
+	for _, cond := range conditions {
+		expr, np, err := b.rewriteWithPreprocess(ctx, cond, p, aggMapper, windowMapper, true, nil)
+		if err != nil {
+			return nil, err
+		}
+		p = np
+		cnfExprs = append(cnfExprs, expression.SplitCNFItems(expr)...)
+	}
+	selection := LogicalSelection{Conditions: cnfExprs}.Init(b.ctx, b.getSelectOffset())
+	selection.SetChildren(p)
+	return selection, nil
+}
+
 // buildProjectionFieldNameFromColumns builds the field name, table name and database name when field expression is a column reference.
 func (*PlanBuilder) buildProjectionFieldNameFromColumns(origField *ast.SelectField, colNameField *ast.ColumnNameExpr, name *types.FieldName) (colName, origColName, tblName, origTblName, dbName model.CIStr) {
 	origTblName, origColName, dbName = name.OrigTblName, name.OrigColName, name.DBName
@@ -2001,6 +2025,9 @@ func (b *PlanBuilder) buildProjection4Union(_ context.Context, u *LogicalUnionAl
 }
 
 func (b *PlanBuilder) buildSetOpr(ctx context.Context, setOpr *ast.SetOprStmt) (LogicalPlan, error) {
+	if b.ctx.GetSessionVars().EnableSharedSubplan {
+		setOpr = shareIdenticalDerivedTables(setOpr)
+	}
 	if setOpr.With != nil {
 		l := len(b.outerCTEs)
 		defer func() {
@@ -2951,6 +2978,16 @@ func (b *PlanBuilder) resolveWindowFunction(sel *ast.SelectStmt, p LogicalPlan)
 			item.Expr = n.(ast.ExprNode)
 		}
 	}
+	if sel.Qualify != nil {
+		// The QUALIFY clause is resolved in the same way as the ORDER BY clause, it can refer to
+		// the select fields, the columns of the FROM clause and the window functions.
+		extractor.curClause = orderByClause
+		n, ok := sel.Qualify.Accept(extractor)
+		if !ok {
+			return nil, extractor.err
+		}
+		sel.Qualify = n.(ast.ExprNode)
+	}
 	sel.Fields.Fields = extractor.selectFields
 	return extractor.aggMapper, nil
 }
@@ -4139,6 +4176,9 @@ func (b *PlanBuilder) buildSelect(ctx context.Context, sel *ast.SelectStmt) (p L
 	}
 
 	hasWindowFuncField := b.detectSelectWindow(sel)
+	if sel.Qualify != nil && !hasWindowFuncField {
+		return nil, plannererrors.ErrNotSupportedYet.GenWithStackByArgs("QUALIFY without window functions")
+	}
 	// Some SQL statements define WINDOW but do not use them. But we also need to check the window specification list.
 	// For example: select id from t group by id WINDOW w AS (ORDER BY uids DESC) ORDER BY id;
 	// We don't use the WINDOW w, but if the 'uids' column is not in the table t, we still need to report an error.
@@ -4288,6 +4328,12 @@ func (b *PlanBuilder) buildSelect(ctx context.Context, sel *ast.SelectStmt) (p L
 				return nil, err
 			}
 		}
+		if sel.Qualify != nil {
+			p, err = b.buildQualify(ctx, p, sel.Qualify, windowAggMap, windowMapper)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if sel.Distinct {
@@ -337,6 +337,41 @@ func TestAntiSemiJoinConstFalse(t *testing.T) {
 	}
 }
 
+func TestRemoveAggInSemiApply(t *testing.T) {
+	tests := []struct {
+		sql      string
+		best     string
+		joinType string
+	}{
+		{
+			sql:      "select a from t t1 where not exists (select t2.b from t t2 where t1.a = t2.a group by t2.b)",
+			best:     "Join{DataScan(t1)->DataScan(t2)}(test.t.a,test.t.a)->Projection",
+			joinType: "anti semi join",
+		},
+		{
+			sql:      "select a from t t1 where exists (select count(*) from t t2 where t1.a = t2.a group by t2.b, t2.c)",
+			best:     "Join{DataScan(t1)->DataScan(t2)}(test.t.a,test.t.a)->Projection",
+			joinType: "semi join",
+		},
+	}
+
+	s := createPlannerSuite()
+	defer s.Close()
+	ctx := context.Background()
+	for _, ca := range tests {
+		comment := fmt.Sprintf("for %s", ca.sql)
+		stmt, err := s.p.ParseOneStmt(ca.sql, "", "")
+		require.NoError(t, err, comment)
+		p, err := BuildLogicalPlanForTest(ctx, s.sctx, stmt, s.is)
+		require.NoError(t, err, comment)
+		p, err = logicalOptimize(context.TODO(), flagDecorrelate|flagPredicatePushDown|flagPrunColumns|flagPrunColumnsAgain, p.(LogicalPlan))
+		require.NoError(t, err, comment)
+		require.Equal(t, ca.best, ToString(p), comment)
+		join, _ := p.(LogicalPlan).Children()[0].(*LogicalJoin)
+		require.Equal(t, ca.joinType, join.JoinType.String(), comment)
+	}
+}
+
 func TestDeriveNotNullConds(t *testing.T) {
 	var (
 		input  []string
@@ -2391,3 +2426,50 @@ func TestRollupExpand(t *testing.T) {
 	require.NotNil(t, gm)
 	require.Equal(t, len(gm), 2)
 }
+
+func TestQualify(t *testing.T) {
+	s := createPlannerSuite()
+	defer s.Close()
+	buildWithQualify := func(sql string) (LogicalPlan, error) {
+		stmt, err := s.p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err)
+		p, err := BuildLogicalPlanForTest(context.Background(), s.sctx, stmt, s.is)
+		if err != nil {
+			return nil, err
+		}
+		return p.(LogicalPlan), nil
+	}
+	// findQualify returns the selection built above the projection of the window functions.
+	var findQualify func(p LogicalPlan) *LogicalSelection
+	findQualify = func(p LogicalPlan) *LogicalSelection {
+		if sel, ok := p.(*LogicalSelection); ok {
+			if proj, ok := sel.Children()[0].(*LogicalProjection); ok {
+				if _, ok := proj.Children()[0].(*LogicalWindow); ok {
+					return sel
+				}
+			}
+		}
+		for _, child := range p.Children() {
+			if sel := findQualify(child); sel != nil {
+				return sel
+			}
+		}
+		return nil
+	}
+
+	p, err := buildWithQualify("select a, row_number() over (partition by b order by c) as rn from t qualify rn = 1")
+	require.NoError(t, err)
+	require.NotNil(t, findQualify(p))
+	require.Equal(t, 2, p.Schema().Len())
+
+	// The window function only used in the QUALIFY clause is not in the output.
+	p, err = buildWithQualify("select a from t qualify row_number() over (partition by b order by c) <= 2 and a > 1")
+	require.NoError(t, err)
+	sel := findQualify(p)
+	require.NotNil(t, sel)
+	require.Len(t, sel.Conditions, 2)
+	require.Equal(t, 1, p.Schema().Len())
+
+	_, err = buildWithQualify("select a from t qualify a > 1")
+	require.ErrorContains(t, err, "QUALIFY without window functions")
+}
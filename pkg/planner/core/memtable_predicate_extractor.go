@@ -781,6 +781,12 @@ type ClusterLogTableExtractor struct {
 	// 2. SELECT * FROM cluster_log WHERE message regexp '.*'
 	Patterns  []string
 	LogLevels set.StringSet
+	// Modules represents the modules of the log message, which is the `category` field of the log.
+	// e.g: SELECT * FROM cluster_log WHERE module in ('ddl', 'ddl-ingest')
+	Modules set.StringSet
+	// JobIDs represents the DDL job IDs of the log message.
+	// e.g: SELECT * FROM cluster_log WHERE job_id = 100
+	JobIDs set.StringSet
 }
 
 // Extract implements the MemTablePredicateExtractor Extract interface
@@ -793,10 +799,14 @@ func (e *ClusterLogTableExtractor) Extract(ctx PlanContext,
 	remained, typeSkipRequest, nodeTypes := e.extractCol(schema, names, predicates, "type", true)
 	remained, addrSkipRequest, instances := e.extractCol(schema, names, remained, "instance", false)
 	remained, levlSkipRequest, logLevels := e.extractCol(schema, names, remained, "level", true)
-	e.SkipRequest = typeSkipRequest || addrSkipRequest || levlSkipRequest
+	remained, moduleSkipRequest, modules := e.extractCol(schema, names, remained, "module", true)
+	remained, jobSkipRequest, jobIDs := e.extractCol(schema, names, remained, "job_id", false)
+	e.SkipRequest = typeSkipRequest || addrSkipRequest || levlSkipRequest || moduleSkipRequest || jobSkipRequest
 	e.NodeTypes = nodeTypes
 	e.Instances = instances
 	e.LogLevels = logLevels
+	e.Modules = modules
+	e.JobIDs = jobIDs
 	if e.SkipRequest {
 		return nil
 	}
@@ -843,6 +853,12 @@ func (e *ClusterLogTableExtractor) explainInfo(p *PhysicalMemTable) string {
 	if len(e.LogLevels) > 0 {
 		fmt.Fprintf(r, "log_levels:[%s], ", extractStringFromStringSet(e.LogLevels))
 	}
+	if len(e.Modules) > 0 {
+		fmt.Fprintf(r, "modules:[%s], ", extractStringFromStringSet(e.Modules))
+	}
+	if len(e.JobIDs) > 0 {
+		fmt.Fprintf(r, "job_ids:[%s], ", extractStringFromStringSet(e.JobIDs))
+	}
 
 	// remove the last ", " in the message info
 	s := r.String()
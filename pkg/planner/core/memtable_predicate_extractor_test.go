@@ -250,6 +250,8 @@ func TestClusterLogTableExtractor(t *testing.T) {
 		startTime, endTime int64
 		patterns           []string
 		level              set.StringSet
+		modules            set.StringSet
+		jobIDs             set.StringSet
 	}{
 		{
 			sql:       "select * from information_schema.cluster_log",
@@ -514,6 +516,19 @@ func TestClusterLogTableExtractor(t *testing.T) {
 			instances: set.NewStringSet(),
 			level:     set.NewStringSet("debug", "error"),
 		},
+		{
+			sql:       "select * from information_schema.cluster_log where module in ('DDL', 'ddl-ingest') and job_id = 100",
+			nodeTypes: set.NewStringSet(),
+			instances: set.NewStringSet(),
+			modules:   set.NewStringSet("ddl", "ddl-ingest"),
+			jobIDs:    set.NewStringSet("100"),
+		},
+		{
+			sql:         "select * from information_schema.cluster_log where job_id = 100 and job_id = 101",
+			nodeTypes:   set.NewStringSet(),
+			instances:   set.NewStringSet(),
+			skipRequest: true,
+		},
 	}
 	for _, ca := range cases {
 		logicalMemTable := getLogicalMemTable(t, dom, se, parser, ca.sql)
@@ -533,6 +548,12 @@ func TestClusterLogTableExtractor(t *testing.T) {
 		if len(ca.level) > 0 {
 			require.EqualValues(t, ca.level, clusterConfigExtractor.LogLevels, "SQL: %v", ca.sql)
 		}
+		if len(ca.modules) > 0 {
+			require.EqualValues(t, ca.modules, clusterConfigExtractor.Modules, "SQL: %v", ca.sql)
+		}
+		if len(ca.jobIDs) > 0 {
+			require.EqualValues(t, ca.jobIDs, clusterConfigExtractor.JobIDs, "SQL: %v", ca.sql)
+		}
 	}
 }
 
@@ -79,6 +79,7 @@ const (
 	flagEliminateProjection
 	flagMaxMinEliminate
 	flagConstantPropagation
+	flagOrExpansion
 	flagPredicatePushDown
 	flagEliminateOuterJoin
 	flagPartitionProcessor
@@ -106,6 +107,7 @@ var optRuleList = []logicalOptRule{
 	&projectionEliminator{},
 	&maxMinEliminator{},
 	&constantPropagationSolver{},
+	&orExpansionSolver{},
 	&ppdSolver{},
 	&outerJoinEliminator{},
 	&partitionProcessor{},
@@ -266,6 +268,33 @@ func CheckTableLock(ctx tablelock.TableLockReadContext, is infoschema.InfoSchema
 	return nil
 }
 
+// CheckRestrictedReadOnlySchema rejects the writes to the databases in tidb_restricted_read_only_schemas.
+// Like tidb_restricted_read_only, only the user with RESTRICTED_REPLICA_WRITER_ADMIN explicitly granted
+// and the internal SQL can ignore the restriction.
+func CheckRestrictedReadOnlySchema(sctx PlanContext, vs []visitInfo) error {
+	sessVars := sctx.GetSessionVars()
+	if sessVars.InRestrictedSQL {
+		return nil
+	}
+	for i := range vs {
+		switch vs[i].privilege {
+		case mysql.InsertPriv, mysql.UpdatePriv, mysql.DeletePriv, mysql.CreatePriv, mysql.DropPriv,
+			mysql.AlterPriv, mysql.IndexPriv, mysql.CreateViewPriv:
+		default:
+			continue
+		}
+		if vs[i].db == "" || !variable.IsRestrictedReadOnlySchema(vs[i].db) {
+			continue
+		}
+		pm := privilege.GetPrivilegeManager(sctx)
+		if pm != nil && pm.HasExplicitlyGrantedDynamicPrivilege(sessVars.ActiveRoles, "RESTRICTED_REPLICA_WRITER_ADMIN", false) {
+			return nil
+		}
+		return plannererrors.ErrSchemaReadOnly.GenWithStackByArgs(vs[i].db)
+	}
+	return nil
+}
+
 func checkStableResultMode(sctx PlanContext) bool {
 	s := sctx.GetSessionVars()
 	st := s.StmtCtx
@@ -325,6 +354,9 @@ func adjustOptimizationFlags(flag uint64, logic LogicalPlan) uint64 {
 		// When we use the straight Join Order hint, we should disable the join reorder optimization.
 		flag &= ^flagJoinReOrder
 	}
+	if flag&flagPredicatePushDown > 0 && logic.SCtx().GetSessionVars().EnableOrExpansion {
+		flag |= flagOrExpansion
+	}
 	flag |= flagCollectPredicateColumnsPoint
 	flag |= flagSyncWaitStatsLoadPoint
 	if !logic.SCtx().GetSessionVars().StmtCtx.UseDynamicPruneMode {
@@ -432,7 +464,15 @@ func postOptimize(ctx context.Context, sctx PlanContext, plan PhysicalPlan) (Phy
 }
 
 func generateRuntimeFilter(sctx PlanContext, plan PhysicalPlan) {
-	if !sctx.GetSessionVars().IsRuntimeFilterEnabled() || sctx.GetSessionVars().InRestrictedSQL {
+	if sctx.GetSessionVars().InRestrictedSQL {
+		return
+	}
+	stmtHints := &sctx.GetSessionVars().StmtCtx.StmtHints
+	hintedRFs := stmtHints.RuntimeFilters
+	if !sctx.GetSessionVars().IsRuntimeFilterEnabled() {
+		if len(hintedRFs) > 0 || (stmtHints.HasRuntimeFilterHint && stmtHints.EnableRuntimeFilter) {
+			sctx.GetSessionVars().StmtCtx.SetHintWarning("RUNTIME_FILTER() is ignored because tidb_runtime_filter_mode is OFF")
+		}
 		return
 	}
 	logutil.BgLogger().Debug("Start runtime filter generator")
@@ -441,8 +481,12 @@ func generateRuntimeFilter(sctx PlanContext, plan PhysicalPlan) {
 		columnUniqueIDToRF: map[int64][]*RuntimeFilter{},
 		parentPhysicalPlan: plan,
 	}
+	for _, hint := range hintedRFs {
+		rfGenerator.hintedRFs = append(rfGenerator.hintedRFs, &hintedRuntimeFilter{hint: hint})
+	}
 	startRFGenerator := time.Now()
 	rfGenerator.GenerateRuntimeFilter(plan)
+	rfGenerator.warnInapplicableHints(sctx)
 	logutil.BgLogger().Debug("Finish runtime filter generator",
 		zap.Duration("Cost", time.Since(startRFGenerator)))
 }
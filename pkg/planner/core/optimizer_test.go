@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/planner/property"
 	"github.com/pingcap/tidb/pkg/types"
@@ -505,3 +506,24 @@ func TestPrunePhysicalColumns(t *testing.T) {
 	require.True(t, len(recv1.Schema().Columns) == 1)
 	require.True(t, recv1.Schema().Contains(col3))
 }
+
+func TestMatchHintedRuntimeFilterColumn(t *testing.T) {
+	alias := model.NewCIStr("a")
+	scan := &PhysicalTableScan{Table: &model.TableInfo{Name: model.NewCIStr("t1")}, DBName: model.NewCIStr("test")}
+	col := &expression.Column{UniqueID: 1, OrigName: "test.t1.K1"}
+	hintedColumn := func(schema, table, name string) *ast.ColumnName {
+		return &ast.ColumnName{Schema: model.NewCIStr(schema), Table: model.NewCIStr(table), Name: model.NewCIStr(name)}
+	}
+
+	require.True(t, matchHintedColumn(hintedColumn("", "", "k1"), scan, col))
+	require.True(t, matchHintedColumn(hintedColumn("", "T1", "k1"), scan, col))
+	require.True(t, matchHintedColumn(hintedColumn("test", "t1", "k1"), scan, col))
+	require.False(t, matchHintedColumn(hintedColumn("test2", "t1", "k1"), scan, col))
+	require.False(t, matchHintedColumn(hintedColumn("", "t2", "k1"), scan, col))
+	require.False(t, matchHintedColumn(hintedColumn("", "t1", "k2"), scan, col))
+
+	// the alias of the table takes the place of the table name.
+	scan.TableAsName = &alias
+	require.True(t, matchHintedColumn(hintedColumn("", "a", "k1"), scan, col))
+	require.False(t, matchHintedColumn(hintedColumn("", "t1", "k1"), scan, col))
+}
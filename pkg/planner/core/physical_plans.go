@@ -935,8 +935,10 @@ func (ts *PhysicalTableScan) Clone() (PhysicalPlan, error) {
 	clonedScan.runtimeFilterList = make([]*RuntimeFilter, len(ts.runtimeFilterList))
 	for i, rf := range ts.runtimeFilterList {
 		clonedRF := rf.Clone()
+		clonedRF.targetNode = clonedScan
 		clonedScan.runtimeFilterList[i] = clonedRF
 	}
+	clonedScan.maxWaitTimeMs = ts.maxWaitTimeMs
 	return clonedScan, nil
 }
 
@@ -1376,6 +1378,7 @@ func (p *PhysicalHashJoin) Clone() (PhysicalPlan, error) {
 	}
 	for _, rf := range p.runtimeFilterList {
 		clonedRF := rf.Clone()
+		clonedRF.buildNode = cloned
 		cloned.runtimeFilterList = append(cloned.runtimeFilterList, clonedRF)
 	}
 	return cloned, nil
@@ -1419,6 +1422,12 @@ func (p *PhysicalHashJoin) MemoryUsage() (sum int64) {
 	return
 }
 
+// RuntimeFilterList returns the runtime filters built by the hash join.
+// For the hash join in TiDB, they're the runtime filters pushed down to the TiKV reader of the probe side.
+func (p *PhysicalHashJoin) RuntimeFilterList() []*RuntimeFilter {
+	return p.runtimeFilterList
+}
+
 // RightIsBuildSide return true when right side is build side
 func (p *PhysicalHashJoin) RightIsBuildSide() bool {
 	if p.UseOuterToBuild {
@@ -274,6 +274,11 @@ func getCachedPlan(sctx sessionctx.Context, isNonPrepared bool, cacheKey kvcache
 	if err := CheckPreparedPriv(sctx, stmt, is); err != nil {
 		return nil, nil, false, err
 	}
+	// The writes to the read-only databases are rejected when executing, like tidb_restricted_read_only,
+	// so the statement can still be prepared.
+	if err := CheckRestrictedReadOnlySchema(sctx.GetPlanCtx(), stmt.VisitInfos); err != nil {
+		return nil, nil, false, err
+	}
 	for tblInfo, unionScan := range cachedVal.TblInfo2UnionScan {
 		if !unionScan && tableHasDirtyContent(sctx.GetPlanCtx(), tblInfo) {
 			// TODO we can inject UnionScan into cached plan to avoid invalidating it, though
@@ -15,6 +15,7 @@ package core
 
 import (
 	"container/list"
+	"slices"
 
 	"github.com/pingcap/errors"
 	core_metrics "github.com/pingcap/tidb/pkg/planner/core/metrics"
@@ -284,6 +285,13 @@ func (l *LRUPlanCache) pickFromBucket(bucket map[*list.Element]struct{}, matchOp
 		if plan.matchOpts.ForeignKeyChecks != matchOpts.ForeignKeyChecks {
 			continue
 		}
+		// the runtime filters are generated by the variables, the plan is re-generated if they're changed
+		if plan.matchOpts.RuntimeFilterMode != matchOpts.RuntimeFilterMode ||
+			!slices.Equal(plan.matchOpts.RuntimeFilterTypes, matchOpts.RuntimeFilterTypes) ||
+			plan.matchOpts.RuntimeFilterMinFilterRatio != matchOpts.RuntimeFilterMinFilterRatio ||
+			plan.matchOpts.MultiColumnRuntimeFilter != matchOpts.MultiColumnRuntimeFilter {
+			continue
+		}
 		return k, true
 	}
 	return nil, false
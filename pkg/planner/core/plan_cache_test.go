@@ -1797,3 +1797,47 @@ partition by hash (a) partitions 3`)
 	require.False(t, tk.Session().GetSessionVars().FoundInPlanCache)
 	tk.MustQuery(`show warnings`).Check(testkit.Rows("Warning 1105 skip prepared plan-cache: query accesses partitioned tables is un-cacheable if tidb_partition_pruning_mode = 'static'"))
 }
+
+func TestPlanCacheWithRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec(`use test`)
+	tk.MustExec(`create table t1 (k1 int, s1 varchar(10))`)
+	tk.MustExec(`create table t2 (k1 int, k2 int, s1 varchar(10))`)
+	tk.MustExec(`insert into t1 values (1, 'a'), (2, 'b')`)
+	tk.MustExec(`insert into t2 values (1, 2, 'a'), (2, 3, 'b'), (3, 4, 'c'), (4, 5, 'd')`)
+	tk.MustExec(`analyze table t1, t2`)
+	tk.MustExec(`set tidb_enable_plan_cache_for_subquery=1`)
+	tk.MustExec(`set tidb_runtime_filter_mode=LOCAL`)
+	tk.MustExec(`set tidb_runtime_filter_type='IN,MIN_MAX'`)
+
+	tk.MustExec(`prepare stmt from 'select /*+ hash_join(t2) */ k1, k2 from t2 where t2.k2 > ? and exists (select 1 from t1 where t1.k1 = t2.k1) order by k1'`)
+	tk.MustExec(`set @a = 1`)
+	tk.MustQuery(`execute stmt using @a`).Check(testkit.Rows("1 2", "2 3"))
+	tkProcess := tk.Session().ShowProcess()
+	ps := []*util.ProcessInfo{tkProcess}
+	tk.Session().SetSessionManager(&testkit.MockSessionManager{PS: ps})
+	tk.MustQuery(fmt.Sprintf("explain for connection %d", tkProcess.ID)).CheckContain("runtime filter:0[IN] <- test.t1.k1, 1[MIN_MAX] <- test.t1.k1")
+	tk.MustExec(`set @a = 2`)
+	tk.MustQuery(`execute stmt using @a`).Check(testkit.Rows("2 3"))
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("1"))
+
+	// the runtime filters are decided by the variables, so the plan is re-generated if they're changed.
+	tk.MustExec(`set tidb_runtime_filter_type='IN'`)
+	tk.MustQuery(`execute stmt using @a`).Check(testkit.Rows("2 3"))
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("0"))
+	tkProcess = tk.Session().ShowProcess()
+	ps = []*util.ProcessInfo{tkProcess}
+	tk.Session().SetSessionManager(&testkit.MockSessionManager{PS: ps})
+	tk.MustQuery(fmt.Sprintf("explain for connection %d", tkProcess.ID)).CheckNotContain("MIN_MAX")
+	tk.MustQuery(`execute stmt using @a`).Check(testkit.Rows("2 3"))
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("1"))
+	tk.MustExec(`set tidb_runtime_filter_min_filter_ratio=0.5`)
+	tk.MustQuery(`execute stmt using @a`).Check(testkit.Rows("2 3"))
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("0"))
+	tk.MustExec(`set tidb_enable_multi_column_runtime_filter=on`)
+	tk.MustQuery(`execute stmt using @a`).Check(testkit.Rows("2 3"))
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("0"))
+	tk.MustQuery(`execute stmt using @a`).Check(testkit.Rows("2 3"))
+	tk.MustQuery(`select @@last_plan_from_cache`).Check(testkit.Rows("1"))
+}
@@ -74,6 +74,19 @@ func (e *paramMarkerExtractor) Leave(in ast.Node) (ast.Node, bool) {
 	return in, true
 }
 
+func importIntoParamCount(stmt *ast.ImportIntoStmt) int {
+	cnt := 0
+	if _, ok := stmt.PathExpr.(ast.ParamMarkerExpr); ok {
+		cnt++
+	}
+	for _, opt := range stmt.Options {
+		if isParamMarkerLoadDataOpt(opt) {
+			cnt++
+		}
+	}
+	return cnt
+}
+
 // GeneratePlanCacheStmtWithAST generates the PlanCacheStmt structure for this AST.
 // paramSQL is the corresponding parameterized sql like 'select * from t where a<? and b>?'.
 // paramStmt is the Node of paramSQL.
@@ -89,8 +102,19 @@ func GeneratePlanCacheStmtWithAST(ctx context.Context, sctx sessionctx.Context,
 	}
 
 	switch stmt := paramStmt.(type) {
-	case *ast.ImportIntoStmt, *ast.LoadDataStmt, *ast.PrepareStmt, *ast.ExecuteStmt, *ast.DeallocateStmt, *ast.NonTransactionalDMLStmt:
+	case *ast.PrepareStmt, *ast.ExecuteStmt, *ast.DeallocateStmt, *ast.NonTransactionalDMLStmt:
 		return nil, nil, 0, plannererrors.ErrUnsupportedPs
+	case *ast.LoadDataStmt:
+		// the file of LOAD DATA LOCAL is sent by the client after the statement
+		// is executed, which is only supported in the text protocol.
+		if stmt.FileLocRef == ast.FileLocClient {
+			return nil, nil, 0, plannererrors.ErrUnsupportedPs
+		}
+	case *ast.ImportIntoStmt:
+		// only the path and options can be parameters, see importIntoStmtText.
+		if importIntoParamCount(stmt) != len(extractor.markers) {
+			return nil, nil, 0, plannererrors.ErrUnsupportedPs
+		}
 	case *ast.SelectStmt:
 		if stmt.SelectIntoOpt != nil {
 			return nil, nil, 0, plannererrors.ErrUnsupportedPs
@@ -387,6 +411,7 @@ func (v *PlanCacheValue) MemoryUsage() (sum int64) {
 		for _, ft := range v.matchOpts.ParamTypes {
 			sum += ft.MemoryUsage()
 		}
+		sum += int64(cap(v.matchOpts.RuntimeFilterTypes)) * size.SizeOfInt64
 	}
 
 	for _, name := range v.OutPutNames {
@@ -538,9 +563,22 @@ func GetMatchOpts(sctx sessionctx.Context, is infoschema.InfoSchema, stmt *PlanC
 		StatsVersionHash:    statsVerHash,
 		ParamTypes:          parseParamTypes(sctx, params),
 		ForeignKeyChecks:    sctx.GetSessionVars().ForeignKeyChecks,
+
+		RuntimeFilterMode:           int64(sctx.GetSessionVars().GetRuntimeFilterMode()),
+		RuntimeFilterTypes:          runtimeFilterTypesForPlanCache(sctx.GetSessionVars().GetRuntimeFilterTypes()),
+		RuntimeFilterMinFilterRatio: sctx.GetSessionVars().GetRuntimeFilterMinFilterRatio(),
+		MultiColumnRuntimeFilter:    sctx.GetSessionVars().IsMultiColumnRuntimeFilterEnabled(),
 	}, nil
 }
 
+func runtimeFilterTypesForPlanCache(rfTypes []RuntimeFilterType) []int64 {
+	result := make([]int64, 0, len(rfTypes))
+	for _, rfType := range rfTypes {
+		result = append(result, int64(rfType))
+	}
+	return result
+}
+
 // CheckTypesCompatibility4PC compares FieldSlice with []*types.FieldType
 // Currently this is only used in plan cache to check whether the types of parameters are compatible.
 // If the types of parameters are compatible, we can use the cached plan.
@@ -309,6 +309,7 @@ func isSelectStmtNonPrepCacheableFastCheck(sctx PlanContext, selectStmt *ast.Sel
 	if len(selectStmt.TableHints) > 0 || // hints
 		selectStmt.Having != nil || // having
 		selectStmt.WindowSpecs != nil || // window function
+		selectStmt.Qualify != nil || // window function
 		(selectStmt.Limit != nil && !sctx.GetSessionVars().EnablePlanCacheForParamLimit) || // limit
 		selectStmt.SelectIntoOpt != nil { // select-into statement
 		return nil, false, "queries that have hints, having-clause, window-function are not supported"
@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +38,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/opcode"
@@ -121,6 +123,7 @@ const (
 	expressionClause
 	windowOrderByClause
 	partitionByClause
+	qualifyClause
 )
 
 var clauseMsg = map[clauseCode]string{
@@ -136,6 +139,7 @@ var clauseMsg = map[clauseCode]string{
 	expressionClause:    "expression",
 	windowOrderByClause: "window order by",
 	partitionByClause:   "window partition by",
+	qualifyClause:       "qualify clause",
 }
 
 type capFlagType = uint64
@@ -555,7 +559,8 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (Plan, error) {
 		*ast.GrantStmt, *ast.DropUserStmt, *ast.AlterUserStmt, *ast.AlterRangeStmt, *ast.RevokeStmt, *ast.KillStmt, *ast.DropStatsStmt,
 		*ast.GrantRoleStmt, *ast.RevokeRoleStmt, *ast.SetRoleStmt, *ast.SetDefaultRoleStmt, *ast.ShutdownStmt,
 		*ast.RenameUserStmt, *ast.NonTransactionalDMLStmt, *ast.SetSessionStatesStmt, *ast.SetResourceGroupStmt,
-		*ast.ImportIntoActionStmt, *ast.CalibrateResourceStmt, *ast.AddQueryWatchStmt, *ast.DropQueryWatchStmt:
+		*ast.ImportIntoActionStmt, *ast.CalibrateResourceStmt, *ast.AddQueryWatchStmt, *ast.DropQueryWatchStmt,
+		*ast.CreateVariableProfileStmt, *ast.SetVariableProfileStmt, *ast.DropVariableProfileStmt:
 		return b.buildSimple(ctx, node.(ast.StmtNode))
 	case ast.DDLNode:
 		return b.buildDDL(ctx, x)
@@ -965,7 +970,7 @@ func (*PlanBuilder) detectSelectWindow(sel *ast.SelectStmt) bool {
 			}
 		}
 	}
-	return false
+	return sel.Qualify != nil && ast.HasWindowFlag(sel.Qualify)
 }
 
 func getPathByIndexName(paths []*util.AccessPath, idxName model.CIStr, tblInfo *model.TableInfo) *util.AccessPath {
@@ -1384,6 +1389,10 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (Plan,
 		p := &ChecksumTable{Tables: as.Tables}
 		p.setSchemaAndNames(buildChecksumTableSchema())
 		ret = p
+	case ast.AdminReclaimTable:
+		p := &ReclaimTable{Tables: as.Tables}
+		p.setSchemaAndNames(buildReclaimTableSchema())
+		ret = p
 	case ast.AdminShowNextRowID:
 		p := &ShowNextRowID{TableName: as.Tables[0]}
 		p.setSchemaAndNames(buildShowNextRowID())
@@ -1463,7 +1472,8 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (Plan,
 		return &Simple{Statement: as}, nil
 	case ast.AdminFlushPlanCache:
 		return &Simple{Statement: as}, nil
-	case ast.AdminSetBDRRole, ast.AdminUnsetBDRRole:
+	case ast.AdminSetBDRRole, ast.AdminUnsetBDRRole, ast.AdminInjectFault,
+		ast.AdminPinGCSafePoint, ast.AdminUnpinGCSafePoint, ast.AdminReorganizeIndex, ast.AdminAlterDDLJob:
 		ret = &Simple{Statement: as}
 	case ast.AdminShowBDRRole:
 		p := &AdminShowBDRRole{}
@@ -3379,6 +3389,9 @@ func (b *PlanBuilder) buildSimple(ctx context.Context, node ast.StmtNode) (Plan,
 		}
 	case *ast.ShutdownStmt:
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.ShutdownPriv, "", "", "", nil)
+	case *ast.CreateVariableProfileStmt, *ast.DropVariableProfileStmt:
+		err := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or SYSTEM_VARIABLES_ADMIN")
+		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "SYSTEM_VARIABLES_ADMIN", false, err)
 	case *ast.BeginStmt:
 		readTS := b.ctx.GetSessionVars().TxnReadTS.PeakTxnReadTS()
 		if raw.AsOf != nil {
@@ -4078,12 +4091,37 @@ func (b *PlanBuilder) buildSelectPlanOfInsert(ctx context.Context, insert *ast.I
 	return nil
 }
 
+// getLoadDataPath returns the path of LOAD DATA and IMPORT INTO, it's
+// evaluated from the parameter marker when the statement is executed in the
+// prepared statement protocol.
+func (b *PlanBuilder) getLoadDataPath(ctx context.Context, path string, pathExpr ast.ExprNode, p LogicalPlan) (string, error) {
+	if pathExpr == nil {
+		return path, nil
+	}
+	expr, _, err := b.rewrite(ctx, pathExpr, p, nil, true)
+	if err != nil {
+		return "", err
+	}
+	val, isNull, err := expr.EvalString(b.ctx.GetExprCtx().GetEvalCtx(), chunk.Row{})
+	if err != nil {
+		return "", err
+	}
+	if isNull || val == "" {
+		return "", exeerrors.ErrLoadDataInvalidURI.FastGenByArgs(ImportIntoDataSource, "the path is empty")
+	}
+	return val, nil
+}
+
 func (b *PlanBuilder) buildLoadData(ctx context.Context, ld *ast.LoadDataStmt) (Plan, error) {
 	mockTablePlan := LogicalTableDual{}.Init(b.ctx, b.getSelectOffset())
 	var (
 		err     error
 		options = make([]*LoadDataOpt, 0, len(ld.Options))
 	)
+	path, err := b.getLoadDataPath(ctx, ld.Path, ld.PathExpr, mockTablePlan)
+	if err != nil {
+		return nil, err
+	}
 	for _, opt := range ld.Options {
 		loadDataOpt := LoadDataOpt{Name: opt.Name}
 		if opt.Value != nil {
@@ -4097,7 +4135,7 @@ func (b *PlanBuilder) buildLoadData(ctx context.Context, ld *ast.LoadDataStmt) (
 	p := LoadData{
 		FileLocRef:         ld.FileLocRef,
 		OnDuplicate:        ld.OnDuplicate,
-		Path:               ld.Path,
+		Path:               path,
 		Format:             ld.Format,
 		Table:              ld.Table,
 		Charset:            ld.Charset,
@@ -4148,6 +4186,39 @@ var (
 	ImportIntoDataSource = "data source"
 )
 
+// importIntoStmtText returns the text of the IMPORT INTO statement. The text is
+// parsed again when the import runs, so the parameter markers of the path and
+// options are replaced by their values when it's a prepared statement.
+func importIntoStmtText(ectx expression.EvalContext, ld *ast.ImportIntoStmt, path string, options []*LoadDataOpt) (string, error) {
+	if ld.PathExpr == nil && !slices.ContainsFunc(ld.Options, isParamMarkerLoadDataOpt) {
+		return ld.Text(), nil
+	}
+	stmt := *ld
+	stmt.Path, stmt.PathExpr = path, nil
+	stmt.Options = make([]*ast.LoadDataOpt, 0, len(ld.Options))
+	for i, opt := range ld.Options {
+		if !isParamMarkerLoadDataOpt(opt) {
+			stmt.Options = append(stmt.Options, opt)
+			continue
+		}
+		d, err := options[i].Value.Eval(ectx, chunk.Row{})
+		if err != nil {
+			return "", err
+		}
+		stmt.Options = append(stmt.Options, &ast.LoadDataOpt{Name: opt.Name, Value: ast.NewValueExpr(d.GetValue(), "", "")})
+	}
+	var sb strings.Builder
+	if err := stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return "", errors.Trace(err)
+	}
+	return sb.String(), nil
+}
+
+func isParamMarkerLoadDataOpt(opt *ast.LoadDataOpt) bool {
+	_, ok := opt.Value.(ast.ParamMarkerExpr)
+	return ok
+}
+
 func (b *PlanBuilder) buildImportInto(ctx context.Context, ld *ast.ImportIntoStmt) (Plan, error) {
 	mockTablePlan := LogicalTableDual{}.Init(b.ctx, b.getSelectOffset())
 	var (
@@ -4156,8 +4227,12 @@ func (b *PlanBuilder) buildImportInto(ctx context.Context, ld *ast.ImportIntoStm
 		importFromServer bool
 	)
 
+	path, err := b.getLoadDataPath(ctx, ld.Path, ld.PathExpr, mockTablePlan)
+	if err != nil {
+		return nil, err
+	}
 	if ld.Select == nil {
-		importFromServer, err = storage.IsLocalPath(ld.Path)
+		importFromServer, err = storage.IsLocalPath(path)
 		if err != nil {
 			return nil, exeerrors.ErrLoadDataInvalidURI.FastGenByArgs(ImportIntoDataSource, err.Error())
 		}
@@ -4177,14 +4252,18 @@ func (b *PlanBuilder) buildImportInto(ctx context.Context, ld *ast.ImportIntoStm
 		}
 		options = append(options, &loadDataOpt)
 	}
+	stmtText, err := importIntoStmtText(b.ctx.GetExprCtx().GetEvalCtx(), ld, path, options)
+	if err != nil {
+		return nil, err
+	}
 	p := ImportInto{
-		Path:               ld.Path,
+		Path:               path,
 		Format:             ld.Format,
 		Table:              ld.Table,
 		ColumnAssignments:  ld.ColumnAssignments,
 		ColumnsAndUserVars: ld.ColumnsAndUserVars,
 		Options:            options,
-		Stmt:               ld.Text(),
+		Stmt:               stmtText,
 	}.Init(b.ctx)
 	user := b.ctx.GetSessionVars().User
 	// IMPORT INTO need full DML privilege of the target table
@@ -4344,6 +4423,7 @@ func (b *PlanBuilder) buildSplitIndexRegion(node *ast.SplitRegionStmt) (Plan, er
 	mockTablePlan.names = names
 
 	p := &SplitRegion{
+		DBName:         node.Table.Schema,
 		TableInfo:      tblInfo,
 		PartitionNames: node.PartitionNames,
 		IndexInfo:      indexInfo,
@@ -4459,6 +4539,7 @@ func (b *PlanBuilder) buildSplitTableRegion(node *ast.SplitRegionStmt) (Plan, er
 	mockTablePlan.names = names
 
 	p := &SplitRegion{
+		DBName:         node.Table.Schema,
 		TableInfo:      tblInfo,
 		PartitionNames: node.PartitionNames,
 	}
@@ -4694,6 +4775,13 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SelectPriv, v.ReferTable.Schema.L,
 				v.ReferTable.Name.L, "", authErr)
 		}
+		if v.Select != nil {
+			p := &DDL{Statement: node}
+			if err := b.buildCTASCols(ctx, v, p); err != nil {
+				return nil, err
+			}
+			return p, nil
+		}
 	case *ast.CreateViewStmt:
 		b.isCreateView = true
 		b.capFlag |= canExpandAST | renameView
@@ -4878,6 +4966,74 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (Plan, err
 	return p, nil
 }
 
+// buildCTASCols derives the columns of the table created by CREATE TABLE ... SELECT from the select fields.
+// As MySQL does, a select field is imported into the column defined explicitly with the same name,
+// or it's appended to the table as a new column. The keys and the auto increment attributes of the source
+// columns are not kept.
+func (b *PlanBuilder) buildCTASCols(ctx context.Context, stmt *ast.CreateTableStmt, p *DDL) error {
+	// privilege of tables in select will be checked here
+	selectPlan, err := b.Build(ctx, stmt.Select)
+	if err != nil {
+		return err
+	}
+	definedCols := make(map[string]struct{}, len(stmt.Cols))
+	for _, col := range stmt.Cols {
+		definedCols[col.Name.Name.L] = struct{}{}
+	}
+	p.CTASCols = slices.Clone(stmt.Cols)
+	p.CTASFieldNames = make([]model.CIStr, 0, selectPlan.Schema().Len())
+	for i, name := range selectPlan.OutputNames() {
+		colName := name.ColName
+		if slices.ContainsFunc(p.CTASFieldNames, func(fieldName model.CIStr) bool { return fieldName.L == colName.L }) {
+			return plannererrors.ErrDupFieldName.GenWithStackByArgs(colName.O)
+		}
+		p.CTASFieldNames = append(p.CTASFieldNames, colName)
+		if _, ok := definedCols[colName.L]; ok {
+			continue
+		}
+		tp := ctasColumnType(selectPlan.Schema().Columns[i].RetType)
+		option := &ast.ColumnOption{Tp: ast.ColumnOptionNull}
+		if mysql.HasNotNullFlag(tp.GetFlag()) {
+			option.Tp = ast.ColumnOptionNotNull
+		}
+		tp.DelFlag(mysql.NotNullFlag | mysql.PriKeyFlag | mysql.UniqueKeyFlag | mysql.MultipleKeyFlag |
+			mysql.AutoIncrementFlag | mysql.OnUpdateNowFlag | mysql.NoDefaultValueFlag)
+		p.CTASCols = append(p.CTASCols, &ast.ColumnDef{
+			Name:    &ast.ColumnName{Name: colName},
+			Tp:      tp,
+			Options: []*ast.ColumnOption{option},
+		})
+	}
+	return nil
+}
+
+// ctasColumnType converts the type of the select field to a valid column type.
+func ctasColumnType(retType *types.FieldType) *types.FieldType {
+	tp := retType.Clone()
+	switch tp.GetType() {
+	case mysql.TypeNull:
+		// The type of NULL is BINARY(0) as MySQL does.
+		tp = types.NewFieldType(mysql.TypeString)
+		tp.SetFlen(0)
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		tp.AddFlag(mysql.BinaryFlag)
+	case mysql.TypeFloat, mysql.TypeDouble:
+		if tp.GetDecimal() == types.UnspecifiedLength {
+			tp.SetFlen(types.UnspecifiedLength)
+		}
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar:
+		if tp.GetType() == mysql.TypeVarString || tp.GetFlen() > mysql.MaxFieldCharLength {
+			tp.SetType(mysql.TypeVarchar)
+		}
+		if desc, err := charset.GetCharsetInfo(tp.GetCharset()); err == nil && tp.GetFlen()*desc.Maxlen > mysql.MaxFieldVarCharLength {
+			tp.SetType(mysql.TypeLongBlob)
+			tp.SetFlen(types.UnspecifiedLength)
+		}
+	}
+	return tp
+}
+
 const (
 	// TraceFormatRow indicates row tracing format.
 	TraceFormatRow = "row"
@@ -5366,6 +5522,16 @@ func calcTSForPlanReplayer(sctx PlanContext, tsExpr ast.ExprNode) uint64 {
 	return oracle.GoTimeToTS(goTime)
 }
 
+func buildReclaimTableSchema() (*expression.Schema, []*types.FieldName) {
+	schema := newColumnsWithNames(5)
+	schema.Append(buildColumnWithName("", "Db_name", mysql.TypeVarchar, 128))
+	schema.Append(buildColumnWithName("", "Table_name", mysql.TypeVarchar, 128))
+	schema.Append(buildColumnWithName("", "Region_count", mysql.TypeLonglong, 22))
+	schema.Append(buildColumnWithName("", "Empty_region_count", mysql.TypeLonglong, 22))
+	schema.Append(buildColumnWithName("", "Approximate_size(MB)", mysql.TypeLonglong, 22))
+	return schema.col2Schema(), schema.names
+}
+
 func buildChecksumTableSchema() (*expression.Schema, []*types.FieldName) {
 	schema := newColumnsWithNames(5)
 	schema.Append(buildColumnWithName("", "Db_name", mysql.TypeVarchar, 128))
@@ -5416,6 +5582,7 @@ func (b *PlanBuilder) buildCompactTable(node *ast.CompactTableStmt) (Plan, error
 	tblInfo := node.Table.TableInfo
 	p := &CompactTable{
 		ReplicaKind:    node.ReplicaKind,
+		DBName:         node.Table.Schema,
 		TableInfo:      tblInfo,
 		PartitionNames: node.PartitionNames,
 	}
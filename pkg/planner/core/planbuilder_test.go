@@ -734,3 +734,26 @@ func TestRequireInsertAndSelectPriv(t *testing.T) {
 	require.Equal(t, mysql.InsertPriv, pb.visitInfo[0].privilege)
 	require.Equal(t, mysql.SelectPriv, pb.visitInfo[1].privilege)
 }
+
+func TestImportIntoStmtText(t *testing.T) {
+	sctx := mock.NewContext()
+	p := parser.New()
+	node, err := p.ParseOneStmt("IMPORT INTO t FROM '/file.csv' WITH thread=1, detached", "", "")
+	require.NoError(t, err)
+	stmt := node.(*ast.ImportIntoStmt)
+	options := []*LoadDataOpt{{Name: "thread", Value: expression.NewInt64Const(1)}, {Name: "detached"}}
+	text, err := importIntoStmtText(sctx.GetExprCtx().GetEvalCtx(), stmt, "/file.csv", options)
+	require.NoError(t, err)
+	require.Equal(t, stmt.Text(), text)
+
+	node, err = p.ParseOneStmt("IMPORT INTO t FROM ? WITH thread=?, detached", "", "")
+	require.NoError(t, err)
+	stmt = node.(*ast.ImportIntoStmt)
+	options[0].Value = expression.NewInt64Const(8)
+	text, err = importIntoStmtText(sctx.GetExprCtx().GetEvalCtx(), stmt, "s3://bucket/file.csv", options)
+	require.NoError(t, err)
+	require.Equal(t, "IMPORT INTO `t` FROM 's3://bucket/file.csv' WITH thread=8, detached", text)
+	// the prepared statement is not changed.
+	require.NotNil(t, stmt.PathExpr)
+	require.Equal(t, 2, importIntoParamCount(stmt))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/charset"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -876,26 +877,34 @@ func TryFastPlan(ctx PlanContext, node ast.Node) (p Plan) {
 				vars.StmtCtx.OptimizeTracer.SetFastPlan(p.BuildPlanTrace())
 			}
 		}()
+		// Try to rewrite the select on a simple view to the select on its base table,
+		// so that the point lookups through the view can still use the fast plan.
+		var view *fastPlanView
+		if stmt, v := tryRewriteSelectOnSimpleView(ctx, x); stmt != nil {
+			x, view = stmt, v
+		}
 		// Try to convert the `SELECT a, b, c FROM t WHERE (a, b, c) in ((1, 2, 4), (1, 3, 5))` to
 		// `PhysicalUnionAll` which children are `PointGet` if exists an unique key (a, b, c) in table `t`
 		if fp := tryWhereIn2BatchPointGet(ctx, x); fp != nil {
-			if checkFastPlanPrivilege(ctx, fp.dbName, fp.TblInfo.Name.L, mysql.SelectPriv) != nil {
+			if view.checkFastPlanPrivilege(ctx, fp.dbName, fp.TblInfo.Name.L) != nil {
 				return
 			}
 			if tidbutil.IsMemDB(fp.dbName) {
 				return nil
 			}
+			view.setOutputNames(fp.OutputNames())
 			fp.Lock, fp.LockWaitTime = getLockWaitTime(ctx, x.LockInfo)
 			p = fp
 			return
 		}
 		if fp := tryPointGetPlan(ctx, x, isForUpdateReadSelectLock(x.LockInfo)); fp != nil {
-			if checkFastPlanPrivilege(ctx, fp.dbName, fp.TblInfo.Name.L, mysql.SelectPriv) != nil {
+			if view.checkFastPlanPrivilege(ctx, fp.dbName, fp.TblInfo.Name.L) != nil {
 				return nil
 			}
 			if tidbutil.IsMemDB(fp.dbName) {
 				return nil
 			}
+			view.setOutputNames(fp.OutputNames())
 			if fp.IsTableDual {
 				tableDual := PhysicalTableDual{}
 				tableDual.names = fp.outputNames
@@ -1191,7 +1200,7 @@ func newBatchPointGetPlan(
 func tryWhereIn2BatchPointGet(ctx PlanContext, selStmt *ast.SelectStmt) *BatchPointGetPlan {
 	if selStmt.OrderBy != nil || selStmt.GroupBy != nil ||
 		selStmt.Limit != nil || selStmt.Having != nil || selStmt.Distinct ||
-		len(selStmt.WindowSpecs) > 0 {
+		len(selStmt.WindowSpecs) > 0 || selStmt.Qualify != nil {
 		return nil
 	}
 	// `expr1 in (1, 2) and expr2 in (1, 2)` isn't PatternInExpr, so it can't use tryWhereIn2BatchPointGet.
@@ -1290,7 +1299,7 @@ func tryWhereIn2BatchPointGet(ctx PlanContext, selStmt *ast.SelectStmt) *BatchPo
 // 3. All the columns must be public and not generated.
 // 4. The condition is an access path that the range is a unique key.
 func tryPointGetPlan(ctx PlanContext, selStmt *ast.SelectStmt, check bool) *PointGetPlan {
-	if selStmt.Having != nil || selStmt.OrderBy != nil {
+	if selStmt.Having != nil || selStmt.OrderBy != nil || selStmt.Qualify != nil {
 		return nil
 	} else if selStmt.Limit != nil {
 		count, offset, err := extractLimitCountOffset(ctx, selStmt.Limit)
@@ -1497,7 +1506,10 @@ func checkFastPlanPrivilege(ctx PlanContext, dbName, tableName string, checkType
 	}
 
 	infoSchema := ctx.GetInfoSchema().(infoschema.InfoSchema)
-	return CheckTableLock(ctx, infoSchema, visitInfos)
+	if err := CheckTableLock(ctx, infoSchema, visitInfos); err != nil {
+		return err
+	}
+	return CheckRestrictedReadOnlySchema(ctx, visitInfos)
 }
 
 func buildSchemaFromFields(
@@ -1606,6 +1618,244 @@ func tryExtractRowChecksumColumn(field *ast.SelectField, idx int) (*types.FieldN
 	return name, column, true
 }
 
+// fastPlanView is the view whose select is rewritten to the select on its base table
+// by tryRewriteSelectOnSimpleView.
+type fastPlanView struct {
+	dbName  model.CIStr
+	tblInfo *model.TableInfo
+}
+
+// checkFastPlanPrivilege checks the select privilege of the fast plan which reads the base
+// table `baseDB`.`baseTbl`. If the fast plan is not built through a view, v is nil.
+func (v *fastPlanView) checkFastPlanPrivilege(ctx PlanContext, baseDB, baseTbl string) error {
+	if v == nil {
+		return checkFastPlanPrivilege(ctx, baseDB, baseTbl, mysql.SelectPriv)
+	}
+	if err := checkFastPlanPrivilege(ctx, v.dbName.L, v.tblInfo.Name.L, mysql.SelectPriv); err != nil {
+		return err
+	}
+	if v.tblInfo.View.Security != model.SecurityDefiner {
+		return checkFastPlanPrivilege(ctx, baseDB, baseTbl, mysql.SelectPriv)
+	}
+	pm := privilege.GetPrivilegeManager(ctx)
+	if pm != nil && !pm.RequestVerificationWithUser(baseDB, baseTbl, "", mysql.SelectPriv, v.tblInfo.View.Definer) {
+		return plannererrors.ErrViewInvalid.GenWithStackByArgs(v.dbName.O, v.tblInfo.Name.O)
+	}
+	infoSchema := ctx.GetInfoSchema().(infoschema.InfoSchema)
+	return CheckTableLock(ctx, infoSchema, []visitInfo{{privilege: mysql.SelectPriv, db: baseDB, table: baseTbl}})
+}
+
+// setOutputNames makes the output names of the fast plan the same as the ones built through the view.
+func (v *fastPlanView) setOutputNames(names types.NameSlice) {
+	if v == nil {
+		return
+	}
+	for _, name := range names {
+		name.DBName = v.dbName
+	}
+}
+
+// tryRewriteSelectOnSimpleView rewrites `SELECT ... FROM v WHERE ...` to the select on the base table
+// of v if v is a bare projection and filter of the base table, i.e. `SELECT a, b AS c FROM t WHERE ...`.
+// The rewritten select only contains the forms that can be handled by the fast plan, it returns nil if
+// the view or the select is not simple enough.
+func tryRewriteSelectOnSimpleView(ctx PlanContext, selStmt *ast.SelectStmt) (*ast.SelectStmt, *fastPlanView) {
+	tblName, tblAlias := getSingleTableNameAndAlias(selStmt.From)
+	if tblName == nil || tblName.TableInfo == nil || !tblName.TableInfo.IsView() {
+		return nil, nil
+	}
+	viewInfo := tblName.TableInfo
+	// The views created by the old versions store the column names of the underlying select in View.Cols,
+	// and explaining a view requires extra privileges, leave them to the normal planner.
+	if viewInfo.View.Cols != nil || ctx.GetSessionVars().StmtCtx.InExplainStmt {
+		return nil, nil
+	}
+	viewDB := tblName.Schema
+	if viewDB.L == "" {
+		viewDB = model.NewCIStr(ctx.GetSessionVars().CurrentDB)
+	}
+
+	charset, collation := ctx.GetSessionVars().GetCharsetInfo()
+	viewParser := parser.New()
+	viewParser.SetParserConfig(ctx.GetSessionVars().BuildParserConfig())
+	node, err := viewParser.ParseOneStmt(viewInfo.View.SelectStmt, charset, collation)
+	if err != nil {
+		return nil, nil
+	}
+	viewSel, ok := node.(*ast.SelectStmt)
+	if !ok || !isSimpleViewSelect(viewSel) || len(viewSel.Fields.Fields) != len(viewInfo.Columns) {
+		return nil, nil
+	}
+	baseName, baseAlias := getSingleTableNameAndAlias(viewSel.From)
+	if baseName == nil || baseName.Schema.L == "" || len(baseName.IndexHints) > 0 ||
+		len(baseName.PartitionNames) > 0 || baseName.TableSample != nil || baseName.AsOf != nil {
+		return nil, nil
+	}
+	infoSchema := ctx.GetInfoSchema().(infoschema.InfoSchema)
+	baseTbl, err := infoSchema.TableByName(baseName.Schema, baseName.Name)
+	if err != nil || baseTbl.Meta().IsView() || baseTbl.Meta().IsSequence() {
+		return nil, nil
+	}
+
+	// The base columns referenced by the view are always unqualified after the rewrite.
+	renameBaseCol := func(name *ast.ColumnName) *ast.ColumnName {
+		if (name.Schema.L != "" && name.Schema.L != baseName.Schema.L) ||
+			(name.Table.L != "" && name.Table.L != baseAlias.L) {
+			return nil
+		}
+		return &ast.ColumnName{Name: name.Name}
+	}
+	viewCols := make(map[string]*ast.ColumnName, len(viewInfo.Columns))
+	for i, field := range viewSel.Fields.Fields {
+		colExpr, ok := field.Expr.(*ast.ColumnNameExpr)
+		if !ok {
+			return nil, nil
+		}
+		baseCol := renameBaseCol(colExpr.Name)
+		if baseCol == nil {
+			return nil, nil
+		}
+		viewCols[viewInfo.Columns[i].Name.L] = baseCol
+	}
+	renameViewCol := func(name *ast.ColumnName) *ast.ColumnName {
+		if (name.Schema.L != "" && name.Schema.L != viewDB.L) ||
+			(name.Table.L != "" && name.Table.L != tblAlias.L) {
+			return nil
+		}
+		baseCol, ok := viewCols[name.Name.L]
+		if !ok {
+			return nil
+		}
+		return &ast.ColumnName{Name: baseCol.Name}
+	}
+
+	fields := make([]*ast.SelectField, 0, len(selStmt.Fields.Fields))
+	for _, field := range selStmt.Fields.Fields {
+		if field.WildCard != nil {
+			if (field.WildCard.Schema.L != "" && field.WildCard.Schema.L != viewDB.L) ||
+				(field.WildCard.Table.L != "" && field.WildCard.Table.L != tblAlias.L) {
+				return nil, nil
+			}
+			for _, col := range viewInfo.Columns {
+				fields = append(fields, &ast.SelectField{
+					Expr:   &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: viewCols[col.Name.L].Name}},
+					AsName: col.Name,
+				})
+			}
+			continue
+		}
+		colExpr, ok := field.Expr.(*ast.ColumnNameExpr)
+		if !ok {
+			return nil, nil
+		}
+		baseCol := renameViewCol(colExpr.Name)
+		if baseCol == nil {
+			return nil, nil
+		}
+		asName := field.AsName
+		if asName.L == "" {
+			asName = colExpr.Name.Name
+		}
+		fields = append(fields, &ast.SelectField{Expr: &ast.ColumnNameExpr{Name: baseCol}, AsName: asName})
+	}
+
+	where := selStmt.Where
+	if where != nil {
+		if where = rewriteFastPlanCondition(where, renameViewCol); where == nil {
+			return nil, nil
+		}
+	}
+	if viewSel.Where != nil {
+		viewWhere := rewriteFastPlanCondition(viewSel.Where, renameBaseCol)
+		if viewWhere == nil {
+			return nil, nil
+		}
+		if where == nil {
+			where = viewWhere
+		} else {
+			where = &ast.BinaryOperationExpr{Op: opcode.LogicAnd, L: where, R: viewWhere}
+		}
+	}
+
+	newStmt := *selStmt
+	newStmt.Fields = &ast.FieldList{Fields: fields}
+	newStmt.Where = where
+	newStmt.From = &ast.TableRefsClause{TableRefs: &ast.Join{Left: &ast.TableSource{
+		Source: &ast.TableName{Schema: baseName.Schema, Name: baseName.Name, TableInfo: baseTbl.Meta()},
+		AsName: tblAlias,
+	}}}
+	return &newStmt, &fastPlanView{dbName: viewDB, tblInfo: viewInfo}
+}
+
+// isSimpleViewSelect checks whether the select of a view only contains the projection, the single
+// table source and the filter.
+func isSimpleViewSelect(sel *ast.SelectStmt) bool {
+	return sel.Kind == ast.SelectStmtKindSelect && sel.Fields != nil && !sel.Distinct &&
+		sel.GroupBy == nil && sel.Having == nil && len(sel.WindowSpecs) == 0 && sel.OrderBy == nil &&
+		sel.Limit == nil && sel.LockInfo == nil && len(sel.TableHints) == 0 && sel.SelectIntoOpt == nil &&
+		sel.With == nil
+}
+
+// rewriteFastPlanCondition copies the condition with the column names renamed by rename. Only the
+// forms handled by the fast plan are copied, it returns nil if any other expression is met or any
+// column can not be renamed. The original condition is not modified.
+func rewriteFastPlanCondition(expr ast.ExprNode, rename func(*ast.ColumnName) *ast.ColumnName) ast.ExprNode {
+	switch x := expr.(type) {
+	case *driver.ValueExpr, *driver.ParamMarkerExpr:
+		return x
+	case *ast.ColumnNameExpr:
+		name := rename(x.Name)
+		if name == nil {
+			return nil
+		}
+		return &ast.ColumnNameExpr{Name: name}
+	case *ast.ParenthesesExpr:
+		inner := rewriteFastPlanCondition(x.Expr, rename)
+		if inner == nil {
+			return nil
+		}
+		return &ast.ParenthesesExpr{Expr: inner}
+	case *ast.BinaryOperationExpr:
+		if x.Op != opcode.LogicAnd && x.Op != opcode.EQ {
+			return nil
+		}
+		l := rewriteFastPlanCondition(x.L, rename)
+		r := rewriteFastPlanCondition(x.R, rename)
+		if l == nil || r == nil {
+			return nil
+		}
+		return &ast.BinaryOperationExpr{Op: x.Op, L: l, R: r}
+	case *ast.RowExpr:
+		values := make([]ast.ExprNode, 0, len(x.Values))
+		for _, v := range x.Values {
+			value := rewriteFastPlanCondition(v, rename)
+			if value == nil {
+				return nil
+			}
+			values = append(values, value)
+		}
+		return &ast.RowExpr{Values: values}
+	case *ast.PatternInExpr:
+		if x.Not || x.Sel != nil {
+			return nil
+		}
+		inExpr := rewriteFastPlanCondition(x.Expr, rename)
+		if inExpr == nil {
+			return nil
+		}
+		list := make([]ast.ExprNode, 0, len(x.List))
+		for _, item := range x.List {
+			value := rewriteFastPlanCondition(item, rename)
+			if value == nil {
+				return nil
+			}
+			list = append(list, value)
+		}
+		return &ast.PatternInExpr{Expr: inExpr, List: list}
+	}
+	return nil
+}
+
 // getSingleTableNameAndAlias return the ast node of queried table name and the alias string.
 // `tblName` is `nil` if there are multiple tables in the query.
 // `tblAlias` will be the real table name if there is no table alias in the query.
@@ -224,3 +224,55 @@ func TestIssue18042(t *testing.T) {
 	require.Equal(t, uint64(100), tk.Session().GetSessionVars().StmtCtx.MaxExecutionTime)
 	tk.MustExec("drop table t")
 }
+
+func TestPointGetOnSimpleView(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int, c int, unique key ubc(b, c))")
+	tk.MustExec("insert into t values (1, 10, 100), (2, 20, 200), (3, 30, 300)")
+	tk.MustExec("create view v as select a as x, b, c from t")
+	tk.MustExec("create view v_filter as select a, b from t where c = 200")
+	tk.MustExec("create view v_agg as select a, count(*) as cnt from t group by a")
+	tk.MustExec("create view v_expr as select a + 1 as a, b from t")
+
+	tests := []struct {
+		sql      string
+		fastPlan bool
+	}{
+		{"select * from v where x = 1", true},
+		{"select v.x, v.c from v where v.x in (1, 3)", true},
+		{"select x as y from test.v where b = 20 and c = 200", true},
+		{"select * from v where a = 1", false},
+		{"select * from v_filter where b = 20", true},
+		{"select * from v_filter where a = 2", false},
+		{"select * from v_agg where a = 1", false},
+		{"select * from v_expr where a = 1", false},
+	}
+	for _, tt := range tests {
+		ctx := tk.Session().(sessionctx.Context)
+		stmts, err := session.Parse(ctx, tt.sql)
+		require.NoError(t, err, tt.sql)
+		require.Len(t, stmts, 1)
+		ret := &core.PreprocessorReturn{}
+		err = core.Preprocess(context.Background(), ctx, stmts[0], core.WithPreprocessorReturn(ret))
+		require.NoError(t, err, tt.sql)
+		p := core.TryFastPlan(ctx.GetPlanCtx(), stmts[0])
+		require.Equal(t, tt.fastPlan, p != nil, tt.sql)
+	}
+
+	tk.MustQuery("select * from v where x = 1").Check(testkit.Rows("1 10 100"))
+	tk.MustQuery("select v.x, v.c from v where v.x in (1, 3)").Sort().Check(testkit.Rows("1 100", "3 300"))
+	tk.MustQuery("select x as y from test.v where b = 20 and c = 200").Check(testkit.Rows("2"))
+	tk.MustQuery("select * from v_filter where b = 20").Check(testkit.Rows("2 20"))
+	tk.MustQuery("select * from v_filter where b = 30").Check(testkit.Rows())
+
+	rs, err := tk.Exec("select * from v where x = 1")
+	require.NoError(t, err)
+	fields := rs.Fields()
+	require.Len(t, fields, 3)
+	require.Equal(t, "x", fields[0].ColumnAsName.O)
+	require.Equal(t, "v", fields[0].TableAsName.O)
+	require.Equal(t, "test", fields[0].DBName.O)
+	require.NoError(t, rs.Close())
+}
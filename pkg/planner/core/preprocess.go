@@ -235,6 +235,11 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 	switch node := in.(type) {
 	case *ast.AdminStmt:
 		p.checkAdminCheckTableGrammar(node)
+		if node.Tp == ast.AdminChecksumTable {
+			// ADMIN CHECKSUM TABLE reads the tables like SELECT, so the AS OF clauses of the
+			// tables are handled by the stale read processor.
+			p.stmtTp = TypeSelect
+		}
 	case *ast.DeleteStmt:
 		p.stmtTp = TypeDelete
 	case *ast.SelectStmt:
@@ -844,9 +849,14 @@ func (p *preprocessor) checkAdminCheckTableGrammar(stmt *ast.AdminStmt) {
 			return
 		}
 		tempTableType := tableInfo.Meta().TempTableType
-		if (stmt.Tp == ast.AdminCheckTable || stmt.Tp == ast.AdminChecksumTable || stmt.Tp == ast.AdminCheckIndex) && tempTableType != model.TempTableNone {
+		if (stmt.Tp == ast.AdminCheckTable || stmt.Tp == ast.AdminChecksumTable || stmt.Tp == ast.AdminCheckIndex || stmt.Tp == ast.AdminReclaimTable ||
+			stmt.Tp == ast.AdminReorganizeIndex) && tempTableType != model.TempTableNone {
 			if stmt.Tp == ast.AdminChecksumTable {
 				p.err = plannererrors.ErrOptOnTemporaryTable.GenWithStackByArgs("admin checksum table")
+			} else if stmt.Tp == ast.AdminReclaimTable {
+				p.err = plannererrors.ErrOptOnTemporaryTable.GenWithStackByArgs("admin reclaim table")
+			} else if stmt.Tp == ast.AdminReorganizeIndex {
+				p.err = plannererrors.ErrOptOnTemporaryTable.GenWithStackByArgs("admin reorganize index")
 			} else if stmt.Tp == ast.AdminCheckTable {
 				p.err = plannererrors.ErrOptOnTemporaryTable.GenWithStackByArgs("admin check table")
 			} else {
@@ -948,9 +958,16 @@ func (p *preprocessor) checkCreateTableGrammar(stmt *ast.CreateTableStmt) {
 		return
 	}
 	if stmt.Select != nil {
-		// FIXME: a temp error noticing 'not implemented' (issue 4754)
-		p.err = errors.New("'CREATE TABLE ... SELECT' is not implemented yet")
-		return
+		// The rows of the select are imported by the ingest pipeline, which can't write into a temporary table
+		// or handle the duplicate rows in the way of IGNORE and REPLACE.
+		if stmt.TemporaryKeyword != ast.TemporaryNone {
+			p.err = dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("CREATE TEMPORARY TABLE ... SELECT")
+			return
+		}
+		if stmt.OnDuplicate != ast.OnDuplicateKeyHandlingError {
+			p.err = dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("CREATE TABLE ... IGNORE/REPLACE SELECT")
+			return
+		}
 	} else if len(stmt.Cols) == 0 && stmt.ReferTable == nil {
 		p.err = dbterror.ErrTableMustHaveColumns
 		return
@@ -204,11 +204,12 @@ func TestValidator(t *testing.T) {
 		{"CREATE TABLE t (a float(54))", false, types.ErrWrongFieldSpec},
 		{"CREATE TABLE t (a double)", true, nil},
 
-		// FIXME: temporary 'not implemented yet' test for 'CREATE TABLE ... SELECT' (issue 4754)
-		{"CREATE TABLE t SELECT * FROM u", false, errors.New("'CREATE TABLE ... SELECT' is not implemented yet")},
-		{"CREATE TABLE t (m int) SELECT * FROM u", false, errors.New("'CREATE TABLE ... SELECT' is not implemented yet")},
-		{"CREATE TABLE t IGNORE SELECT * FROM u UNION SELECT * from v", false, errors.New("'CREATE TABLE ... SELECT' is not implemented yet")},
-		{"CREATE TABLE t (m int) REPLACE AS (SELECT * FROM u) UNION (SELECT * FROM v)", false, errors.New("'CREATE TABLE ... SELECT' is not implemented yet")},
+		// CREATE TABLE ... SELECT
+		{"CREATE TABLE t1 SELECT * FROM t", false, nil},
+		{"CREATE TABLE t1 (m int) SELECT * FROM t", false, nil},
+		{"CREATE TABLE t1 IGNORE SELECT * FROM t UNION SELECT * from t", false, dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("CREATE TABLE ... IGNORE/REPLACE SELECT")},
+		{"CREATE TABLE t1 (m int) REPLACE AS (SELECT * FROM t) UNION (SELECT * FROM t)", false, dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("CREATE TABLE ... IGNORE/REPLACE SELECT")},
+		{"CREATE TEMPORARY TABLE t1 SELECT * FROM t", false, dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("CREATE TEMPORARY TABLE ... SELECT")},
 
 		// issue 24309
 		{"SELECT * FROM t INTO OUTFILE 'ttt' UNION SELECT * FROM u", false, plannererrors.ErrWrongUsage.GenWithStackByArgs("UNION", "INTO")},
@@ -40,6 +40,30 @@ func (la *LogicalApply) canPullUpAgg() bool {
 	return len(la.children[0].Schema().Keys) > 0
 }
 
+// canRemoveInnerAgg checks if the aggregation on the inner side of a semi apply can be removed.
+// An aggregation with group by items outputs at least one row if and only if its child does, so it makes
+// no difference to `exists` or `not exists` as long as the apply doesn't reference any output of it.
+func (la *LogicalApply) canRemoveInnerAgg(agg *LogicalAggregation) bool {
+	if la.JoinType != SemiJoin && la.JoinType != LeftOuterSemiJoin && la.JoinType != AntiSemiJoin && la.JoinType != AntiLeftOuterSemiJoin {
+		return false
+	}
+	if len(agg.GroupByItems) == 0 {
+		return false
+	}
+	conds := make([]expression.Expression, 0, len(la.EqualConditions)+len(la.RightConditions)+len(la.OtherConditions))
+	for _, cond := range la.EqualConditions {
+		conds = append(conds, cond)
+	}
+	conds = append(conds, la.RightConditions...)
+	conds = append(conds, la.OtherConditions...)
+	for _, col := range expression.ExtractColumnsFromExpressions(nil, conds, nil) {
+		if agg.Schema().Contains(col) {
+			return false
+		}
+	}
+	return true
+}
+
 // canPullUp checks if an aggregation can be pulled up. An aggregate function like count(*) cannot be pulled up.
 func (la *LogicalAggregation) canPullUp() bool {
 	if len(la.GroupByItems) > 0 {
@@ -303,6 +327,15 @@ func (s *decorrelateSolver) optimize(ctx context.Context, p LogicalPlan, opt *ut
 				return s.optimize(ctx, p, opt)
 			}
 		} else if agg, ok := innerPlan.(*LogicalAggregation); ok {
+			// e.g. select * from t1 where not exists (select 1 from t2 where t2.a = t1.a group by t2.b);
+			// The aggregation can be removed, then the selection below it can be decorrelated and the
+			// apply turns into an (anti) semi join, which can be executed by MPP.
+			if apply.canRemoveInnerAgg(agg) {
+				innerPlan = agg.children[0]
+				apply.SetChildren(outerPlan, innerPlan)
+				appendRemoveAggTraceStep(apply, agg, opt)
+				return s.optimize(ctx, p, opt)
+			}
 			if apply.canPullUpAgg() && agg.canPullUp() {
 				innerPlan = agg.children[0]
 				apply.JoinType = LeftOuterJoin
@@ -504,6 +537,16 @@ func appendRemoveLimitTraceStep(limit *LogicalLimit, opt *util.LogicalOptimizeOp
 	opt.AppendStepToCurrent(limit.ID(), limit.TP(), reason, action)
 }
 
+func appendRemoveAggTraceStep(p *LogicalApply, agg *LogicalAggregation, opt *util.LogicalOptimizeOp) {
+	action := func() string {
+		return fmt.Sprintf("%v_%v removed from plan tree", agg.TP(), agg.ID())
+	}
+	reason := func() string {
+		return fmt.Sprintf("%v_%v has group by items and %v_%v is %v, the aggregation doesn't affect the result", agg.TP(), agg.ID(), p.TP(), p.ID(), p.JoinType.String())
+	}
+	opt.AppendStepToCurrent(agg.ID(), agg.TP(), reason, action)
+}
+
 func appendRemoveProjTraceStep(p *LogicalApply, proj *LogicalProjection, opt *util.LogicalOptimizeOp) {
 	action := func() string {
 		return fmt.Sprintf("%v_%v removed from plan tree", proj.TP(), proj.ID())
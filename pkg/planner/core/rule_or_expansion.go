@@ -0,0 +1,309 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/cardinality"
+	"github.com/pingcap/tidb/pkg/planner/util"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/plancodec"
+)
+
+const (
+	// maxOrExpansionBranches is the max number of the disjunctions expanded into the UNION ALL.
+	maxOrExpansionBranches = 8
+	// orExpansionLookupFactor is the cost of a row read by the index lookups of a branch, comparing
+	// to the one read by a table scan. The driving table of a branch is read by the double read of
+	// an index, and the other tables are joined by the index lookups.
+	orExpansionLookupFactor = 3.0
+)
+
+// orExpansionSolver expands the disjunctions over the different tables of a join into the
+// UNION ALL of the joins driven by each disjunction. For example:
+//
+//	select * from t1 join t2 on t1.id = t2.id where t1.a = 1 or t2.b = 2
+//
+// can't use any index for the disjunction, because each item of it references a different
+// table, so both of the tables are scanned entirely. It's rewritten to:
+//
+//	select * from t1 join t2 on t1.id = t2.id where t1.a = 1
+//	union all
+//	select * from t1 join t2 on t1.id = t2.id where t2.b = 2 and not (t1.a = 1 is true)
+//
+// each branch can be driven by the index on the column of its disjunction, and the rows
+// matched by the previous branches are excluded, so no row is returned twice.
+//
+// The expansion is applied only when the estimated rows read by all the branches through
+// the indexes are cheaper than the scans of the tables referenced by the disjunction.
+type orExpansionSolver struct {
+}
+
+func (s *orExpansionSolver) optimize(_ context.Context, p LogicalPlan, opt *util.LogicalOptimizeOp) (LogicalPlan, bool, error) {
+	planChanged := false
+	if !p.SCtx().GetSessionVars().EnableOrExpansion {
+		return p, planChanged, nil
+	}
+	newPlan, err := s.recursivePlan(p, opt)
+	return newPlan, planChanged, err
+}
+
+func (*orExpansionSolver) name() string {
+	return "or_expansion"
+}
+
+func (s *orExpansionSolver) recursivePlan(p LogicalPlan, opt *util.LogicalOptimizeOp) (LogicalPlan, error) {
+	if _, ok := p.(*LogicalCTE); ok {
+		return p, nil
+	}
+	for i, child := range p.Children() {
+		newChild, err := s.recursivePlan(child, opt)
+		if err != nil {
+			return nil, err
+		}
+		p.Children()[i] = newChild
+	}
+	sel, ok := p.(*LogicalSelection)
+	if !ok {
+		return p, nil
+	}
+	return s.expandSelection(sel, opt)
+}
+
+// orExpansionBranch is a disjunction driving a branch of the UNION ALL.
+type orExpansionBranch struct {
+	cond expression.Expression
+	ds   *DataSource
+	// rows is the estimated rows of ds matching cond.
+	rows float64
+}
+
+func (s *orExpansionSolver) expandSelection(sel *LogicalSelection, opt *util.LogicalOptimizeOp) (LogicalPlan, error) {
+	var dataSources []*DataSource
+	if !collectOrExpansionDataSources(sel.Children()[0], &dataSources) || len(dataSources) < 2 {
+		// the disjunctions over a single table are handled by the index merge.
+		return sel, nil
+	}
+	for i, cond := range sel.Conditions {
+		branches, err := s.buildBranches(cond, dataSources)
+		if err != nil {
+			return nil, err
+		}
+		if branches == nil {
+			continue
+		}
+		otherConds := slices.Delete(slices.Clone(sel.Conditions), i, i+1)
+		return s.expand(sel, branches, otherConds, opt), nil
+	}
+	return sel, nil
+}
+
+// collectOrExpansionDataSources collects the data sources of the plan, which can be cloned for
+// each branch. Only the inner joins of the tables and the selections over them, e.g. the ones
+// built from the ON clauses, are supported.
+func collectOrExpansionDataSources(p LogicalPlan, dataSources *[]*DataSource) bool {
+	switch x := p.(type) {
+	case *DataSource:
+		if x.SampleInfo != nil {
+			return false
+		}
+		*dataSources = append(*dataSources, x)
+		return true
+	case *LogicalSelection:
+		return collectOrExpansionDataSources(x.children[0], dataSources)
+	case *LogicalJoin:
+		if x.JoinType != InnerJoin {
+			return false
+		}
+		return collectOrExpansionDataSources(x.children[0], dataSources) &&
+			collectOrExpansionDataSources(x.children[1], dataSources)
+	default:
+		return false
+	}
+}
+
+// buildBranches returns the branches of the disjunction when it's worth being expanded, or nil.
+func (*orExpansionSolver) buildBranches(cond expression.Expression, dataSources []*DataSource) ([]orExpansionBranch, error) {
+	sf, ok := cond.(*expression.ScalarFunction)
+	if !ok || sf.FuncName.L != ast.LogicOr {
+		return nil, nil
+	}
+	// the disjunction items are evaluated again by the later branches to exclude the rows of the
+	// previous ones, so they must return the same result every time they're evaluated. The rows of
+	// the items referencing the correlated columns can't be estimated either.
+	if expression.IsMutableEffectsExpr(cond) || expression.CheckNonDeterministic(cond) ||
+		expression.ContainCorrelatedColumn([]expression.Expression{cond}) {
+		return nil, nil
+	}
+	items := expression.SplitDNFItems(cond)
+	if len(items) > maxOrExpansionBranches {
+		return nil, nil
+	}
+	branches := make([]orExpansionBranch, 0, len(items))
+	scanRows := make(map[*DataSource]float64, len(dataSources))
+	for _, item := range items {
+		ds := findOrExpansionDataSource(item, dataSources)
+		if ds == nil || !hasIndexPrefixOn(ds, item) {
+			return nil, nil
+		}
+		statsTbl := getStatsTable(ds.SCtx(), ds.tableInfo, ds.physicalTableID)
+		coll := statsTbl.GenerateHistCollFromColumnInfo(ds.tableInfo, ds.TblCols)
+		selectivity, _, err := cardinality.Selectivity(ds.SCtx(), coll, []expression.Expression{item}, nil)
+		if err != nil {
+			return nil, err
+		}
+		rowCount := float64(statsTbl.RealtimeCount)
+		scanRows[ds] = rowCount
+		branches = append(branches, orExpansionBranch{cond: item, ds: ds, rows: selectivity * rowCount})
+	}
+	if len(scanRows) < 2 {
+		// the disjunction over a single table is handled by the index merge.
+		return nil, nil
+	}
+	var scanCost, expandCost float64
+	for _, rows := range scanRows {
+		scanCost += rows
+	}
+	for _, branch := range branches {
+		expandCost += branch.rows * orExpansionLookupFactor
+	}
+	if expandCost >= scanCost {
+		return nil, nil
+	}
+	return branches, nil
+}
+
+// findOrExpansionDataSource returns the data source which provides all the columns of the condition.
+func findOrExpansionDataSource(cond expression.Expression, dataSources []*DataSource) *DataSource {
+	cols := expression.ExtractColumns(cond)
+	if len(cols) == 0 {
+		return nil
+	}
+	for _, ds := range dataSources {
+		if ds.schema.ColumnsIndices(cols) != nil {
+			return ds
+		}
+	}
+	return nil
+}
+
+// hasIndexPrefixOn returns whether the condition references the first column of an index or the
+// handle of the data source, so the branch driven by the condition can read the table by ranges.
+func hasIndexPrefixOn(ds *DataSource, cond expression.Expression) bool {
+	cols := expression.ExtractColumnSet(cond)
+	for _, path := range ds.possibleAccessPaths {
+		var prefix *expression.Column
+		switch {
+		case path.IsIntHandlePath:
+			prefix = ds.getPKIsHandleCol()
+		case path.IsCommonHandlePath, path.Index != nil:
+			if len(path.FullIdxCols) > 0 {
+				prefix = path.FullIdxCols[0]
+			}
+		}
+		if prefix != nil && cols.Has(int(prefix.UniqueID)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (*orExpansionSolver) expand(sel *LogicalSelection, branches []orExpansionBranch, otherConds []expression.Expression, opt *util.LogicalOptimizeOp) LogicalPlan {
+	ctx := sel.SCtx()
+	exprCtx := ctx.GetExprCtx()
+	child := sel.Children()[0]
+	children := make([]LogicalPlan, 0, len(branches))
+	for i, branch := range branches {
+		conds := util.CloneExprs(otherConds)
+		conds = append(conds, branch.cond.Clone())
+		for _, prev := range branches[:i] {
+			isTrue := expression.NewFunctionInternal(exprCtx, ast.IsTruthWithoutNull, types.NewFieldType(mysql.TypeTiny), prev.cond.Clone())
+			conds = append(conds, expression.NewFunctionInternal(exprCtx, ast.UnaryNot, types.NewFieldType(mysql.TypeTiny), isTrue))
+		}
+		branchSel := LogicalSelection{Conditions: conds}.Init(ctx, sel.QueryBlockOffset())
+		branchSel.SetChildren(cloneOrExpansionPlan(child))
+		children = append(children, branchSel)
+	}
+	unionAll := LogicalUnionAll{}.Init(ctx, sel.QueryBlockOffset())
+	unionAll.SetChildren(children...)
+	unionAll.SetSchema(sel.Schema().Clone())
+	unionAll.SetOutputNames(sel.OutputNames())
+	appendOrExpansionTraceStep(sel, unionAll, branches, opt)
+	return unionAll
+}
+
+// cloneOrExpansionPlan clones the plans walked by collectOrExpansionDataSources.
+func cloneOrExpansionPlan(p LogicalPlan) LogicalPlan {
+	switch x := p.(type) {
+	case *LogicalSelection:
+		newSel := LogicalSelection{Conditions: util.CloneExprs(x.Conditions)}.Init(x.SCtx(), x.QueryBlockOffset())
+		newSel.SetChildren(cloneOrExpansionPlan(x.children[0]))
+		return newSel
+	case *DataSource:
+		// Not a deep copy.
+		newDataSource := *x
+		newDataSource.baseLogicalPlan = newBaseLogicalPlan(x.SCtx(), plancodec.TypeDataSource, &newDataSource, x.QueryBlockOffset())
+		newDataSource.schema = x.schema.Clone()
+		newDataSource.Columns = slices.Clone(x.Columns)
+		newDataSource.possibleAccessPaths = make([]*util.AccessPath, 0, len(x.possibleAccessPaths))
+		for _, path := range x.possibleAccessPaths {
+			newDataSource.possibleAccessPaths = append(newDataSource.possibleAccessPaths, path.Clone())
+		}
+		// Same as the partition processor, the expressions may use the id of the data source as FromID.
+		newDataSource.SetID(x.ID())
+		return &newDataSource
+	case *LogicalJoin:
+		newJoin := x.Shallow()
+		newJoin.SetSchema(x.schema.Clone())
+		newJoin.EqualConditions = make([]*expression.ScalarFunction, 0, len(x.EqualConditions))
+		for _, cond := range x.EqualConditions {
+			newJoin.EqualConditions = append(newJoin.EqualConditions, cond.Clone().(*expression.ScalarFunction))
+		}
+		newJoin.LeftConditions = util.CloneExprs(x.LeftConditions)
+		newJoin.RightConditions = util.CloneExprs(x.RightConditions)
+		newJoin.OtherConditions = util.CloneExprs(x.OtherConditions)
+		newJoin.SetChildren(cloneOrExpansionPlan(x.children[0]), cloneOrExpansionPlan(x.children[1]))
+		return newJoin
+	}
+	return p
+}
+
+func appendOrExpansionTraceStep(sel *LogicalSelection, unionAll *LogicalUnionAll, branches []orExpansionBranch, opt *util.LogicalOptimizeOp) {
+	if opt.TracerIsNil() {
+		return
+	}
+	action := func() string {
+		return fmt.Sprintf("%v_%v is expanded into %v_%v with %v branches", sel.TP(), sel.ID(), unionAll.TP(), unionAll.ID(), len(branches))
+	}
+	reason := func() string {
+		buffer := bytes.NewBufferString("the disjunction items [")
+		for i, branch := range branches {
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+			fmt.Fprintf(buffer, "%s(estimated rows %.2f)", branch.cond.String(), branch.rows)
+		}
+		buffer.WriteString("] are cheaper to be read by the indexes than the table scans")
+		return buffer.String()
+	}
+	opt.AppendStepToCurrent(sel.ID(), sel.TP(), reason, action)
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrExpansion(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (id int primary key, a int, c int, key(a))")
+	tk.MustExec("create table t2 (id int primary key, b int, c int, key(b))")
+	tk.MustExec("insert into t1 values (1, 1, 1), (2, 1, 2), (3, null, 3), (4, 4, 4), (5, 5, 5)")
+	tk.MustExec("insert into t2 values (1, 2, 1), (2, 3, 2), (3, 2, 3), (4, null, 4), (6, 2, 6)")
+	// the expansion is only cheaper when the disjunctions match a few rows of the tables.
+	for i := 10; i < 100; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t1 values (%d, %d, %d)", i, i, i))
+		tk.MustExec(fmt.Sprintf("insert into t2 values (%d, %d, %d)", i, i, i))
+	}
+	for _, offset := range []int{100, 200, 400, 800} {
+		tk.MustExec(fmt.Sprintf("insert into t1 select id + %d, a + %d, c + %d from t1", offset, offset, offset))
+	}
+	tk.MustExec("analyze table t1, t2")
+
+	hasUnion := func(sql string) bool {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(fmt.Sprint(row[0]), "Union") {
+				return true
+			}
+		}
+		return false
+	}
+	sqls := []string{
+		"select t1.id, t1.a, t2.b from t1 join t2 on t1.id = t2.id where t1.a = 1 or t2.b = 2",
+		"select t1.id, t1.a, t2.b from t1, t2 where t1.id = t2.id and (t1.a = 1 or t2.b = 2 or t1.id = 5)",
+		"select t1.id, t1.a, t2.b from t1 join t2 on t1.id = t2.id where (t1.a = 1 or t2.b = 2) and t1.c > 1",
+	}
+	for _, sql := range sqls {
+		sql += " order by t1.id"
+		tk.MustExec("set @@tidb_opt_enable_or_expansion = off")
+		require.False(t, hasUnion(sql), sql)
+		expected := tk.MustQuery(sql).Sort().Rows()
+		tk.MustExec("set @@tidb_opt_enable_or_expansion = on")
+		require.True(t, hasUnion(sql), sql)
+		// the rows matched by more than one disjunction are returned once.
+		tk.MustQuery(sql).Sort().Check(expected)
+	}
+
+	// the disjunction isn't expanded when one of its items can't use any index.
+	require.False(t, hasUnion("select * from t1 join t2 on t1.id = t2.id where t1.a = 1 or t2.c = 2"))
+	// the disjunction over a single table is left to the index merge.
+	require.False(t, hasUnion("select * from t1 join t2 on t1.id = t2.id where t1.a = 1 or t1.id = 2"))
+	// the outer joins aren't expanded.
+	require.False(t, hasUnion("select * from t1 left join t2 on t1.id = t2.id where t1.a = 1 or t2.b = 2"))
+
+	// the disjunction is evaluated again by the later branches, so it isn't expanded when it may
+	// return a different result every time.
+	tk.MustExec("create sequence s")
+	require.False(t, hasUnion("select * from t1 join t2 on t1.id = t2.id where t1.a = 1 or (t2.b = 2 and rand() < 0.5)"))
+	require.False(t, hasUnion("select * from t1 join t2 on t1.id = t2.id where t1.a = 1 or (t2.b = 2 and sleep(0) = 0)"))
+	require.False(t, hasUnion("select * from t1 join t2 on t1.id = t2.id where t1.a = 1 or (t2.b = 2 and nextval(s) > 0)"))
+	// the disjunction with correlated columns isn't expanded either.
+	require.False(t, hasUnion("select * from t1 where t1.c > (select count(*) from t2 join t1 as t3 on t2.id = t3.id where t3.a = 1 or (t2.b = 2 and t2.c > t1.c))"))
+}
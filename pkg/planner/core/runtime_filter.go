@@ -16,6 +16,7 @@ package core
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/pingcap/tidb/pkg/expression"
@@ -28,7 +29,7 @@ import (
 	"go.uber.org/zap"
 )
 
-// RuntimeFilterType "IN"
+// RuntimeFilterType "IN", "MIN_MAX", "BLOOM_FILTER"
 type RuntimeFilterType = variable.RuntimeFilterType
 
 // RuntimeFilterMode "OFF", "LOCAL"
@@ -55,10 +56,10 @@ type RuntimeFilterMode = variable.RuntimeFilterMode
 //	buildNodeID: 2
 //	targetNodeID: 0
 //
-// Although srcExprList and targetExprList attributes are lists, in fact, there will only be one value in them at present.
-// The reason why it is designed as a list is because:
-// Reserve an interface for subsequent multiple join equivalent expressions corresponding to one rf.
-// (Since IN supports multiple columns, this construction can be more efficient at the execution level)
+// srcExprList and targetExprList have more than one value only for the IN runtime filter generated
+// from a composite join key, e.g. `t1.k1 = t2.k1 and t1.k2 = t2.k2`, which is `(t2.k1, t2.k2) in (...)`.
+// It is only generated when tidb_enable_multi_column_runtime_filter is on and all the target columns are in the same table scan,
+// and filters much more rows than one IN runtime filter for each of the columns.
 type RuntimeFilter struct {
 	// runtime filter id, unique in one query plan
 	id             int
@@ -66,9 +67,10 @@ type RuntimeFilter struct {
 	srcExprList    []*expression.Column
 	targetExprList []*expression.Column
 	rfType         RuntimeFilterType
-	// The following properties need to be set after assigning a scan node to RF
+	// The following properties need to be set after assigning a scan node to RF.
+	// targetNode is the table scan of TiFlash, or the table scan or the index scan of TiKV.
 	rfMode     RuntimeFilterMode
-	targetNode *PhysicalTableScan
+	targetNode PhysicalPlan
 	// The plan id will be set when runtime filter clone()
 	// It is only used for runtime filter pb
 	buildNodeID  int
@@ -76,7 +78,8 @@ type RuntimeFilter struct {
 }
 
 // NewRuntimeFilter construct runtime filter by Join and equal predicate of Join
-func NewRuntimeFilter(rfIDGenerator *util.IDGenerator, eqPredicate *expression.ScalarFunction, buildNode *PhysicalHashJoin) ([]*RuntimeFilter, int64) {
+func NewRuntimeFilter(rfIDGenerator *util.IDGenerator, eqPredicate *expression.ScalarFunction, buildNode *PhysicalHashJoin,
+	rfTypes []RuntimeFilterType) ([]*RuntimeFilter, int64) {
 	rightSideIsBuild := buildNode.RightIsBuildSide()
 	var srcExprList []*expression.Column
 	var targetExprUniqueID int64
@@ -88,7 +91,6 @@ func NewRuntimeFilter(rfIDGenerator *util.IDGenerator, eqPredicate *expression.S
 		targetExprUniqueID = eqPredicate.GetArgs()[1].(*expression.Column).UniqueID
 	}
 
-	rfTypes := buildNode.SCtx().GetSessionVars().GetRuntimeFilterTypes()
 	result := make([]*RuntimeFilter, 0, len(rfTypes))
 	for _, rfType := range rfTypes {
 		rf := &RuntimeFilter{
@@ -102,19 +104,84 @@ func NewRuntimeFilter(rfIDGenerator *util.IDGenerator, eqPredicate *expression.S
 	return result, targetExprUniqueID
 }
 
-func (rf *RuntimeFilter) assign(targetNode *PhysicalTableScan, targetExpr *expression.Column) {
+// NewMultiColumnRuntimeFilter construct an IN runtime filter by Join and all the equal predicates of the composite join key
+func NewMultiColumnRuntimeFilter(rfIDGenerator *util.IDGenerator, eqPredicates []*expression.ScalarFunction,
+	buildNode *PhysicalHashJoin) *RuntimeFilter {
+	srcIdx := 0
+	if buildNode.RightIsBuildSide() {
+		srcIdx = 1
+	}
+	srcExprList := make([]*expression.Column, 0, len(eqPredicates))
+	for _, eqPredicate := range eqPredicates {
+		srcExprList = append(srcExprList, eqPredicate.GetArgs()[srcIdx].(*expression.Column))
+	}
+	return &RuntimeFilter{
+		id:          rfIDGenerator.GetNextID(),
+		buildNode:   buildNode,
+		srcExprList: srcExprList,
+		rfType:      variable.In,
+	}
+}
+
+// estimateBuildSideNDV estimates the NDV of the src column on the build side of the hash join.
+// It falls back to the row count of the build side if there is no NDV of the column.
+func estimateBuildSideNDV(buildNode *PhysicalHashJoin, srcExpr *expression.Column) int64 {
+	buildSide := buildNode.Children()[0]
+	if buildNode.RightIsBuildSide() {
+		buildSide = buildNode.Children()[1]
+	}
+	stats := buildSide.StatsInfo()
+	ndv := stats.RowCount
+	if colNDV, ok := stats.ColNDVs[srcExpr.UniqueID]; ok && colNDV < ndv {
+		ndv = colNDV
+	}
+	return max(int64(math.Ceil(ndv)), 1)
+}
+
+func (rf *RuntimeFilter) assign(targetNode *PhysicalTableScan, targetExprs ...*expression.Column) {
 	rf.targetNode = targetNode
-	if len(rf.targetNode.runtimeFilterList) == 0 {
-		// todo use session variables instead
-		rf.targetNode.maxWaitTimeMs = 10000
+	if len(targetNode.runtimeFilterList) == 0 {
+		targetNode.maxWaitTimeMs = targetNode.SCtx().GetSessionVars().GetRuntimeFilterWaitTimeMs()
 	}
-	rf.targetExprList = append(rf.targetExprList, targetExpr)
+	rf.targetExprList = append(rf.targetExprList, targetExprs...)
 	rf.buildNode.runtimeFilterList = append(rf.buildNode.runtimeFilterList, rf)
-	rf.targetNode.runtimeFilterList = append(rf.targetNode.runtimeFilterList, rf)
+	targetNode.runtimeFilterList = append(targetNode.runtimeFilterList, rf)
 	logutil.BgLogger().Debug("Assign RF to target node",
 		zap.String("RuntimeFilter", rf.String()))
 }
 
+// assignToTiKV assigns the runtime filter built by the hash join in TiDB to the table scan or the index scan of TiKV.
+// Unlike assign, it isn't added to the runtime filters of the table scan, which are sent to TiFlash,
+// the hash join converts it to the conditions pushed down to the reader after building the hash table.
+func (rf *RuntimeFilter) assignToTiKV(targetNode PhysicalPlan, targetExpr *expression.Column) {
+	rf.targetNode = targetNode
+	rf.rfMode = variable.RFLocal
+	rf.targetExprList = append(rf.targetExprList, targetExpr)
+	rf.buildNode.runtimeFilterList = append(rf.buildNode.runtimeFilterList, rf)
+	logutil.BgLogger().Debug("Assign RF to TiKV target node",
+		zap.String("RuntimeFilter", rf.String()))
+}
+
+// ID returns the id of the runtime filter, it's unique in one query plan.
+func (rf *RuntimeFilter) ID() int {
+	return rf.id
+}
+
+// SrcExprList returns the columns of the build side which the runtime filter is built from.
+func (rf *RuntimeFilter) SrcExprList() []*expression.Column {
+	return rf.srcExprList
+}
+
+// TargetExprList returns the columns of the target scan which the runtime filter is applied to.
+func (rf *RuntimeFilter) TargetExprList() []*expression.Column {
+	return rf.targetExprList
+}
+
+// Type returns the type of the runtime filter.
+func (rf *RuntimeFilter) Type() RuntimeFilterType {
+	return rf.rfType
+}
+
 // ExplainInfo explain info of runtime filter
 func (rf *RuntimeFilter) ExplainInfo(isBuildNode bool) string {
 	var builder strings.Builder
@@ -143,12 +210,12 @@ func (rf *RuntimeFilter) String() string {
 	var builder strings.Builder
 	fmt.Fprintf(&builder, "id=%d", rf.id)
 	builder.WriteString(", ")
-	fmt.Fprintf(&builder, "buildNodeID=%d", rf.buildNode.ID())
+	fmt.Fprintf(&builder, "buildNodeID=%d", rf.getBuildNodeID())
 	builder.WriteString(", ")
-	if rf.targetNode == nil {
+	if rf.targetNode == nil && rf.targetNodeID == 0 {
 		fmt.Fprintf(&builder, "targetNodeID=nil")
 	} else {
-		fmt.Fprintf(&builder, "targetNodeID=%d", rf.targetNode.ID())
+		fmt.Fprintf(&builder, "targetNodeID=%d", rf.getTargetNodeID())
 	}
 	builder.WriteString(", ")
 	fmt.Fprintf(&builder, "srcColumn=")
@@ -172,22 +239,32 @@ func (rf *RuntimeFilter) String() string {
 	return builder.String()
 }
 
-// Clone deep copy of runtime filter
-func (rf *RuntimeFilter) Clone() *RuntimeFilter {
-	cloned := new(RuntimeFilter)
-	cloned.id = rf.id
-	// Because build node only needs to get its executor id attribute when converting to pb format,
-	// so we only copy explain id here
+// getBuildNodeID returns the plan id of the build node, the cloned runtime filter only keeps the id.
+func (rf *RuntimeFilter) getBuildNodeID() int {
 	if rf.buildNode == nil {
-		cloned.buildNodeID = rf.buildNodeID
-	} else {
-		cloned.buildNodeID = rf.buildNode.ID()
+		return rf.buildNodeID
 	}
+	return rf.buildNode.ID()
+}
+
+// getTargetNodeID returns the plan id of the target node, the cloned runtime filter only keeps the id.
+func (rf *RuntimeFilter) getTargetNodeID() int {
 	if rf.targetNode == nil {
-		cloned.targetNodeID = rf.targetNodeID
-	} else {
-		cloned.targetNodeID = rf.targetNode.ID()
+		return rf.targetNodeID
 	}
+	return rf.targetNode.ID()
+}
+
+// Clone deep copy of runtime filter.
+// The build node and target node aren't copied, because the cloned plan tree has its own nodes,
+// e.g. the plan cloned for the plan cache. The cloned nodes rebind the runtime filters to themselves,
+// and the runtime filter only needs the executor id of the other node when converting to pb format,
+// so we only copy the explain id here.
+func (rf *RuntimeFilter) Clone() *RuntimeFilter {
+	cloned := new(RuntimeFilter)
+	cloned.id = rf.id
+	cloned.buildNodeID = rf.getBuildNodeID()
+	cloned.targetNodeID = rf.getTargetNodeID()
 
 	for _, srcExpr := range rf.srcExprList {
 		cloned.srcExprList = append(cloned.srcExprList, srcExpr.Clone().(*expression.Column))
@@ -238,6 +315,8 @@ func (rf *RuntimeFilter) ToPB(ctx PlanContext, client kv.Client) (*tipb.RuntimeF
 		rfTypePB = tipb.RuntimeFilterType_IN
 	case variable.MinMax:
 		rfTypePB = tipb.RuntimeFilterType_MIN_MAX
+	case variable.BloomFilter:
+		rfTypePB = tipb.RuntimeFilterType_BLOOM_FILTER
 	}
 	rfModePB := tipb.RuntimeFilterMode_LOCAL
 	switch rf.rfMode {
@@ -250,8 +329,8 @@ func (rf *RuntimeFilter) ToPB(ctx PlanContext, client kv.Client) (*tipb.RuntimeF
 		Id:               int32(rf.id),
 		SourceExprList:   srcExprListPB,
 		TargetExprList:   targetExprListPB,
-		SourceExecutorId: fmt.Sprint(rf.buildNodeID),
-		TargetExecutorId: fmt.Sprint(rf.targetNodeID),
+		SourceExecutorId: fmt.Sprint(rf.getBuildNodeID()),
+		TargetExecutorId: fmt.Sprint(rf.getTargetNodeID()),
 		RfType:           rfTypePB,
 		RfMode:           rfModePB,
 	}
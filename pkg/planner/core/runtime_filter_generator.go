@@ -15,11 +15,16 @@
 package core
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
@@ -29,8 +34,26 @@ import (
 type RuntimeFilterGenerator struct {
 	rfIDGenerator                 *util.IDGenerator
 	columnUniqueIDToRF            map[int64][]*RuntimeFilter
+	multiColumnRFs                []*multiColumnRuntimeFilter
 	parentPhysicalPlan            PhysicalPlan
 	childIdxForParentPhysicalPlan int
+	// hintedRFs are the runtime filters forced by the RUNTIME_FILTER hints.
+	hintedRFs []*hintedRuntimeFilter
+}
+
+// hintedRuntimeFilter is a RUNTIME_FILTER(build column -> target column) hint, the runtime filters of the eq predicate
+// between the columns are generated regardless of the estimated filter ratio and the storage of the build side.
+type hintedRuntimeFilter struct {
+	hint ast.HintRuntimeFilter
+	// rfs are the runtime filters generated for the hint, the hint is inapplicable if none of them is assigned.
+	rfs []*RuntimeFilter
+}
+
+// multiColumnRuntimeFilter is an IN runtime filter generated from a composite join key,
+// it waits for the table scan which contains all the target columns.
+type multiColumnRuntimeFilter struct {
+	rf            *RuntimeFilter
+	targetColumns []*expression.Column
 }
 
 // GenerateRuntimeFilter is the root method.
@@ -61,6 +84,8 @@ func (generator *RuntimeFilterGenerator) GenerateRuntimeFilter(plan PhysicalPlan
 		generator.generateRuntimeFilterInterval(physicalPlan)
 	case *PhysicalTableScan:
 		generator.assignRuntimeFilter(physicalPlan)
+	case *PhysicalProjection:
+		generator.remapThroughProjection(physicalPlan)
 	case *PhysicalTableReader:
 		generator.parentPhysicalPlan = plan
 		generator.childIdxForParentPhysicalPlan = 0
@@ -78,33 +103,401 @@ func (generator *RuntimeFilterGenerator) GenerateRuntimeFilter(plan PhysicalPlan
 }
 
 func (generator *RuntimeFilterGenerator) generateRuntimeFilterInterval(hashJoinPlan *PhysicalHashJoin) {
-	// precondition: the storage type of hash join must be TiFlash
+	// precondition: the storage type of hash join must be TiFlash,
+	// except the hash join in TiDB which builds the runtime filters for the table scan of TiKV.
 	if hashJoinPlan.storeTp != kv.TiFlash {
-		logutil.BgLogger().Warn("RF only support TiFlash compute engine while storage type of hash join node is not TiFlash",
-			zap.Int("PhysicalHashJoinId", hashJoinPlan.ID()),
-			zap.String("StoreTP", hashJoinPlan.storeTp.Name()))
+		generator.generateTiKVRuntimeFilter(hashJoinPlan)
 		return
 	}
+	// The runtime filters of the upper hash joins are derived before generating the ones of this hash join,
+	// which target the probe side of this hash join and must not be derived through its own eq predicates.
+	generator.deriveThroughHashJoin(hashJoinPlan)
 	// check hash join pattern
 	if !generator.matchRFJoinType(hashJoinPlan) {
 		return
 	}
 	// check eq predicate pattern
+	matchedEQPredicates := make([]*expression.ScalarFunction, 0, len(hashJoinPlan.EqualConditions))
+	for _, eqPredicate := range hashJoinPlan.EqualConditions {
+		if !generator.matchEQPredicate(eqPredicate, hashJoinPlan.RightIsBuildSide()) {
+			continue
+		}
+		if generator.matchHintedRFs(hashJoinPlan, eqPredicate) != nil || generator.isRFBeneficial(hashJoinPlan, eqPredicate) {
+			matchedEQPredicates = append(matchedEQPredicates, eqPredicate)
+		}
+	}
+	rfTypes := hashJoinPlan.SCtx().GetSessionVars().GetRuntimeFilterTypes()
+	// Use one IN runtime filter for the composite join key instead of one for each column if it's enabled,
+	// the tuples of the join key filter much more rows than the values of each column.
+	if len(matchedEQPredicates) > 1 && slices.Contains(rfTypes, variable.In) &&
+		hashJoinPlan.SCtx().GetSessionVars().IsMultiColumnRuntimeFilterEnabled() {
+		if targetColumns, ok := generator.matchMultiColumnTarget(hashJoinPlan, matchedEQPredicates); ok {
+			multiColumnRF := NewMultiColumnRuntimeFilter(generator.rfIDGenerator, matchedEQPredicates, hashJoinPlan)
+			generator.multiColumnRFs = append(generator.multiColumnRFs, &multiColumnRuntimeFilter{
+				rf:            multiColumnRF,
+				targetColumns: targetColumns,
+			})
+			for _, eqPredicate := range matchedEQPredicates {
+				for _, hintedRF := range generator.matchHintedRFs(hashJoinPlan, eqPredicate) {
+					hintedRF.rfs = append(hintedRF.rfs, multiColumnRF)
+				}
+			}
+			rfTypes = slices.DeleteFunc(slices.Clone(rfTypes), func(rfType RuntimeFilterType) bool {
+				return rfType == variable.In
+			})
+		}
+	}
+	if len(rfTypes) == 0 {
+		return
+	}
+	for _, eqPredicate := range matchedEQPredicates {
+		// construct runtime filter
+		newRFList, targetColumnUniqueID := NewRuntimeFilter(generator.rfIDGenerator, eqPredicate, hashJoinPlan, rfTypes)
+		for _, hintedRF := range generator.matchHintedRFs(hashJoinPlan, eqPredicate) {
+			hintedRF.rfs = append(hintedRF.rfs, newRFList...)
+		}
+		// update generator rf list
+		rfList := generator.columnUniqueIDToRF[targetColumnUniqueID]
+		if rfList == nil {
+			generator.columnUniqueIDToRF[targetColumnUniqueID] = newRFList
+		} else {
+			generator.columnUniqueIDToRF[targetColumnUniqueID] = append(generator.columnUniqueIDToRF[targetColumnUniqueID], newRFList...)
+		}
+	}
+}
+
+// matchMultiColumnTarget returns the target columns of the composite join key,
+// it succeeds only if all of them are the output of the same table scan on the probe side.
+func (generator *RuntimeFilterGenerator) matchMultiColumnTarget(hashJoinPlan *PhysicalHashJoin,
+	eqPredicates []*expression.ScalarFunction) ([]*expression.Column, bool) {
+	targetIdx, probeChild := 1, hashJoinPlan.Children()[1]
+	if hashJoinPlan.RightIsBuildSide() {
+		targetIdx, probeChild = 0, hashJoinPlan.Children()[0]
+	}
+	targetColumns := make([]*expression.Column, 0, len(eqPredicates))
+	for _, eqPredicate := range eqPredicates {
+		targetColumns = append(targetColumns, eqPredicate.GetArgs()[targetIdx].(*expression.Column))
+	}
+	return targetColumns, generator.existsScanWithColumns(probeChild, targetColumns)
+}
+
+func (generator *RuntimeFilterGenerator) existsScanWithColumns(plan PhysicalPlan, columns []*expression.Column) bool {
+	switch physicalPlan := plan.(type) {
+	case *PhysicalTableScan:
+		return containsAllColumns(physicalPlan.schema, columns)
+	case *PhysicalTableReader:
+		return generator.existsScanWithColumns(physicalPlan.tablePlan, columns)
+	}
+	for _, child := range plan.Children() {
+		if generator.existsScanWithColumns(child, columns) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAllColumns(schema *expression.Schema, columns []*expression.Column) bool {
+	for _, column := range columns {
+		if schema.RetrieveColumn(column) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveThroughHashJoin propagates the unassigned runtime filters of the upper hash joins through the eq predicates
+// of the hash join, so they can be assigned to the table scans below it. For example:
+/*
+PhysicalPlanTree:
+      HashJoin_1 (t1.k1=t2.k1, RF1 <- t1.k1)
+       /     \
+ TableScan(t1)  HashJoin_2 (t2.k1=t3.k1)
+                  /     \
+           TableScan(t2)  TableScan(t3)
+
+RF1 targets t2.k1, and it's also derived as RF2 targeting t3.k1 by t2.k1=t3.k1, because the rows of t3 whose k1
+isn't in the build side of HashJoin_1 only join the rows of t2 which are filtered by HashJoin_1 anyway.
+*/
+// It's only done for the inner join and the semi join, whose output rows always satisfy the eq predicates.
+// The other joins may output the rows without a match, e.g. the anti semi join outputs more rows
+// if the rows of the inner side are filtered.
+func (generator *RuntimeFilterGenerator) deriveThroughHashJoin(hashJoinPlan *PhysicalHashJoin) {
+	if hashJoinPlan.JoinType != InnerJoin && hashJoinPlan.JoinType != SemiJoin {
+		return
+	}
+	// The runtime filters are derived from the lists before any derivation of this hash join,
+	// otherwise the derived ones are derived back to the original target columns.
+	originRFs := make(map[int64][]*RuntimeFilter, len(hashJoinPlan.EqualConditions)*2)
 	for _, eqPredicate := range hashJoinPlan.EqualConditions {
-		if generator.matchEQPredicate(eqPredicate, hashJoinPlan.RightIsBuildSide()) {
-			// construct runtime filter
-			newRFList, targetColumnUniqueID := NewRuntimeFilter(generator.rfIDGenerator, eqPredicate, hashJoinPlan)
-			// update generator rf list
-			rfList := generator.columnUniqueIDToRF[targetColumnUniqueID]
-			if rfList == nil {
-				generator.columnUniqueIDToRF[targetColumnUniqueID] = newRFList
-			} else {
-				generator.columnUniqueIDToRF[targetColumnUniqueID] = append(generator.columnUniqueIDToRF[targetColumnUniqueID], newRFList...)
+		for _, arg := range eqPredicate.GetArgs() {
+			col := arg.(*expression.Column)
+			if _, ok := originRFs[col.UniqueID]; !ok {
+				originRFs[col.UniqueID] = slices.Clone(generator.columnUniqueIDToRF[col.UniqueID])
 			}
 		}
 	}
+	for _, eqPredicate := range hashJoinPlan.EqualConditions {
+		if eqPredicate.FuncName.L == ast.NullEQ {
+			continue
+		}
+		lCol, rCol := eqPredicate.GetArgs()[0].(*expression.Column), eqPredicate.GetArgs()[1].(*expression.Column)
+		// The values of the runtime filter are compared with the derived target column in the same way
+		// only if the types of the columns are the same.
+		if !lCol.GetType().Equal(rCol.GetType()) {
+			continue
+		}
+		generator.deriveRuntimeFilters(originRFs[lCol.UniqueID], lCol, rCol)
+		generator.deriveRuntimeFilters(originRFs[rCol.UniqueID], rCol, lCol)
+	}
 }
 
+// deriveRuntimeFilters derives the unassigned runtime filters targeting the column to the new target column.
+func (generator *RuntimeFilterGenerator) deriveRuntimeFilters(rfList []*RuntimeFilter, targetColumn, newTargetColumn *expression.Column) {
+	if newTargetColumn.IsHidden || newTargetColumn.OrigName == "" {
+		return
+	}
+	for _, rf := range rfList {
+		if rf.targetNode != nil {
+			continue
+		}
+		derivedRF := &RuntimeFilter{
+			id:          generator.rfIDGenerator.GetNextID(),
+			buildNode:   rf.buildNode,
+			srcExprList: rf.srcExprList,
+			rfType:      rf.rfType,
+		}
+		generator.columnUniqueIDToRF[newTargetColumn.UniqueID] = append(generator.columnUniqueIDToRF[newTargetColumn.UniqueID], derivedRF)
+		logutil.BgLogger().Debug("Derive RF through hash join",
+			zap.String("TargetColumn", targetColumn.String()),
+			zap.String("NewTargetColumn", newTargetColumn.String()))
+	}
+}
+
+// remapThroughProjection maps the unassigned runtime filters targeting the output columns of the projection
+// to the columns of its child, if the output column is exactly the column of the child.
+func (generator *RuntimeFilterGenerator) remapThroughProjection(projection *PhysicalProjection) {
+	for i, expr := range projection.Exprs {
+		childColumn, ok := expr.(*expression.Column)
+		outputColumn := projection.schema.Columns[i]
+		if !ok || childColumn.UniqueID == outputColumn.UniqueID {
+			continue
+		}
+		for _, rf := range generator.columnUniqueIDToRF[outputColumn.UniqueID] {
+			if rf.targetNode != nil || slices.Contains(generator.columnUniqueIDToRF[childColumn.UniqueID], rf) {
+				continue
+			}
+			generator.columnUniqueIDToRF[childColumn.UniqueID] = append(generator.columnUniqueIDToRF[childColumn.UniqueID], rf)
+		}
+	}
+}
+
+// generateTiKVRuntimeFilter generates the runtime filters for the hash join in TiDB whose build side reads from TiFlash
+// and probe side reads from TiKV, or the hash semi join of the EXISTS/IN subquery whose build side is the subquery,
+// the result set of the subquery is usually small and prunes the outer table scan well.
+// TiKV doesn't accept runtime filters, so the hash join collects the IN/MIN_MAX set
+// of the build side itself, then converts it to the conditions pushed down to the reader of the probe side.
+// The probe side must be a table reader with a pushed-down selection right above the table scan, or an index reader
+// or an index lookup reader reading the target column from the index, see matchTiKVTargetScan. The conditions
+// are appended to the selection right above the scan, and only the target columns of numeric and time types are supported,
+// because the values of the other types may be compared differently in TiKV, such as the strings with collations.
+// The eq predicates forced by the RUNTIME_FILTER hints get the runtime filters whatever the build side is.
+func (generator *RuntimeFilterGenerator) generateTiKVRuntimeFilter(hashJoinPlan *PhysicalHashJoin) {
+	if !generator.matchRFJoinType(hashJoinPlan) {
+		return
+	}
+	buildChild, probeChild, targetIdx := hashJoinPlan.Children()[0], hashJoinPlan.Children()[1], 1
+	if hashJoinPlan.RightIsBuildSide() {
+		buildChild, probeChild, targetIdx = hashJoinPlan.Children()[1], hashJoinPlan.Children()[0], 0
+	}
+	buildSideMatched := readsFromTiFlash(buildChild) || isSubqueryBuildSemiJoin(hashJoinPlan)
+	if !buildSideMatched && len(generator.hintedRFs) == 0 {
+		return
+	}
+	targetScan := matchTiKVTargetScan(probeChild)
+	if targetScan == nil {
+		logutil.BgLogger().Debug("The probe side does not match the TiKV RF pattern",
+			zap.Int("PhysicalHashJoinId", hashJoinPlan.ID()))
+		return
+	}
+	rfTypes := slices.DeleteFunc(slices.Clone(hashJoinPlan.SCtx().GetSessionVars().GetRuntimeFilterTypes()), func(rfType RuntimeFilterType) bool {
+		return rfType != variable.In && rfType != variable.MinMax
+	})
+	if len(rfTypes) == 0 {
+		return
+	}
+	for _, eqPredicate := range hashJoinPlan.EqualConditions {
+		if !generator.matchEQPredicate(eqPredicate, hashJoinPlan.RightIsBuildSide()) {
+			continue
+		}
+		hintedRFs := generator.matchHintedRFs(hashJoinPlan, eqPredicate)
+		if hintedRFs == nil && (!buildSideMatched || !generator.isRFBeneficial(hashJoinPlan, eqPredicate)) {
+			continue
+		}
+		srcColumn := eqPredicate.GetArgs()[1-targetIdx].(*expression.Column)
+		targetColumn := targetScan.Schema().RetrieveColumn(eqPredicate.GetArgs()[targetIdx].(*expression.Column))
+		if targetColumn == nil {
+			continue
+		}
+		switch evalType := targetColumn.GetType().EvalType(); evalType {
+		case types.ETInt, types.ETReal, types.ETDecimal, types.ETDatetime, types.ETDuration:
+			if srcColumn.GetType().EvalType() != evalType {
+				continue
+			}
+		default:
+			continue
+		}
+		newRFList, _ := NewRuntimeFilter(generator.rfIDGenerator, eqPredicate, hashJoinPlan, rfTypes)
+		for _, rf := range newRFList {
+			rf.assignToTiKV(targetScan, targetColumn)
+		}
+		for _, hintedRF := range hintedRFs {
+			hintedRF.rfs = append(hintedRF.rfs, newRFList...)
+		}
+	}
+}
+
+// matchHintedRFs returns the RUNTIME_FILTER hints whose build column is the join key of the build side
+// and target column is the join key of the probe side in the eq predicate.
+func (generator *RuntimeFilterGenerator) matchHintedRFs(hashJoinPlan *PhysicalHashJoin, eqPredicate *expression.ScalarFunction) []*hintedRuntimeFilter {
+	if len(generator.hintedRFs) == 0 {
+		return nil
+	}
+	srcIdx, targetIdx, buildChild, probeChild := 0, 1, hashJoinPlan.Children()[0], hashJoinPlan.Children()[1]
+	if hashJoinPlan.RightIsBuildSide() {
+		srcIdx, targetIdx, buildChild, probeChild = 1, 0, hashJoinPlan.Children()[1], hashJoinPlan.Children()[0]
+	}
+	srcScan := findScanWithColumn(buildChild, eqPredicate.GetArgs()[srcIdx].(*expression.Column))
+	targetScan := findScanWithColumn(probeChild, eqPredicate.GetArgs()[targetIdx].(*expression.Column))
+	if srcScan == nil || targetScan == nil {
+		return nil
+	}
+	var matched []*hintedRuntimeFilter
+	for _, hintedRF := range generator.hintedRFs {
+		if matchHintedColumn(hintedRF.hint.Build, srcScan, eqPredicate.GetArgs()[srcIdx].(*expression.Column)) &&
+			matchHintedColumn(hintedRF.hint.Target, targetScan, eqPredicate.GetArgs()[targetIdx].(*expression.Column)) {
+			matched = append(matched, hintedRF)
+		}
+	}
+	return matched
+}
+
+// findScanWithColumn returns the table scan in the plan which outputs the column.
+func findScanWithColumn(plan PhysicalPlan, column *expression.Column) *PhysicalTableScan {
+	switch physicalPlan := plan.(type) {
+	case *PhysicalTableScan:
+		if physicalPlan.schema.Contains(column) {
+			return physicalPlan
+		}
+		return nil
+	case *PhysicalTableReader:
+		return findScanWithColumn(physicalPlan.tablePlan, column)
+	}
+	for _, child := range plan.Children() {
+		if scan := findScanWithColumn(child, column); scan != nil {
+			return scan
+		}
+	}
+	return nil
+}
+
+// matchHintedColumn checks whether the column of the table scan is the column in the hint,
+// the table in the hint can be either the alias or the name of the table.
+func matchHintedColumn(hintedColumn *ast.ColumnName, scan *PhysicalTableScan, column *expression.Column) bool {
+	if hintedColumn.Schema.L != "" && hintedColumn.Schema.L != scan.DBName.L {
+		return false
+	}
+	if hintedColumn.Table.L != "" {
+		tblName := scan.Table.Name
+		if scan.TableAsName != nil && scan.TableAsName.L != "" {
+			tblName = *scan.TableAsName
+		}
+		if hintedColumn.Table.L != tblName.L {
+			return false
+		}
+	}
+	// The original name of the column is in the format of db.table.column.
+	colName := column.OrigName[strings.LastIndexByte(column.OrigName, '.')+1:]
+	return hintedColumn.Name.L == strings.ToLower(colName)
+}
+
+// warnInapplicableHints warns the RUNTIME_FILTER hints for which no runtime filter is generated and assigned.
+func (generator *RuntimeFilterGenerator) warnInapplicableHints(sctx PlanContext) {
+	for _, hintedRF := range generator.hintedRFs {
+		if slices.ContainsFunc(hintedRF.rfs, func(rf *RuntimeFilter) bool { return rf.targetNode != nil }) {
+			continue
+		}
+		sctx.GetSessionVars().StmtCtx.SetHintWarning(fmt.Sprintf("RUNTIME_FILTER(%s -> %s) is inapplicable, "+
+			"the build column and the target column must be the join keys of the build side and the probe side of a hash join",
+			hintedRF.hint.Build.String(), hintedRF.hint.Target.String()))
+	}
+}
+
+// readsFromTiFlash checks whether the plan reads from TiFlash.
+func readsFromTiFlash(plan PhysicalPlan) bool {
+	if reader, ok := plan.(*PhysicalTableReader); ok {
+		return reader.StoreType == kv.TiFlash
+	}
+	for _, child := range plan.Children() {
+		if readsFromTiFlash(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSubqueryBuildSemiJoin checks whether the hash join is a semi join whose build side is the inner side,
+// which is the subquery of EXISTS/IN, so the rows of the outer side without a match can be filtered at once.
+func isSubqueryBuildSemiJoin(hashJoinPlan *PhysicalHashJoin) bool {
+	return hashJoinPlan.JoinType == SemiJoin && !hashJoinPlan.UseOuterToBuild && hashJoinPlan.RightIsBuildSide()
+}
+
+// matchTiKVTargetScan returns the scan of the probe side which the runtime filters are pushed down to, it's
+//   - the table scan of a TiKV table reader with only a selection above it,
+//   - the index scan of an index reader with at most a selection above it,
+//   - the index scan of an index lookup reader without a pushed-down limit, the runtime filters only target
+//     the columns read from the index, so the rows are filtered before looking up the table.
+//
+// The executors pushed down above the scan, such as the limit and the aggregation, aren't allowed,
+// since the runtime filters must be applied before them to keep the results.
+func matchTiKVTargetScan(probeChild PhysicalPlan) PhysicalPlan {
+	switch reader := probeChild.(type) {
+	case *PhysicalTableReader:
+		if reader.StoreType != kv.TiKV {
+			return nil
+		}
+		sel, ok := reader.tablePlan.(*PhysicalSelection)
+		if !ok {
+			return nil
+		}
+		if scan, ok := sel.children[0].(*PhysicalTableScan); ok {
+			return scan
+		}
+	case *PhysicalIndexReader:
+		if scan := matchIndexScan(reader.indexPlan); scan != nil {
+			return scan
+		}
+	case *PhysicalIndexLookUpReader:
+		if reader.PushedLimit != nil {
+			return nil
+		}
+		if scan := matchIndexScan(reader.indexPlan); scan != nil {
+			return scan
+		}
+	}
+	return nil
+}
+
+// matchIndexScan returns the index scan if the plan is the index scan or a selection right above it.
+func matchIndexScan(indexPlan PhysicalPlan) *PhysicalIndexScan {
+	if sel, ok := indexPlan.(*PhysicalSelection); ok {
+		indexPlan = sel.children[0]
+	}
+	scan, _ := indexPlan.(*PhysicalIndexScan)
+	return scan
+}
+
+// assignRuntimeFilter assigns the runtime filters of the hash joins in TiFlash to the table scan.
+// TiFlash never reads through an index scan, the index scans of TiKV are assigned by generateTiKVRuntimeFilter.
 func (generator *RuntimeFilterGenerator) assignRuntimeFilter(physicalTableScan *PhysicalTableScan) {
 	// match rf for current scan node
 	cacheBuildNodeIDToRFMode := map[int]RuntimeFilterMode{}
@@ -112,28 +505,28 @@ func (generator *RuntimeFilterGenerator) assignRuntimeFilter(physicalTableScan *
 	for _, scanOutputColumn := range physicalTableScan.schema.Columns {
 		currentColumnRFList := generator.columnUniqueIDToRF[scanOutputColumn.UniqueID]
 		for _, runtimeFilter := range currentColumnRFList {
-			// compute rf mode
-			var rfMode RuntimeFilterMode
-			if cacheBuildNodeIDToRFMode[runtimeFilter.buildNode.ID()] != 0 {
-				rfMode = cacheBuildNodeIDToRFMode[runtimeFilter.buildNode.ID()]
-			} else {
-				rfMode = generator.calculateRFMode(runtimeFilter.buildNode, physicalTableScan)
-				cacheBuildNodeIDToRFMode[runtimeFilter.buildNode.ID()] = rfMode
+			// The runtime filter may be remapped to more than one column by the projections.
+			if runtimeFilter.targetNode != nil {
+				continue
 			}
-			// todo support global RF
-			if rfMode == variable.RFGlobal {
-				logutil.BgLogger().Debug("Now we don't support global RF. Remove it",
-					zap.Int("BuildNodeId", runtimeFilter.buildNode.ID()),
-					zap.Int("TargetNodeId", physicalTableScan.ID()))
+			if !generator.matchRFMode(runtimeFilter, physicalTableScan, cacheBuildNodeIDToRFMode) {
 				continue
 			}
-			runtimeFilter.rfMode = rfMode
-
 			// assign rf to current node
 			runtimeFilter.assign(physicalTableScan, scanOutputColumn)
 			currentRFList = append(currentRFList, runtimeFilter)
 		}
 	}
+	for _, multiColumnRF := range generator.multiColumnRFs {
+		if multiColumnRF.rf.targetNode != nil || !containsAllColumns(physicalTableScan.schema, multiColumnRF.targetColumns) {
+			continue
+		}
+		if !generator.matchRFMode(multiColumnRF.rf, physicalTableScan, cacheBuildNodeIDToRFMode) {
+			continue
+		}
+		multiColumnRF.rf.assign(physicalTableScan, multiColumnRF.targetColumns...)
+		currentRFList = append(currentRFList, multiColumnRF.rf)
+	}
 
 	if len(currentRFList) == 0 {
 		return
@@ -156,6 +549,29 @@ func (generator *RuntimeFilterGenerator) assignRuntimeFilter(physicalTableScan *
 	// filter predicate selectivity, A scan node does not need many RFs, and the same column does not need many RFs
 }
 
+// matchRFMode computes the mode of the runtime filter for the target scan,
+// it returns false if the runtime filter can't be assigned to the scan in this mode.
+func (generator *RuntimeFilterGenerator) matchRFMode(runtimeFilter *RuntimeFilter, physicalTableScan *PhysicalTableScan,
+	cacheBuildNodeIDToRFMode map[int]RuntimeFilterMode) bool {
+	// compute rf mode
+	var rfMode RuntimeFilterMode
+	if cacheBuildNodeIDToRFMode[runtimeFilter.buildNode.ID()] != 0 {
+		rfMode = cacheBuildNodeIDToRFMode[runtimeFilter.buildNode.ID()]
+	} else {
+		rfMode = generator.calculateRFMode(runtimeFilter.buildNode, physicalTableScan)
+		cacheBuildNodeIDToRFMode[runtimeFilter.buildNode.ID()] = rfMode
+	}
+	// todo support global RF
+	if rfMode == variable.RFGlobal {
+		logutil.BgLogger().Debug("Now we don't support global RF. Remove it",
+			zap.Int("BuildNodeId", runtimeFilter.buildNode.ID()),
+			zap.Int("TargetNodeId", physicalTableScan.ID()))
+		return false
+	}
+	runtimeFilter.rfMode = rfMode
+	return true
+}
+
 func (*RuntimeFilterGenerator) matchRFJoinType(hashJoinPlan *PhysicalHashJoin) bool {
 	if hashJoinPlan.RightIsBuildSide() {
 		// case1: build side is on the right
@@ -220,6 +636,45 @@ func (*RuntimeFilterGenerator) matchEQPredicate(eqPredicate *expression.ScalarFu
 	return true
 }
 
+// isRFBeneficial estimates the ratio of the probe side rows filtered by the runtime filter of the eq predicate.
+// Assuming the values are uniformly distributed, the ratio is 1 - NDV(src column) / NDV(target column).
+// The runtime filter is skipped if the ratio is below tidb_runtime_filter_min_filter_ratio,
+// since building, sending and applying it costs more than it saves.
+// The RUNTIME_FILTER() and NO_RUNTIME_FILTER() hints override the estimation.
+func (*RuntimeFilterGenerator) isRFBeneficial(hashJoinPlan *PhysicalHashJoin, eqPredicate *expression.ScalarFunction) bool {
+	stmtHints := &hashJoinPlan.SCtx().GetSessionVars().StmtCtx.StmtHints
+	if stmtHints.HasRuntimeFilterHint {
+		return stmtHints.EnableRuntimeFilter
+	}
+	minFilterRatio := hashJoinPlan.SCtx().GetSessionVars().GetRuntimeFilterMinFilterRatio()
+	if minFilterRatio <= 0 {
+		return true
+	}
+	srcIdx, targetIdx, probeChild := 0, 1, hashJoinPlan.Children()[1]
+	if hashJoinPlan.RightIsBuildSide() {
+		srcIdx, targetIdx, probeChild = 1, 0, hashJoinPlan.Children()[0]
+	}
+	srcColumn := eqPredicate.GetArgs()[srcIdx].(*expression.Column)
+	targetColumn := eqPredicate.GetArgs()[targetIdx].(*expression.Column)
+	probeStats := probeChild.StatsInfo()
+	targetNDV := probeStats.RowCount
+	if colNDV, ok := probeStats.ColNDVs[targetColumn.UniqueID]; ok && colNDV < targetNDV {
+		targetNDV = colNDV
+	}
+	filterRatio := 0.0
+	if targetNDV > 0 {
+		filterRatio = 1 - float64(estimateBuildSideNDV(hashJoinPlan, srcColumn))/targetNDV
+	}
+	if filterRatio < minFilterRatio {
+		logutil.BgLogger().Debug("The runtime filter is expected to filter too few rows",
+			zap.String("EQPredicate", eqPredicate.String()),
+			zap.Float64("FilterRatio", filterRatio),
+			zap.Float64("MinFilterRatio", minFilterRatio))
+		return false
+	}
+	return true
+}
+
 func (generator *RuntimeFilterGenerator) calculateRFMode(buildNode *PhysicalHashJoin, targetNode *PhysicalTableScan) variable.RuntimeFilterMode {
 	if generator.belongsToSameFragment(buildNode, targetNode) {
 		return variable.RFLocal
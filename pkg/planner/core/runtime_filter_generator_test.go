@@ -88,3 +88,169 @@ func TestRuntimeFilterGenerator(t *testing.T) {
 		tk.MustQuery("explain " + ts).Check(testkit.Rows(output[i].Plan...))
 	}
 }
+
+func TestBloomFilterRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	prepareMPPRuntimeFilterTables(t, tk, "create table t1 (k1 int)", "create table t2 (k1 int)")
+	tk.MustExec("set tidb_runtime_filter_type=bloom_filter")
+	checkRuntimeFilterPlans(t, tk)
+	tk.MustGetErrMsg("set tidb_runtime_filter_type='IN,BF'",
+		"incorrect value: IN,BF. tidb_runtime_filter_type should be sepreated by , such as IN, also we only support IN, MIN_MAX and BLOOM_FILTER now. ")
+}
+
+func TestMultiColumnRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	prepareMPPRuntimeFilterTables(t, tk, "create table t1 (k1 int, k2 int)", "create table t2 (k1 int, k2 int)")
+	tk.MustExec("set tidb_runtime_filter_type='IN,MIN_MAX'")
+	checkRuntimeFilterPlans(t, tk)
+}
+
+func TestRuntimeFilterMinFilterRatio(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	prepareMPPRuntimeFilterTables(t, tk, "create table t1 (k1 int)", "create table t2 (k1 int)")
+	tk.MustQuery("select @@tidb_runtime_filter_min_filter_ratio").Check(testkit.Rows("0"))
+	checkRuntimeFilterPlans(t, tk)
+}
+
+func TestRuntimeFilterHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	prepareMPPRuntimeFilterTables(t, tk, "create table t1 (k1 int, k2 int)", "create table t2 (k1 int, k2 int)")
+	tk.MustExec("set tidb_runtime_filter_min_filter_ratio=0.5")
+	checkRuntimeFilterPlans(t, tk)
+}
+
+func TestTiKVRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (k1 int, s1 varchar(10))")
+	tk.MustExec("create table t2 (k1 int, k2 int, s1 varchar(10))")
+	tk.MustExec("analyze table t1, t2")
+	dom := domain.GetDomain(tk.Session())
+	is := dom.InfoSchema()
+	tbl, err := is.TableByName(model.NewCIStr("test"), model.NewCIStr("t1"))
+	require.NoError(t, err)
+	tbl.Meta().TiFlashReplica = &model.TiFlashReplicaInfo{
+		Count:     1,
+		Available: true,
+	}
+
+	tk.MustExec("set @@tidb_allow_mpp=0")
+	tk.MustExec("set @@tidb_allow_tiflash_cop=1")
+	tk.MustExec("set tidb_runtime_filter_mode=LOCAL")
+	tk.MustExec("set tidb_runtime_filter_type='IN,MIN_MAX,BLOOM_FILTER'")
+	checkRuntimeFilterPlans(t, tk)
+}
+
+func TestSemiJoinRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (k1 int, s1 varchar(10))")
+	tk.MustExec("create table t2 (k1 int, k2 int, s1 varchar(10))")
+	tk.MustExec("insert into t1 values (1, 'a'), (2, 'b')")
+	tk.MustExec("insert into t2 values (1, 2, 'a'), (2, 3, 'b'), (3, 4, 'c'), (4, 5, 'd')")
+	tk.MustExec("analyze table t1, t2")
+
+	tk.MustExec("set tidb_runtime_filter_mode=LOCAL")
+	tk.MustExec("set tidb_runtime_filter_type='IN,MIN_MAX'")
+	tk.MustExec("set tidb_opt_insubq_to_join_and_agg=0")
+	checkRuntimeFilterPlans(t, tk)
+	// the runtime filters don't filter the rows with a match in the subquery.
+	tk.MustQuery("select /*+ hash_join(t2) */ k1, k2 from t2 where t2.k2 > 1 and exists (select 1 from t1 where t1.k1 = t2.k1) order by k1").
+		Check(testkit.Rows("1 2", "2 3"))
+	tk.MustQuery("select /*+ hash_join(t2) */ k1, k2 from t2 where t2.k2 > 1 and t2.k1 in (select k1 from t1) order by k1").
+		Check(testkit.Rows("1 2", "2 3"))
+}
+
+func TestIndexScanRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (k1 int)")
+	tk.MustExec("create table t2 (k1 int, k2 int, k3 int, key k1(k1), key k1k2(k1, k2))")
+	tk.MustExec("insert into t1 values (20), (30)")
+	tk.MustExec("insert into t2 values (10, 1, 1), (20, 2, 2), (30, 3, 3), (40, 4, 4)")
+	tk.MustExec("analyze table t1, t2")
+	dom := domain.GetDomain(tk.Session())
+	is := dom.InfoSchema()
+	tbl, err := is.TableByName(model.NewCIStr("test"), model.NewCIStr("t1"))
+	require.NoError(t, err)
+	tbl.Meta().TiFlashReplica = &model.TiFlashReplicaInfo{
+		Count:     1,
+		Available: true,
+	}
+
+	tk.MustExec("set @@tidb_allow_mpp=0")
+	tk.MustExec("set @@tidb_allow_tiflash_cop=1")
+	tk.MustExec("set tidb_runtime_filter_mode=LOCAL")
+	tk.MustExec("set tidb_runtime_filter_type='IN,MIN_MAX'")
+	checkRuntimeFilterPlans(t, tk)
+}
+
+func TestTransitiveRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	prepareMPPRuntimeFilterTables(t, tk, "create table t1 (k1 int)", "create table t2 (k1 int, k2 int)", "create table t3 (k1 int, k2 int)")
+	tk.MustExec("INSERT INTO mysql.opt_rule_blacklist VALUES(\"join_reorder\");")
+	tk.MustExec("admin reload opt_rule_blacklist;")
+	defer func() {
+		tk.MustExec("DELETE FROM mysql.opt_rule_blacklist WHERE name = \"join_reorder\";")
+		tk.MustExec("admin reload opt_rule_blacklist;")
+	}()
+	tk.MustExec("set tidb_runtime_filter_type='IN'")
+	checkRuntimeFilterPlans(t, tk)
+}
+
+// prepareMPPRuntimeFilterTables creates the tables with the TiFlash replicas in the test database,
+// and enables the local runtime filters of the MPP plans whose build side is the first child of the hash join.
+func prepareMPPRuntimeFilterTables(t *testing.T, tk *testkit.TestKit, createTableSQLs ...string) {
+	tk.MustExec("use test")
+	for _, sql := range createTableSQLs {
+		tk.MustExec(sql)
+	}
+	dom := domain.GetDomain(tk.Session())
+	is := dom.InfoSchema()
+	db, exists := is.SchemaByName(model.NewCIStr("test"))
+	require.True(t, exists)
+	for _, tbl := range is.SchemaTables(db.Name) {
+		tk.MustExec("analyze table " + tbl.Meta().Name.O)
+		tbl.Meta().TiFlashReplica = &model.TiFlashReplicaInfo{
+			Count:     1,
+			Available: true,
+		}
+	}
+	tk.MustExec("set @@tidb_allow_mpp=1; set @@tidb_enforce_mpp=1;")
+	tk.MustExec("set tidb_runtime_filter_mode=LOCAL")
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/planner/core/mockPreferredBuildIndex", fmt.Sprintf(`return(%d)`, 0)))
+	t.Cleanup(func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/planner/core/mockPreferredBuildIndex"))
+	})
+}
+
+// checkRuntimeFilterPlans checks the plans of the cases of the test in the runtime filter generator suite.
+func checkRuntimeFilterPlans(t *testing.T, tk *testkit.TestKit) {
+	var (
+		input  []string
+		output []struct {
+			SQL  string
+			Plan []string
+			Warn []string
+		}
+	)
+	planSuiteData := core.GetRuntimeFilterGeneratorData()
+	planSuiteData.LoadTestCasesByName(t.Name(), t, &input, &output)
+	for i, ts := range input {
+		testdata.OnRecord(func() {
+			output[i].SQL = ts
+			output[i].Plan = testdata.ConvertRowsToStrings(tk.MustQuery("explain " + ts).Rows())
+			output[i].Warn = testdata.ConvertSQLWarnToStrings(tk.Session().GetSessionVars().StmtCtx.GetWarnings())
+		})
+		tk.MustQuery("explain " + ts).Check(testkit.Rows(output[i].Plan...))
+		require.Equal(t, output[i].Warn, testdata.ConvertSQLWarnToStrings(tk.Session().GetSessionVars().StmtCtx.GetWarnings()))
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/format"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+// sharedSubplanCTEPrefix is the name prefix of the CTEs generated for the shared derived tables and table scans.
+const sharedSubplanCTEPrefix = "__tidb_shared_subplan_"
+
+// nonDeterministicFuncs are the functions that may return different results in every execution,
+// the derived tables containing them can't be shared.
+var nonDeterministicFuncs = map[string]struct{}{
+	ast.Rand:            {},
+	ast.RandomBytes:     {},
+	ast.UUID:            {},
+	ast.UUIDShort:       {},
+	ast.Sysdate:         {},
+	ast.Sleep:           {},
+	ast.Benchmark:       {},
+	ast.NextVal:         {},
+	ast.LastVal:         {},
+	ast.SetVal:          {},
+	ast.GetLock:         {},
+	ast.ReleaseLock:     {},
+	ast.ReleaseAllLocks: {},
+	ast.FoundRows:       {},
+	ast.RowCount:        {},
+	ast.LastInsertId:    {},
+}
+
+// sharableSource is a derived table or a filtered table scan which can be shared by the set
+// operation branches.
+type sharableSource struct {
+	ts    *ast.TableSource
+	query ast.ResultSetNode
+	// scan is the branch whose table scan is shared, the WHERE clause of it is moved into the CTE.
+	scan *ast.SelectStmt
+}
+
+// shareIdenticalDerivedTables rewrites the identical derived tables in the FROM clauses of the
+// set operation branches, and the identical filtered scans of a single table which are the FROM
+// and WHERE clauses of the branches, to the references of one CTE. The CTE is referenced more
+// than once, so it won't be inlined, and the shared part is executed only once and its result
+// is shared by all the branches through the CTE storage.
+// The original statement is not modified, a rewritten copy is returned if anything is shared.
+func shareIdenticalDerivedTables(setOpr *ast.SetOprStmt) *ast.SetOprStmt {
+	// The generated CTEs are appended to the WITH clause of the statement, so the names in them
+	// are resolved in the same way as in the branches. The CTEs defined inside the branches are
+	// not visible there, so such statements are skipped.
+	if setOpr.SelectList == nil || setOpr.SelectList.With != nil {
+		return setOpr
+	}
+	selects := setOpr.SelectList.Selects
+	groups := make(map[string][]sharableSource)
+	keys := make([]string, 0)
+	add := func(key string, source sharableSource) {
+		if _, exists := groups[key]; !exists {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], source)
+	}
+	for _, node := range selects {
+		sel, ok := node.(*ast.SelectStmt)
+		if !ok || sel.With != nil || sel.From == nil {
+			continue
+		}
+		if ts, query, ok := sharableTableScan(sel); ok {
+			// The query is the same as a derived table selecting all the columns of the scan,
+			// so they share the key space and a scan can be shared with such a derived table.
+			if key, ok := sharableNodeKey(query); ok {
+				add(key, sharableSource{ts: ts, query: query, scan: sel})
+			}
+			continue
+		}
+		for _, ts := range collectDerivedTables(sel.From.TableRefs, nil) {
+			if key, ok := sharableDerivedTableKey(ts); ok {
+				add(key, sharableSource{ts: ts, query: ts.Source})
+			}
+		}
+	}
+
+	replaced := make(map[*ast.TableSource]*ast.TableSource)
+	scans := make(map[*ast.SelectStmt]struct{})
+	ctes := make([]*ast.CommonTableExpression, 0)
+	for _, key := range keys {
+		sources := groups[key]
+		if len(sources) < 2 {
+			continue
+		}
+		name := model.NewCIStr(fmt.Sprintf("%s%d", sharedSubplanCTEPrefix, len(ctes)))
+		ctes = append(ctes, &ast.CommonTableExpression{
+			Name:          name,
+			Query:         &ast.SubqueryExpr{Query: sources[0].query},
+			ConsumerCount: len(sources),
+		})
+		for _, source := range sources {
+			asName := source.ts.AsName
+			if source.scan != nil {
+				scans[source.scan] = struct{}{}
+				if asName.L == "" {
+					// The columns of the scan may be qualified by the table name.
+					asName = source.ts.Source.(*ast.TableName).Name
+				}
+			}
+			replaced[source.ts] = &ast.TableSource{Source: &ast.TableName{Name: name}, AsName: asName}
+		}
+	}
+	if len(ctes) == 0 {
+		return setOpr
+	}
+
+	newSelects := make([]ast.Node, 0, len(selects))
+	for _, node := range selects {
+		sel, ok := node.(*ast.SelectStmt)
+		if !ok || sel.From == nil {
+			newSelects = append(newSelects, node)
+			continue
+		}
+		refs, changed := replaceDerivedTables(sel.From.TableRefs, replaced)
+		if !changed {
+			newSelects = append(newSelects, node)
+			continue
+		}
+		newSel := *sel
+		newSel.From = &ast.TableRefsClause{TableRefs: refs.(*ast.Join)}
+		if _, ok := scans[sel]; ok {
+			newSel.Where = nil
+		}
+		newSelects = append(newSelects, &newSel)
+	}
+	newList := *setOpr.SelectList
+	newList.Selects = newSelects
+	newSetOpr := *setOpr
+	newSetOpr.SelectList = &newList
+	if setOpr.With != nil {
+		with := *setOpr.With
+		with.CTEs = append(slices.Clip(setOpr.With.CTEs), ctes...)
+		newSetOpr.With = &with
+	} else {
+		newSetOpr.With = &ast.WithClause{CTEs: ctes}
+	}
+	return &newSetOpr
+}
+
+// sharableTableScan returns the table of the branch and the query selecting all its columns with
+// the WHERE clause of the branch, if the FROM clause of the branch is a single table and the table
+// can be replaced by the result of the query.
+func sharableTableScan(sel *ast.SelectStmt) (*ast.TableSource, ast.ResultSetNode, bool) {
+	if (sel.LockInfo != nil && sel.LockInfo.LockType != ast.SelectLockNone) || len(sel.TableHints) > 0 {
+		return nil, nil, false
+	}
+	join := sel.From.TableRefs
+	if join == nil || join.Right != nil {
+		return nil, nil, false
+	}
+	ts, ok := join.Left.(*ast.TableSource)
+	if !ok {
+		return nil, nil, false
+	}
+	tn, ok := ts.Source.(*ast.TableName)
+	if !ok || len(tn.IndexHints) > 0 || len(tn.PartitionNames) > 0 || tn.TableSample != nil || tn.AsOf != nil {
+		return nil, nil, false
+	}
+	checker := &columnRefChecker{}
+	sel.Accept(checker)
+	if checker.found {
+		return nil, nil, false
+	}
+	query := &ast.SelectStmt{
+		SelectStmtOpts: &ast.SelectStmtOpts{SQLCache: true},
+		Kind:           ast.SelectStmtKindSelect,
+		Fields:         &ast.FieldList{Fields: []*ast.SelectField{{WildCard: &ast.WildCardField{}}}},
+		From:           &ast.TableRefsClause{TableRefs: &ast.Join{Left: ts}},
+		Where:          sel.Where,
+	}
+	return ts, query, true
+}
+
+func collectDerivedTables(node ast.ResultSetNode, sources []*ast.TableSource) []*ast.TableSource {
+	switch x := node.(type) {
+	case *ast.Join:
+		sources = collectDerivedTables(x.Left, sources)
+		if x.Right != nil {
+			sources = collectDerivedTables(x.Right, sources)
+		}
+	case *ast.TableSource:
+		switch x.Source.(type) {
+		case *ast.SelectStmt, *ast.SetOprStmt:
+			sources = append(sources, x)
+		case *ast.Join:
+			sources = collectDerivedTables(x.Source, sources)
+		}
+	}
+	return sources
+}
+
+func replaceDerivedTables(node ast.ResultSetNode, replaced map[*ast.TableSource]*ast.TableSource) (ast.ResultSetNode, bool) {
+	switch x := node.(type) {
+	case *ast.Join:
+		left, leftChanged := replaceDerivedTables(x.Left, replaced)
+		right, rightChanged := x.Right, false
+		if x.Right != nil {
+			right, rightChanged = replaceDerivedTables(x.Right, replaced)
+		}
+		if !leftChanged && !rightChanged {
+			return node, false
+		}
+		join := *x
+		join.Left, join.Right = left, right
+		return &join, true
+	case *ast.TableSource:
+		if ts, ok := replaced[x]; ok {
+			return ts, true
+		}
+		if _, ok := x.Source.(*ast.Join); ok {
+			source, changed := replaceDerivedTables(x.Source, replaced)
+			if changed {
+				ts := *x
+				ts.Source = source
+				return &ts, true
+			}
+		}
+	}
+	return node, false
+}
+
+// sharableDerivedTableKey returns the text of the derived table, the derived tables with the same
+// text are identical and can be shared.
+func sharableDerivedTableKey(ts *ast.TableSource) (string, bool) {
+	return sharableNodeKey(ts.Source)
+}
+
+// subqueryResultCacheKey returns the key to share the result of the uncorrelated subquery among
+// its identical occurrences in the statement, tp distinguishes the kinds of the subquery results.
+func subqueryResultCacheKey(planCtx *exprRewriterPlanCtx, tp string, subq *ast.SubqueryExpr) (string, bool) {
+	if !planCtx.builder.ctx.GetSessionVars().EnableSubqueryResultReuse {
+		return "", false
+	}
+	// The subqueries in the different scopes of the CTEs may refer to different tables by the same text.
+	if len(planCtx.builder.outerCTEs) > 0 {
+		return "", false
+	}
+	key, ok := sharableNodeKey(subq.Query)
+	if !ok {
+		return "", false
+	}
+	return tp + ":" + key, true
+}
+
+// getSubqueryResultCache returns the results of the uncorrelated subqueries evaluated in the statement.
+func getSubqueryResultCache(sctx PlanContext) map[string][]types.Datum {
+	cache := sctx.GetSessionVars().StmtCtx.GetOrStoreStmtCache(stmtctx.StmtSubqueryResultCacheKey, make(map[string][]types.Datum))
+	return cache.(map[string][]types.Datum)
+}
+
+// sharableNodeKey returns the text of the node, the nodes with the same text are identical and their
+// results can be shared if they don't contain any non-deterministic expressions.
+func sharableNodeKey(node ast.Node) (string, bool) {
+	checker := &nonDeterministicChecker{}
+	node.Accept(checker)
+	if checker.found {
+		return "", false
+	}
+	var sb strings.Builder
+	if err := node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return "", false
+	}
+	return sb.String(), true
+}
+
+// nonDeterministicChecker checks whether a node contains the expressions whose results may differ
+// in every execution, or depend on the values bound to the statement.
+type nonDeterministicChecker struct {
+	found bool
+}
+
+// Enter implements Visitor interface.
+func (c *nonDeterministicChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.FuncCallExpr:
+		if _, ok := nonDeterministicFuncs[x.FnName.L]; ok {
+			c.found = true
+		}
+	case *ast.VariableExpr:
+		if !x.IsSystem {
+			c.found = true
+		}
+	case ast.ParamMarkerExpr:
+		c.found = true
+	}
+	return in, c.found
+}
+
+// Leave implements Visitor interface.
+func (c *nonDeterministicChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, !c.found
+}
+
+// columnRefChecker checks whether a node refers to the columns which are not available after the
+// table is replaced by a CTE, i.e. the columns qualified by the schema and the handle column.
+type columnRefChecker struct {
+	found bool
+}
+
+// Enter implements Visitor interface.
+func (c *columnRefChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.ColumnName:
+		if x.Schema.L != "" || x.Name.L == model.ExtraHandleName.L {
+			c.found = true
+		}
+	case *ast.WildCardField:
+		if x.Schema.L != "" {
+			c.found = true
+		}
+	}
+	return in, c.found
+}
+
+// Leave implements Visitor interface.
+func (c *columnRefChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, !c.found
+}
@@ -337,6 +337,7 @@ func (ds *DataSource) derivePathStatsAndTryHeuristics() error {
 			ds.deriveIndexPathStats(path, ds.pushedDownConds, false)
 			path.IsSingleScan = ds.isSingleScan(path.FullIdxCols, path.FullIdxColLens)
 		}
+		ds.applyAccessPathFeedback(path)
 		// step: 3
 		// Try some heuristic rules to select access path.
 		// tiFlash path also have table-range-scan (range point like here) to be heuristic treated.
@@ -488,6 +489,7 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 	// TODO: Can we move ds.deriveStatsByFilter after pruning by heuristics? In this way some computation can be avoided
 	// when ds.possibleAccessPaths are pruned.
 	ds.SetStats(ds.deriveStatsByFilter(ds.pushedDownConds, ds.possibleAccessPaths))
+	ds.applyCardinalityFeedback()
 	err := ds.derivePathStatsAndTryHeuristics()
 	if err != nil {
 		return nil, err
@@ -187,6 +187,9 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		}
 	}()
 
+	// The fix control of the statement digest is applied before the SET_VAR hints, so the hints take precedence.
+	applyFixControlByDigest(sessVars)
+
 	warns = warns[:0]
 	for name, val := range sessVars.StmtCtx.StmtHints.SetVars {
 		oldV, err := sessVars.SetSystemVarWithOldValAsRet(name, val)
@@ -383,6 +386,9 @@ func OptimizeForForeignKeyCascade(ctx context.Context, sctx pctx.PlanContext, no
 	if err := core.CheckTableLock(sctx, is, builder.GetVisitInfo()); err != nil {
 		return nil, err
 	}
+	if err := core.CheckRestrictedReadOnlySchema(sctx, builder.GetVisitInfo()); err != nil {
+		return nil, err
+	}
 	return p, nil
 }
 
@@ -481,6 +487,9 @@ func optimize(ctx context.Context, sctx pctx.PlanContext, node ast.Node, is info
 	if err := core.CheckTableLock(sctx, is, builder.GetVisitInfo()); err != nil {
 		return nil, nil, 0, err
 	}
+	if err := core.CheckRestrictedReadOnlySchema(sctx, builder.GetVisitInfo()); err != nil {
+		return nil, nil, 0, err
+	}
 
 	names := p.OutputNames()
 
@@ -573,6 +582,34 @@ func handleInvalidBinding(ctx context.Context, sctx pctx.PlanContext, level stri
 }
 
 // setVarHintChecker checks whether the variable name in set_var hint is valid.
+// applyFixControlByDigest overrides the optimizer fix control of the current statement with the one set
+// for its digest through tidb_opt_fix_control_by_digest. The original value is restored after the statement
+// is executed, the same as the SET_VAR hint.
+func applyFixControlByDigest(sessVars *variable.SessionVars) {
+	if len(sessVars.OptimizerFixControlByDigest) == 0 {
+		return
+	}
+	_, digest := sessVars.StmtCtx.SQLDigest()
+	if digest == nil {
+		return
+	}
+	fixes, ok := sessVars.OptimizerFixControlByDigest[digest.String()]
+	if !ok || len(fixes) == 0 {
+		return
+	}
+	oldV, _ := sessVars.GetSystemVar(variable.TiDBOptFixControl)
+	newMap := make(map[uint64]string, len(sessVars.OptimizerFixControl)+len(fixes))
+	for k, v := range sessVars.OptimizerFixControl {
+		newMap[k] = v
+	}
+	for k, v := range fixes {
+		newMap[k] = v
+	}
+	sessVars.OptimizerFixControl = newMap
+	sessVars.StmtCtx.AddSetVarHintRestore(variable.TiDBOptFixControl, oldV)
+	sessVars.StmtCtx.SetSkipPlanCache(errors.NewNoStackError("the fix control of the SQL digest is used"))
+}
+
 func setVarHintChecker(varName, hint string) (ok bool, warning error) {
 	sysVar := variable.GetSysVar(varName)
 	if sysVar == nil { // no such a variable
@@ -15,8 +15,10 @@
 package fixcontrol_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/planner/util/fixcontrol"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/testdata"
@@ -87,3 +89,63 @@ func TestFixControl(t *testing.T) {
 		require.Equal(t, output[i].Variable, rows)
 	}
 }
+
+func TestParseByDigestToMap(t *testing.T) {
+	m, warns, err := fixcontrol.ParseByDigestToMap("")
+	require.NoError(t, err)
+	require.Empty(t, warns)
+	require.Empty(t, m)
+
+	m, warns, err = fixcontrol.ParseByDigestToMap(" ABC=44262:ON, 44389:1 ; def = 45132:100;")
+	require.NoError(t, err)
+	require.Empty(t, warns)
+	require.Equal(t, map[string]map[uint64]string{
+		"abc": {44262: "ON", 44389: "1"},
+		"def": {45132: "100"},
+	}, m)
+
+	m, warns, err = fixcontrol.ParseByDigestToMap("abc=44262:ON;abc=44262:OFF")
+	require.NoError(t, err)
+	require.Len(t, warns, 1)
+	require.Equal(t, map[string]map[uint64]string{"abc": {44262: "OFF"}}, m)
+
+	_, _, err = fixcontrol.ParseByDigestToMap("abc")
+	require.ErrorContains(t, err, "expected equal sign not found")
+	_, _, err = fixcontrol.ParseByDigestToMap("=44262:ON")
+	require.ErrorContains(t, err, "empty digest")
+	_, _, err = fixcontrol.ParseByDigestToMap("abc=44262")
+	require.ErrorContains(t, err, "expected colon not found")
+}
+
+func TestFixControlByDigest(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int)")
+	tk.MustExec("insert into t values (1), (2)")
+	s := tk.Session()
+
+	sql := "select * from t where a = '1'"
+	_, digest := parser.NormalizeDigest(sql)
+	tk.MustExec("set @@tidb_opt_fix_control = '44262:ON'")
+	tk.MustExec(fmt.Sprintf("set @@tidb_opt_fix_control_by_digest = '%s=%d:ON'", digest.String(), fixcontrol.Fix50785))
+
+	// The fix control of the digest is merged into the fix control of the session for the statement.
+	tk.MustQuery(sql).Check(testkit.Rows("1"))
+	require.Equal(t, map[uint64]string{44262: "ON", fixcontrol.Fix50785: "ON"}, s.GetSessionVars().OptimizerFixControl)
+	// The other statements are not affected, and the fix control of the session is restored.
+	tk.MustQuery("select * from t where a > '1'").Check(testkit.Rows("2"))
+	require.Equal(t, map[uint64]string{44262: "ON"}, s.GetSessionVars().OptimizerFixControl)
+	tk.MustQuery("select @@tidb_opt_fix_control").Check(testkit.Rows("44262:ON"))
+
+	// The SET_VAR hint takes precedence over the fix control of the digest.
+	sql = "select /*+ SET_VAR(tidb_opt_fix_control='45132:100') */ * from t where a = '1'"
+	_, digest = parser.NormalizeDigest(sql)
+	tk.MustExec(fmt.Sprintf("set @@tidb_opt_fix_control_by_digest = '%s=%d:ON'", digest.String(), fixcontrol.Fix50785))
+	tk.MustQuery(sql).Check(testkit.Rows("1"))
+	require.Equal(t, map[uint64]string{45132: "100"}, s.GetSessionVars().OptimizerFixControl)
+	tk.MustExec("do 1")
+	require.Equal(t, map[uint64]string{44262: "ON"}, s.GetSessionVars().OptimizerFixControl)
+
+	tk.MustGetErrMsg("set @@tidb_opt_fix_control_by_digest = 'abc'", "invalid fix control by digest: expected equal sign not found")
+}
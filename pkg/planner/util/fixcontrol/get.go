@@ -47,6 +47,10 @@ const (
 	// Fix49736 controls whether to force the optimizer to use plan cache even if there is risky optimization.
 	// This fix-control is test-only.
 	Fix49736 uint64 = 49736
+	// Fix50785 controls whether to compare an integer column with a string constant as double values as MySQL does,
+	// instead of refining the string constant to an integer. It keeps the compatibility for the edge cases
+	// that the string is not a valid integer.
+	Fix50785 uint64 = 50785
 )
 
 // GetStr fetches the given key from the fix control map as a string type.
@@ -83,3 +83,34 @@ func ParseToMap(s string) (result map[uint64]string, warnMsgs []string, err erro
 	}
 	return m, warnMsgs, nil
 }
+
+// ParseByDigestToMap parses the user input to the optimizer fix control maps of the statement digests.
+// The input is a list of `digest=fix controls` separated by semicolons, and the fix controls have the
+// same format as tidb_opt_fix_control, for example: `<digest1>=44262:ON,44389:ON;<digest2>=45132:100`.
+func ParseByDigestToMap(s string) (result map[string]map[uint64]string, warnMsgs []string, err error) {
+	result = make(map[string]map[uint64]string)
+	for _, item := range strings.Split(s, ";") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+		eqIdx := strings.IndexByte(item, '=')
+		if eqIdx < 0 {
+			return nil, nil, errors.New("invalid fix control by digest: expected equal sign not found")
+		}
+		digest := strings.ToLower(strings.TrimSpace(item[:eqIdx]))
+		if len(digest) == 0 {
+			return nil, nil, errors.New("invalid fix control by digest: empty digest")
+		}
+		m, warns, err := ParseToMap(item[eqIdx+1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		warnMsgs = append(warnMsgs, warns...)
+		if _, ok := result[digest]; ok {
+			warnMsgs = append(warnMsgs, fmt.Sprintf("repeated assignment for the fix control of digest: %s.", digest))
+		}
+		result[digest] = m
+	}
+	return result, warnMsgs, nil
+}
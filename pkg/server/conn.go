@@ -43,6 +43,7 @@ import (
 	goerr "errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"os/user"
 	"runtime"
@@ -112,6 +113,7 @@ import (
 	"github.com/tikv/client-go/v2/tikvrpc"
 	"github.com/tikv/client-go/v2/util"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -191,6 +193,7 @@ type clientConn struct {
 	rsEncoder     *column.ResultEncoder // rsEncoder is used to encode the string result to different charsets
 	inputDecoder  *util2.InputDecoder   // inputDecoder is used to decode the different charsets of incoming strings to utf-8
 	socketCredUID uint32                // UID from the other end of the Unix Socket
+	egressLimiter *rate.Limiter         // egressLimiter limits the rate of the result sets by tidb_egress_rate_limit
 	// mu is used for cancelling the execution of current transaction.
 	mu struct {
 		sync.RWMutex
@@ -1151,7 +1154,7 @@ func (cc *clientConn) Run(ctx context.Context) {
 					zap.Stringer("sql", getLastStmtInConn{cc}),
 					zap.String("txn_mode", txnMode),
 					zap.Uint64("timestamp", startTS),
-					zap.String("err", errStrForLog(err, cc.ctx.GetSessionVars().EnableRedactLog)),
+					zap.String("err", errStrForLog(err, cc.ctx.GetSessionVars().RedactLogMode(variable.RedactLogDestErrorLog))),
 				)
 			}
 			err1 := cc.writeError(ctx, err)
@@ -1268,6 +1271,18 @@ func (cc *clientConn) dispatch(ctx context.Context, data []byte) error {
 	cc.lastPacket = data
 	cmd := data[0]
 	data = data[1:]
+	var queryAttrs map[string]string
+	if cmd == mysql.ComQuery && cc.capability&mysql.ClientQueryAttributes > 0 {
+		attrs, query, err := parseQueryAttributes(cc.ctx.GetSessionVars().StmtCtx.TypeCtx(), data, cc.inputDecoder)
+		if err != nil {
+			return err
+		}
+		queryAttrs = attrs
+		// Strip the attributes from the last packet, so that it's logged as a plain query.
+		cc.lastPacket = cc.lastPacket[len(cc.lastPacket)-len(query)-1:]
+		cc.lastPacket[0] = cmd
+		data = query
+	}
 	if topsqlstate.TopSQLEnabled() {
 		defer pprof.SetGoroutineLabels(ctx)
 	}
@@ -1309,6 +1324,7 @@ func (cc *clientConn) dispatch(ctx context.Context, data []byte) error {
 	vars := cc.ctx.GetSessionVars()
 	// reset killed for each request
 	vars.SQLKiller.Reset()
+	vars.QueryAttributes = queryAttrs
 	if cmd < mysql.ComEnd {
 		cc.ctx.SetCommandValue(cmd)
 	}
@@ -2344,6 +2360,7 @@ func (cc *clientConn) writeChunks(ctx context.Context, rs resultset.ResultSet, b
 		if stmtDetail != nil {
 			start = time.Now()
 		}
+		chunkBytes := 0
 		for i := 0; i < rowCount; i++ {
 			data = data[0:4]
 			if binary {
@@ -2359,6 +2376,11 @@ func (cc *clientConn) writeChunks(ctx context.Context, rs resultset.ResultSet, b
 				reg.End()
 				return false, err
 			}
+			chunkBytes += len(data)
+		}
+		if err = cc.waitEgressQuota(ctx, chunkBytes); err != nil {
+			reg.End()
+			return false, err
 		}
 		reg.End()
 		if stmtDetail != nil {
@@ -2380,6 +2402,34 @@ func (cc *clientConn) writeChunks(ctx context.Context, rs resultset.ResultSet, b
 	return false, err
 }
 
+// waitEgressQuota blocks until the n bytes which have been written are allowed by
+// tidb_egress_rate_limit, so that the result sets are sent to the client no faster
+// than the limit. The burst of the limiter is the limit of one second, so a killed
+// query stops waiting within about one second.
+func (cc *clientConn) waitEgressQuota(ctx context.Context, n int) error {
+	sessVars := cc.ctx.GetSessionVars()
+	limit := sessVars.EgressRateLimit
+	if limit <= 0 || n <= 0 {
+		cc.egressLimiter = nil
+		return nil
+	}
+	if cc.egressLimiter == nil || cc.egressLimiter.Limit() != rate.Limit(limit) {
+		cc.egressLimiter = rate.NewLimiter(rate.Limit(limit), int(min(limit, math.MaxInt32)))
+	}
+	burst := cc.egressLimiter.Burst()
+	for n > 0 {
+		if err := sessVars.SQLKiller.HandleSignal(); err != nil {
+			return err
+		}
+		size := min(n, burst)
+		if err := cc.egressLimiter.WaitN(ctx, size); err != nil {
+			return errors.Trace(err)
+		}
+		n -= size
+	}
+	return nil
+}
+
 // writeChunksWithFetchSize writes data from a Chunk, which filled data by a ResultSet, into a connection.
 // binary specifies the way to dump data. It throws any error while dumping data.
 // serverStatus, a flag bit represents server information.
@@ -2402,6 +2452,7 @@ func (cc *clientConn) writeChunksWithFetchSize(ctx context.Context, rs resultset
 
 	iter := rs.GetRowContainerReader()
 	// send the rows to the client according to fetchSize.
+	fetchedBytes := 0
 	for i := 0; i < fetchSize && iter.Current() != iter.End(); i++ {
 		row := iter.Current()
 
@@ -2413,12 +2464,16 @@ func (cc *clientConn) writeChunksWithFetchSize(ctx context.Context, rs resultset
 		if err = cc.writePacket(data); err != nil {
 			return err
 		}
+		fetchedBytes += len(data)
 
 		iter.Next()
 	}
 	if iter.Error() != nil {
 		return iter.Error()
 	}
+	if err = cc.waitEgressQuota(ctx, fetchedBytes); err != nil {
+		return err
+	}
 
 	// tell the client COM_STMT_FETCH has finished by setting proper serverStatus,
 	// and close ResultSet.
@@ -2604,7 +2659,7 @@ func (cc getLastStmtInConn) String() string {
 		return "ListFields " + string(data)
 	case mysql.ComQuery, mysql.ComStmtPrepare:
 		sql := string(hack.String(data))
-		sql = parser.Normalize(sql, cc.ctx.GetSessionVars().EnableRedactLog)
+		sql = parser.Normalize(sql, cc.ctx.GetSessionVars().RedactLogMode(variable.RedactLogDestErrorLog))
 		return executor.FormatSQL(sql).String()
 	case mysql.ComStmtExecute, mysql.ComStmtFetch:
 		stmtID := binary.LittleEndian.Uint32(data[0:4])
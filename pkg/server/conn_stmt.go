@@ -54,6 +54,7 @@ import (
 	"github.com/pingcap/tidb/pkg/server/internal/dump"
 	"github.com/pingcap/tidb/pkg/server/internal/parse"
 	"github.com/pingcap/tidb/pkg/server/internal/resultset"
+	util2 "github.com/pingcap/tidb/pkg/server/internal/util"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/sessiontxn"
 	storeerr "github.com/pingcap/tidb/pkg/store/driver/error"
@@ -180,33 +181,72 @@ func (cc *clientConn) handleStmtExecute(ctx context.Context, data []byte) (err e
 	)
 	cc.initInputEncoder(ctx)
 	numParams := stmt.NumParams()
+	// The client with CLIENT_QUERY_ATTRIBUTES sends the name of each param, and the query attributes are
+	// sent as the params after the ones of the statement, whose count is included in the param count sent
+	// when PARAMETER_COUNT_AVAILABLE is set.
+	// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_execute.html
+	withNames := cc.capability&mysql.ClientQueryAttributes > 0
+	paramCount := numParams
+	if withNames && flag&mysql.ParameterCountAvailable > 0 {
+		if !hasLengthEncodedInt(data[pos:]) {
+			return mysql.ErrMalformPacket
+		}
+		count, _, n := util2.ParseLengthEncodedInt(data[pos:])
+		pos += n
+		// Each param takes at least one byte in the packet.
+		if count < uint64(numParams) || count > uint64(len(data)) {
+			return mysql.ErrMalformPacket
+		}
+		paramCount = int(count)
+	}
 	args := make([]param.BinaryParam, numParams)
-	if numParams > 0 {
-		nullBitmapLen := (numParams + 7) >> 3
+	if paramCount > 0 {
+		nullBitmapLen := (paramCount + 7) >> 3
 		if len(data) < (pos + nullBitmapLen + 1) {
 			return mysql.ErrMalformPacket
 		}
 		nullBitmaps = data[pos : pos+nullBitmapLen]
 		pos += nullBitmapLen
 
+		var attrNames []string
 		// new param bound flag
 		if data[pos] == 1 {
 			pos++
-			if len(data) < (pos + (numParams << 1)) {
-				return mysql.ErrMalformPacket
+			if withNames {
+				var n int
+				paramTypes, attrNames, n, err = parseParamTypesWithNames(data[pos:], paramCount)
+				if err != nil {
+					return err
+				}
+				pos += n
+				attrNames = attrNames[numParams:]
+			} else {
+				if len(data) < (pos + (numParams << 1)) {
+					return mysql.ErrMalformPacket
+				}
+				paramTypes = data[pos : pos+(numParams<<1)]
+				pos += numParams << 1
 			}
-
-			paramTypes = data[pos : pos+(numParams<<1)]
-			pos += numParams << 1
 			paramValues = data[pos:]
 			// Just the first StmtExecute packet contain parameters type,
 			// we need save it for further use.
-			stmt.SetParamsType(paramTypes)
+			stmt.SetParamsType(paramTypes[:numParams<<1])
 		} else {
+			// The types of the query attributes are always sent.
+			if paramCount > numParams {
+				return mysql.ErrMalformPacket
+			}
+			paramTypes = stmt.GetParamsType()
 			paramValues = data[pos+1:]
 		}
 
-		err = parseBinaryParams(args, stmt.BoundParams(), nullBitmaps, stmt.GetParamsType(), paramValues, cc.inputDecoder)
+		params, boundParams := args, stmt.BoundParams()
+		if paramCount > numParams {
+			params = make([]param.BinaryParam, paramCount)
+			boundParams = append(boundParams[:numParams:numParams], make([][]byte, paramCount-numParams)...)
+		}
+		err = parseBinaryParams(params, boundParams, nullBitmaps, paramTypes, paramValues, cc.inputDecoder)
+		copy(args, params)
 		// This `.Reset` resets the arguments, so it's fine to just ignore the error (and the it'll be reset again in the following routine)
 		errReset := stmt.Reset()
 		if errReset != nil {
@@ -215,6 +255,13 @@ func (cc *clientConn) handleStmtExecute(ctx context.Context, data []byte) (err e
 		if err != nil {
 			return errors.Annotate(err, cc.preparedStmt2String(stmtID))
 		}
+		if paramCount > numParams {
+			sessVars := cc.ctx.GetSessionVars()
+			sessVars.QueryAttributes, err = binaryParamsToQueryAttributes(sessVars.StmtCtx.TypeCtx(), params[numParams:], attrNames)
+			if err != nil {
+				return errors.Annotate(err, cc.preparedStmt2String(stmtID))
+			}
+		}
 	}
 
 	sessVars := cc.ctx.GetSessionVars()
@@ -581,9 +628,10 @@ func (cc *clientConn) preparedStmt2String(stmtID uint32) string {
 	if sv == nil {
 		return ""
 	}
-	sql := parser.Normalize(cc.preparedStmt2StringNoArgs(stmtID), sv.EnableRedactLog)
-	if m := sv.EnableRedactLog; m != errors.RedactLogEnable {
-		sql += redact.String(sv.EnableRedactLog, sv.PlanCacheParams.String())
+	rmode := sv.RedactLogMode(variable.RedactLogDestErrorLog)
+	sql := parser.Normalize(cc.preparedStmt2StringNoArgs(stmtID), rmode)
+	if rmode != errors.RedactLogEnable {
+		sql += redact.String(rmode, sv.PlanCacheParams.String())
 	}
 	return sql
 }
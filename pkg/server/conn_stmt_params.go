@@ -15,7 +15,10 @@
 package server
 
 import (
+	"strings"
+
 	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/param"
 	"github.com/pingcap/tidb/pkg/parser/charset"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -28,7 +31,12 @@ var errUnknownFieldType = dbterror.ClassServer.NewStd(errno.ErrUnknownFieldType)
 
 // parseBinaryParams decodes the binary params according to the protocol
 func parseBinaryParams(params []param.BinaryParam, boundParams [][]byte, nullBitmap, paramTypes, paramValues []byte, enc *util2.InputDecoder) (err error) {
-	pos := 0
+	_, err = decodeBinaryParams(params, boundParams, nullBitmap, paramTypes, paramValues, enc)
+	return err
+}
+
+// decodeBinaryParams decodes the binary params, and returns the length of the decoded param values.
+func decodeBinaryParams(params []param.BinaryParam, boundParams [][]byte, nullBitmap, paramTypes, paramValues []byte, enc *util2.InputDecoder) (pos int, err error) {
 	if enc == nil {
 		enc = util2.NewInputDecoder(charset.CharsetUTF8)
 	}
@@ -59,7 +67,7 @@ func parseBinaryParams(params []param.BinaryParam, boundParams [][]byte, nullBit
 		}
 
 		if (i<<1)+1 >= len(paramTypes) {
-			return mysql.ErrMalformPacket
+			return pos, mysql.ErrMalformPacket
 		}
 
 		tp := paramTypes[i<<1]
@@ -128,3 +136,120 @@ func parseBinaryParams(params []param.BinaryParam, boundParams [][]byte, nullBit
 	}
 	return
 }
+
+// parseQueryAttributes decodes the query attributes sent before the query text of COM_QUERY
+// when CLIENT_QUERY_ATTRIBUTES is set, and returns the attributes and the query text.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_query.html
+func parseQueryAttributes(typectx types.Context, data []byte, enc *util2.InputDecoder) (attrs map[string]string, query []byte, err error) {
+	if !hasLengthEncodedInt(data) {
+		return nil, nil, mysql.ErrMalformPacket
+	}
+	paramCount, _, n := util2.ParseLengthEncodedInt(data)
+	pos := n
+	// parameter_set_count is always 1.
+	if !hasLengthEncodedInt(data[pos:]) {
+		return nil, nil, mysql.ErrMalformPacket
+	}
+	_, _, n = util2.ParseLengthEncodedInt(data[pos:])
+	pos += n
+	if paramCount == 0 {
+		return nil, data[pos:], nil
+	}
+
+	// Each attribute takes at least one byte in the packet.
+	if paramCount > uint64(len(data)) {
+		return nil, nil, mysql.ErrMalformPacket
+	}
+	nullBitmapLen := int((paramCount + 7) >> 3)
+	if len(data) < pos+nullBitmapLen+1 {
+		return nil, nil, mysql.ErrMalformPacket
+	}
+	nullBitmap := data[pos : pos+nullBitmapLen]
+	pos += nullBitmapLen
+	// new_params_bind_flag is always 1, the types and the names are always sent.
+	if data[pos] != 1 {
+		return nil, nil, mysql.ErrMalformPacket
+	}
+	pos++
+
+	paramTypes, names, n, err := parseParamTypesWithNames(data[pos:], int(paramCount))
+	if err != nil {
+		return nil, nil, err
+	}
+	pos += n
+	params := make([]param.BinaryParam, paramCount)
+	n, err = decodeBinaryParams(params, make([][]byte, paramCount), nullBitmap, paramTypes, data[pos:], enc)
+	if err != nil {
+		return nil, nil, err
+	}
+	pos += n
+	attrs, err = binaryParamsToQueryAttributes(typectx, params, names)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attrs, data[pos:], nil
+}
+
+// parseParamTypesWithNames decodes the types and the names of the params sent with CLIENT_QUERY_ATTRIBUTES,
+// and returns the types in the layout of the params sent without names, the names, and the length decoded.
+func parseParamTypesWithNames(data []byte, paramCount int) (paramTypes []byte, names []string, pos int, err error) {
+	paramTypes = make([]byte, 0, paramCount<<1)
+	names = make([]string, 0, paramCount)
+	for i := 0; i < paramCount; i++ {
+		if len(data) < pos+2 {
+			return nil, nil, 0, mysql.ErrMalformPacket
+		}
+		paramTypes = append(paramTypes, data[pos], data[pos+1])
+		pos += 2
+		if !hasLengthEncodedInt(data[pos:]) {
+			return nil, nil, 0, mysql.ErrMalformPacket
+		}
+		name, _, n, err := util2.ParseLengthEncodedBytes(data[pos:])
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		pos += n
+		names = append(names, string(name))
+	}
+	return paramTypes, names, pos, nil
+}
+
+// binaryParamsToQueryAttributes converts the decoded params to the query attributes by their names,
+// the NULL attributes are skipped.
+func binaryParamsToQueryAttributes(typectx types.Context, params []param.BinaryParam, names []string) (map[string]string, error) {
+	args, err := param.ExecArgs(typectx, params)
+	if err != nil {
+		return nil, err
+	}
+	attrs := make(map[string]string, len(params))
+	for i, arg := range args {
+		//nolint:forcetypeassert
+		val := arg.(*expression.Constant).Value
+		if val.IsNull() {
+			continue
+		}
+		str, err := val.ToString()
+		if err != nil {
+			return nil, err
+		}
+		// The value may refer to the packet buffer, which is reused by the next packet.
+		attrs[names[i]] = strings.Clone(str)
+	}
+	return attrs, nil
+}
+
+// hasLengthEncodedInt checks whether b starts with a complete length encoded integer.
+func hasLengthEncodedInt(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	switch b[0] {
+	case 0xfc:
+		return len(b) >= 3
+	case 0xfd:
+		return len(b) >= 4
+	case 0xfe:
+		return len(b) >= 9
+	}
+	return true
+}
@@ -379,3 +379,36 @@ func TestDateTimeTypes(t *testing.T) {
 
 	require.Equal(t, expected, out.Bytes())
 }
+
+func TestParseQueryAttributes(t *testing.T) {
+	query := []byte("select 1")
+
+	// No attributes.
+	attrs, q, err := parseQueryAttributes(types.DefaultStmtNoWarningContext, append([]byte{0x00, 0x01}, query...), nil)
+	require.NoError(t, err)
+	require.Nil(t, attrs)
+	require.Equal(t, query, q)
+
+	data := []byte{
+		0x03, 0x01, // parameter_count, parameter_set_count
+		0x04, // null_bitmap, the third attribute is NULL
+		0x01, // new_params_bind_flag
+	}
+	data = append(data, mysql.TypeVarString, 0x00, 0x08)
+	data = append(data, "trace_id"...)
+	data = append(data, mysql.TypeLonglong, 0x00, 0x01, 'n')
+	data = append(data, mysql.TypeNull, 0x00, 0x01, 'x')
+	data = append(data, 0x03, 'a', 'b', 'c')
+	data = append(data, 42, 0, 0, 0, 0, 0, 0, 0)
+	data = append(data, query...)
+	attrs, q, err = parseQueryAttributes(types.DefaultStmtNoWarningContext, data, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"trace_id": "abc", "n": "42"}, attrs)
+	require.Equal(t, query, q)
+
+	// Malformed packets.
+	for i := 0; i < 20; i++ {
+		_, _, err = parseQueryAttributes(types.DefaultStmtNoWarningContext, data[:i], nil)
+		require.Error(t, err)
+	}
+}
@@ -1956,6 +1956,43 @@ func TestProcessInfoForExecuteCommand(t *testing.T) {
 	require.Equal(t, cc.ctx.Session.ShowProcess().Info, "select sum(col1) from t where col1 < ? and col1 > 100")
 }
 
+func TestQueryAttributesForExecuteCommand(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	cc := &clientConn{
+		alloc:      arena.NewAllocator(1024),
+		chunkAlloc: chunk.NewAllocator(),
+		pkt:        internal.NewPacketIOForTest(bufio.NewWriter(bytes.NewBuffer(nil))),
+		capability: mysql.ClientQueryAttributes,
+	}
+	ctx := context.Background()
+	cc.SetCtx(&TiDBContext{Session: tk.Session(), stmts: make(map[int]*TiDBStatement)})
+
+	require.NoError(t, cc.HandleStmtPrepare(ctx, "select ? + 1"))
+	header := []byte{0x1, 0x0, 0x0, 0x0, mysql.ParameterCountAvailable, 0x1, 0x0, 0x0, 0x0}
+	// 1 param and 2 query attributes, the second attribute is NULL.
+	data := append(header, 0x03, 0x04, 0x01)
+	data = append(data, mysql.TypeLonglong, 0x0, 0x0)
+	data = append(data, mysql.TypeVarString, 0x0, 0x08)
+	data = append(data, "trace_id"...)
+	data = append(data, mysql.TypeNull, 0x0, 0x01, 'x')
+	data = append(data, 0x0A, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0)
+	data = append(data, 0x03, 'a', 'b', 'c')
+	require.NoError(t, cc.handleStmtExecute(ctx, data))
+	require.Equal(t, map[string]string{"trace_id": "abc"}, tk.Session().GetSessionVars().QueryAttributes)
+
+	// the param count is only sent with PARAMETER_COUNT_AVAILABLE, while the names are always sent.
+	header[4] = 0
+	data = append(header, 0x00, 0x01, mysql.TypeLonglong, 0x0, 0x0, 0x0A, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0)
+	tk.Session().GetSessionVars().QueryAttributes = nil
+	require.NoError(t, cc.handleStmtExecute(ctx, data))
+	require.Nil(t, tk.Session().GetSessionVars().QueryAttributes)
+
+	// the param count is less than the params of the statement.
+	header[4] = mysql.ParameterCountAvailable
+	require.ErrorIs(t, cc.handleStmtExecute(ctx, append(header, 0x00)), mysql.ErrMalformPacket)
+}
+
 func TestLDAPAuthSwitch(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	cfg := serverutil.NewTestConfig()
@@ -2085,3 +2122,46 @@ func TestCloseConn(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestEgressRateLimit(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	var outBuffer bytes.Buffer
+	tidbdrv := NewTiDBDriver(store)
+	cfg := serverutil.NewTestConfig()
+	cfg.Port, cfg.Status.StatusPort = 0, 0
+	cfg.Status.ReportStatus = false
+	server, err := NewServer(cfg, tidbdrv)
+	require.NoError(t, err)
+	defer server.Close()
+
+	cc := &clientConn{
+		connectionID: 1,
+		server:       server,
+		pkt:          internal.NewPacketIOForTest(bufio.NewWriter(&outBuffer)),
+		collation:    mysql.DefaultCollationID,
+		peerHost:     "localhost",
+		alloc:        arena.NewAllocator(512),
+		chunkAlloc:   chunk.NewAllocator(),
+		capability:   mysql.ClientProtocol41 | mysql.ClientDeprecateEOF,
+	}
+	tk := testkit.NewTestKit(t, store)
+	cc.SetCtx(&TiDBContext{Session: tk.Session()})
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1), (2), (3), (4), (5)")
+
+	// about 2.5KB of rows are sent, the first 1KB is allowed by the burst
+	// and the rest of them have to wait for more than 1 second.
+	tk.MustExec("set @@tidb_egress_rate_limit = 1024")
+	start := time.Now()
+	require.NoError(t, cc.handleQuery(context.Background(), "select repeat('a', 500) from t"))
+	require.Greater(t, time.Since(start), time.Second)
+	require.NotNil(t, cc.egressLimiter)
+
+	tk.MustExec("set @@tidb_egress_rate_limit = 0")
+	start = time.Now()
+	require.NoError(t, cc.handleQuery(context.Background(), "select repeat('a', 500) from t"))
+	require.Less(t, time.Since(start), time.Second)
+	require.Nil(t, cc.egressLimiter)
+}
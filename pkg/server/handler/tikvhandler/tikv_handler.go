@@ -219,6 +219,8 @@ const (
 	OpTableRanges = "ranges"
 	// OpTableDiskUsage is the operation for getting disk usage of a table.
 	OpTableDiskUsage = "disk-usage"
+	// OpTableHotRegions is the operation for getting hot regions of a table.
+	OpTableHotRegions = "hot-regions"
 	// OpTableScatter is the operation for scattering a table.
 	OpTableScatter = "scatter-table"
 	// OpStopTableScatter is the operation for stopping scattering a table.
@@ -1049,6 +1051,8 @@ func (h *TableHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		h.handleRangeRequest(tableVal, w)
 	case OpTableDiskUsage:
 		h.handleDiskUsageRequest(tableVal, w)
+	case OpTableHotRegions:
+		h.handleHotRegionsRequest(model.NewCIStr(dbName), tableVal, w)
 	case OpTableScatter:
 		// supports partition table, only get one physical table, prevent too many scatter schedulers.
 		ptbl, err := h.GetPartition(tableVal, partitionName)
@@ -1393,6 +1397,37 @@ func (h *TableHandler) handleDiskUsageRequest(tbl table.Table, w http.ResponseWr
 	handler.WriteData(w, stats.StorageSize)
 }
 
+func (h *TableHandler) handleHotRegionsRequest(dbName model.CIStr, tbl table.Table, w http.ResponseWriter) {
+	// Only match the hot regions against the table, so that the regions of other tables are left out.
+	schemas := []*model.DBInfo{{Name: dbName, Tables: []*model.TableInfo{tbl.Meta()}}}
+	ctx := context.Background()
+	hotRead, err := h.ScrapeHotInfo(ctx, helper.HotRead, schemas)
+	if err != nil {
+		handler.WriteError(w, err)
+		return
+	}
+	hotWrite, err := h.ScrapeHotInfo(ctx, helper.HotWrite, schemas)
+	if err != nil {
+		handler.WriteError(w, err)
+		return
+	}
+	handler.WriteData(w, map[string]any{
+		"write": filterTableHotRegions(hotWrite),
+		"read":  filterTableHotRegions(hotRead),
+	})
+}
+
+// filterTableHotRegions removes the hot regions which don't belong to any of the scraped tables.
+func filterTableHotRegions(hotRegions []helper.HotTableIndex) []helper.HotTableIndex {
+	result := hotRegions[:0]
+	for _, hotRegion := range hotRegions {
+		if hotRegion.TableName != "" {
+			result = append(result, hotRegion)
+		}
+	}
+	return result
+}
+
 // ServeHTTP handles request of get region by ID.
 func (h RegionHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// parse and check params
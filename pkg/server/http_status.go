@@ -262,6 +262,7 @@ func (s *Server) startHTTPServer() {
 		router.Handle("/tables/{db}/{table}/scatter", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpTableScatter))
 		router.Handle("/tables/{db}/{table}/stop-scatter", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpStopTableScatter))
 		router.Handle("/tables/{db}/{table}/disk-usage", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpTableDiskUsage))
+		router.Handle("/tables/{db}/{table}/hot-regions", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpTableHotRegions))
 		router.Handle("/regions/meta", tikvhandler.NewRegionHandler(tikvHandlerTool)).Name("RegionsMeta")
 		router.Handle("/regions/hot", tikvhandler.NewRegionHandler(tikvHandlerTool)).Name("RegionHot")
 		router.Handle("/regions/{regionID}", tikvhandler.NewRegionHandler(tikvHandlerTool))
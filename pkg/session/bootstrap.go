@@ -688,6 +688,73 @@ const (
 		KEY (created_by),
 		KEY (status));`
 
+	// CreateVariableProfilesTable stores the named bundles of session variables
+	// created by `CREATE VARIABLE PROFILE`.
+	CreateVariableProfilesTable = `CREATE TABLE IF NOT EXISTS mysql.tidb_variable_profiles (
+		profile_name VARCHAR(64) NOT NULL,
+		resource_group VARCHAR(32) DEFAULT NULL,
+		variables JSON NOT NULL,
+		create_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (profile_name),
+		UNIQUE KEY (resource_group)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;`
+
+	// CreatePlacementViolationsTable stores the tables and partitions whose placement
+	// doesn't comply with the placement policies.
+	CreatePlacementViolationsTable = `CREATE TABLE IF NOT EXISTS mysql.tidb_placement_violations (
+		table_id BIGINT(64) NOT NULL,
+		table_schema VARCHAR(64) NOT NULL,
+		table_name VARCHAR(64) NOT NULL,
+		partition_name VARCHAR(64) DEFAULT NULL,
+		violation VARCHAR(32) NOT NULL,
+		detail TEXT DEFAULT NULL,
+		check_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		repair_time TIMESTAMP(6) NULL DEFAULT NULL,
+		PRIMARY KEY (table_id)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;`
+
+	// CreateAddIndexConflictsTable stores the conflicting records found when adding a unique index fails.
+	CreateAddIndexConflictsTable = `CREATE TABLE IF NOT EXISTS mysql.add_index_conflicts (
+		job_id BIGINT(64) NOT NULL,
+		table_schema VARCHAR(64) NOT NULL,
+		table_name VARCHAR(64) NOT NULL,
+		index_name VARCHAR(64) NOT NULL,
+		index_value TEXT DEFAULT NULL,
+		handle TEXT DEFAULT NULL,
+		create_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		KEY (job_id)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;`
+
+	// CreateBackupHistoryTable stores the finished backups and the backup estimates of BR.
+	// The throughput and compression ratio of the finished backups are used to estimate the later backups.
+	CreateBackupHistoryTable = `CREATE TABLE IF NOT EXISTS mysql.tidb_backup_history (
+		id BIGINT(64) NOT NULL AUTO_INCREMENT,
+		cmd VARCHAR(64) NOT NULL,
+		estimate_only TINYINT(1) NOT NULL DEFAULT 0,
+		backup_ts BIGINT(64) UNSIGNED NOT NULL DEFAULT 0,
+		region_count BIGINT(64) NOT NULL DEFAULT 0,
+		kv_size BIGINT(64) NOT NULL DEFAULT 0,
+		backup_size BIGINT(64) NOT NULL DEFAULT 0,
+		duration DOUBLE NOT NULL DEFAULT 0,
+		storage_cost DOUBLE NOT NULL DEFAULT 0,
+		create_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (id)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;`
+
+	// CreateColdPartitionsTable stores the partitions moved to the cold storage, and the
+	// partitions recalled from it, which won't be moved again.
+	CreateColdPartitionsTable = `CREATE TABLE IF NOT EXISTS mysql.tidb_cold_partitions (
+		partition_id BIGINT(64) NOT NULL,
+		table_id BIGINT(64) NOT NULL,
+		table_schema VARCHAR(64) NOT NULL,
+		table_name VARCHAR(64) NOT NULL,
+		partition_name VARCHAR(64) NOT NULL,
+		state VARCHAR(16) NOT NULL,
+		move_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		recall_time TIMESTAMP(6) NULL DEFAULT NULL,
+		PRIMARY KEY (partition_id)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;`
+
 	// DropMySQLIndexUsageTable removes the table `mysql.schema_index_usage`
 	DropMySQLIndexUsageTable = "DROP TABLE IF EXISTS mysql.schema_index_usage"
 
@@ -1082,11 +1149,36 @@ const (
 	//   create `sys` schema
 	//   create `sys.schema_unused_indexes` table
 	version195 = 195
+
+	// version 196
+	//   add new system table `mysql.tidb_variable_profiles`, which is used to
+	//   store the variable profiles.
+	version196 = 196
+
+	// version 197
+	//   add new system table `mysql.tidb_placement_violations`, which is used to
+	//   store the violations of the placement policies.
+	version197 = 197
+
+	// version 198
+	//   add new system table `mysql.add_index_conflicts`, which is used to
+	//   store the conflicting records found when adding a unique index fails.
+	version198 = 198
+
+	// version 199
+	//   add new system table `mysql.tidb_backup_history`, which is used to
+	//   store the finished backups and the backup estimates of BR.
+	version199 = 199
+
+	// version 200
+	//   add new system table `mysql.tidb_cold_partitions`, which is used to
+	//   store the partitions moved to the cold storage.
+	version200 = 200
 )
 
 // currentBootstrapVersion is defined as a variable, so we can modify its value for testing.
 // please make sure this is the largest version
-var currentBootstrapVersion int64 = version195
+var currentBootstrapVersion int64 = version200
 
 // DDL owner key's expired time is ManagerSessionTTL seconds, we should wait the time and give more time to have a chance to finish it.
 var internalSQLTimeout = owner.ManagerSessionTTL + 15
@@ -1247,6 +1339,11 @@ var (
 		upgradeToVer193,
 		upgradeToVer194,
 		upgradeToVer195,
+		upgradeToVer196,
+		upgradeToVer197,
+		upgradeToVer198,
+		upgradeToVer199,
+		upgradeToVer200,
 	}
 )
 
@@ -3069,6 +3166,41 @@ func upgradeToVer195(s sessiontypes.Session, ver int64) {
 	doReentrantDDL(s, DropMySQLIndexUsageTable)
 }
 
+func upgradeToVer196(s sessiontypes.Session, ver int64) {
+	if ver >= version196 {
+		return
+	}
+	doReentrantDDL(s, CreateVariableProfilesTable)
+}
+
+func upgradeToVer197(s sessiontypes.Session, ver int64) {
+	if ver >= version197 {
+		return
+	}
+	doReentrantDDL(s, CreatePlacementViolationsTable)
+}
+
+func upgradeToVer198(s sessiontypes.Session, ver int64) {
+	if ver >= version198 {
+		return
+	}
+	doReentrantDDL(s, CreateAddIndexConflictsTable)
+}
+
+func upgradeToVer199(s sessiontypes.Session, ver int64) {
+	if ver >= version199 {
+		return
+	}
+	doReentrantDDL(s, CreateBackupHistoryTable)
+}
+
+func upgradeToVer200(s sessiontypes.Session, ver int64) {
+	if ver >= version200 {
+		return
+	}
+	doReentrantDDL(s, CreateColdPartitionsTable)
+}
+
 func writeOOMAction(s sessiontypes.Session) {
 	comment := "oom-action is `log` by default in v3.0.x, `cancel` by default in v4.0.11+"
 	mustExecute(s, `INSERT HIGH_PRIORITY INTO %n.%n VALUES (%?, %?, %?) ON DUPLICATE KEY UPDATE VARIABLE_VALUE= %?`,
@@ -3196,6 +3328,16 @@ func doDDLWorks(s sessiontypes.Session) {
 	mustExecute(s, CreateDistFrameworkMeta)
 	// create request_unit_by_group
 	mustExecute(s, CreateRequestUnitByGroupTable)
+	// create tidb_variable_profiles
+	mustExecute(s, CreateVariableProfilesTable)
+	// create tidb_placement_violations
+	mustExecute(s, CreatePlacementViolationsTable)
+	// create add_index_conflicts
+	mustExecute(s, CreateAddIndexConflictsTable)
+	// create tidb_backup_history
+	mustExecute(s, CreateBackupHistoryTable)
+	// create tidb_cold_partitions
+	mustExecute(s, CreateColdPartitionsTable)
 	// create `sys` schema
 	mustExecute(s, CreateSysSchema)
 	// create `sys.schema_unused_indexes` view
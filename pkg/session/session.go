@@ -103,6 +103,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/dbterror"
 	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
 	"github.com/pingcap/tidb/pkg/util/execdetails"
+	"github.com/pingcap/tidb/pkg/util/faultinject"
 	"github.com/pingcap/tidb/pkg/util/intest"
 	"github.com/pingcap/tidb/pkg/util/kvcache"
 	"github.com/pingcap/tidb/pkg/util/logutil"
@@ -520,6 +521,9 @@ func (s *session) doCommit(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if err = s.sessionVars.InjectedFaults.Eval(ctx, faultinject.TxnBeforeCommit); err != nil {
+		return err
+	}
 	// mockCommitError and mockGetTSErrorInRetry use to test PR #8743.
 	failpoint.Inject("mockCommitError", func(val failpoint.Value) {
 		if val.(bool) {
@@ -528,6 +532,9 @@ func (s *session) doCommit(ctx context.Context) error {
 			}
 		}
 	})
+	if err = executor.ThrottleTableWrites(ctx, s); err != nil {
+		return err
+	}
 
 	if s.sessionVars.BinlogClient != nil {
 		prewriteValue := binloginfo.GetPrewriteValue(s, false)
@@ -692,7 +699,7 @@ func (s *session) handleAssertionFailure(ctx context.Context, err error) error {
 		assertionFailure.ExistingStartTs, assertionFailure.ExistingCommitTs,
 	)
 
-	rmode := s.GetSessionVars().EnableRedactLog
+	rmode := s.GetSessionVars().RedactLogMode(variable.RedactLogDestErrorLog)
 	if rmode == errors.RedactLogEnable {
 		return newErr
 	}
@@ -1181,8 +1188,8 @@ func (s *session) retry(ctx context.Context, maxCnt uint) (err error) {
 				// We do not have to log the query every time.
 				// We print the queries at the first try only.
 				sql := sqlForLog(st.GetTextToLog(false))
-				if sessVars.EnableRedactLog != errors.RedactLogEnable {
-					sql += redact.String(sessVars.EnableRedactLog, sessVars.PlanCacheParams.String())
+				if rmode := sessVars.RedactLogMode(variable.RedactLogDestErrorLog); rmode != errors.RedactLogEnable {
+					sql += redact.String(rmode, sessVars.PlanCacheParams.String())
 				}
 				logutil.Logger(ctx).Warn("retrying",
 					zap.Int64("schemaVersion", schemaVersion),
@@ -1535,7 +1542,7 @@ func (s *session) SetProcessInfo(sql string, t time.Time, command byte, maxExecu
 		TableIDs:              s.sessionVars.StmtCtx.TableIDs,
 		IndexNames:            s.sessionVars.StmtCtx.IndexNames,
 		MaxExecutionTime:      maxExecutionTime,
-		RedactSQL:             s.sessionVars.EnableRedactLog,
+		RedactSQL:             s.sessionVars.RedactLogMode(variable.RedactLogDestErrorLog),
 		ResourceGroupName:     s.sessionVars.StmtCtx.ResourceGroupName,
 		SessionAlias:          s.sessionVars.SessionAlias,
 	}
@@ -1677,7 +1684,7 @@ func (s *session) Parse(ctx context.Context, sql string) ([]ast.StmtNode, error)
 		// Only print log message when this SQL is from the user.
 		// Mute the warning for internal SQLs.
 		if !s.sessionVars.InRestrictedSQL {
-			logutil.Logger(ctx).Warn("parse SQL failed", zap.Error(err), zap.String("SQL", redact.String(s.sessionVars.EnableRedactLog, sql)))
+			logutil.Logger(ctx).Warn("parse SQL failed", zap.Error(err), zap.String("SQL", redact.String(s.sessionVars.RedactLogMode(variable.RedactLogDestErrorLog), sql)))
 			s.sessionVars.StmtCtx.AppendError(err)
 		}
 		return nil, err
@@ -1727,7 +1734,7 @@ func (s *session) ParseWithParams(ctx context.Context, sql string, args ...any)
 	if err != nil {
 		s.rollbackOnError(ctx)
 		logSQL := sql[:min(500, len(sql))]
-		logutil.Logger(ctx).Warn("parse SQL failed", zap.Error(err), zap.String("SQL", redact.String(s.sessionVars.EnableRedactLog, logSQL)))
+		logutil.Logger(ctx).Warn("parse SQL failed", zap.Error(err), zap.String("SQL", redact.String(s.sessionVars.RedactLogMode(variable.RedactLogDestErrorLog), logSQL)))
 		return nil, util.SyntaxError(err)
 	}
 	durParse := time.Since(parseStartTime)
@@ -2215,7 +2222,7 @@ func (s *session) ExecuteStmt(ctx context.Context, stmtNode ast.StmtNode) (sqlex
 		if !s.sessionVars.InRestrictedSQL {
 			if !variable.ErrUnknownSystemVar.Equal(err) {
 				sql := stmtNode.Text()
-				sql = parser.Normalize(sql, s.sessionVars.EnableRedactLog)
+				sql = parser.Normalize(sql, s.sessionVars.RedactLogMode(variable.RedactLogDestErrorLog))
 				logutil.Logger(ctx).Warn("compile SQL failed", zap.Error(err),
 					zap.String("SQL", sql))
 			}
@@ -2687,6 +2694,7 @@ func (s *session) GetDistSQLCtx() *distsqlctx.DistSQLContext {
 			TiKVClientReadTimeout:         vars.GetTiKVClientReadTimeout(),
 
 			ReplicaClosestReadThreshold: vars.ReplicaClosestReadThreshold,
+			ReplicaReadStoreLabels:      vars.ReplicaReadStoreLabels,
 			ConnectionID:                vars.ConnectionID,
 			SessionAlias:                vars.SessionAlias,
 
@@ -3106,16 +3114,31 @@ type Opt struct {
 func CreateSession4TestWithOpt(store kv.Storage, opt *Opt) (types.Session, error) {
 	s, err := CreateSessionWithOpt(store, opt)
 	if err == nil {
-		// initialize session variables for test.
-		s.GetSessionVars().InitChunkSize = 2
-		s.GetSessionVars().MaxChunkSize = 32
-		s.GetSessionVars().MinPagingSize = variable.DefMinPagingSize
-		s.GetSessionVars().EnablePaging = variable.DefTiDBEnablePaging
-		err = s.GetSessionVars().SetSystemVarWithoutValidation(variable.CharacterSetConnection, "utf8mb4")
+		err = initSession4Test(s)
 	}
 	return s, err
 }
 
+// CreateSession4TestWithDomain creates a new session environment bound to the
+// domain for test, it's used to simulate sessions on different TiDB nodes which
+// share the same store, only for unit testing.
+func CreateSession4TestWithDomain(store kv.Storage, dom *domain.Domain) (types.Session, error) {
+	s, err := createSessionWithDomainAndOpt(store, dom, nil)
+	if err == nil {
+		err = initSession4Test(s)
+	}
+	return s, err
+}
+
+// initSession4Test initializes session variables for test.
+func initSession4Test(s types.Session) error {
+	s.GetSessionVars().InitChunkSize = 2
+	s.GetSessionVars().MaxChunkSize = 32
+	s.GetSessionVars().MinPagingSize = variable.DefMinPagingSize
+	s.GetSessionVars().EnablePaging = variable.DefTiDBEnablePaging
+	return s.GetSessionVars().SetSystemVarWithoutValidation(variable.CharacterSetConnection, "utf8mb4")
+}
+
 // CreateSession creates a new session environment.
 func CreateSession(store kv.Storage) (types.Session, error) {
 	return CreateSessionWithOpt(store, nil)
@@ -3124,16 +3147,17 @@ func CreateSession(store kv.Storage) (types.Session, error) {
 // CreateSessionWithOpt creates a new session environment with option.
 // Use default option if opt is nil.
 func CreateSessionWithOpt(store kv.Storage, opt *Opt) (types.Session, error) {
-	s, err := createSessionWithOpt(store, opt)
+	do, err := domap.Get(store)
 	if err != nil {
 		return nil, err
 	}
+	return createSessionWithDomainAndOpt(store, do, opt)
+}
+
+func createSessionWithDomainAndOpt(store kv.Storage, do *domain.Domain, opt *Opt) (types.Session, error) {
+	s := newSessionWithDomain(store, do, opt)
 
 	// Add auth here.
-	do, err := domap.Get(store)
-	if err != nil {
-		return nil, err
-	}
 	extensions, err := extension.GetExtensions()
 	if err != nil {
 		return nil, err
@@ -3663,6 +3687,10 @@ func createSessionWithOpt(store kv.Storage, opt *Opt) (*session, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newSessionWithDomain(store, dom, opt), nil
+}
+
+func newSessionWithDomain(store kv.Storage, dom *domain.Domain, opt *Opt) *session {
 	s := &session{
 		store:                 store,
 		ddlOwnerManager:       dom.DDL().OwnerManager(),
@@ -3692,7 +3720,7 @@ func createSessionWithOpt(store kv.Storage, opt *Opt) (*session, error) {
 	sessionBindHandle := bindinfo.NewSessionBindingHandle()
 	s.SetValue(bindinfo.SessionBindInfoKeyType, sessionBindHandle)
 	s.SetSessionStatesHandler(sessionstates.StateBinding, sessionBindHandle)
-	return s, nil
+	return s
 }
 
 // attachStatsCollector attaches the stats collector in the dom for the session
@@ -4003,12 +4031,12 @@ func logGeneralQuery(execStmt *executor.ExecStmt, s *session, isPrepared bool) {
 		if isPrepared {
 			query = execStmt.OriginText()
 		} else {
-			query = execStmt.GetTextToLog(false)
+			query = execStmt.GetTextToLogFor(false, variable.RedactLogDestGeneralLog)
 		}
 
 		query = executor.QueryReplacer.Replace(query)
-		if vars.EnableRedactLog != errors.RedactLogEnable {
-			query += redact.String(vars.EnableRedactLog, vars.PlanCacheParams.String())
+		if rmode := vars.RedactLogMode(variable.RedactLogDestGeneralLog); rmode != errors.RedactLogEnable {
+			query += redact.String(rmode, vars.PlanCacheParams.String())
 		}
 		logutil.GeneralLogger.Info("GENERAL_LOG",
 			zap.Uint64("conn", vars.ConnectionID),
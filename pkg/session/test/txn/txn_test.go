@@ -24,6 +24,7 @@ import (
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -318,6 +319,49 @@ func TestAutoCommitRespectsReadOnly(t *testing.T) {
 	tk1.MustExec("SET GLOBAL tidb_super_read_only = 0")
 }
 
+func TestRestrictedReadOnlySchemas(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("create database ro_db")
+	tk.MustExec("create table ro_db.t (a int primary key, b int)")
+	tk.MustExec("insert into ro_db.t values (1, 1)")
+	tk.MustExec("create table test.t (a int primary key, b int)")
+
+	tk.MustGetErrCode("set global tidb_restricted_read_only_schemas = 'mysql'", mysql.ErrWrongValueForVar)
+	tk.MustExec("set global tidb_restricted_read_only_schemas = ' RO_DB, ro_db,,ro_other '")
+	tk.MustQuery("select @@global.tidb_restricted_read_only_schemas").Check(testkit.Rows("ro_db,ro_other"))
+	defer tk.MustExec("set global tidb_restricted_read_only_schemas = ''")
+
+	// the writes to the read-only databases are rejected, including the point plans and the prepared statements.
+	for _, sql := range []string{
+		"insert into ro_db.t values (2, 2)",
+		"update ro_db.t set b = 2 where a = 1",
+		"update ro_db.t set b = 2",
+		"delete from ro_db.t where a = 1",
+		"insert into ro_db.t select * from test.t",
+		"alter table ro_db.t add index idx(b)",
+		"create table ro_db.t2 (a int)",
+		"drop table ro_db.t",
+	} {
+		tk.MustGetErrCode(sql, errno.ErrSchemaReadOnly)
+	}
+	tk.MustExec("prepare stmt from 'update ro_db.t set b = ? where a = 1'")
+	tk.MustGetErrCode("execute stmt using @b", errno.ErrSchemaReadOnly)
+	tk.MustQuery("select * from ro_db.t").Check(testkit.Rows("1 1"))
+	// the other databases are still writable.
+	tk.MustExec("insert into test.t select * from ro_db.t")
+	tk.MustQuery("select * from test.t").Check(testkit.Rows("1 1"))
+
+	tk.MustExec("GRANT RESTRICTED_REPLICA_WRITER_ADMIN on *.* to 'root'")
+	tk.MustExec("update ro_db.t set b = 2 where a = 1")
+	tk.MustExec("REVOKE RESTRICTED_REPLICA_WRITER_ADMIN on *.* from 'root'")
+
+	tk.MustExec("set global tidb_restricted_read_only_schemas = ''")
+	tk.MustExec("execute stmt using @b")
+	tk.MustExec("insert into ro_db.t values (2, 2)")
+}
+
 func TestTxnRetryErrMsg(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	setTxnTk := testkit.NewTestKit(t, store)
@@ -508,3 +552,55 @@ func TestInTrans(t *testing.T) {
 	tk.MustExec("rollback")
 	require.False(t, txn.Valid())
 }
+
+func TestTableWriteRateLimit(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int) partition by hash(a) partitions 4")
+	tk.MustExec("create table t2 (a int primary key, b int)")
+
+	tk.MustGetErrCode("set global tidb_table_write_rate_limit = 'test.t:0rows'", mysql.ErrWrongValueForVar)
+	tk.MustExec("set global tidb_table_write_rate_limit = 'Test.T:10 rows, test.t3:1mb'")
+	tk.MustQuery("select @@global.tidb_table_write_rate_limit").Check(testkit.Rows("test.t:10rows,test.t3:1MB"))
+	defer tk.MustExec("set global tidb_table_write_rate_limit = ''")
+
+	// the first commit isn't queued, and the writes to all the partitions are counted.
+	start := time.Now()
+	tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8), (9, 9), (10, 10)")
+	require.Less(t, time.Since(start), time.Second)
+	// the other tables aren't limited.
+	tk.MustExec("insert into t2 values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8), (9, 9), (10, 10)")
+	require.Less(t, time.Since(start), time.Second)
+	// the next commit waits until the former 10 rows have drained.
+	tk.MustExec("update t set b = b + 1 where a <= 5")
+	require.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	tk.MustQuery("select count(*) from t where b = a + 1").Check(testkit.Rows("5"))
+
+	// the pessimistic DML waits for the former writes before locking the keys, so it doesn't block
+	// the others while waiting, and its commit doesn't wait again with the locks held.
+	tk.MustExec("begin pessimistic")
+	start = time.Now()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tk.MustExec("update t set b = b + 1 where a <= 5")
+	}()
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use test")
+	time.Sleep(100 * time.Millisecond)
+	tk2.MustExec("begin pessimistic")
+	tk2.MustQuery("select * from t where a = 1 for update nowait").Check(testkit.Rows("1 2"))
+	tk2.MustExec("rollback")
+	<-done
+	require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	start = time.Now()
+	tk.MustExec("commit")
+	require.Less(t, time.Since(start), 400*time.Millisecond)
+	tk.MustQuery("select count(*) from t where b = a + 2").Check(testkit.Rows("5"))
+
+	tk.MustExec("set global tidb_table_write_rate_limit = ''")
+	start = time.Now()
+	tk.MustExec("delete from t")
+	require.Less(t, time.Since(start), time.Second)
+}
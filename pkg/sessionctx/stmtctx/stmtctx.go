@@ -559,6 +559,8 @@ const (
 	StmtSafeTSCacheKey
 	// StmtExternalTSCacheKey is a variable for externalTS calculation/cache of one stmt.
 	StmtExternalTSCacheKey
+	// StmtSubqueryResultCacheKey is a variable for the results of the uncorrelated subqueries evaluated in one stmt.
+	StmtSubqueryResultCacheKey
 )
 
 // GetOrStoreStmtCache gets the cached value of the given key if it exists, otherwise stores the value.
@@ -1205,7 +1207,13 @@ func (sc *StatementContext) GetStaleTSO() (uint64, error) {
 }
 
 // AddSetVarHintRestore records the variables which are affected by SET_VAR hint. And restore them to the old value later.
+// A variable may be set more than once in a statement, e.g. by the hints of the
+// statement and then its binding, only the first old value is recorded.
 func (sc *StatementContext) AddSetVarHintRestore(name, val string) {
+	name = strings.ToLower(name)
+	if _, ok := sc.SetVarHintRestore[name]; ok {
+		return
+	}
 	if sc.SetVarHintRestore == nil {
 		sc.SetVarHintRestore = make(map[string]string)
 	}
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/domain/resourcegroup"
 	"github.com/pingcap/tidb/pkg/errctx"
@@ -52,6 +53,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
 	"github.com/pingcap/tidb/pkg/util/disk"
 	"github.com/pingcap/tidb/pkg/util/execdetails"
+	"github.com/pingcap/tidb/pkg/util/faultinject"
 	"github.com/pingcap/tidb/pkg/util/kvcache"
 	"github.com/pingcap/tidb/pkg/util/mathutil"
 	"github.com/pingcap/tidb/pkg/util/memory"
@@ -673,6 +675,8 @@ type SessionVars struct {
 	Concurrency
 	MemQuota
 	BatchSize
+	// MaxChunkMemSize defines the max estimated memory size of a Chunk during query execution, 0 means no limit.
+	MaxChunkMemSize int
 	// DMLBatchSize indicates the number of rows batch-committed for a statement.
 	// It will be used when using LOAD DATA or BatchInsert or BatchDelete is on.
 	DMLBatchSize        int
@@ -736,6 +740,10 @@ type SessionVars struct {
 	// ConnectionID is the connection id of the current session.
 	ConnectionID uint64
 
+	// InjectedFaults is the faults injected by `ADMIN INJECT FAULT`, it's nil
+	// if no fault has been injected into the session.
+	InjectedFaults *faultinject.Faults
+
 	// PlanID is the unique id of logical and physical plan.
 	PlanID atomic.Int32
 
@@ -937,6 +945,15 @@ type SessionVars struct {
 	// enableForceInlineCTE is used to enable/disable force inline CTE.
 	enableForceInlineCTE bool
 
+	// EnableSharedSubplan indicates whether to share the identical derived tables in the branches of a set operation.
+	EnableSharedSubplan bool
+
+	// EnableSubqueryResultReuse indicates whether to reuse the results of the identical uncorrelated subqueries in a statement.
+	EnableSubqueryResultReuse bool
+
+	// EnableCardinalityFeedback indicates whether to correct the estimated row count with the execution feedback.
+	EnableCardinalityFeedback bool
+
 	// CopTiFlashConcurrencyFactor is the concurrency number of computation in tiflash coprocessor.
 	CopTiFlashConcurrencyFactor float64
 
@@ -1138,6 +1155,19 @@ type SessionVars struct {
 	// ReplicaClosestReadThreshold is the minimum response body size that a cop request should be sent to the closest replica.
 	// this variable only take effect when `tidb_follower_read` = 'closest-adaptive'
 	ReplicaClosestReadThreshold int64
+	// ReplicaReadStoreLabels is the labels that the stores must match when reading data from replicas.
+	ReplicaReadStoreLabels []*metapb.StoreLabel
+
+	// IndexLookupStreaming indicates whether the index double read executor returns the table rows as soon as
+	// they're read when the order of the index isn't required.
+	IndexLookupStreaming bool
+	// IndexLookupMaxInflightMem is the max memory of the table rows read but not returned yet by the streaming
+	// index double read executor, 0 means no limit.
+	IndexLookupMaxInflightMem int64
+
+	// EnableUnionPointGetBatch indicates whether the KV gets of the point gets under UNION ALL are coalesced
+	// into the BatchGet requests.
+	EnableUnionPointGetBatch bool
 
 	// IsolationReadEngines is used to isolation read, tidb only read from the stores whose engine type is in the engines.
 	IsolationReadEngines map[kv.StoreType]struct{}
@@ -1208,6 +1238,9 @@ type SessionVars struct {
 	// EnableRedactLog indicates that whether redact log. Possible values are 'OFF', 'ON', 'MARKER'.
 	EnableRedactLog string
 
+	// RedactLogProfile is the redact mode of each log destination which overrides EnableRedactLog.
+	RedactLogProfile map[string]string
+
 	// ShardAllocateStep indicates the max size of continuous rowid shard in one transaction.
 	ShardAllocateStep int64
 
@@ -1244,6 +1277,9 @@ type SessionVars struct {
 	// DisableHashJoin indicates whether to disable hash join.
 	DisableHashJoin bool
 
+	// EnableOrExpansion indicates whether to expand the disjunctions over the different tables into UNION ALL.
+	EnableOrExpansion bool
+
 	// EnableHistoricalStats indicates whether to enable historical statistics.
 	EnableHistoricalStats bool
 
@@ -1286,6 +1322,9 @@ type SessionVars struct {
 	// MPPStoreFailTTL indicates the duration that protect TiDB from sending task to a new recovered TiFlash.
 	MPPStoreFailTTL string
 
+	// EnableMPPFailureRetry indicates whether to retry the MPP query when a TiFlash node fails.
+	EnableMPPFailureRetry bool
+
 	// ReadStaleness indicates the staleness duration for the following query
 	ReadStaleness time.Duration
 
@@ -1459,6 +1498,10 @@ type SessionVars struct {
 	chunkPool chunk.Allocator
 	// EnableReuseChunk indicates  request chunk whether use chunk alloc
 	EnableReuseChunk bool
+	// ChunkArenaSize is the max bytes held by chunkArena, 0 means the arena isn't used.
+	ChunkArenaSize int64
+	// chunkArena recycles the released chunks of the statements when ChunkArenaSize > 0.
+	chunkArena *chunk.ArenaAllocator
 
 	// EnableAdvancedJoinHint indicates whether the join method hint is compatible with join order hint.
 	EnableAdvancedJoinHint bool
@@ -1498,6 +1541,10 @@ type SessionVars struct {
 	// Enable late materialization: push down some selection condition to tablescan.
 	EnableLateMaterialization bool
 
+	// PrunePartitionsBySubquery indicates whether to evaluate the small uncorrelated `IN (SUBQUERY)` at optimize time
+	// to prune the partitions statically.
+	PrunePartitionsBySubquery bool
+
 	// EnableRowLevelChecksum indicates whether row level checksum is enabled.
 	EnableRowLevelChecksum bool
 
@@ -1531,6 +1578,10 @@ type SessionVars struct {
 	// OptimizerFixControl control some details of the optimizer behavior through the tidb_opt_fix_control variable.
 	OptimizerFixControl map[uint64]string
 
+	// OptimizerFixControlByDigest is the optimizer fix control of the statement digests set through the
+	// tidb_opt_fix_control_by_digest variable.
+	OptimizerFixControlByDigest map[string]map[uint64]string
+
 	// FastCheckTable is used to control whether fast check table is enabled.
 	FastCheckTable bool
 
@@ -1544,11 +1595,17 @@ type SessionVars struct {
 	HypoTiFlashReplicas map[string]map[string]struct{} // dbName -> tblName -> whether to have replicas
 
 	// Runtime Filter Group
-	// Runtime filter type: only support IN or MIN_MAX now.
+	// Runtime filter type: IN, MIN_MAX or BLOOM_FILTER.
 	// Runtime filter type can take multiple values at the same time.
 	runtimeFilterTypes []RuntimeFilterType
 	// Runtime filter mode: only support OFF, LOCAL now
 	runtimeFilterMode RuntimeFilterMode
+	// runtimeFilterWaitTimeMs is the max time in milliseconds a scan waits for its runtime filters.
+	runtimeFilterWaitTimeMs int
+	// runtimeFilterMinFilterRatio is the min estimated ratio of the probe side rows filtered by a runtime filter.
+	runtimeFilterMinFilterRatio float64
+	// enableMultiColumnRuntimeFilter indicates whether to generate one IN runtime filter for a composite join key.
+	enableMultiColumnRuntimeFilter bool
 
 	// Whether to lock duplicate keys in INSERT IGNORE and REPLACE statements,
 	// or unchanged unique keys in UPDATE statements, see PR #42210 and #42713
@@ -1565,6 +1622,9 @@ type SessionVars struct {
 	// SessionAlias is the identifier of the session
 	SessionAlias string
 
+	// QueryAttributes is the query attributes sent by the client with the current statement.
+	QueryAttributes map[string]string
+
 	// OptObjective indicates whether the optimizer should be more stable, predictable or more aggressive.
 	// For now, the possible values and corresponding behaviors are:
 	// OptObjectiveModerate: The default value. The optimizer considers the real-time stats (real-time row count, modify count).
@@ -1584,6 +1644,12 @@ type SessionVars struct {
 
 	// GroupConcatMaxLen represents the maximum length of the result of GROUP_CONCAT.
 	GroupConcatMaxLen uint64
+
+	// EgressRateLimit is the maximum bytes per second of the result sets sent to the client, 0 means no limit.
+	EgressRateLimit int64
+
+	// DDLIndexPreSplitRegions is the number of regions to pre-split for each index added by the ingest backfill.
+	DDLIndexPreSplitRegions int
 }
 
 // GetOptimizerFixControlMap returns the specified value of the optimizer fix control.
@@ -1651,6 +1717,14 @@ func (s *SessionVars) SetAlloc(alloc chunk.Allocator) {
 		s.chunkPool = nil
 		return
 	}
+	if s.ChunkArenaSize > 0 {
+		if s.chunkArena == nil {
+			s.chunkArena = chunk.NewArenaAllocator(s.ChunkArenaSize)
+		} else {
+			s.chunkArena.SetCapacity(s.ChunkArenaSize)
+		}
+		alloc = s.chunkArena
+	}
 	s.chunkPool = chunk.NewReuseHookAllocator(
 		chunk.NewSyncAllocator(alloc),
 		func() {
@@ -1668,7 +1742,15 @@ func (s *SessionVars) IsAllocValid() bool {
 }
 
 // ClearAlloc indicates stop reuse chunk. If `hasErr` is true, it'll also recreate the `alloc` in parameter.
+// The released chunks of the statement are recycled into the chunk arena, which is dropped if `hasErr` is true.
 func (s *SessionVars) ClearAlloc(alloc *chunk.Allocator, hasErr bool) {
+	if s.chunkArena != nil {
+		if hasErr || s.ChunkArenaSize <= 0 {
+			s.chunkArena = nil
+		} else {
+			s.chunkArena.Reset()
+		}
+	}
 	if !hasErr {
 		s.chunkPool = nil
 		return
@@ -1966,6 +2048,8 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		DDLReorgPriority:              kv.PriorityLow,
 		allowInSubqToJoinAndAgg:       DefOptInSubqToJoinAndAgg,
 		preferRangeScan:               DefOptPreferRangeScan,
+		runtimeFilterWaitTimeMs:       DefRuntimeFilterWaitTimeMs,
+		runtimeFilterMinFilterRatio:   DefRuntimeFilterMinFilterRatio,
 		EnableCorrelationAdjustment:   DefOptEnableCorrelationAdjustment,
 		LimitPushDownThreshold:        DefOptLimitPushDownThreshold,
 		CorrelationThreshold:          DefOptCorrelationThreshold,
@@ -2021,6 +2105,7 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		CTEMaxRecursionDepth:          DefCTEMaxRecursionDepth,
 		TMPTableSize:                  DefTiDBTmpTableMaxSize,
 		MPPStoreFailTTL:               DefTiDBMPPStoreFailTTL,
+		EnableMPPFailureRetry:         DefTiDBEnableMPPFailureRetry,
 		Rng:                           mathutil.NewWithTime(),
 		StatsLoadSyncWait:             StatsLoadSyncWait.Load(),
 		EnableLegacyInstanceScope:     DefEnableLegacyInstanceScope,
@@ -2033,11 +2118,13 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		ForeignKeyChecks:              DefTiDBForeignKeyChecks,
 		HookContext:                   hctx,
 		EnableReuseChunk:              DefTiDBEnableReusechunk,
+		ChunkArenaSize:                DefTiDBChunkArenaSize,
 		preUseChunkAlloc:              DefTiDBUseAlloc,
 		chunkPool:                     nil,
 		mppExchangeCompressionMode:    DefaultExchangeCompressionMode,
 		mppVersion:                    kv.MppVersionUnspecified,
 		EnableLateMaterialization:     DefTiDBOptEnableLateMaterialization,
+		PrunePartitionsBySubquery:     DefTiDBOptPrunePartitionsBySubquery,
 		TiFlashComputeDispatchPolicy:  tiflashcompute.DispatchPolicyConsistentHash,
 		ResourceGroupName:             resourcegroup.DefaultResourceGroupName,
 		DefaultCollationForUTF8MB4:    mysql.DefaultCollationName,
@@ -2074,6 +2161,7 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		MinPagingSize:      DefMinPagingSize,
 		MaxPagingSize:      DefMaxPagingSize,
 	}
+	vars.MaxChunkMemSize = DefMaxChunkMemSize
 	vars.DMLBatchSize = DefDMLBatchSize
 	vars.AllowBatchCop = DefTiDBAllowBatchCop
 	vars.allowMPPExecution = DefTiDBAllowMPPExecution
@@ -3041,6 +3129,8 @@ const (
 	SlowLogConnIDStr = "Conn_ID"
 	// SlowLogSessAliasStr is the session alias set by user
 	SlowLogSessAliasStr = "Session_alias"
+	// SlowLogQueryAttributesStr is the query attributes sent by the client with the statement.
+	SlowLogQueryAttributesStr = "Query_attributes"
 	// SlowLogQueryTimeStr is slow log field name.
 	SlowLogQueryTimeStr = "Query_time"
 	// SlowLogParseTimeStr is the parse sql time.
@@ -3258,6 +3348,14 @@ func (s *SessionVars) SlowLogFormat(logItems *SlowQueryLogItems) string {
 	if s.SessionAlias != "" {
 		writeSlowLogItem(&buf, SlowLogSessAliasStr, s.SessionAlias)
 	}
+	if len(s.QueryAttributes) > 0 {
+		// The attributes are sorted by the keys in the JSON.
+		attrs, err := json.Marshal(s.QueryAttributes)
+		if err != nil {
+			attrs = []byte(err.Error())
+		}
+		writeSlowLogItem(&buf, SlowLogQueryAttributesStr, string(attrs))
+	}
 	if logItems.ExecRetryCount > 0 {
 		buf.WriteString(SlowLogRowPrefixStr)
 		buf.WriteString(SlowLogExecRetryTime)
@@ -3607,6 +3705,15 @@ func (s *SessionVars) EnableForceInlineCTE() bool {
 	return s.enableForceInlineCTE
 }
 
+// RedactLogMode returns the redact mode of the log destination, it's EnableRedactLog
+// unless the destination is set in tidb_redact_log_profile.
+func (s *SessionVars) RedactLogMode(destination string) string {
+	if mode, ok := s.RedactLogProfile[destination]; ok {
+		return mode
+	}
+	return s.EnableRedactLog
+}
+
 // IsRuntimeFilterEnabled return runtime filter mode whether OFF
 func (s *SessionVars) IsRuntimeFilterEnabled() bool {
 	return s.runtimeFilterMode != RFOff
@@ -3622,6 +3729,21 @@ func (s *SessionVars) GetRuntimeFilterMode() RuntimeFilterMode {
 	return s.runtimeFilterMode
 }
 
+// GetRuntimeFilterWaitTimeMs return the session variable runtimeFilterWaitTimeMs
+func (s *SessionVars) GetRuntimeFilterWaitTimeMs() int {
+	return s.runtimeFilterWaitTimeMs
+}
+
+// GetRuntimeFilterMinFilterRatio return the session variable runtimeFilterMinFilterRatio
+func (s *SessionVars) GetRuntimeFilterMinFilterRatio() float64 {
+	return s.runtimeFilterMinFilterRatio
+}
+
+// IsMultiColumnRuntimeFilterEnabled return the session variable enableMultiColumnRuntimeFilter
+func (s *SessionVars) IsMultiColumnRuntimeFilterEnabled() bool {
+	return s.enableMultiColumnRuntimeFilter
+}
+
 // GetMaxExecutionTime get the max execution timeout value.
 func (s *SessionVars) GetMaxExecutionTime() uint64 {
 	if s.StmtCtx.HasMaxExecutionTime {
@@ -3635,15 +3757,17 @@ func (s *SessionVars) GetTiKVClientReadTimeout() uint64 {
 	return s.TiKVClientReadTimeout
 }
 
-// RuntimeFilterType type of runtime filter "IN"
+// RuntimeFilterType type of runtime filter "IN", "MIN_MAX", "BLOOM_FILTER"
 type RuntimeFilterType int64
 
 // In type of runtime filter, like "t.k1 in (?)"
 // MinMax type of runtime filter, like "t.k1 < ? and t.k1 > ?"
+// BloomFilter type of runtime filter, a bloom filter built from the values of the build side,
+// it is used for the join keys of high cardinality, for which the IN list is too large.
 const (
 	In RuntimeFilterType = iota
 	MinMax
-	// todo BloomFilter, bf/in
+	BloomFilter
 )
 
 // String convert Runtime Filter Type to String name
@@ -3653,6 +3777,8 @@ func (rfType RuntimeFilterType) String() string {
 		return "IN"
 	case MinMax:
 		return "MIN_MAX"
+	case BloomFilter:
+		return "BLOOM_FILTER"
 	default:
 		return ""
 	}
@@ -3662,13 +3788,15 @@ func (rfType RuntimeFilterType) String() string {
 // If name is legal, it will return Runtime Filter Type and true
 // Else, it will return -1 and false
 // The second param means the convert is ok or not. Ture is ok, false means it is illegal name
-// At present, we only support two names: "IN" and "MIN_MAX"
+// At present, we support three names: "IN", "MIN_MAX" and "BLOOM_FILTER"
 func RuntimeFilterTypeStringToType(name string) (RuntimeFilterType, bool) {
 	switch name {
 	case "IN":
 		return In, true
 	case "MIN_MAX":
 		return MinMax, true
+	case "BLOOM_FILTER":
+		return BloomFilter, true
 	default:
 		return -1, false
 	}
@@ -3680,17 +3808,16 @@ func RuntimeFilterTypeStringToType(name string) (RuntimeFilterType, bool) {
 // The legal value should be comma-separated, eg: "IN,MIN_MAX"
 func ToRuntimeFilterType(sessionVarValue string) ([]RuntimeFilterType, bool) {
 	typeNameList := strings.Split(sessionVarValue, ",")
-	rfTypeMap := make(map[RuntimeFilterType]bool)
+	rfTypeList := make([]RuntimeFilterType, 0, len(typeNameList))
 	for _, typeName := range typeNameList {
 		rfType, ok := RuntimeFilterTypeStringToType(strings.ToUpper(typeName))
 		if !ok {
 			return nil, ok
 		}
-		rfTypeMap[rfType] = true
-	}
-	rfTypeList := make([]RuntimeFilterType, 0, len(rfTypeMap))
-	for rfType := range rfTypeMap {
-		rfTypeList = append(rfTypeList, rfType)
+		// keep the order in the value, so the runtime filters of a plan are always generated in the same order.
+		if !slices.Contains(rfTypeList, rfType) {
+			rfTypeList = append(rfTypeList, rfType)
+		}
 	}
 	return rfTypeList, true
 }
@@ -151,6 +151,7 @@ func TestSlowLogFormat(t *testing.T) {
 	seVar.ConnectionInfo = &variable.ConnectionInfo{ClientIP: "192.168.0.1"}
 	seVar.ConnectionID = 1
 	seVar.SessionAlias = "aliasabc"
+	seVar.QueryAttributes = map[string]string{"trace_id": "t1", "app": "a1"}
 	// the out put of the loged CurrentDB should be 'test', should be to lower cased.
 	seVar.CurrentDB = "TeST"
 	seVar.InRestrictedSQL = true
@@ -225,6 +226,7 @@ func TestSlowLogFormat(t *testing.T) {
 # User@Host: root[root] @ 192.168.0.1 [192.168.0.1]
 # Conn_ID: 1
 # Session_alias: aliasabc
+# Query_attributes: {"app":"a1","trace_id":"t1"}
 # Exec_retry_time: 5.1 Exec_retry_count: 3
 # Query_time: 1
 # Parse_time: 0.00000001
@@ -515,6 +517,49 @@ func TestGetReuseChunk(t *testing.T) {
 	require.False(t, sessVars.IsAllocValid())
 }
 
+func TestChunkArena(t *testing.T) {
+	fieldTypes := []*types.FieldType{
+		types.NewFieldTypeBuilder().SetType(mysql.TypeVarchar).BuildP(),
+		types.NewFieldTypeBuilder().SetType(mysql.TypeLonglong).BuildP(),
+	}
+	sessVars := variable.NewSessionVars(nil)
+	sessVars.EnableReuseChunk = true
+	sessVars.ChunkArenaSize = 1 << 30
+	var alloc chunk.Allocator = chunk.NewAllocator()
+
+	// the released chunks of the statement are recycled into the arena, not `alloc`.
+	sessVars.SetAlloc(alloc)
+	columns := make(map[*chunk.Column]struct{})
+	for i := 0; i < 100; i++ {
+		chk := sessVars.GetChunkAllocator().Alloc(fieldTypes, 10, 10)
+		for j := 0; j < chk.NumCols(); j++ {
+			columns[chk.Column(j)] = struct{}{}
+		}
+		chunk.Release(sessVars.GetChunkAllocator(), chk)
+	}
+	sessVars.ClearAlloc(&alloc, false)
+	require.False(t, sessVars.IsAllocValid())
+
+	// the next statement allocates from the arena.
+	sessVars.SetAlloc(alloc)
+	for i := 0; i < 100; i++ {
+		chk := sessVars.GetChunkAllocator().Alloc(fieldTypes, 10, 10)
+		for j := 0; j < chk.NumCols(); j++ {
+			_, ok := columns[chk.Column(j)]
+			require.True(t, ok)
+		}
+		chunk.Release(sessVars.GetChunkAllocator(), chk)
+	}
+	require.True(t, sessVars.GetUseChunkAlloc())
+
+	// the arena is dropped if the statement fails.
+	sessVars.ClearAlloc(&alloc, true)
+	sessVars.SetAlloc(alloc)
+	chk := sessVars.GetChunkAllocator().Alloc(fieldTypes, 10, 10)
+	_, ok := columns[chk.Column(0)]
+	require.False(t, ok)
+}
+
 func TestUserVarConcurrently(t *testing.T) {
 	sv := variable.NewSessionVars(nil)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -31,6 +31,7 @@ var isHintUpdatableVerified = map[string]struct{}{
 	"tidb_enable_outer_join_reorder":                   {},
 	"tidb_enable_null_aware_anti_join":                 {},
 	"tidb_replica_read":                                {},
+	"tidb_replica_read_store_labels":                   {},
 	// This var is used during the planner's preprocess phase. Need to fix its behavior.
 	// "tidb_read_staleness":                              {},
 	"tidb_enable_paging":                              {},
@@ -65,6 +66,7 @@ var isHintUpdatableVerified = map[string]struct{}{
 	"tidb_max_bytes_before_tiflash_external_group_by": {},
 	"tidb_max_bytes_before_tiflash_external_sort":     {},
 	"tidb_max_chunk_size":                             {},
+	"tidb_max_chunk_mem_size":                         {},
 	"tidb_min_paging_size":                            {},
 	"tidb_max_paging_size":                            {},
 	"tidb_enable_cascades_planner":                    {},
@@ -95,12 +97,16 @@ var isHintUpdatableVerified = map[string]struct{}{
 	"mpp_version":                                     {},
 	"tidb_enable_inl_join_inner_multi_pattern":        {},
 	"tidb_opt_enable_late_materialization":            {},
+	"tidb_opt_prune_partitions_by_subquery":           {},
 	"tidb_opt_ordering_index_selectivity_threshold":   {},
 	"tidb_opt_ordering_index_selectivity_ratio":       {},
 	"tidb_opt_enable_mpp_shared_cte_execution":        {},
 	"tidb_opt_fix_control":                            {},
 	"tidb_runtime_filter_type":                        {},
 	"tidb_runtime_filter_mode":                        {},
+	"tidb_runtime_filter_wait_time_ms":                {},
+	"tidb_runtime_filter_min_filter_ratio":            {},
+	"tidb_enable_multi_column_runtime_filter":         {},
 	"tidb_session_alias":                              {},
 	"tidb_opt_objective":                              {},
 	"mpp_exchange_compression_mode":                   {},
@@ -111,6 +117,7 @@ var isHintUpdatableVerified = map[string]struct{}{
 	// Variables that is compatible with MySQL.
 	"cte_max_recursion_depth": {},
 	"sql_mode":                {},
+	"time_zone":               {},
 	"max_execution_time":      {},
 }
 
@@ -829,6 +829,13 @@ var defaultSysVars = []*SysVar{
 		RestrictedReadOnly.Store(on)
 		return nil
 	}},
+	{Scope: ScopeGlobal, Name: TiDBRestrictedReadOnlySchemas, Value: DefTiDBRestrictedReadOnlySchemas, Type: TypeStr, Validation: func(_ *SessionVars, normalizedValue string, _ string, _ ScopeFlag) (string, error) {
+		return ValidRestrictedReadOnlySchemas(normalizedValue)
+	}, SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+		schemas := ParseRestrictedReadOnlySchemas(val)
+		RestrictedReadOnlySchemas.Store(&schemas)
+		return nil
+	}},
 	{Scope: ScopeGlobal, Name: TiDBSuperReadOnly, Value: BoolToOnOff(DefTiDBSuperReadOnly), Type: TypeBool, Validation: func(s *SessionVars, normalizedValue string, _ string, _ ScopeFlag) (string, error) {
 		on := TiDBOptOn(normalizedValue)
 		if !on && s.StmtCtx.StmtType == "Set" {
@@ -909,7 +916,13 @@ var defaultSysVars = []*SysVar{
 	{Scope: ScopeGlobal, Name: TiDBGCLifetime, Value: "10m0s", Type: TypeDuration, MinValue: int64(time.Minute * 10), MaxValue: uint64(time.Hour * 24 * 365), GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
 		return getTiDBTableValue(s, "tikv_gc_life_time", "10m0s")
 	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
-		return setTiDBTableValue(s, "tikv_gc_life_time", val, "All versions within life time will not be collected by GC, at least 10m, in Go format.")
+		if err := setTiDBTableValue(s, "tikv_gc_life_time", val, "All versions within life time will not be collected by GC, at least 10m, in Go format."); err != nil {
+			return err
+		}
+		if lifeTime, err := time.ParseDuration(val); err == nil {
+			GCLifeTime.Store(lifeTime)
+		}
+		return nil
 	}},
 	{Scope: ScopeGlobal, Name: TiDBGCConcurrency, Value: "-1", Type: TypeInt, MinValue: 1, MaxValue: MaxConfigurableConcurrency, AllowAutoValue: true, GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
 		autoConcurrencyVal, err := getTiDBTableValue(s, "tikv_gc_auto_concurrency", On)
@@ -937,6 +950,10 @@ var defaultSysVars = []*SysVar{
 		GCMaxWaitTime.Store(TidbOptInt64(val, DefTiDBGCMaxWaitTime))
 		return nil
 	}},
+	{Scope: ScopeGlobal, Name: TiDBGCQueryProtectTime, Value: strconv.Itoa(DefTiDBGCQueryProtectTime), Type: TypeInt, MinValue: 0, MaxValue: 31536000, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		GCQueryProtectTime.Store(TidbOptInt64(val, DefTiDBGCQueryProtectTime))
+		return nil
+	}},
 	{Scope: ScopeGlobal, Name: TiDBTableCacheLease, Value: strconv.Itoa(DefTiDBTableCacheLease), Type: TypeUnsigned, MinValue: 1, MaxValue: 10, SetGlobal: func(_ context.Context, s *SessionVars, sVal string) error {
 		var val int64
 		val, err := strconv.ParseInt(sVal, 10, 64)
@@ -1787,6 +1804,10 @@ var defaultSysVars = []*SysVar{
 		s.MPPStoreFailTTL = val
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableMPPFailureRetry, Type: TypeBool, Value: BoolToOnOff(DefTiDBEnableMPPFailureRetry), SetSession: func(s *SessionVars, val string) error {
+		s.EnableMPPFailureRetry = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBHashExchangeWithNewCollation, Type: TypeBool, Value: BoolToOnOff(DefTiDBHashExchangeWithNewCollation), SetSession: func(s *SessionVars, val string) error {
 		s.HashExchangeWithNewCollation = TiDBOptOn(val)
 		return nil
@@ -1903,6 +1924,18 @@ var defaultSysVars = []*SysVar{
 		s.enableForceInlineCTE = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptEnableSharedSubplan, Value: BoolToOnOff(DefOptEnableSharedSubplan), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableSharedSubplan = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptEnableSubqueryResultReuse, Value: BoolToOnOff(DefOptEnableSubqueryResultReuse), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableSubqueryResultReuse = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptEnableCardinalityFeedback, Value: BoolToOnOff(DefOptEnableCardinalityFeedback), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableCardinalityFeedback = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBIndexJoinBatchSize, Value: strconv.Itoa(DefIndexJoinBatchSize), Type: TypeUnsigned, MinValue: 1, MaxValue: math.MaxInt32, SetSession: func(s *SessionVars, val string) error {
 		s.IndexJoinBatchSize = tidbOptPositiveInt32(val, DefIndexJoinBatchSize)
 		return nil
@@ -1911,6 +1944,18 @@ var defaultSysVars = []*SysVar{
 		s.IndexLookupSize = tidbOptPositiveInt32(val, DefIndexLookupSize)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBIndexLookupStreaming, Value: BoolToOnOff(DefTiDBIndexLookupStreaming), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.IndexLookupStreaming = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBIndexLookupMaxInflightMem, Value: strconv.Itoa(DefTiDBIndexLookupMaxInflightMem), Type: TypeInt, MinValue: 0, MaxValue: math.MaxInt64, SetSession: func(s *SessionVars, val string) error {
+		s.IndexLookupMaxInflightMem = TidbOptInt64(val, DefTiDBIndexLookupMaxInflightMem)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableUnionPointGetBatch, Value: BoolToOnOff(DefTiDBEnableUnionPointGetBatch), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableUnionPointGetBatch = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBIndexLookupConcurrency, Value: strconv.Itoa(DefIndexLookupConcurrency), Type: TypeInt, MinValue: 1, MaxValue: MaxConfigurableConcurrency, AllowAutoValue: true, SetSession: func(s *SessionVars, val string) error {
 		s.indexLookupConcurrency = tidbOptPositiveInt32(val, ConcurrencyUnset)
 		return nil
@@ -1945,6 +1990,10 @@ var defaultSysVars = []*SysVar{
 		s.MaxChunkSize = tidbOptPositiveInt32(val, DefMaxChunkSize)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBMaxChunkMemSize, Value: strconv.Itoa(DefMaxChunkMemSize), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt32, SetSession: func(s *SessionVars, val string) error {
+		s.MaxChunkMemSize = int(TidbOptInt64(val, DefMaxChunkMemSize))
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBAllowBatchCop, Value: strconv.Itoa(DefTiDBAllowBatchCop), Type: TypeInt, MinValue: 0, MaxValue: 2, SetSession: func(s *SessionVars, val string) error {
 		s.AllowBatchCop = int(TidbOptInt64(val, DefTiDBAllowBatchCop))
 		return nil
@@ -2193,6 +2242,16 @@ var defaultSysVars = []*SysVar{
 		s.ReplicaClosestReadThreshold = TidbOptInt64(val, DefAdaptiveClosestReadThreshold)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBReplicaReadStoreLabels, Value: "", Type: TypeStr, Validation: func(_ *SessionVars, normalizedValue string, _ string, _ ScopeFlag) (string, error) {
+		return ValidReplicaReadStoreLabels(normalizedValue)
+	}, SetSession: func(s *SessionVars, val string) error {
+		labels, err := ParseReplicaReadStoreLabels(val)
+		if err != nil {
+			return err
+		}
+		s.ReplicaReadStoreLabels = labels
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBUsePlanBaselines, Value: BoolToOnOff(DefTiDBUsePlanBaselines), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.UsePlanBaselines = TiDBOptOn(val)
 		return nil
@@ -2259,6 +2318,16 @@ var defaultSysVars = []*SysVar{
 		errors.RedactLogEnabled.Store(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBRedactLogProfile, Value: "", Type: TypeStr, Validation: func(_ *SessionVars, normalizedValue string, _ string, _ ScopeFlag) (string, error) {
+		return ValidRedactLogProfile(normalizedValue)
+	}, SetSession: func(s *SessionVars, val string) error {
+		profile, err := ParseRedactLogProfile(val)
+		if err != nil {
+			return err
+		}
+		s.RedactLogProfile = profile
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBShardAllocateStep, Value: strconv.Itoa(DefTiDBShardAllocateStep), Type: TypeInt, MinValue: 1, MaxValue: uint64(math.MaxInt64), SetSession: func(s *SessionVars, val string) error {
 		s.ShardAllocateStep = TidbOptInt64(val, DefTiDBShardAllocateStep)
 		return nil
@@ -2495,6 +2564,72 @@ var defaultSysVars = []*SysVar{
 		DDLDiskQuota.Store(TidbOptUint64(val, DefTiDBDDLDiskQuota))
 		return nil
 	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLIngestMaxWriteSpeed, Value: strconv.Itoa(DefTiDBDDLIngestMaxWriteSpeed), Type: TypeInt, MinValue: 0, MaxValue: math.MaxInt64, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return strconv.FormatUint(DDLIngestMaxWriteSpeed.Load(), 10), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLIngestMaxWriteSpeed.Store(TidbOptUint64(val, DefTiDBDDLIngestMaxWriteSpeed))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLIngestFallbackErrorCount, Value: strconv.Itoa(DefTiDBDDLIngestFallbackErrorCount), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt64, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return strconv.FormatInt(DDLIngestFallbackErrorCount.Load(), 10), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLIngestFallbackErrorCount.Store(TidbOptInt64(val, DefTiDBDDLIngestFallbackErrorCount))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBDDLMaxIndexConflictRecords, Value: strconv.Itoa(DefTiDBDDLMaxIndexConflictRecords), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt32, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return strconv.FormatInt(DDLMaxIndexConflictRecords.Load(), 10), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		DDLMaxIndexConflictRecords.Store(TidbOptInt64(val, DefTiDBDDLMaxIndexConflictRecords))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBEnablePlacementCheck, Value: BoolToOnOff(DefTiDBEnablePlacementCheck), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(EnablePlacementCheck.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		EnablePlacementCheck.Store(TiDBOptOn(val))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBEnablePlacementAutoRepair, Value: BoolToOnOff(DefTiDBEnablePlacementAutoRepair), Type: TypeBool, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return BoolToOnOff(EnablePlacementAutoRepair.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		EnablePlacementAutoRepair.Store(TiDBOptOn(val))
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBColdPartitionPlacementPolicy, Value: "", Type: TypeStr, GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return ColdPartitionPlacementPolicy.Load(), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		ColdPartitionPlacementPolicy.Store(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBColdPartitionAge, Value: DefTiDBColdPartitionAge.String(), Type: TypeDuration, MinValue: int64(time.Hour), MaxValue: uint64(time.Hour * 24 * 365 * 100), GetGlobal: func(_ context.Context, sv *SessionVars) (string, error) {
+		return ColdPartitionAge.Load().String(), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return err
+		}
+		ColdPartitionAge.Store(d)
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBTableWriteRateLimit, Value: "", Type: TypeStr, Validation: func(_ *SessionVars, normalizedValue string, _ string, _ ScopeFlag) (string, error) {
+		return ValidTableWriteRateLimits(normalizedValue)
+	}, SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+		limits, err := ParseTableWriteRateLimits(val)
+		if err != nil {
+			return err
+		}
+		TableWriteRateLimits.Store(&limits)
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBTableRegionSchedule, Value: "", Type: TypeStr, Validation: func(_ *SessionVars, normalizedValue string, _ string, _ ScopeFlag) (string, error) {
+		return ValidTableRegionSchedules(normalizedValue)
+	}, SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+		schedules, err := ParseTableRegionSchedules(val)
+		if err != nil {
+			return err
+		}
+		TableRegionSchedules.Store(&schedules)
+		return nil
+	}},
 	// can't assign validate function here. Because validation function will run after GetGlobal function
 	{Scope: ScopeGlobal, Name: TiDBCloudStorageURI, Value: "", Type: TypeStr, GetGlobal: func(ctx context.Context, sv *SessionVars) (string, error) {
 		cloudStorageURI := CloudStorageURI.Load()
@@ -2781,6 +2916,10 @@ var defaultSysVars = []*SysVar{
 		s.EnableLateMaterialization = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptPrunePartitionsBySubquery, Value: BoolToOnOff(DefTiDBOptPrunePartitionsBySubquery), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.PrunePartitionsBySubquery = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBLoadBasedReplicaReadThreshold, Value: DefTiDBLoadBasedReplicaReadThreshold.String(), Type: TypeDuration, MaxValue: uint64(time.Hour), SetSession: func(s *SessionVars, val string) error {
 		d, err := time.ParseDuration(val)
 		if err != nil {
@@ -2842,6 +2981,32 @@ var defaultSysVars = []*SysVar{
 			s.OptimizerFixControl = newMap
 			return nil
 		}},
+	{
+		Scope: ScopeGlobal | ScopeSession,
+		Name:  TiDBOptFixControlByDigest,
+		Value: "",
+		Type:  TypeStr,
+		SetGlobal: func(ctx context.Context, vars *SessionVars, val string) error {
+			_, warnings, err := fixcontrol.ParseByDigestToMap(val)
+			if err != nil {
+				return err
+			}
+			for _, warning := range warnings {
+				vars.StmtCtx.AppendWarning(errors.NewNoStackError(warning))
+			}
+			return nil
+		},
+		SetSession: func(s *SessionVars, val string) error {
+			newMap, warnings, err := fixcontrol.ParseByDigestToMap(val)
+			if err != nil {
+				return err
+			}
+			for _, warning := range warnings {
+				s.StmtCtx.AppendWarning(errors.NewNoStackError(warning))
+			}
+			s.OptimizerFixControlByDigest = newMap
+			return nil
+		}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBAnalyzeSkipColumnTypes, Value: "json,blob,mediumblob,longblob", Type: TypeStr,
 		Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
 			return ValidAnalyzeSkipColumnTypes(normalizedValue)
@@ -3046,7 +3211,7 @@ var defaultSysVars = []*SysVar{
 			if ok {
 				return normalizedValue, nil
 			}
-			errMsg := fmt.Sprintf("incorrect value: %s. %s should be sepreated by , such as %s, also we only support IN and MIN_MAX now. ",
+			errMsg := fmt.Sprintf("incorrect value: %s. %s should be sepreated by , such as %s, also we only support IN, MIN_MAX and BLOOM_FILTER now. ",
 				originalValue, TiDBRuntimeFilterTypeName, DefRuntimeFilterType)
 			return normalizedValue, errors.New(errMsg)
 		},
@@ -3061,8 +3226,15 @@ var defaultSysVars = []*SysVar{
 			if ok {
 				return normalizedValue, nil
 			}
+			// TiFlash only applies the runtime filters built in the same MPP fragment,
+			// the runtime filters across the exchanges can't be dispatched to the target scans.
+			if normalizedValue == RFGlobal.String() {
+				errMsg := fmt.Sprintf("incorrect value: %s. %s GLOBAL isn't supported, the runtime filters can't be sent across the MPP exchanges ",
+					originalValue, TiDBRuntimeFilterModeName)
+				return normalizedValue, errors.New(errMsg)
+			}
 			errMsg := fmt.Sprintf("incorrect value: %s. %s options: %s ",
-				originalValue, TiDBRuntimeFilterModeName, DefRuntimeFilterMode)
+				originalValue, TiDBRuntimeFilterModeName, "OFF, LOCAL")
 			return normalizedValue, errors.New(errMsg)
 		},
 		SetSession: func(s *SessionVars, val string) error {
@@ -3070,6 +3242,24 @@ var defaultSysVars = []*SysVar{
 			return nil
 		},
 	},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBRuntimeFilterWaitTimeMs, Value: strconv.Itoa(DefRuntimeFilterWaitTimeMs), Type: TypeInt, MinValue: 0, MaxValue: math.MaxInt32,
+		SetSession: func(s *SessionVars, val string) error {
+			s.runtimeFilterWaitTimeMs = int(TidbOptInt64(val, DefRuntimeFilterWaitTimeMs))
+			return nil
+		},
+	},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBRuntimeFilterMinFilterRatio, Value: strconv.FormatFloat(DefRuntimeFilterMinFilterRatio, 'f', -1, 64), Type: TypeFloat, MinValue: 0, MaxValue: 1,
+		SetSession: func(s *SessionVars, val string) error {
+			s.runtimeFilterMinFilterRatio = tidbOptFloat64(val, DefRuntimeFilterMinFilterRatio)
+			return nil
+		},
+	},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableMultiColumnRuntimeFilter, Value: BoolToOnOff(DefTiDBEnableMultiColumnRuntimeFilter), Type: TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.enableMultiColumnRuntimeFilter = TiDBOptOn(val)
+			return nil
+		},
+	},
 	{
 		Scope: ScopeGlobal | ScopeSession,
 		Name:  TiDBLockUnchangedKeys,
@@ -3165,6 +3355,44 @@ var defaultSysVars = []*SysVar{
 			s.DivPrecisionIncrement = tidbOptPositiveInt32(val, DefDivPrecisionIncrement)
 			return nil
 		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEgressRateLimit, Value: strconv.Itoa(DefTiDBEgressRateLimit), Type: TypeInt, MinValue: 0, MaxValue: math.MaxInt64,
+		SetSession: func(s *SessionVars, val string) error {
+			s.EgressRateLimit = TidbOptInt64(val, DefTiDBEgressRateLimit)
+			return nil
+		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBDDLIndexPreSplitRegions, Value: strconv.Itoa(DefTiDBDDLIndexPreSplitRegions), Type: TypeUnsigned, MinValue: 0, MaxValue: MaxDDLIndexPreSplitRegions,
+		SetSession: func(s *SessionVars, val string) error {
+			s.DDLIndexPreSplitRegions = TidbOptInt(val, DefTiDBDDLIndexPreSplitRegions)
+			return nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBPinnedPlanTTL, Value: DefTiDBPinnedPlanTTL.String(), Type: TypeDuration, MinValue: 0, MaxValue: uint64(time.Hour * 24 * 365),
+		GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return PinnedPlanTTL.Load().String(), nil
+		}, SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return err
+			}
+			PinnedPlanTTL.Store(d)
+			return nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBPinnedPlanMaxRegression, Value: strconv.FormatFloat(DefTiDBPinnedPlanMaxRegression, 'f', -1, 64), Type: TypeFloat, MinValue: 0, MaxValue: math.MaxUint16,
+		GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return strconv.FormatFloat(PinnedPlanMaxRegression.Load(), 'f', -1, 64), nil
+		}, SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			PinnedPlanMaxRegression.Store(tidbOptFloat64(val, DefTiDBPinnedPlanMaxRegression))
+			return nil
+		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBChunkArenaSize, Value: strconv.Itoa(DefTiDBChunkArenaSize), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt64,
+		SetSession: func(s *SessionVars, val string) error {
+			s.ChunkArenaSize = TidbOptInt64(val, DefTiDBChunkArenaSize)
+			return nil
+		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptEnableOrExpansion, Value: BoolToOnOff(DefTiDBOptEnableOrExpansion), Type: TypeBool,
+		SetSession: func(s *SessionVars, val string) error {
+			s.EnableOrExpansion = TiDBOptOn(val)
+			return nil
+		}},
 	{Scope: ScopeSession, Name: TiDBDMLType, Value: DefTiDBDMLType, Type: TypeStr,
 		SetSession: func(s *SessionVars, val string) error {
 			lowerVal := strings.ToLower(val)
@@ -899,6 +899,31 @@ func TestSetTIDBDiskQuota(t *testing.T) {
 	require.Equal(t, strconv.FormatInt(pb, 10), val)
 }
 
+func TestSetTiDBDDLIngestMaxWriteSpeed(t *testing.T) {
+	vars := NewSessionVars(nil)
+	mock := NewMockGlobalAccessor4Tests()
+	mock.SessionVars = vars
+	vars.GlobalVarsAccessor = mock
+	maxWriteSpeed := GetSysVar(TiDBDDLIngestMaxWriteSpeed)
+	// Default no limit
+	require.Equal(t, "0", maxWriteSpeed.Value)
+	require.Equal(t, uint64(0), DDLIngestMaxWriteSpeed.Load())
+
+	err := mock.SetGlobalSysVar(context.Background(), TiDBDDLIngestMaxWriteSpeed, "104857600")
+	require.NoError(t, err)
+	val, err := mock.GetGlobalSysVar(TiDBDDLIngestMaxWriteSpeed)
+	require.NoError(t, err)
+	require.Equal(t, "104857600", val)
+	require.Equal(t, uint64(104857600), DDLIngestMaxWriteSpeed.Load())
+
+	// MinValue is 0, a negative value is set to no limit.
+	err = mock.SetGlobalSysVar(context.Background(), TiDBDDLIngestMaxWriteSpeed, "-1")
+	require.NoError(t, err)
+	val, err = mock.GetGlobalSysVar(TiDBDDLIngestMaxWriteSpeed)
+	require.NoError(t, err)
+	require.Equal(t, "0", val)
+}
+
 func TestTiDBServerMemoryLimit(t *testing.T) {
 	vars := NewSessionVars(nil)
 	mock := NewMockGlobalAccessor4Tests()
@@ -1605,3 +1630,31 @@ func TestTiDBLowResTSOUpdateInterval(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "1000", val)
 }
+
+func TestTiDBRuntimeFilterMode(t *testing.T) {
+	sv := GetSysVar(TiDBRuntimeFilterModeName)
+	vars := NewSessionVars(nil)
+
+	val, err := sv.Validate(vars, "LOCAL", ScopeSession)
+	require.NoError(t, err)
+	require.Equal(t, "LOCAL", val)
+	// the runtime filters across the MPP exchanges aren't supported.
+	_, err = sv.Validate(vars, "GLOBAL", ScopeGlobal)
+	require.EqualError(t, err, "incorrect value: GLOBAL. tidb_runtime_filter_mode GLOBAL isn't supported, the runtime filters can't be sent across the MPP exchanges ")
+	_, err = sv.Validate(vars, "REMOTE", ScopeSession)
+	require.EqualError(t, err, "incorrect value: REMOTE. tidb_runtime_filter_mode options: OFF, LOCAL ")
+}
+
+func TestTiDBRuntimeFilterWaitTimeMs(t *testing.T) {
+	sv := GetSysVar(TiDBRuntimeFilterWaitTimeMs)
+	vars := NewSessionVars(nil)
+
+	require.Equal(t, "10000", sv.Value)
+	val, err := sv.Validate(vars, "-1", ScopeSession)
+	require.NoError(t, err)
+	require.Equal(t, "0", val)
+	_, err = sv.Validate(vars, "1s", ScopeGlobal)
+	require.EqualError(t, err, "[variable:1232]Incorrect argument type to variable 'tidb_runtime_filter_wait_time_ms'")
+	require.NoError(t, sv.SetSessionFromHook(vars, "30000"))
+	require.Equal(t, 30000, vars.GetRuntimeFilterWaitTimeMs())
+}
@@ -207,6 +207,11 @@ const (
 	// This variable only take effect when `tidb_replica_read` is 'closest-adaptive'.
 	TiDBAdaptiveClosestReadThreshold = "tidb_adaptive_closest_read_threshold"
 
+	// TiDBReplicaReadStoreLabels is the store labels, e.g. "zone=z1,role=analytics", that the replicas must match
+	// when reading data from replicas or stale reading. It takes no effect when `tidb_replica_read` is 'leader'
+	// and the statement is not a stale read.
+	TiDBReplicaReadStoreLabels = "tidb_replica_read_store_labels"
+
 	// TiDBAllowRemoveAutoInc indicates whether a user can drop the auto_increment column attribute or not.
 	TiDBAllowRemoveAutoInc = "tidb_allow_remove_auto_inc"
 
@@ -342,6 +347,15 @@ const (
 	TiDBOptConcurrencyFactor = "tidb_opt_concurrency_factor"
 	// TiDBOptForceInlineCTE is used to enable/disable inline CTE
 	TiDBOptForceInlineCTE = "tidb_opt_force_inline_cte"
+	// TiDBOptEnableSharedSubplan is used to enable/disable executing the identical derived tables
+	// in the branches of a set operation only once and sharing the result.
+	TiDBOptEnableSharedSubplan = "tidb_opt_enable_shared_subplan"
+	// TiDBOptEnableSubqueryResultReuse is used to enable/disable evaluating the identical uncorrelated
+	// subqueries in a statement only once and reusing the result.
+	TiDBOptEnableSubqueryResultReuse = "tidb_opt_enable_subquery_result_reuse"
+	// TiDBOptEnableCardinalityFeedback is used to enable/disable correcting the estimated row count of the data sources
+	// with the actual row count collected from the previous slow executions of the same statement.
+	TiDBOptEnableCardinalityFeedback = "tidb_opt_enable_cardinality_feedback"
 
 	// TiDBIndexJoinBatchSize is used to set the batch size of an index lookup join.
 	// The index lookup join fetches batches of data from outer executor and constructs ranges for inner executor.
@@ -356,6 +370,23 @@ const (
 	// Large value may do more work than needed if the query has a limit.
 	TiDBIndexLookupSize = "tidb_index_lookup_size"
 
+	// TiDBIndexLookupStreaming is used for index lookup executor.
+	// When the order of the index isn't required, the table rows are returned as soon as they're read by the lookup
+	// requests, instead of being returned task by task in the order of the index, which reduces the latency of the
+	// queries with LIMIT. But the rows are returned in a nondeterministic order.
+	TiDBIndexLookupStreaming = "tidb_index_lookup_streaming"
+
+	// TiDBIndexLookupMaxInflightMem is used for index lookup executor with tidb_index_lookup_streaming.
+	// It's the max memory of the table rows read but not returned yet, the lookup tasks aren't started until the
+	// parent executor consumes the rows if it's exceeded. 0 means no limit.
+	TiDBIndexLookupMaxInflightMem = "tidb_index_lookup_max_inflight_mem"
+
+	// TiDBEnableUnionPointGetBatch is used for union executor.
+	// When some children of UNION ALL are point gets, their KV gets are coalesced into a BatchGet request per
+	// wave before the children are executed, which saves the round trips of fetching multiple entities by the
+	// ORM-generated queries.
+	TiDBEnableUnionPointGetBatch = "tidb_enable_union_point_get_batch"
+
 	// TiDBIndexLookupConcurrency is used for index lookup executor.
 	// A lookup task may have 'tidb_index_lookup_size' of handles at maximum, the handles may be distributed
 	// in many TiKV nodes, we execute multiple concurrent index lookup tasks concurrently to reduce the time
@@ -377,6 +408,10 @@ const (
 	// TiDBMaxChunkSize is used to control the max chunk size during query execution.
 	TiDBMaxChunkSize = "tidb_max_chunk_size"
 
+	// TiDBMaxChunkMemSize is used to limit the estimated memory size of a chunk during query execution.
+	// The max row count of a chunk is reduced for the executors with wide rows, 0 means no limit.
+	TiDBMaxChunkMemSize = "tidb_max_chunk_mem_size"
+
 	// TiDBAllowBatchCop means if we should send batch coprocessor to TiFlash. It can be set to 0, 1 and 2.
 	// 0 means never use batch cop, 1 means use batch cop in case of aggregation and join, 2, means to force sending batch cop for any query.
 	// The default value is 0
@@ -427,6 +462,10 @@ const (
 	// TiFlash even though the failed TiFlash node has been recovered.
 	TiDBMPPStoreFailTTL = "tidb_mpp_store_fail_ttl"
 
+	// TiDBEnableMPPFailureRetry indicates whether to retry the MPP query on other TiFlash nodes when a TiFlash node fails.
+	// The query can only be retried before any result is returned to the client.
+	TiDBEnableMPPFailureRetry = "tidb_enable_mpp_failure_retry"
+
 	// TiDBInitChunkSize is used to control the init chunk size during query execution.
 	TiDBInitChunkSize = "tidb_init_chunk_size"
 
@@ -646,9 +685,17 @@ const (
 	// TiDBRedactLog indicates that whether redact log.
 	TiDBRedactLog = "tidb_redact_log"
 
+	// TiDBRedactLogProfile overrides tidb_redact_log for the destinations of the logs, e.g. "slow_log=OFF,error_log=ON".
+	// The destinations are slow_log, general_log, statements_summary and error_log, and the others follow tidb_redact_log.
+	TiDBRedactLogProfile = "tidb_redact_log_profile"
+
 	// TiDBRestrictedReadOnly is meant for the cloud admin to toggle the cluster read only
 	TiDBRestrictedReadOnly = "tidb_restricted_read_only"
 
+	// TiDBRestrictedReadOnlySchemas is a comma-separated list of the databases which are read only,
+	// e.g. during the cutover of a migration. The DML and DDL on these databases are rejected.
+	TiDBRestrictedReadOnlySchemas = "tidb_restricted_read_only_schemas"
+
 	// TiDBSuperReadOnly is tidb's variant of mysql's super_read_only, which has some differences from mysql's super_read_only.
 	TiDBSuperReadOnly = "tidb_super_read_only"
 
@@ -902,6 +949,9 @@ const (
 
 	// TiDBOptEnableLateMaterialization indicates whether to enable late materialization
 	TiDBOptEnableLateMaterialization = "tidb_opt_enable_late_materialization"
+	// TiDBOptPrunePartitionsBySubquery indicates whether to evaluate the small uncorrelated `IN (SUBQUERY)` at optimize
+	// time to prune the partitions statically in the dynamic prune mode.
+	TiDBOptPrunePartitionsBySubquery = "tidb_opt_prune_partitions_by_subquery"
 	// TiDBLoadBasedReplicaReadThreshold is the wait duration threshold to enable replica read automatically.
 	TiDBLoadBasedReplicaReadThreshold = "tidb_load_based_replica_read_threshold"
 
@@ -916,6 +966,9 @@ const (
 	TiDBOptEnableMPPSharedCTEExecution = "tidb_opt_enable_mpp_shared_cte_execution"
 	// TiDBOptFixControl makes the user able to control some details of the optimizer behavior.
 	TiDBOptFixControl = "tidb_opt_fix_control"
+	// TiDBOptFixControlByDigest makes the user able to control some details of the optimizer behavior for the statements
+	// of the specified digests, it overrides tidb_opt_fix_control for these statements.
+	TiDBOptFixControlByDigest = "tidb_opt_fix_control_by_digest"
 
 	// TiFlashReplicaRead is used to set the policy of TiFlash replica read when the query needs the TiFlash engine.
 	TiFlashReplicaRead = "tiflash_replica_read"
@@ -949,6 +1002,31 @@ const (
 	// TiDBSchemaCacheSize indicates the size of infoschema meta data which are cached in V2 implementation.
 	TiDBSchemaCacheSize = "tidb_schema_cache_size"
 
+	// TiDBEgressRateLimit limits the bytes per second of the result sets sent to the client by a connection,
+	// so that a connection reading a huge result set can't saturate the network of the TiDB node.
+	TiDBEgressRateLimit = "tidb_egress_rate_limit"
+
+	// TiDBDDLIndexPreSplitRegions is the number of regions to pre-split for each index added by the ingest
+	// backfill, by the histogram of the first column of the index. 0 means no pre-split.
+	TiDBDDLIndexPreSplitRegions = "tidb_ddl_index_pre_split_regions"
+
+	// TiDBPinnedPlanTTL is the duration after which the plans pinned by the bindings created from the
+	// plan digests in the statement summary are unpinned automatically. 0 means they never expire.
+	TiDBPinnedPlanTTL = "tidb_pinned_plan_ttl"
+
+	// TiDBPinnedPlanMaxRegression unpins a pinned plan when its average latency since it's pinned exceeds
+	// this ratio of the average latency before it's pinned. 0 disables the check.
+	TiDBPinnedPlanMaxRegression = "tidb_pinned_plan_max_regression"
+
+	// TiDBChunkArenaSize is the max bytes of the chunk columns kept by the chunk arena of a session. When it's
+	// larger than 0 and tidb_enable_reuse_chunk is on, the chunks allocated by a statement and released by their
+	// owners, such as the build side rows of the hash joins, are recycled into the arena after the statement finishes.
+	TiDBChunkArenaSize = "tidb_chunk_arena_size"
+
+	// TiDBOptEnableOrExpansion indicates whether the optimizer expands the disjunctions over the different tables of a
+	// join into the UNION ALL of the joins driven by each disjunction, when it's estimated cheaper.
+	TiDBOptEnableOrExpansion = "tidb_opt_enable_or_expansion"
+
 	// DivPrecisionIncrement indicates the number of digits by which to increase the scale of the result of
 	// division operations performed with the / operator.
 	DivPrecisionIncrement = "div_precision_increment"
@@ -969,6 +1047,11 @@ const (
 	TiDBGCScanLockMode = "tidb_gc_scan_lock_mode"
 	// TiDBGCMaxWaitTime sets max time for gc advances the safepoint delayed by active transactions
 	TiDBGCMaxWaitTime = "tidb_gc_max_wait_time"
+	// TiDBGCQueryProtectTime sets max time for gc advances the safepoint delayed by the running statements,
+	// which extends tidb_gc_max_wait_time for the long queries. The statements whose snapshots are older than
+	// both of them and tidb_gc_life_time are killed before their snapshots are garbage collected.
+	// It's disabled if 0.
+	TiDBGCQueryProtectTime = "tidb_gc_query_protect_time"
 	// TiDBEnableEnhancedSecurity restricts SUPER users from certain operations.
 	TiDBEnableEnhancedSecurity = "tidb_enable_enhanced_security"
 	// TiDBEnableHistoricalStats enables the historical statistics feature (default off)
@@ -1024,6 +1107,15 @@ const (
 	TiDBDDLEnableFastReorg = "tidb_ddl_enable_fast_reorg"
 	// TiDBDDLDiskQuota used to set disk quota for lightning add index.
 	TiDBDDLDiskQuota = "tidb_ddl_disk_quota"
+	// TiDBDDLIngestMaxWriteSpeed is the max speed in bytes per second that the ingest backend writes to each TiKV store
+	// when adding index with lightning, 0 means no limit.
+	TiDBDDLIngestMaxWriteSpeed = "tidb_ddl_ingest_max_write_speed"
+	// TiDBDDLIngestFallbackErrorCount is the count of the ingest backend errors after which the add index job
+	// falls back to the txn-merge backfill process, 0 means never fall back.
+	TiDBDDLIngestFallbackErrorCount = "tidb_ddl_ingest_fallback_error_count"
+	// TiDBDDLMaxIndexConflictRecords is the max number of the conflicting records collected into
+	// mysql.add_index_conflicts when adding a unique index fails with duplicate keys, 0 means not collecting.
+	TiDBDDLMaxIndexConflictRecords = "tidb_ddl_max_index_conflict_records"
 	// TiDBCloudStorageURI used to set a cloud storage uri for ddl add index and import into.
 	TiDBCloudStorageURI = "tidb_cloud_storage_uri"
 	// TiDBAutoBuildStatsConcurrency is used to set the build concurrency of auto-analyze.
@@ -1133,10 +1225,19 @@ const (
 	AuthenticationLDAPSimpleInitPoolSize = "authentication_ldap_simple_init_pool_size"
 	// AuthenticationLDAPSimpleMaxPoolSize defines the max size of connection pool to LDAP server for SASL plugin.
 	AuthenticationLDAPSimpleMaxPoolSize = "authentication_ldap_simple_max_pool_size"
-	// TiDBRuntimeFilterTypeName the value of is string, a runtime filter type list split by ",", such as: "IN,MIN_MAX"
+	// TiDBRuntimeFilterTypeName the value of is string, a runtime filter type list split by ",", such as: "IN,MIN_MAX,BLOOM_FILTER"
 	TiDBRuntimeFilterTypeName = "tidb_runtime_filter_type"
 	// TiDBRuntimeFilterModeName the mode of runtime filter, such as "OFF", "LOCAL"
 	TiDBRuntimeFilterModeName = "tidb_runtime_filter_mode"
+	// TiDBRuntimeFilterWaitTimeMs the max time in milliseconds a table scan waits for its runtime filters,
+	// the scan goes on without the runtime filters which are not ready in time.
+	TiDBRuntimeFilterWaitTimeMs = "tidb_runtime_filter_wait_time_ms"
+	// TiDBRuntimeFilterMinFilterRatio the min estimated ratio of the probe side rows filtered by a runtime filter,
+	// the runtime filter is not generated if it is expected to filter fewer rows.
+	TiDBRuntimeFilterMinFilterRatio = "tidb_runtime_filter_min_filter_ratio"
+	// TiDBEnableMultiColumnRuntimeFilter indicates whether to generate one IN runtime filter for a composite join key
+	// whose target columns are in the same table scan, instead of one IN runtime filter for each column.
+	TiDBEnableMultiColumnRuntimeFilter = "tidb_enable_multi_column_runtime_filter"
 	// TiDBSkipMissingPartitionStats controls how to handle missing partition stats when merging partition stats to global stats.
 	// When set to true, skip missing partition stats and continue to merge other partition stats to global stats.
 	// When set to false, give up merging partition stats to global stats.
@@ -1160,6 +1261,34 @@ const (
 	// The value can be STANDARD, BULK.
 	// Currently, the BULK mode only affects auto-committed DML.
 	TiDBDMLType = "tidb_dml_type"
+	// TiDBEnablePlacementCheck indicates whether to check the placement of the tables periodically
+	// and record the violations of the placement policies into mysql.tidb_placement_violations.
+	TiDBEnablePlacementCheck = "tidb_enable_placement_check"
+	// TiDBEnablePlacementAutoRepair indicates whether to repair the violations found by the placement check
+	// by syncing the placement rules to PD again.
+	TiDBEnablePlacementAutoRepair = "tidb_enable_placement_auto_repair"
+	// TiDBColdPartitionPlacementPolicy is the placement policy which places the data on the cold storage.
+	// The range partitions older than tidb_cold_partition_age are moved to the cold storage by altering their
+	// placement policy to it. It's disabled if empty.
+	TiDBColdPartitionPlacementPolicy = "tidb_cold_partition_placement_policy"
+	// TiDBColdPartitionAge is the age after which a range partition is moved to the cold storage. The age
+	// of a partition is the time passed since its upper bound.
+	TiDBColdPartitionAge = "tidb_cold_partition_age"
+	// TiDBTableWriteRateLimit is a comma-separated list of the max write throughput of the tables, e.g.
+	// "db1.t1:10MB,db2.t2:1000rows". The limit is the bytes written per second, or the rows written per
+	// second with the "rows" suffix. The transactions writing a table faster than its limit are queued, so that
+	// the bulk writes can't overwhelm the downstream such as TiCDC and the log backup. The pessimistic DMLs are
+	// queued before locking the keys, the other writes are queued before committing. The limit is enforced by
+	// each TiDB instance on its own, the write throughput of a table in the cluster is up to the limit times the
+	// number of the instances writing it.
+	TiDBTableWriteRateLimit = "tidb_table_write_rate_limit"
+	// TiDBTableRegionSchedule is a comma-separated list of the scheduling of the regions split for the tables
+	// by the DDLs and the SPLIT statements, e.g. "db1.t1:window=02:00-06:00;rate=100". The new regions of a
+	// table are only scattered in its maintenance window, which is in the local time zone and may cross
+	// midnight, and at most rate regions of the table are split per second, so that splitting and scattering
+	// the regions don't cause the balance storms during the peak traffic. The merges of the regions are
+	// scheduled by PD, which are limited by its merge-schedule-limit.
+	TiDBTableRegionSchedule = "tidb_table_region_schedule"
 )
 
 // TiDB intentional limits
@@ -1179,6 +1308,9 @@ const (
 	DefIndexSerialScanConcurrency                  = 1
 	DefIndexJoinBatchSize                          = 25000
 	DefIndexLookupSize                             = 20000
+	DefTiDBIndexLookupStreaming                    = false
+	DefTiDBIndexLookupMaxInflightMem               = 0
+	DefTiDBEnableUnionPointGetBatch                = true
 	DefDistSQLScanConcurrency                      = 15
 	DefAnalyzeDistSQLScanConcurrency               = 4
 	DefBuildStatsConcurrency                       = 2
@@ -1211,6 +1343,9 @@ const (
 	DefOptDiskFactor                               = 1.5
 	DefOptConcurrencyFactor                        = 3.0
 	DefOptForceInlineCTE                           = false
+	DefOptEnableSharedSubplan                      = false
+	DefOptEnableSubqueryResultReuse                = false
+	DefOptEnableCardinalityFeedback                = false
 	DefOptInSubqToJoinAndAgg                       = true
 	DefOptPreferRangeScan                          = false
 	DefBatchInsert                                 = false
@@ -1221,6 +1356,7 @@ const (
 	DefMinPagingSize                               = int(paging.MinPagingSize)
 	DefMaxPagingSize                               = int(paging.MaxPagingSize)
 	DefMaxChunkSize                                = 1024
+	DefMaxChunkMemSize                             = 0
 	DefDMLBatchSize                                = 0
 	DefMaxPreparedStmtCount                        = -1
 	DefWaitTimeout                                 = 28800
@@ -1253,6 +1389,7 @@ const (
 	DefTiFlashQuerySpillRatio                      = 0.7
 	DefTiDBEnableTiFlashPipelineMode               = true
 	DefTiDBMPPStoreFailTTL                         = "60s"
+	DefTiDBEnableMPPFailureRetry                   = true
 	DefTiDBTxnMode                                 = PessimisticTxnMode
 	DefTiDBRowFormatV1                             = 1
 	DefTiDBRowFormatV2                             = 2
@@ -1302,6 +1439,7 @@ const (
 	DefTiDBEnableGlobalIndex                       = false
 	DefTiDBRedactLog                               = Off
 	DefTiDBRestrictedReadOnly                      = false
+	DefTiDBRestrictedReadOnlySchemas               = ""
 	DefTiDBSuperReadOnly                           = false
 	DefTiDBShardAllocateStep                       = math.MaxInt64
 	DefTiDBEnableTelemetry                         = false
@@ -1356,6 +1494,7 @@ const (
 	DefTiDBOptExplainEvaledSubquery                = false
 	DefTiDBReadStaleness                           = 0
 	DefTiDBGCMaxWaitTime                           = 24 * 60 * 60
+	DefTiDBGCQueryProtectTime                      = 0
 	DefMaxAllowedPacket                     uint64 = 67108864
 	DefTiDBEnableBatchDML                          = false
 	DefTiDBMemQuotaQuery                           = 1073741824 // 1GB
@@ -1394,6 +1533,12 @@ const (
 	DefMemoryUsageAlarmKeepRecordNum               = 5
 	DefTiDBEnableFastReorg                         = true
 	DefTiDBDDLDiskQuota                            = 100 * 1024 * 1024 * 1024 // 100GB
+	DefTiDBDDLIngestMaxWriteSpeed                  = 0
+	DefTiDBDDLIngestFallbackErrorCount             = 0
+	DefTiDBDDLMaxIndexConflictRecords              = 0
+	DefTiDBEnablePlacementCheck                    = false
+	DefTiDBEnablePlacementAutoRepair               = false
+	DefTiDBColdPartitionAge                        = 30 * 24 * time.Hour
 	DefExecutorConcurrency                         = 5
 	DefTiDBEnableNonPreparedPlanCache              = false
 	DefTiDBEnableNonPreparedPlanCacheForDML        = false
@@ -1454,6 +1599,7 @@ const (
 	DefTiDBEnablePlanCacheForSubquery                 = true
 	DefTiDBLoadBasedReplicaReadThreshold              = time.Second
 	DefTiDBOptEnableLateMaterialization               = true
+	DefTiDBOptPrunePartitionsBySubquery               = true
 	DefTiDBOptOrderingIdxSelThresh                    = 0.0
 	DefTiDBOptOrderingIdxSelRatio                     = -1
 	DefTiDBOptEnableMPPSharedCTEExecution             = false
@@ -1475,6 +1621,9 @@ const (
 	DefTiDBEnableFastCheckTable                       = true
 	DefRuntimeFilterType                              = "IN"
 	DefRuntimeFilterMode                              = "OFF"
+	DefRuntimeFilterWaitTimeMs                        = 10000
+	DefRuntimeFilterMinFilterRatio                    = 0.0
+	DefTiDBEnableMultiColumnRuntimeFilter             = false
 	DefTiDBLockUnchangedKeys                          = true
 	DefTiDBEnableCheckConstraint                      = false
 	DefTiDBSkipMissingPartitionStats                  = true
@@ -1488,6 +1637,14 @@ const (
 	DefDivPrecisionIncrement                          = 4
 	DefTiDBDMLType                                    = "STANDARD"
 	DefGroupConcatMaxLen                              = uint64(1024)
+	DefTiDBEgressRateLimit                            = 0
+	DefTiDBDDLIndexPreSplitRegions                    = 0
+	DefTiDBPinnedPlanTTL                              = time.Duration(0)
+	DefTiDBPinnedPlanMaxRegression                    = 0.0
+	DefTiDBChunkArenaSize                             = 0
+	DefTiDBOptEnableOrExpansion                       = false
+	// MaxDDLIndexPreSplitRegions is the max value of tidb_ddl_index_pre_split_regions.
+	MaxDDLIndexPreSplitRegions = 10000
 )
 
 // Process global variables.
@@ -1519,6 +1676,7 @@ var (
 	EnableTSOFollowerProxy               = atomic.NewBool(DefTiDBEnableTSOFollowerProxy)
 	EnablePDFollowerHandleRegion         = atomic.NewBool(DefPDEnableFollowerHandleRegion)
 	RestrictedReadOnly                   = atomic.NewBool(DefTiDBRestrictedReadOnly)
+	RestrictedReadOnlySchemas            = atomic.NewPointer[map[string]struct{}](nil)
 	VarTiDBSuperReadOnly                 = atomic.NewBool(DefTiDBSuperReadOnly)
 	PersistAnalyzeOptions                = atomic.NewBool(DefTiDBPersistAnalyzeOptions)
 	TableCacheLease                      = atomic.NewInt64(DefTiDBTableCacheLease)
@@ -1527,6 +1685,8 @@ var (
 	StatsLoadPseudoTimeout               = atomic.NewBool(DefTiDBStatsLoadPseudoTimeout)
 	MemQuotaBindingCache                 = atomic.NewInt64(DefTiDBMemQuotaBindingCache)
 	GCMaxWaitTime                        = atomic.NewInt64(DefTiDBGCMaxWaitTime)
+	GCQueryProtectTime                   = atomic.NewInt64(DefTiDBGCQueryProtectTime)
+	GCLifeTime                           = atomic.NewDuration(10 * time.Minute)
 	StatsCacheMemQuota                   = atomic.NewInt64(DefTiDBStatsCacheMemQuota)
 	OOMAction                            = atomic.NewString(DefTiDBMemOOMAction)
 	MaxAutoAnalyzeTime                   = atomic.NewInt64(DefTiDBMaxAutoAnalyzeTime)
@@ -1542,6 +1702,24 @@ var (
 	EnableFastReorg = atomic.NewBool(DefTiDBEnableFastReorg)
 	// DDLDiskQuota is the temporary variable for set disk quota for lightning
 	DDLDiskQuota = atomic.NewUint64(DefTiDBDDLDiskQuota)
+	// DDLIngestMaxWriteSpeed is the max write speed to each TiKV store for lightning add index.
+	DDLIngestMaxWriteSpeed = atomic.NewUint64(DefTiDBDDLIngestMaxWriteSpeed)
+	// DDLIngestFallbackErrorCount is the count of the ingest errors after which the ingest backfill falls back to txn-merge.
+	DDLIngestFallbackErrorCount = atomic.NewInt64(DefTiDBDDLIngestFallbackErrorCount)
+	// DDLMaxIndexConflictRecords is the max number of the conflicting records collected when adding a unique index fails.
+	DDLMaxIndexConflictRecords = atomic.NewInt64(DefTiDBDDLMaxIndexConflictRecords)
+	// EnablePlacementCheck indicates whether to check the placement of the tables periodically.
+	EnablePlacementCheck = atomic.NewBool(DefTiDBEnablePlacementCheck)
+	// EnablePlacementAutoRepair indicates whether to repair the violations found by the placement check.
+	EnablePlacementAutoRepair = atomic.NewBool(DefTiDBEnablePlacementAutoRepair)
+	// ColdPartitionPlacementPolicy is the placement policy which places the data on the cold storage.
+	ColdPartitionPlacementPolicy = atomic.NewString("")
+	// ColdPartitionAge is the age after which a range partition is moved to the cold storage.
+	ColdPartitionAge = atomic.NewDuration(DefTiDBColdPartitionAge)
+	// TableWriteRateLimits are the max write throughput of the tables keyed by the lower-case "db.table".
+	TableWriteRateLimits = atomic.NewPointer[map[string]TableWriteRateLimit](nil)
+	// TableRegionSchedules are the scheduling of the regions split for the tables keyed by the lower-case "db.table".
+	TableRegionSchedules = atomic.NewPointer[map[string]RegionSchedule](nil)
 	// EnableForeignKey indicates whether to enable foreign key feature.
 	EnableForeignKey    = atomic.NewBool(true)
 	EnableRCReadCheckTS = atomic.NewBool(false)
@@ -1595,6 +1773,9 @@ var (
 	TxnEntrySizeLimit         = atomic.NewUint64(DefTiDBTxnEntrySizeLimit)
 
 	SchemaCacheSize = atomic.NewInt64(DefTiDBSchemaCacheSize)
+
+	PinnedPlanTTL           = atomic.NewDuration(DefTiDBPinnedPlanTTL)
+	PinnedPlanMaxRegression = atomic.NewFloat64(DefTiDBPinnedPlanMaxRegression)
 )
 
 var (
@@ -25,10 +25,12 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/charset"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/tikv/client-go/v2/oracle"
@@ -576,6 +578,323 @@ func ValidAnalyzeSkipColumnTypes(val string) (string, error) {
 	return strings.Join(columnTypes, ","), nil
 }
 
+// ValidReplicaReadStoreLabels makes validation for tidb_replica_read_store_labels.
+func ValidReplicaReadStoreLabels(val string) (string, error) {
+	labels, err := ParseReplicaReadStoreLabels(val)
+	if err != nil {
+		return val, err
+	}
+	items := make([]string, 0, len(labels))
+	for _, label := range labels {
+		items = append(items, label.Key+"="+label.Value)
+	}
+	return strings.Join(items, ","), nil
+}
+
+// ParseReplicaReadStoreLabels converts tidb_replica_read_store_labels to the store labels.
+func ParseReplicaReadStoreLabels(val string) ([]*metapb.StoreLabel, error) {
+	if strings.TrimSpace(val) == "" {
+		return nil, nil
+	}
+	items := strings.Split(val, ",")
+	labels := make([]*metapb.StoreLabel, 0, len(items))
+	for _, item := range items {
+		key, value, ok := strings.Cut(item, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBReplicaReadStoreLabels, val)
+		}
+		labels = append(labels, &metapb.StoreLabel{Key: key, Value: value})
+	}
+	return labels, nil
+}
+
+// The destinations of the logs whose redact mode can be set in tidb_redact_log_profile.
+const (
+	// RedactLogDestSlowLog is the slow query log.
+	RedactLogDestSlowLog = "slow_log"
+	// RedactLogDestGeneralLog is the general log.
+	RedactLogDestGeneralLog = "general_log"
+	// RedactLogDestStmtSummary is the sample SQL of the statements summary.
+	RedactLogDestStmtSummary = "statements_summary"
+	// RedactLogDestErrorLog is the other logs of TiDB, such as the logs of the failed statements.
+	RedactLogDestErrorLog = "error_log"
+)
+
+var redactLogDestinations = []string{RedactLogDestSlowLog, RedactLogDestGeneralLog, RedactLogDestStmtSummary, RedactLogDestErrorLog}
+
+// ValidRedactLogProfile makes validation for tidb_redact_log_profile.
+func ValidRedactLogProfile(val string) (string, error) {
+	profile, err := ParseRedactLogProfile(val)
+	if err != nil {
+		return val, err
+	}
+	items := make([]string, 0, len(profile))
+	for _, destination := range redactLogDestinations {
+		if mode, ok := profile[destination]; ok {
+			items = append(items, destination+"="+mode)
+		}
+	}
+	return strings.Join(items, ","), nil
+}
+
+// ParseRedactLogProfile converts tidb_redact_log_profile to the redact mode of each destination.
+func ParseRedactLogProfile(val string) (map[string]string, error) {
+	if strings.TrimSpace(val) == "" {
+		return nil, nil
+	}
+	items := strings.Split(val, ",")
+	profile := make(map[string]string, len(items))
+	for _, item := range items {
+		destination, mode, ok := strings.Cut(item, "=")
+		destination, mode = strings.ToLower(strings.TrimSpace(destination)), strings.ToUpper(strings.TrimSpace(mode))
+		if !ok || !slices.Contains(redactLogDestinations, destination) {
+			return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBRedactLogProfile, val)
+		}
+		switch mode {
+		case Off, On, Marker:
+		default:
+			return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBRedactLogProfile, val)
+		}
+		profile[destination] = mode
+	}
+	return profile, nil
+}
+
+// ValidRestrictedReadOnlySchemas makes validation for tidb_restricted_read_only_schemas.
+func ValidRestrictedReadOnlySchemas(val string) (string, error) {
+	schemas := make([]string, 0, 4)
+	for _, schema := range strings.Split(val, ",") {
+		schema = strings.ToLower(strings.TrimSpace(schema))
+		if schema == "" {
+			continue
+		}
+		if util.IsMemOrSysDB(schema) {
+			return val, ErrWrongValueForVar.GenWithStackByArgs(TiDBRestrictedReadOnlySchemas, val)
+		}
+		if !slices.Contains(schemas, schema) {
+			schemas = append(schemas, schema)
+		}
+	}
+	return strings.Join(schemas, ","), nil
+}
+
+// ParseRestrictedReadOnlySchemas converts tidb_restricted_read_only_schemas to the set of the databases.
+func ParseRestrictedReadOnlySchemas(val string) map[string]struct{} {
+	schemas := make(map[string]struct{})
+	for _, schema := range strings.Split(val, ",") {
+		if schema = strings.ToLower(strings.TrimSpace(schema)); schema != "" {
+			schemas[schema] = struct{}{}
+		}
+	}
+	return schemas
+}
+
+// IsRestrictedReadOnlySchema returns whether the database is in tidb_restricted_read_only_schemas.
+func IsRestrictedReadOnlySchema(schema string) bool {
+	schemas := RestrictedReadOnlySchemas.Load()
+	if schemas == nil || len(*schemas) == 0 {
+		return false
+	}
+	_, ok := (*schemas)[strings.ToLower(schema)]
+	return ok
+}
+
+// TableWriteRateLimit is the max write throughput of a table in tidb_table_write_rate_limit.
+type TableWriteRateLimit struct {
+	// Limit is the max number of the bytes, or the rows if ByRows is true, written to the table per second.
+	Limit  uint64
+	ByRows bool
+}
+
+// String implements fmt.Stringer.
+func (l TableWriteRateLimit) String() string {
+	if l.ByRows {
+		return fmt.Sprintf("%drows", l.Limit)
+	}
+	for _, unit := range []struct {
+		suffix string
+		shift  uint
+	}{{"TB", 40}, {"GB", 30}, {"MB", 20}, {"KB", 10}} {
+		if l.Limit&(1<<unit.shift-1) == 0 {
+			return fmt.Sprintf("%d%s", l.Limit>>unit.shift, unit.suffix)
+		}
+	}
+	return strconv.FormatUint(l.Limit, 10)
+}
+
+// ValidTableWriteRateLimits makes validation for tidb_table_write_rate_limit.
+func ValidTableWriteRateLimits(val string) (string, error) {
+	limits, err := ParseTableWriteRateLimits(val)
+	if err != nil {
+		return val, err
+	}
+	names := make([]string, 0, len(limits))
+	for name := range limits {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	items := make([]string, 0, len(names))
+	for _, name := range names {
+		items = append(items, name+":"+limits[name].String())
+	}
+	return strings.Join(items, ","), nil
+}
+
+// ParseTableWriteRateLimits converts tidb_table_write_rate_limit to the limits of the tables keyed by
+// the lower-case "db.table".
+func ParseTableWriteRateLimits(val string) (map[string]TableWriteRateLimit, error) {
+	limits := make(map[string]TableWriteRateLimit)
+	for _, item := range strings.Split(val, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		name, limitStr, ok := strings.Cut(item, ":")
+		name, limitStr = strings.ToLower(strings.TrimSpace(name)), strings.ToUpper(strings.TrimSpace(limitStr))
+		schema, table, ok2 := strings.Cut(name, ".")
+		if !ok || !ok2 || schema == "" || table == "" || util.IsMemOrSysDB(schema) {
+			return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableWriteRateLimit, val)
+		}
+		var limit TableWriteRateLimit
+		if rows, ok := strings.CutSuffix(limitStr, "ROWS"); ok {
+			n, err := strconv.ParseUint(strings.TrimSpace(rows), 10, 64)
+			if err != nil {
+				return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableWriteRateLimit, val)
+			}
+			limit = TableWriteRateLimit{Limit: n, ByRows: true}
+		} else {
+			n, normalized := parseByteSize(limitStr)
+			if normalized == "" {
+				return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableWriteRateLimit, val)
+			}
+			limit.Limit = n
+		}
+		if limit.Limit == 0 {
+			return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableWriteRateLimit, val)
+		}
+		limits[name] = limit
+	}
+	return limits, nil
+}
+
+// RegionSchedule is the scheduling of the regions split for a table in tidb_table_region_schedule.
+type RegionSchedule struct {
+	// WindowStart and WindowEnd are the offsets from midnight of the maintenance window in the local time
+	// zone, and the window crosses midnight if WindowEnd is before WindowStart. There's no window if they're
+	// equal.
+	WindowStart time.Duration
+	WindowEnd   time.Duration
+	// Rate is the max number of the regions split per second, 0 means no limit.
+	Rate uint64
+}
+
+// InWindow returns whether t is in the maintenance window, it's always true if there's no window.
+func (s RegionSchedule) InWindow(t time.Time) bool {
+	if s.WindowStart == s.WindowEnd {
+		return true
+	}
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if s.WindowStart < s.WindowEnd {
+		return offset >= s.WindowStart && offset < s.WindowEnd
+	}
+	return offset >= s.WindowStart || offset < s.WindowEnd
+}
+
+// String implements fmt.Stringer.
+func (s RegionSchedule) String() string {
+	options := make([]string, 0, 2)
+	if s.WindowStart != s.WindowEnd {
+		options = append(options, fmt.Sprintf("window=%02d:%02d-%02d:%02d",
+			s.WindowStart/time.Hour, s.WindowStart%time.Hour/time.Minute, s.WindowEnd/time.Hour, s.WindowEnd%time.Hour/time.Minute))
+	}
+	if s.Rate > 0 {
+		options = append(options, fmt.Sprintf("rate=%d", s.Rate))
+	}
+	return strings.Join(options, ";")
+}
+
+// GetTableRegionSchedule returns the scheduling of the regions split for the table in tidb_table_region_schedule.
+func GetTableRegionSchedule(schema, table string) (RegionSchedule, bool) {
+	schedules := TableRegionSchedules.Load()
+	if schedules == nil || len(*schedules) == 0 {
+		return RegionSchedule{}, false
+	}
+	s, ok := (*schedules)[strings.ToLower(schema)+"."+strings.ToLower(table)]
+	return s, ok
+}
+
+// ValidTableRegionSchedules makes validation for tidb_table_region_schedule.
+func ValidTableRegionSchedules(val string) (string, error) {
+	schedules, err := ParseTableRegionSchedules(val)
+	if err != nil {
+		return val, err
+	}
+	names := make([]string, 0, len(schedules))
+	for name := range schedules {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	items := make([]string, 0, len(names))
+	for _, name := range names {
+		items = append(items, name+":"+schedules[name].String())
+	}
+	return strings.Join(items, ","), nil
+}
+
+// ParseTableRegionSchedules converts tidb_table_region_schedule to the scheduling of the tables keyed by
+// the lower-case "db.table".
+func ParseTableRegionSchedules(val string) (map[string]RegionSchedule, error) {
+	schedules := make(map[string]RegionSchedule)
+	for _, item := range strings.Split(val, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		name, options, ok := strings.Cut(item, ":")
+		name = strings.ToLower(strings.TrimSpace(name))
+		schema, table, ok2 := strings.Cut(name, ".")
+		if !ok || !ok2 || schema == "" || table == "" || util.IsMemOrSysDB(schema) {
+			return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableRegionSchedule, val)
+		}
+		var s RegionSchedule
+		for _, option := range strings.Split(options, ";") {
+			key, value, ok := strings.Cut(option, "=")
+			key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+			if !ok {
+				return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableRegionSchedule, val)
+			}
+			switch key {
+			case "window":
+				start, end, ok := strings.Cut(value, "-")
+				startOffset, err1 := parseClock(strings.TrimSpace(start))
+				endOffset, err2 := parseClock(strings.TrimSpace(end))
+				if !ok || err1 != nil || err2 != nil || startOffset == endOffset {
+					return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableRegionSchedule, val)
+				}
+				s.WindowStart, s.WindowEnd = startOffset, endOffset
+			case "rate":
+				rate, err := strconv.ParseUint(value, 10, 64)
+				if err != nil || rate == 0 {
+					return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableRegionSchedule, val)
+				}
+				s.Rate = rate
+			default:
+				return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBTableRegionSchedule, val)
+			}
+		}
+		schedules[name] = s
+	}
+	return schedules, nil
+}
+
+// parseClock parses the time of day in the "HH:MM" format to the offset from midnight.
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
 // ParseAnalyzeSkipColumnTypes converts tidb_analyze_skip_column_types to the map form.
 func ParseAnalyzeSkipColumnTypes(val string) map[string]struct{} {
 	skipTypes := make(map[string]struct{})
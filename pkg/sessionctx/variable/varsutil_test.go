@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -738,3 +739,110 @@ func TestAssertionLevel(t *testing.T) {
 	require.Equal(t, AssertionLevelFast, tidbOptAssertionLevel(AssertionFastStr))
 	require.Equal(t, AssertionLevelOff, tidbOptAssertionLevel("bogus"))
 }
+
+func TestReplicaReadStoreLabels(t *testing.T) {
+	labels, err := ParseReplicaReadStoreLabels("")
+	require.NoError(t, err)
+	require.Empty(t, labels)
+
+	labels, err = ParseReplicaReadStoreLabels(" zone = z1 ,role=analytics")
+	require.NoError(t, err)
+	require.Equal(t, []*metapb.StoreLabel{{Key: "zone", Value: "z1"}, {Key: "role", Value: "analytics"}}, labels)
+	val, err := ValidReplicaReadStoreLabels(" zone = z1 ,role=analytics")
+	require.NoError(t, err)
+	require.Equal(t, "zone=z1,role=analytics", val)
+
+	for _, invalid := range []string{"zone", "zone=", "=z1", "zone=z1,", "zone=z1,,role=analytics"} {
+		_, err = ValidReplicaReadStoreLabels(invalid)
+		require.ErrorContains(t, err, "can't be set to the value of")
+	}
+
+	vars := NewSessionVars(nil)
+	require.NoError(t, vars.SetSystemVar(TiDBReplicaReadStoreLabels, "role=analytics"))
+	require.Equal(t, []*metapb.StoreLabel{{Key: "role", Value: "analytics"}}, vars.ReplicaReadStoreLabels)
+	require.NoError(t, vars.SetSystemVar(TiDBReplicaReadStoreLabels, ""))
+	require.Empty(t, vars.ReplicaReadStoreLabels)
+}
+
+func TestRedactLogProfile(t *testing.T) {
+	profile, err := ParseRedactLogProfile("")
+	require.NoError(t, err)
+	require.Empty(t, profile)
+
+	val, err := ValidRedactLogProfile(" error_log = on ,Slow_Log=off, statements_summary=marker")
+	require.NoError(t, err)
+	require.Equal(t, "slow_log=OFF,statements_summary=MARKER,error_log=ON", val)
+
+	for _, invalid := range []string{"slow_log", "slow_log=", "audit_log=ON", "slow_log=1", "slow_log=ON,"} {
+		_, err = ValidRedactLogProfile(invalid)
+		require.ErrorContains(t, err, "can't be set to the value of")
+	}
+
+	vars := NewSessionVars(nil)
+	require.NoError(t, vars.SetSystemVar(TiDBRedactLog, On))
+	require.NoError(t, vars.SetSystemVar(TiDBRedactLogProfile, "slow_log=OFF,general_log=MARKER"))
+	require.Equal(t, Off, vars.RedactLogMode(RedactLogDestSlowLog))
+	require.Equal(t, Marker, vars.RedactLogMode(RedactLogDestGeneralLog))
+	// the destinations not in the profile follow tidb_redact_log.
+	require.Equal(t, On, vars.RedactLogMode(RedactLogDestStmtSummary))
+	require.Equal(t, On, vars.RedactLogMode(RedactLogDestErrorLog))
+	require.NoError(t, vars.SetSystemVar(TiDBRedactLogProfile, ""))
+	require.Equal(t, On, vars.RedactLogMode(RedactLogDestSlowLog))
+}
+
+func TestTableWriteRateLimits(t *testing.T) {
+	limits, err := ParseTableWriteRateLimits("")
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	val, err := ValidTableWriteRateLimits(" Test.T2 : 1000 rows, test.t1:10mb ,test.t3:1536")
+	require.NoError(t, err)
+	require.Equal(t, "test.t1:10MB,test.t2:1000rows,test.t3:1536", val)
+	limits, err = ParseTableWriteRateLimits(val)
+	require.NoError(t, err)
+	require.Equal(t, map[string]TableWriteRateLimit{
+		"test.t1": {Limit: 10 << 20},
+		"test.t2": {Limit: 1000, ByRows: true},
+		"test.t3": {Limit: 1536},
+	}, limits)
+
+	for _, invalid := range []string{"t1:10MB", "test.t1", "test.t1:", "test.t1:0", "test.t1:-1rows", "test.t1:10XB", "mysql.user:10MB"} {
+		_, err = ValidTableWriteRateLimits(invalid)
+		require.ErrorContains(t, err, "can't be set to the value of")
+	}
+}
+
+func TestTableRegionSchedules(t *testing.T) {
+	schedules, err := ParseTableRegionSchedules("")
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+
+	val, err := ValidTableRegionSchedules(" Test.T2 : rate = 100 , test.t1:window=22:00-6:30;rate=10")
+	require.NoError(t, err)
+	require.Equal(t, "test.t1:window=22:00-06:30;rate=10,test.t2:rate=100", val)
+	schedules, err = ParseTableRegionSchedules(val)
+	require.NoError(t, err)
+	require.Equal(t, map[string]RegionSchedule{
+		"test.t1": {WindowStart: 22 * time.Hour, WindowEnd: 6*time.Hour + 30*time.Minute, Rate: 10},
+		"test.t2": {Rate: 100},
+	}, schedules)
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+	s := schedules["test.t1"]
+	require.True(t, s.InWindow(at(23, 0)))
+	require.True(t, s.InWindow(at(6, 29)))
+	require.False(t, s.InWindow(at(6, 30)))
+	require.False(t, s.InWindow(at(12, 0)))
+	s = RegionSchedule{WindowStart: 2 * time.Hour, WindowEnd: 6 * time.Hour}
+	require.True(t, s.InWindow(at(2, 0)))
+	require.False(t, s.InWindow(at(23, 0)))
+	require.True(t, schedules["test.t2"].InWindow(at(12, 0)))
+
+	for _, invalid := range []string{"t1:rate=10", "test.t1", "test.t1:", "test.t1:rate=0", "test.t1:rate=-1",
+		"test.t1:window=02:00", "test.t1:window=02:00-02:00", "test.t1:window=25:00-02:00", "test.t1:speed=10", "mysql.user:rate=10"} {
+		_, err = ValidTableRegionSchedules(invalid)
+		require.ErrorContains(t, err, "can't be set to the value of")
+	}
+}
@@ -17,11 +17,13 @@ package unistore
 import (
 	"os"
 	spath "path"
+	"strconv"
 	"strings"
 
 	"github.com/pingcap/errors"
 	usconf "github.com/pingcap/tidb/pkg/store/mockstore/unistore/config"
 	ussvr "github.com/pingcap/tidb/pkg/store/mockstore/unistore/server"
+	us "github.com/pingcap/tidb/pkg/store/mockstore/unistore/tikv"
 	pd "github.com/tikv/pd/client"
 )
 
@@ -56,6 +58,12 @@ func New(path string, pdAddrs []string) (*RPCClient, pd.Client, *Cluster, error)
 		conf.Engine.VlogFileSize = 16 << 20
 	}
 
+	if persistent {
+		if err := loadTS(path); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	srv, rm, pd, err := ussvr.NewMock(&conf, 1)
 	if err != nil {
 		return nil, nil, nil, errors.Trace(err)
@@ -74,3 +82,29 @@ func New(path string, pdAddrs []string) (*RPCClient, pd.Client, *Cluster, error)
 
 	return client, pdClient, cluster, nil
 }
+
+// tsFileName is the file in the persistent store recording the last allocated physical time.
+const tsFileName = "last_ts"
+
+// loadTS makes the timestamps allocated after the persistent store is reopened greater than
+// the ones allocated before it's closed.
+func loadTS(path string) error {
+	data, err := os.ReadFile(spath.Join(path, tsFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	physical, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return errors.Annotatef(err, "invalid timestamp file %s", tsFileName)
+	}
+	us.AdvanceTS(physical)
+	return nil
+}
+
+func saveTS(path string) error {
+	physical, _ := us.GetTS()
+	return errors.Trace(os.WriteFile(spath.Join(path, tsFileName), []byte(strconv.FormatInt(physical, 10)), 0600))
+}
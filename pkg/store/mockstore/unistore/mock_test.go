@@ -0,0 +1,57 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unistore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistentStoreTS(t *testing.T) {
+	path := t.TempDir()
+	ctx := context.Background()
+
+	rpc, client, cluster, err := New(path, nil)
+	require.NoError(t, err)
+	physical, _, err := client.GetTS(ctx)
+	require.NoError(t, err)
+	client.Close()
+	require.NoError(t, rpc.Close())
+	cluster.Close()
+
+	data, err := os.ReadFile(filepath.Join(path, tsFileName))
+	require.NoError(t, err)
+	saved, err := strconv.ParseInt(string(data), 10, 64)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, saved, physical)
+
+	// the timestamps keep increasing after reopening even if the clock goes back.
+	future := time.Now().Add(time.Hour).UnixMilli()
+	require.NoError(t, os.WriteFile(filepath.Join(path, tsFileName), []byte(strconv.FormatInt(future, 10)), 0600))
+	rpc, client, cluster, err = New(path, nil)
+	require.NoError(t, err)
+	physical, _, err = client.GetTS(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, physical, future)
+	client.Close()
+	require.NoError(t, rpc.Close())
+	cluster.Close()
+}
@@ -450,7 +450,10 @@ func (c *RPCClient) Close() error {
 	if c.usSvr != nil {
 		c.usSvr.Stop()
 	}
-	if !c.persistent && c.path != "" {
+	if c.persistent {
+		return saveTS(c.path)
+	}
+	if c.path != "" {
 		err := os.RemoveAll(c.path)
 		_ = err
 	}
@@ -101,7 +101,10 @@ func getRegionOptions(conf *config.Config) tikv.RegionOptions {
 func setupStandAlongInnerServer(bundle *mvcc.DBBundle, safePoint *tikv.SafePoint, rm tikv.RegionManager, pdClient pd.Client, conf *config.Config) (*tikv.Server, error) {
 	innerServer := tikv.NewStandAlongInnerServer(bundle)
 	innerServer.Setup(pdClient)
-	store := tikv.NewMVCCStore(conf, bundle, conf.Engine.DBPath, safePoint, tikv.NewDBWriter(bundle), pdClient)
+	store, err := tikv.NewMVCCStore(conf, bundle, conf.Engine.DBPath, safePoint, tikv.NewDBWriter(bundle), pdClient)
+	if err != nil {
+		return nil, err
+	}
 	store.DeadlockDetectSvr.ChangeRole(tikv.Leader)
 
 	if err := innerServer.Start(pdClient); err != nil {
@@ -874,6 +874,18 @@ func (pd *MockPD) GetTS(ctx context.Context) (int64, int64, error) {
 	return p, l, nil
 }
 
+// AdvanceTS makes the timestamps allocated later greater than the physical time, it's used to keep the
+// timestamps increasing when the persistent store is reopened, even if the clock goes back.
+func AdvanceTS(physical int64) {
+	tsMu.Lock()
+	defer tsMu.Unlock()
+
+	if tsMu.physicalTS < physical {
+		tsMu.physicalTS = physical
+		tsMu.logicalTS = 0
+	}
+}
+
 // GetTS gets a timestamp.
 func GetTS() (int64, int64) {
 	tsMu.Lock()
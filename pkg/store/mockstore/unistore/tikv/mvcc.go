@@ -20,6 +20,7 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"slices"
@@ -67,7 +68,7 @@ type MVCCStore struct {
 
 // NewMVCCStore creates a new MVCCStore
 func NewMVCCStore(conf *config.Config, bundle *mvcc.DBBundle, dataDir string, safePoint *SafePoint,
-	writer mvcc.DBWriter, pdClient pd.Client) *MVCCStore {
+	writer mvcc.DBWriter, pdClient pd.Client) (*MVCCStore, error) {
 	store := &MVCCStore{
 		db:                bundle.DB,
 		dir:               dataDir,
@@ -81,12 +82,15 @@ func NewMVCCStore(conf *config.Config, bundle *mvcc.DBBundle, dataDir string, sa
 	}
 	store.DeadlockDetectSvr = NewDetectorServer()
 	store.DeadlockDetectCli = NewDetectorClient(store.lockWaiterManager, pdClient)
+	if err := store.loadMemLocks(); err != nil {
+		return nil, err
+	}
 	writer.Open()
 	if pdClient != nil {
 		// pdClient is nil in unit test.
 		go store.runUpdateSafePointLoop()
 	}
-	return store
+	return store, nil
 }
 
 func (store *MVCCStore) updateLatestTS(ts uint64) {
@@ -165,6 +169,48 @@ func (store *MVCCStore) dumpMemLocks() error {
 	return os.Rename(tmpFileName, store.dir+"/lock_store")
 }
 
+// loadMemLocks loads the locks dumped by dumpMemLocks when the store was closed, so the transactions
+// which were not committed or rolled back can be resolved after the persistent store is reopened.
+func (store *MVCCStore) loadMemLocks() error {
+	fileName := store.dir + "/lock_store"
+	//nolint: gosec
+	f, err := os.Open(fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	reader := bufio.NewReader(f)
+	cnt := 0
+	hdrBuf := make([]byte, 8)
+	hdr := (*lockEntryHdr)(unsafe.Pointer(&hdrBuf[0]))
+	for {
+		_, err = io.ReadFull(reader, hdrBuf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = f.Close()
+			return errors.Trace(err)
+		}
+		buf := make([]byte, hdr.keyLen+hdr.valLen)
+		if _, err = io.ReadFull(reader, buf); err != nil {
+			_ = f.Close()
+			return errors.Trace(err)
+		}
+		store.lockStore.Put(buf[:hdr.keyLen], buf[hdr.keyLen:])
+		cnt++
+	}
+	if err = f.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	log.Info("loaded mem locks", zap.Int("entries", cnt))
+	// The locks are dumped again when the store is closed, the stale file mustn't be loaded
+	// again if the store isn't closed normally.
+	return errors.Trace(os.Remove(fileName))
+}
+
 func (store *MVCCStore) getDBItems(reqCtx *requestCtx, mutations []*kvrpcpb.Mutation) (items []*badger.Item, err error) {
 	txn := reqCtx.getDBReader().GetTxn()
 	keys := make([][]byte, len(mutations))
@@ -121,7 +121,8 @@ func NewTestStore(dbPrefix string, logPrefix string, t *testing.T) *TestStore {
 	})
 	require.NoError(t, err)
 	pdClient := NewMockPD(rm)
-	store := NewMVCCStore(&config.DefaultConf, dbBundle, dbPath, safePoint, writer, pdClient)
+	store, err := NewMVCCStore(&config.DefaultConf, dbBundle, dbPath, safePoint, writer, pdClient)
+	require.NoError(t, err)
 	svr := NewServer(nil, store, nil)
 
 	t.Cleanup(func() {
@@ -1881,3 +1882,27 @@ func TestRcReadCheckTS(t *testing.T) {
 	conflictErr = getConflictErr(scanRes)
 	require.NotNil(t, conflictErr)
 }
+
+func TestLoadMemLocks(t *testing.T) {
+	store := NewTestStore("TestLoadMemLocks", "TestLoadMemLocks", t)
+	key := []byte("tkey")
+	require.NoError(t, PrewriteOptimistic(key, key, []byte("val"), 1, lockTTL, 0, false, nil, store))
+	require.NoError(t, store.MvccStore.dumpMemLocks())
+
+	// the locks are loaded when the store is reopened.
+	reopened := &MVCCStore{dir: store.DBPath, lockStore: lockstore.NewMemStore(4096)}
+	require.NoError(t, reopened.loadMemLocks())
+	require.Equal(t, store.MvccStore.lockStore.Get(key, nil), reopened.lockStore.Get(key, nil))
+	require.NotNil(t, reopened.lockStore.Get(key, nil))
+
+	// the dumped locks are only loaded once.
+	_, err := os.Stat(filepath.Join(store.DBPath, "lock_store"))
+	require.True(t, os.IsNotExist(err))
+	reopened = &MVCCStore{dir: store.DBPath, lockStore: lockstore.NewMemStore(4096)}
+	require.NoError(t, reopened.loadMemLocks())
+	require.Nil(t, reopened.lockStore.Get(key, nil))
+
+	// a broken dump fails to open the store.
+	require.NoError(t, os.WriteFile(filepath.Join(store.DBPath, "lock_store"), []byte{1, 0, 0, 0}, 0600))
+	require.Error(t, reopened.loadMemLocks())
+}
@@ -148,6 +148,7 @@ func (d *DistExecutionContext) InitOwner() {
 	}
 	err := d.domains[len(d.domains)-1].DDL().OwnerManager().CampaignOwner()
 	require.NoError(d.t, err)
+	d.waitOwner(len(d.domains) - 1)
 }
 
 // SetOwner set one mock domain to DDL Owner by idx.
@@ -163,6 +164,20 @@ func (d *DistExecutionContext) SetOwner(idx int) {
 	}
 	err := d.domains[idx].DDL().OwnerManager().CampaignOwner()
 	require.NoError(d.t, err)
+	d.waitOwner(idx)
+}
+
+// waitOwner waits until the domain by idx becomes the DDL owner, since every
+// domain keeps campaigning, the owner got by the others is retired again.
+func (d *DistExecutionContext) waitOwner(idx int) {
+	require.Eventually(d.t, func() bool {
+		for i, dom := range d.domains {
+			if i != idx && dom.DDL().OwnerManager().IsOwner() {
+				dom.DDL().OwnerManager().RetireOwner()
+			}
+		}
+		return d.domains[idx].DDL().OwnerManager().IsOwner()
+	}, 10*time.Second, 100*time.Millisecond)
 }
 
 // AddDomain add 1 domain which is not ddl owner.
@@ -200,6 +215,42 @@ func (d *DistExecutionContext) DeleteDomain(idx int) {
 	require.NoError(d.t, err)
 }
 
+// KillDomain removes 1 domain by idx and stops its DDL to simulate the crash
+// of a server, unlike DeleteDomain, the DDL owner is not handed over explicitly,
+// the remaining servers have to campaign for it, use WaitOwner to wait for the
+// new owner. The domain itself is closed in Close, since closing a domain resets
+// the mock server info of all the servers.
+func (d *DistExecutionContext) KillDomain(idx int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if idx >= len(d.domains) || idx < 0 {
+		require.NoError(d.t, errors.New("server idx out of bound"))
+		return
+	}
+	if len(d.domains) == 1 {
+		require.NoError(d.t, errors.New("can't kill server, since server num = 1"))
+		return
+	}
+	dom := d.domains[idx]
+	require.NoError(d.t, dom.DDL().Stop())
+	d.deletedDomains = append(d.deletedDomains, dom)
+	d.domains = append(d.domains[:idx], d.domains[idx+1:]...)
+
+	err := infosync.MockGlobalServerInfoManagerEntry.Delete(idx)
+	require.NoError(d.t, err)
+}
+
+// WaitOwner waits until one of the domains except the one by excludeIdx
+// becomes the DDL owner and returns its idx, pass -1 to accept any domain.
+func (d *DistExecutionContext) WaitOwner(excludeIdx int) int {
+	ownerIdx := -1
+	require.Eventually(d.t, func() bool {
+		ownerIdx = d.GetOwnerIdx()
+		return ownerIdx >= 0 && ownerIdx != excludeIdx
+	}, 10*time.Second, 100*time.Millisecond)
+	return ownerIdx
+}
+
 // Close cleanup running goroutines, release resources used.
 func (d *DistExecutionContext) Close() {
 	d.t.Cleanup(func() {
@@ -232,6 +283,26 @@ func (d *DistExecutionContext) GetDomainCnt() int {
 	return len(d.domains)
 }
 
+// GetOwnerIdx returns the index of the domain which is the DDL owner, -1 if
+// there is no DDL owner.
+func (d *DistExecutionContext) GetOwnerIdx() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, dom := range d.domains {
+		if dom.DDL().OwnerManager().IsOwner() {
+			return i
+		}
+	}
+	return -1
+}
+
+// NewTestKit returns a new *TestKit which runs SQL on the domain by index, the
+// domains share the same store but have their own DDL, dist task executor and
+// schema cache.
+func (d *DistExecutionContext) NewTestKit(idx int) *TestKit {
+	return NewTestKitWithDomain(d.t, d.Store, d.GetDomain(idx))
+}
+
 // NewDistExecutionContext create DistExecutionContext for testing.
 func NewDistExecutionContext(t testing.TB, serverNum int) *DistExecutionContext {
 	store, err := mockstore.NewMockStore()
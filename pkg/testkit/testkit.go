@@ -26,6 +26,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
@@ -57,10 +58,23 @@ type TestKit struct {
 	store   kv.Storage
 	session sessiontypes.Session
 	alloc   chunk.Allocator
+	// dom is the domain which the sessions are bound to, nil means the domain
+	// of the store.
+	dom *domain.Domain
 }
 
 // NewTestKit returns a new *TestKit.
 func NewTestKit(t testing.TB, store kv.Storage) *TestKit {
+	return newTestKit(t, store, nil)
+}
+
+// NewTestKitWithDomain returns a new *TestKit whose sessions are bound to dom,
+// it's used to run SQL on one of the TiDB nodes sharing the same store.
+func NewTestKitWithDomain(t testing.TB, store kv.Storage, dom *domain.Domain) *TestKit {
+	return newTestKit(t, store, dom)
+}
+
+func newTestKit(t testing.TB, store kv.Storage, dom *domain.Domain) *TestKit {
 	require.True(t, intest.InTest, "you should add --tags=intest when to test, see https://pingcap.github.io/tidb-dev-guide/get-started/setup-an-ide.html for help")
 	testenv.SetGOMAXPROCSForTest()
 	tk := &TestKit{
@@ -69,10 +83,13 @@ func NewTestKit(t testing.TB, store kv.Storage) *TestKit {
 		t:       t,
 		store:   store,
 		alloc:   chunk.NewAllocator(),
+		dom:     dom,
 	}
 	tk.RefreshSession()
 
-	dom, _ := session.GetDomain(store)
+	if dom == nil {
+		dom, _ = session.GetDomain(store)
+	}
 	sm := dom.InfoSyncer().GetSessionManager()
 	if sm != nil {
 		mockSm, ok := sm.(*MockSessionManager)
@@ -104,7 +121,11 @@ func NewTestKitWithSession(t testing.TB, store kv.Storage, se sessiontypes.Sessi
 
 // RefreshSession set a new session for the testkit
 func (tk *TestKit) RefreshSession() {
-	tk.session = NewSession(tk.t, tk.store)
+	if tk.dom != nil {
+		tk.session = NewSessionWithDomain(tk.t, tk.store, tk.dom)
+	} else {
+		tk.session = NewSession(tk.t, tk.store)
+	}
 	// enforce sysvar cache loading, ref loadCommonGlobalVariableIfNeeded
 	tk.MustExec("select 3")
 }
@@ -432,6 +453,14 @@ func NewSession(t testing.TB, store kv.Storage) sessiontypes.Session {
 	return se
 }
 
+// NewSessionWithDomain creates a new session bound to dom.
+func NewSessionWithDomain(t testing.TB, store kv.Storage, dom *domain.Domain) sessiontypes.Session {
+	se, err := session.CreateSession4TestWithDomain(store, dom)
+	require.NoError(t, err)
+	se.SetConnectionID(testKitIDGenerator.Inc())
+	return se
+}
+
 // RefreshConnectionID refresh the connection ID for session of the testkit
 func (tk *TestKit) RefreshConnectionID() {
 	if tk.session != nil {
@@ -17,6 +17,7 @@ package testkit
 import (
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +33,38 @@ func TestMultiStatementInTk(t *testing.T) {
 		require.Len(t, tk.Session().GetSessionVars().MemTracker.GetChildrenForTest(), 0)
 	}
 }
+
+func TestDistExecutionContext(t *testing.T) {
+	distCtx := NewDistExecutionContext(t, 3)
+	defer distCtx.Close()
+	require.Equal(t, 2, distCtx.GetOwnerIdx())
+
+	tk0 := distCtx.NewTestKit(0)
+	tk1 := distCtx.NewTestKit(1)
+	require.Same(t, distCtx.GetDomain(0), domain.GetDomain(tk0.Session()))
+	require.Same(t, distCtx.GetDomain(1), domain.GetDomain(tk1.Session()))
+
+	// the DDL submitted on a non-owner node is visible on other nodes.
+	tk0.MustExec("create table test.t(a int)")
+	require.NoError(t, distCtx.GetDomain(1).Reload())
+	tk1.MustExec("insert into test.t values (1)")
+	tk0.MustQuery("select * from test.t").Check(Rows("1"))
+
+	// the DDL owner fails over to the first node after the owner is deleted.
+	distCtx.DeleteDomain(2)
+	require.Equal(t, 0, distCtx.GetOwnerIdx())
+	tk1.MustExec("alter table test.t add index idx(a)")
+	require.NoError(t, distCtx.GetDomain(0).Reload())
+	tk0.MustQuery("select a from test.t use index(idx)").Check(Rows("1"))
+
+	// the remaining node campaigns for the DDL owner after the owner crashes.
+	distCtx.AddDomain()
+	distCtx.SetOwner(2)
+	distCtx.KillDomain(2)
+	require.Equal(t, 2, distCtx.GetDomainCnt())
+	ownerIdx := distCtx.WaitOwner(-1)
+	tk := distCtx.NewTestKit(1 - ownerIdx)
+	tk.MustExec("alter table test.t add column b int")
+	require.NoError(t, distCtx.GetDomain(ownerIdx).Reload())
+	distCtx.NewTestKit(ownerIdx).MustQuery("select * from test.t").Check(Rows("1 <nil>"))
+}
@@ -33,6 +33,19 @@ type Allocator interface {
 var maxFreeChunks = 64
 var maxFreeColumnsPerType = 256
 
+// Releaser is implemented by the allocators which only recycle the chunks released by their owners.
+type Releaser interface {
+	// Release releases the chunk allocated by the allocator to be recycled.
+	Release(chk *Chunk)
+}
+
+// Release releases the chunk to `alloc` if it's a Releaser, the chunk mustn't be used after it's released.
+func Release(alloc Allocator, chk *Chunk) {
+	if r, ok := alloc.(Releaser); ok {
+		r.Release(chk)
+	}
+}
+
 // InitChunkAllocSize init the maximum cache size
 func InitChunkAllocSize(setMaxFreeChunks, setMaxFreeColumns uint32) {
 	if setMaxFreeChunks > math.MaxInt32 {
@@ -282,6 +295,14 @@ func (s *syncAllocator) Reset() {
 	s.alloc.Reset()
 }
 
+// Release implements `Releaser` for `*syncAllocator`
+func (s *syncAllocator) Release(chk *Chunk) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	Release(s.alloc, chk)
+}
+
 var _ Allocator = &reuseHookAllocator{}
 
 // reuseHookAllocator will run the function hook when it allocates the first chunk from reused part
@@ -319,6 +340,11 @@ func (r *reuseHookAllocator) Reset() {
 	r.alloc.Reset()
 }
 
+// Release implements `Releaser` for `*reuseHookAllocator`
+func (r *reuseHookAllocator) Release(chk *Chunk) {
+	Release(r.alloc, chk)
+}
+
 var _ Allocator = emptyAllocator{}
 
 type emptyAllocator struct{}
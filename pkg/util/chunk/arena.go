@@ -0,0 +1,178 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chunk
+
+import (
+	"unsafe"
+
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+var (
+	_ Allocator = &ArenaAllocator{}
+	_ Releaser  = &ArenaAllocator{}
+)
+
+// ArenaAllocator allocates the chunks of the statements from an arena, whose lifetime
+// is tied to the statement: the chunks allocated by a statement and released by their
+// owners, such as the build side rows of the hash joins, are recycled into the arena by
+// Reset() after the statement finishes, and the next statements allocate from them.
+// The chunks which aren't released may be still referenced, so they're left to GC.
+//
+// Different from `allocator`, which only recycles a limited number of small chunks,
+// ArenaAllocator recycles every released chunk of the statement and bounds the arena
+// by the memory it holds, so the analytical statements which allocate thousands of
+// chunks don't leave them to GC.
+type ArenaAllocator struct {
+	// capacity is the max bytes of the free columns held by the arena.
+	capacity int64
+
+	// allocated are the chunks allocated since the last Reset(), the value is true if the
+	// chunk is released by its owner.
+	allocated map[*Chunk]bool
+	free      []*Chunk
+	// freeColumns are the recycled columns, keyed by the type size.
+	freeColumns map[int][]*Column
+	freeBytes   int64
+}
+
+// NewArenaAllocator creates an ArenaAllocator which holds at most capacity bytes of
+// the recycled columns.
+func NewArenaAllocator(capacity int64) *ArenaAllocator {
+	return &ArenaAllocator{
+		capacity:    capacity,
+		allocated:   make(map[*Chunk]bool),
+		freeColumns: make(map[int][]*Column),
+	}
+}
+
+// SetCapacity sets the max bytes held by the arena, it takes effect from the next Reset().
+func (a *ArenaAllocator) SetCapacity(capacity int64) {
+	a.capacity = capacity
+}
+
+// FreeBytes returns the bytes of the free columns held by the arena.
+func (a *ArenaAllocator) FreeBytes() int64 {
+	return a.freeBytes
+}
+
+// Alloc implements the Allocator interface.
+func (a *ArenaAllocator) Alloc(fields []*types.FieldType, capacity, maxChunkSize int) *Chunk {
+	var chk *Chunk
+	if len(a.free) > 0 {
+		chk = a.free[len(a.free)-1]
+		a.free[len(a.free)-1] = nil
+		a.free = a.free[:len(a.free)-1]
+	} else {
+		chk = &Chunk{columns: make([]*Column, 0, len(fields))}
+	}
+	chk.capacity = min(capacity, maxChunkSize)
+	chk.requiredRows = maxChunkSize
+	for _, f := range fields {
+		chk.columns = append(chk.columns, a.newColumn(getFixedLen(f), chk.capacity))
+	}
+	a.allocated[chk] = false
+	return chk
+}
+
+func (a *ArenaAllocator) newColumn(typeSize, capacity int) *Column {
+	cols := a.freeColumns[typeSize]
+	if len(cols) == 0 {
+		return newColumn(typeSize, capacity)
+	}
+	col := cols[len(cols)-1]
+	cols[len(cols)-1] = nil
+	a.freeColumns[typeSize] = cols[:len(cols)-1]
+	a.freeBytes -= columnMemoryUsage(col)
+	if typeSize != varElemLen && cap(col.data) < typeSize*capacity {
+		return newColumn(typeSize, capacity)
+	}
+	return col
+}
+
+// Release implements the Releaser interface. The chunk is recycled by the next Reset() if it's
+// allocated by the arena since the last Reset(), it mustn't be used after it's released.
+func (a *ArenaAllocator) Release(chk *Chunk) {
+	if _, ok := a.allocated[chk]; ok {
+		a.allocated[chk] = true
+	}
+}
+
+// CheckReuseAllocSize implements the Allocator interface.
+func (a *ArenaAllocator) CheckReuseAllocSize() bool {
+	return a.capacity > 0
+}
+
+// Reset implements the Allocator interface. It recycles the chunks allocated and
+// released since the last Reset(), and forgets the others.
+func (a *ArenaAllocator) Reset() {
+	// some of the chunk columns are just the reference to the others, the columns
+	// referenced by the chunks which aren't released can't be recycled.
+	recycled := make(freeList)
+	for chk, released := range a.allocated {
+		if !released {
+			for _, col := range chk.columns {
+				recycled[col] = struct{}{}
+			}
+		}
+	}
+	for chk, released := range a.allocated {
+		if !released {
+			continue
+		}
+		for _, col := range chk.columns {
+			if _, ok := recycled[col]; ok {
+				continue
+			}
+			recycled[col] = struct{}{}
+			a.recycleColumn(col)
+		}
+		chk.resetForReuse()
+		a.free = append(a.free, chk)
+	}
+	clear(a.allocated)
+	if len(a.free) > maxFreeChunks {
+		clear(a.free[maxFreeChunks:])
+		a.free = a.free[:maxFreeChunks]
+	}
+}
+
+func (a *ArenaAllocator) recycleColumn(col *Column) {
+	if col.avoidReusing {
+		return
+	}
+	typeSize := col.typeSize()
+	// the column may be changed to another type by `Column.Reset`, only recycle the
+	// columns which are consistent with their type.
+	if typeSize == varElemLen {
+		if col.elemBuf != nil || len(col.offsets) == 0 {
+			return
+		}
+	} else if len(col.offsets) > 0 {
+		return
+	}
+	size := columnMemoryUsage(col)
+	if a.freeBytes+size > a.capacity {
+		return
+	}
+	col.reset()
+	a.freeColumns[typeSize] = append(a.freeColumns[typeSize], col)
+	a.freeBytes += size
+}
+
+func columnMemoryUsage(col *Column) int64 {
+	return int64(unsafe.Sizeof(*col)) + int64(cap(col.nullBitmap)) + int64(cap(col.offsets)*8) +
+		int64(cap(col.data)) + int64(cap(col.elemBuf))
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chunk
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArenaAllocator(t *testing.T) {
+	fieldTypes := []*types.FieldType{
+		types.NewFieldType(mysql.TypeVarchar),
+		types.NewFieldType(mysql.TypeLonglong),
+		types.NewFieldType(mysql.TypeNewDecimal),
+	}
+	alloc := NewArenaAllocator(1 << 30)
+	require.True(t, alloc.CheckReuseAllocSize())
+
+	// the arena recycles all the released chunks of the statement, more than maxFreeChunks.
+	cols := make(map[*Column]struct{})
+	chkCount := maxFreeChunks * 2
+	for i := 0; i < chkCount; i++ {
+		chk := alloc.Alloc(fieldTypes, 32, 1024)
+		chk.AppendString(0, "abc")
+		chk.AppendInt64(1, 1)
+		chk.AppendMyDecimal(2, types.NewDecFromInt(1))
+		for _, col := range chk.columns {
+			cols[col] = struct{}{}
+		}
+		alloc.Release(chk)
+	}
+	require.Zero(t, alloc.FreeBytes())
+	alloc.Reset()
+	require.Positive(t, alloc.FreeBytes())
+	require.Len(t, alloc.free, maxFreeChunks)
+	for _, typeSize := range []int{varElemLen, 8, types.MyDecimalStructSize} {
+		require.Len(t, alloc.freeColumns[typeSize], chkCount)
+	}
+
+	// the next statement allocates from the arena, and the columns are reset.
+	for i := 0; i < chkCount; i++ {
+		chk := alloc.Alloc(fieldTypes, 32, 1024)
+		require.Zero(t, chk.NumRows())
+		for _, col := range chk.columns {
+			_, ok := cols[col]
+			require.True(t, ok)
+		}
+		require.Len(t, chk.columns[0].offsets, 1)
+		alloc.Release(chk)
+	}
+	require.Zero(t, alloc.FreeBytes())
+
+	// the columns which are too small for the capacity aren't reused.
+	alloc.Reset()
+	chk := alloc.Alloc(fieldTypes, 1024, 1024)
+	_, ok := cols[chk.columns[0]]
+	require.True(t, ok)
+	_, ok = cols[chk.columns[1]]
+	require.False(t, ok)
+	require.Len(t, alloc.freeColumns[8], chkCount-1)
+	alloc.Release(chk)
+
+	// the arena holds at most capacity bytes.
+	alloc.Reset()
+	alloc.SetCapacity(0)
+	for i := 0; i < chkCount; i++ {
+		alloc.Release(alloc.Alloc(fieldTypes, 32, 1024))
+	}
+	freeBytes := alloc.FreeBytes()
+	alloc.Reset()
+	require.Equal(t, freeBytes, alloc.FreeBytes())
+	require.False(t, alloc.CheckReuseAllocSize())
+}
+
+func TestArenaAllocatorSharedColumns(t *testing.T) {
+	fieldTypes := []*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}
+	alloc := NewArenaAllocator(1 << 30)
+	chk1 := alloc.Alloc(fieldTypes, 32, 1024)
+	chk2 := alloc.Alloc(fieldTypes, 32, 1024)
+	require.NoError(t, chk2.MakeRefTo(0, chk1, 0))
+	alloc.Release(chk1)
+	alloc.Release(chk2)
+	alloc.Reset()
+	// the shared column is recycled once.
+	require.Len(t, alloc.freeColumns[8], 1)
+
+	// the column shared with a chunk which isn't released isn't recycled.
+	chk1 = alloc.Alloc(fieldTypes, 32, 1024)
+	chk2 = alloc.Alloc(fieldTypes, 32, 1024)
+	require.NoError(t, chk2.MakeRefTo(0, chk1, 0))
+	alloc.Release(chk1)
+	alloc.Reset()
+	require.Empty(t, alloc.freeColumns[8])
+	require.Len(t, alloc.free, 1)
+}
+
+func TestArenaAllocatorRelease(t *testing.T) {
+	fieldTypes := []*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}
+	alloc := NewArenaAllocator(1 << 30)
+	syncAlloc := NewReuseHookAllocator(NewSyncAllocator(alloc), func() {})
+
+	// only the released chunks are recycled, the others may be still referenced.
+	released := syncAlloc.Alloc(fieldTypes, 32, 1024)
+	owned := syncAlloc.Alloc(fieldTypes, 32, 1024)
+	col := released.columns[0]
+	Release(syncAlloc, released)
+	alloc.Reset()
+	require.Equal(t, []*Chunk{released}, alloc.free)
+	require.Equal(t, []*Column{col}, alloc.freeColumns[8])
+
+	// the chunks allocated before the last Reset() can't be released.
+	Release(syncAlloc, owned)
+	alloc.Reset()
+	require.Len(t, alloc.free, 1)
+	require.Len(t, alloc.freeColumns[8], 1)
+
+	// releasing a chunk to the allocators which don't implement Releaser is a no-op.
+	Release(NewAllocator(), owned)
+}
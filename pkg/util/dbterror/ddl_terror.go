@@ -406,6 +406,10 @@ var (
 	ErrCannotPauseDDLJob = ClassDDL.NewStd(mysql.ErrCannotPauseDDLJob)
 	// ErrCannotResumeDDLJob returns  when the State is not qualified to be resumed.
 	ErrCannotResumeDDLJob = ClassDDL.NewStd(mysql.ErrCannotResumeDDLJob)
+	// ErrCannotAlterDDLJob returns when the job or the option is not qualified to be altered.
+	ErrCannotAlterDDLJob = ClassDDL.NewStd(mysql.ErrCannotAlterDDLJob)
+	// ErrIngestFallback is the warning when the ingest job falls back to the txn-merge backfill.
+	ErrIngestFallback = ClassDDL.NewStd(mysql.ErrIngestFallback)
 	// ErrDDLSetting returns when failing to enable/disable DDL.
 	ErrDDLSetting = ClassDDL.NewStd(mysql.ErrDDLSetting)
 	// ErrIngestFailed returns when the DDL ingest job is failed.
@@ -0,0 +1,96 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"os"
+
+	"github.com/pingcap/errors"
+)
+
+// The following constants are the methods to encrypt the files at rest.
+const (
+	MethodPlaintext = "plaintext"
+	MethodAES128CTR = "aes128-ctr"
+	MethodAES192CTR = "aes192-ctr"
+	MethodAES256CTR = "aes256-ctr"
+)
+
+// MethodKeyLen returns the key length in bytes of the encryption method, it's 0 for plaintext.
+func MethodKeyLen(method string) (int, error) {
+	switch method {
+	case MethodPlaintext:
+		return 0, nil
+	case MethodAES128CTR:
+		return 16, nil
+	case MethodAES192CTR:
+		return 24, nil
+	case MethodAES256CTR:
+		return 32, nil
+	}
+	return 0, errors.Errorf("unsupported encryption method %s, only supports [%s, %s, %s, %s]",
+		method, MethodPlaintext, MethodAES128CTR, MethodAES192CTR, MethodAES256CTR)
+}
+
+// LoadMasterKey loads the master key of the encryption method from the file, which contains the
+// key encoded as a hex string, the same as the file master key of the TiKV encryption at rest.
+// It returns nil for plaintext.
+func LoadMasterKey(method, path string) ([]byte, error) {
+	keyLen, err := MethodKeyLen(method)
+	if err != nil || keyLen == 0 {
+		return nil, err
+	}
+	if path == "" {
+		return nil, errors.Errorf("the master key file of the encryption method %s is not specified", method)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "read the master key file failed")
+	}
+	key, err := hex.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil {
+		return nil, errors.Annotate(err, "the master key should be encoded as a hex string")
+	}
+	if len(key) != keyLen {
+		return nil, errors.Errorf("the master key of the encryption method %s should be %d bytes, but got %d bytes",
+			method, keyLen, len(key))
+	}
+	return key, nil
+}
+
+// XORKeyStreamAt XORs each byte of src with the AES-CTR key stream starting from offset bytes
+// after iv, and writes the result to dst, so any part of a file can be encrypted or decrypted
+// independently.
+func XORKeyStreamAt(block cipher.Block, iv []byte, dst, src []byte, offset int64) {
+	counter := make([]byte, aes.BlockSize)
+	copy(counter, iv)
+	// add the block index to the big-endian counter.
+	carry := uint64(offset / aes.BlockSize)
+	for i := aes.BlockSize - 1; i >= 0 && carry > 0; i-- {
+		sum := uint64(counter[i]) + carry&0xff
+		counter[i] = byte(sum)
+		carry = carry>>8 + sum>>8
+	}
+	stream := cipher.NewCTR(block, counter)
+	if skip := int(offset % aes.BlockSize); skip > 0 {
+		var discard [aes.BlockSize]byte
+		stream.XORKeyStream(discard[:skip], discard[:skip])
+	}
+	stream.XORKeyStream(dst, src)
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMasterKey(t *testing.T) {
+	key, err := LoadMasterKey(MethodPlaintext, "")
+	require.NoError(t, err)
+	require.Nil(t, key)
+	_, err = LoadMasterKey("aes-ctr", "")
+	require.ErrorContains(t, err, "unsupported encryption method")
+	_, err = LoadMasterKey(MethodAES256CTR, "")
+	require.ErrorContains(t, err, "is not specified")
+
+	path := filepath.Join(t.TempDir(), "master.key")
+	expected := bytes.Repeat([]byte{0xab}, 32)
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(expected)+"\n"), 0o600))
+	key, err = LoadMasterKey(MethodAES256CTR, path)
+	require.NoError(t, err)
+	require.Equal(t, expected, key)
+	_, err = LoadMasterKey(MethodAES128CTR, path)
+	require.ErrorContains(t, err, "should be 16 bytes")
+
+	require.NoError(t, os.WriteFile(path, []byte("not hex"), 0o600))
+	_, err = LoadMasterKey(MethodAES256CTR, path)
+	require.ErrorContains(t, err, "hex string")
+}
+
+func TestXORKeyStreamAt(t *testing.T) {
+	block, err := aes.NewCipher(bytes.Repeat([]byte{1}, 16))
+	require.NoError(t, err)
+	// the counter carries across the bytes of the iv.
+	iv := append([]byte{0}, bytes.Repeat([]byte{0xff}, 15)...)
+	plain := make([]byte, 1000)
+	for i := range plain {
+		plain[i] = byte(i)
+	}
+	expected := make([]byte, len(plain))
+	cipher.NewCTR(block, iv).XORKeyStream(expected, plain)
+
+	encrypted := make([]byte, len(plain))
+	XORKeyStreamAt(block, iv, encrypted, plain, 0)
+	require.Equal(t, expected, encrypted)
+	for _, off := range []int{1, 15, 16, 17, 500, 999} {
+		got := make([]byte, len(plain)-off)
+		XORKeyStreamAt(block, iv, got, encrypted[off:], int64(off))
+		require.Equal(t, plain[off:], got, off)
+	}
+}
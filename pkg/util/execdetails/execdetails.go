@@ -918,6 +918,8 @@ const (
 	TpFKCascadeRuntimeStats
 	// TpRURuntimeStats is the tp for RURuntimeStats
 	TpRURuntimeStats
+	// TpTiKVRuntimeFilterRuntimeStats is the tp for TiKVRuntimeFilterRuntimeStats
+	TpTiKVRuntimeFilterRuntimeStats
 )
 
 // RuntimeStats is used to express the executor runtime information.
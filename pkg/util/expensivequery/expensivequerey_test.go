@@ -16,8 +16,17 @@ package expensivequery
 
 import (
 	"testing"
+	"time"
 
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/testkit/testsetup"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/pingcap/tidb/pkg/util/sqlkiller"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/goleak"
 )
 
@@ -32,3 +41,45 @@ func TestMain(m *testing.M) {
 	}
 	goleak.VerifyTestMain(m, opts...)
 }
+
+func TestKillOnSnapshotGCProtectionExceeded(t *testing.T) {
+	defer func(maxWaitTime, protectTime int64, lifeTime time.Duration) {
+		variable.GCMaxWaitTime.Store(maxWaitTime)
+		variable.GCQueryProtectTime.Store(protectTime)
+		variable.GCLifeTime.Store(lifeTime)
+	}(variable.GCMaxWaitTime.Load(), variable.GCQueryProtectTime.Load(), variable.GCLifeTime.Load())
+	variable.GCMaxWaitTime.Store(3600)
+
+	killer := &sqlkiller.SQLKiller{ConnID: 1}
+	tracker := memory.NewTracker(-1, -1)
+	tracker.Killer = killer
+	info := &util.ProcessInfo{
+		ID:            1,
+		Command:       mysql.ComQuery,
+		Info:          "select * from t",
+		CurTxnStartTS: oracle.GoTimeToTS(time.Now().Add(-2 * time.Hour)),
+		MemTracker:    tracker,
+	}
+	// it's disabled by default.
+	killOnSnapshotGCProtectionExceeded(info)
+	require.NoError(t, killer.HandleSignal())
+	// the snapshot is still protected by tidb_gc_query_protect_time.
+	variable.GCQueryProtectTime.Store(3 * 3600)
+	killOnSnapshotGCProtectionExceeded(info)
+	require.NoError(t, killer.HandleSignal())
+
+	// the snapshot is still protected by tidb_gc_life_time.
+	variable.GCQueryProtectTime.Store(1800)
+	variable.GCLifeTime.Store(3 * time.Hour)
+	killOnSnapshotGCProtectionExceeded(info)
+	require.NoError(t, killer.HandleSignal())
+
+	// the snapshot is no longer protected by any of them.
+	variable.GCLifeTime.Store(10 * time.Minute)
+	info.Command = mysql.ComSleep
+	killOnSnapshotGCProtectionExceeded(info)
+	require.NoError(t, killer.HandleSignal())
+	info.Command = mysql.ComQuery
+	killOnSnapshotGCProtectionExceeded(info)
+	require.True(t, exeerrors.ErrSnapshotGCProtectionExceeded.Equal(killer.HandleSignal()))
+}
@@ -20,9 +20,12 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sqlkiller"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -97,6 +100,7 @@ func (eqh *Handle) Run() {
 						zap.Duration("maxExecutionTime", time.Duration(info.MaxExecutionTime)*time.Millisecond), zap.String("processInfo", info.String()))
 					sm.Kill(info.ID, true, true)
 				}
+				killOnSnapshotGCProtectionExceeded(info)
 				if info.ID == sm.GetAutoAnalyzeProcID() {
 					maxAutoAnalyzeTime := variable.MaxAutoAnalyzeTime.Load()
 					if maxAutoAnalyzeTime > 0 && costTime > time.Duration(maxAutoAnalyzeTime)*time.Second {
@@ -114,6 +118,33 @@ func (eqh *Handle) Run() {
 	}
 }
 
+// killOnSnapshotGCProtectionExceeded kills the running statement if its snapshot is no longer protected
+// from GC by tidb_gc_life_time, tidb_gc_max_wait_time and tidb_gc_query_protect_time. The GC safe point
+// never passes a snapshot younger than any of them, so the statement is killed with a specific error
+// before the snapshot is garbage collected, instead of failing by "GC life time is shorter than transaction
+// duration" when it reads the snapshot later.
+func killOnSnapshotGCProtectionExceeded(info *util.ProcessInfo) {
+	protectTime := variable.GCQueryProtectTime.Load()
+	if protectTime <= 0 || info.CurTxnStartTS == 0 || info.Command == mysql.ComSleep ||
+		info.MemTracker == nil || info.MemTracker.Killer == nil {
+		return
+	}
+	protectDuration := max(time.Duration(max(protectTime, variable.GCMaxWaitTime.Load()))*time.Second,
+		variable.GCLifeTime.Load())
+	snapshotAge := time.Since(oracle.GetTimeFromTS(info.CurTxnStartTS))
+	if snapshotAge < protectDuration {
+		return
+	}
+	killer := info.MemTracker.Killer
+	if atomic.LoadUint32(&killer.Signal) != 0 {
+		return
+	}
+	logutil.BgLogger().Warn("the snapshot of the query is going to be garbage collected, kill it",
+		zap.Duration("snapshotAge", snapshotAge), zap.Duration("protectTime", protectDuration),
+		zap.String("processInfo", info.String()))
+	killer.SendKillSignal(sqlkiller.SnapshotGCProtectionExceeded)
+}
+
 // LogOnQueryExceedMemQuota prints a log when memory usage of connID is out of memory quota.
 func (eqh *Handle) LogOnQueryExceedMemQuota(connID uint64) {
 	if log.GetLevel() > zapcore.WarnLevel {
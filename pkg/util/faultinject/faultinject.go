@@ -0,0 +1,180 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package faultinject implements the session scoped fault injection set by
+// `ADMIN INJECT FAULT`, it makes integration tests and chaos tools able to
+// exercise the failure paths without building TiDB with failpoints enabled.
+package faultinject
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+// The points which can be injected by `ADMIN INJECT FAULT`.
+const (
+	// TxnBeforeCommit is evaluated before committing a transaction.
+	TxnBeforeCommit = "txn/before-commit"
+	// DDLBeforeSubmitJob is evaluated before submitting a DDL job.
+	DDLBeforeSubmitJob = "ddl/before-submit-job"
+	// ImportIntoBeforeSubmitTask is evaluated before submitting the task of IMPORT INTO.
+	ImportIntoBeforeSubmitTask = "import-into/before-submit-task"
+)
+
+// TermOff is the term to remove the injected fault.
+const TermOff = "off"
+
+var supportedPoints = map[string]struct{}{
+	TxnBeforeCommit:            {},
+	DDLBeforeSubmitJob:         {},
+	ImportIntoBeforeSubmitTask: {},
+}
+
+// ErrInjectedFault is returned when a `return` fault is triggered.
+var ErrInjectedFault = errors.New("injected fault")
+
+type fault struct {
+	// remaining is the times the fault can be triggered, -1 means unlimited.
+	remaining int
+	// sleep is the duration to sleep when triggered, 0 means returning
+	// ErrInjectedFault.
+	sleep time.Duration
+	// expireAt is zero if the fault never expires.
+	expireAt time.Time
+}
+
+// parseTerm parses the term of the fault, the format is `[<count>*]<action>`,
+// action is `return` or `sleep(<milliseconds>)`.
+func parseTerm(term string) (*fault, error) {
+	f := &fault{remaining: -1}
+	action := strings.ToLower(strings.TrimSpace(term))
+	if idx := strings.IndexByte(action, '*'); idx >= 0 {
+		cnt, err := strconv.Atoi(strings.TrimSpace(action[:idx]))
+		if err != nil || cnt <= 0 {
+			return nil, errors.Errorf("invalid count of fault term '%s'", term)
+		}
+		f.remaining = cnt
+		action = strings.TrimSpace(action[idx+1:])
+	}
+	switch {
+	case action == "return":
+	case strings.HasPrefix(action, "sleep(") && strings.HasSuffix(action, ")"):
+		ms, err := strconv.ParseUint(action[len("sleep("):len(action)-1], 10, 32)
+		if err != nil || ms == 0 {
+			return nil, errors.Errorf("invalid sleep duration of fault term '%s'", term)
+		}
+		f.sleep = time.Duration(ms) * time.Millisecond
+	default:
+		return nil, errors.Errorf("unsupported fault term '%s', it should be [<count>*]return or [<count>*]sleep(<milliseconds>)", term)
+	}
+	return f, nil
+}
+
+// Faults is the faults injected into a session, it's safe for concurrent use.
+type Faults struct {
+	mu     sync.Mutex
+	faults map[string]*fault
+}
+
+// Inject injects the fault described by term into the point, the fault is
+// removed after ttl, ttl <= 0 means the fault lasts until it's removed. term
+// TermOff removes the fault injected into the point.
+func (f *Faults) Inject(point, term string, ttl time.Duration) error {
+	var expireAt time.Time
+	if ttl > 0 {
+		expireAt = time.Now().Add(ttl)
+	}
+	return f.inject(point, term, expireAt)
+}
+
+func (f *Faults) inject(point, term string, expireAt time.Time) error {
+	if _, ok := supportedPoints[point]; !ok {
+		return errors.Errorf("unsupported fault injection point '%s'", point)
+	}
+	if strings.EqualFold(strings.TrimSpace(term), TermOff) {
+		f.Remove(point)
+		return nil
+	}
+	flt, err := parseTerm(term)
+	if err != nil {
+		return err
+	}
+	flt.expireAt = expireAt
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.faults == nil {
+		f.faults = make(map[string]*fault)
+	}
+	f.faults[point] = flt
+	return nil
+}
+
+// Remove removes the fault injected into the point.
+func (f *Faults) Remove(point string) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.faults, point)
+}
+
+// trigger returns the fault injected into the point if it can be triggered,
+// expired or exhausted faults are removed.
+func (f *Faults) trigger(point string) *fault {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	flt, ok := f.faults[point]
+	if !ok {
+		return nil
+	}
+	if !flt.expireAt.IsZero() && !time.Now().Before(flt.expireAt) {
+		delete(f.faults, point)
+		return nil
+	}
+	if flt.remaining > 0 {
+		flt.remaining--
+		if flt.remaining == 0 {
+			delete(f.faults, point)
+		}
+	}
+	return flt
+}
+
+// Eval evaluates the fault injected into the point, it returns ErrInjectedFault
+// for a `return` fault, and sleeps for a `sleep` fault. It's safe to call on a
+// nil Faults.
+func (f *Faults) Eval(ctx context.Context, point string) error {
+	if f == nil {
+		return nil
+	}
+	flt := f.trigger(point)
+	if flt == nil {
+		return nil
+	}
+	if flt.sleep == 0 {
+		return errors.Annotatef(ErrInjectedFault, "point: %s", point)
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(flt.sleep):
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faultinject
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTerm(t *testing.T) {
+	f, err := parseTerm("return")
+	require.NoError(t, err)
+	require.Equal(t, -1, f.remaining)
+	require.Zero(t, f.sleep)
+
+	f, err = parseTerm(" 2*Sleep(100) ")
+	require.NoError(t, err)
+	require.Equal(t, 2, f.remaining)
+	require.Equal(t, 100*time.Millisecond, f.sleep)
+
+	for _, term := range []string{"", "panic", "0*return", "a*return", "sleep()", "sleep(0)", "sleep(-1)"} {
+		_, err = parseTerm(term)
+		require.Error(t, err, term)
+	}
+}
+
+func TestFaults(t *testing.T) {
+	ctx := context.Background()
+	var nilFaults *Faults
+	require.NoError(t, nilFaults.Eval(ctx, TxnBeforeCommit))
+	nilFaults.Remove(TxnBeforeCommit)
+
+	f := &Faults{}
+	require.ErrorContains(t, f.Inject("unknown", "return", 0), "unsupported fault injection point")
+	require.ErrorContains(t, f.Inject(TxnBeforeCommit, "panic", 0), "unsupported fault term")
+	require.NoError(t, f.Eval(ctx, TxnBeforeCommit))
+
+	// unlimited fault
+	require.NoError(t, f.Inject(TxnBeforeCommit, "return", 0))
+	for i := 0; i < 3; i++ {
+		err := f.Eval(ctx, TxnBeforeCommit)
+		require.True(t, errors.ErrorEqual(err, ErrInjectedFault))
+	}
+	require.NoError(t, f.Eval(ctx, DDLBeforeSubmitJob))
+	require.NoError(t, f.Inject(TxnBeforeCommit, "OFF", 0))
+	require.NoError(t, f.Eval(ctx, TxnBeforeCommit))
+
+	// fault with count
+	require.NoError(t, f.Inject(DDLBeforeSubmitJob, "2*return", time.Hour))
+	require.Error(t, f.Eval(ctx, DDLBeforeSubmitJob))
+	require.Error(t, f.Eval(ctx, DDLBeforeSubmitJob))
+	require.NoError(t, f.Eval(ctx, DDLBeforeSubmitJob))
+
+	// expired fault
+	require.NoError(t, f.inject(DDLBeforeSubmitJob, "return", time.Now().Add(-time.Second)))
+	require.NoError(t, f.Eval(ctx, DDLBeforeSubmitJob))
+	require.Empty(t, f.faults)
+
+	// sleep fault respects the context
+	require.NoError(t, f.Inject(ImportIntoBeforeSubmitTask, "sleep(10)", 0))
+	require.NoError(t, f.Eval(ctx, ImportIntoBeforeSubmitTask))
+	require.NoError(t, f.Inject(ImportIntoBeforeSubmitTask, "sleep(100000)", 0))
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, f.Eval(cancelCtx, ImportIntoBeforeSubmitTask), context.Canceled)
+}
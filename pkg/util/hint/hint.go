@@ -17,6 +17,7 @@ package hint
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -122,6 +123,11 @@ const (
 	HintNoIndexMerge = "no_index_merge"
 	// HintMaxExecutionTime specifies the max allowed execution time in milliseconds
 	HintMaxExecutionTime = "max_execution_time"
+	// HintRuntimeFilter forces a runtime filter between the specified build and target columns,
+	// or forces all the runtime filters regardless of their estimated benefits without the columns.
+	HintRuntimeFilter = "runtime_filter"
+	// HintNoRuntimeFilter disables the runtime filters which aren't forced by the RUNTIME_FILTER hints.
+	HintNoRuntimeFilter = "no_runtime_filter"
 
 	// HintFlagSemiJoinRewrite corresponds to HintSemiJoinRewrite.
 	HintFlagSemiJoinRewrite uint64 = 1 << iota
@@ -208,6 +214,8 @@ type StmtHints struct {
 	AllowInSubqToJoinAndAgg bool
 	NoIndexMergeHint        bool
 	StraightJoinOrder       bool
+	// EnableRuntimeFilter is true for RUNTIME_FILTER() and false for NO_RUNTIME_FILTER().
+	EnableRuntimeFilter bool
 	// EnableCascadesPlanner is use cascades planner for a single query only.
 	EnableCascadesPlanner bool
 	// ForceNthPlan indicates the PlanCounterTp number for finding physical plan.
@@ -222,8 +230,12 @@ type StmtHints struct {
 	HasMaxExecutionTime            bool
 	HasEnableCascadesPlannerHint   bool
 	HasResourceGroup               bool
+	HasRuntimeFilterHint           bool
 	SetVars                        map[string]string
 
+	// RuntimeFilters are the build and target columns of the runtime filters forced by the RUNTIME_FILTER hints.
+	RuntimeFilters []ast.HintRuntimeFilter
+
 	// the original table hints
 	OriginalTableHints []*ast.TableOptimizerHint
 }
@@ -236,8 +248,9 @@ func (sh *StmtHints) TaskMapNeedBackUp() bool {
 // Clone the StmtHints struct and returns the pointer of the new one.
 func (sh *StmtHints) Clone() *StmtHints {
 	var (
-		vars       map[string]string
-		tableHints []*ast.TableOptimizerHint
+		vars           map[string]string
+		tableHints     []*ast.TableOptimizerHint
+		runtimeFilters []ast.HintRuntimeFilter
 	)
 	if len(sh.SetVars) > 0 {
 		vars = make(map[string]string, len(sh.SetVars))
@@ -249,6 +262,9 @@ func (sh *StmtHints) Clone() *StmtHints {
 		tableHints = make([]*ast.TableOptimizerHint, len(sh.OriginalTableHints))
 		copy(tableHints, sh.OriginalTableHints)
 	}
+	if len(sh.RuntimeFilters) > 0 {
+		runtimeFilters = slices.Clone(sh.RuntimeFilters)
+	}
 	return &StmtHints{
 		MemQuotaQuery:                  sh.MemQuotaQuery,
 		MaxExecutionTime:               sh.MaxExecutionTime,
@@ -256,6 +272,7 @@ func (sh *StmtHints) Clone() *StmtHints {
 		AllowInSubqToJoinAndAgg:        sh.AllowInSubqToJoinAndAgg,
 		NoIndexMergeHint:               sh.NoIndexMergeHint,
 		StraightJoinOrder:              sh.StraightJoinOrder,
+		EnableRuntimeFilter:            sh.EnableRuntimeFilter,
 		EnableCascadesPlanner:          sh.EnableCascadesPlanner,
 		ForceNthPlan:                   sh.ForceNthPlan,
 		ResourceGroup:                  sh.ResourceGroup,
@@ -265,11 +282,47 @@ func (sh *StmtHints) Clone() *StmtHints {
 		HasMaxExecutionTime:            sh.HasMaxExecutionTime,
 		HasEnableCascadesPlannerHint:   sh.HasEnableCascadesPlannerHint,
 		HasResourceGroup:               sh.HasResourceGroup,
+		HasRuntimeFilterHint:           sh.HasRuntimeFilterHint,
 		SetVars:                        vars,
+		RuntimeFilters:                 runtimeFilters,
 		OriginalTableHints:             tableHints,
 	}
 }
 
+// ExtractSetVarHints returns the values of the variables in SET_VAR hints of
+// the statement, only the variables in names are returned. The first hint of a
+// variable takes effect if it appears more than once, which is the same as
+// ParseStmtHints.
+func ExtractSetVarHints(node ast.Node, names ...string) map[string]string {
+	var setVars map[string]string
+	for _, hint := range ExtractTableHintsFromStmtNode(node, discardHintWarnings{}) {
+		if hint.HintName.L != "set_var" {
+			continue
+		}
+		setVarHint := hint.HintData.(ast.HintSetVar)
+		name := strings.ToLower(setVarHint.VarName)
+		if !slices.Contains(names, name) {
+			continue
+		}
+		if _, ok := setVars[name]; ok {
+			continue
+		}
+		if setVars == nil {
+			setVars = make(map[string]string, len(names))
+		}
+		setVars[name] = setVarHint.Value
+	}
+	return setVars
+}
+
+// discardHintWarnings discards the warnings, it's used when the hints are
+// checked again later and the warnings will be reported there.
+type discardHintWarnings struct{}
+
+func (discardHintWarnings) SetHintWarning(string) {}
+
+func (discardHintWarnings) SetHintWarningFromError(error) {}
+
 // ParseStmtHints parses statement hints.
 func ParseStmtHints(hints []*ast.TableOptimizerHint,
 	setVarHintChecker func(varName, hint string) (ok bool, warning error),
@@ -279,10 +332,11 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 		return
 	}
 	hintOffs := make(map[string]int, len(hints))
-	var forceNthPlan *ast.TableOptimizerHint
-	var memoryQuotaHintCnt, useToJAHintCnt, useCascadesHintCnt, noIndexMergeHintCnt, readReplicaHintCnt, maxExecutionTimeCnt, forceNthPlanCnt, straightJoinHintCnt, resourceGroupHintCnt int
+	var forceNthPlan, runtimeFilterHint *ast.TableOptimizerHint
+	var memoryQuotaHintCnt, useToJAHintCnt, useCascadesHintCnt, noIndexMergeHintCnt, readReplicaHintCnt, maxExecutionTimeCnt, forceNthPlanCnt, straightJoinHintCnt, resourceGroupHintCnt, runtimeFilterHintCnt int
 	setVars := make(map[string]string)
 	setVarsOffs := make([]int, 0, len(hints))
+	runtimeFilterOffs := make([]int, 0, len(hints))
 	for i, hint := range hints {
 		switch hint.HintName.L {
 		case "memory_quota":
@@ -332,6 +386,15 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 			}
 			setVars[setVarHint.VarName] = setVarHint.Value
 			setVarsOffs = append(setVarsOffs, i)
+		case HintRuntimeFilter, HintNoRuntimeFilter:
+			if hintData, ok := hint.HintData.(ast.HintRuntimeFilter); ok {
+				stmtHints.RuntimeFilters = append(stmtHints.RuntimeFilters, hintData)
+				runtimeFilterOffs = append(runtimeFilterOffs, i)
+				continue
+			}
+			hintOffs[HintRuntimeFilter] = i
+			runtimeFilterHintCnt++
+			runtimeFilterHint = hint
 		}
 	}
 	stmtHints.OriginalTableHints = hints
@@ -423,6 +486,15 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 		stmtHints.HasResourceGroup = true
 		stmtHints.ResourceGroup = resourceGroup.HintData.(string)
 	}
+	// Handle RUNTIME_FILTER() and NO_RUNTIME_FILTER()
+	if runtimeFilterHintCnt != 0 {
+		if runtimeFilterHintCnt > 1 {
+			warn := errors.NewNoStackErrorf("RUNTIME_FILTER() or NO_RUNTIME_FILTER() is defined more than once, only the last definition takes effect: %s()", strings.ToUpper(runtimeFilterHint.HintName.L))
+			warns = append(warns, warn)
+		}
+		stmtHints.HasRuntimeFilterHint = true
+		stmtHints.EnableRuntimeFilter = runtimeFilterHint.HintName.L == HintRuntimeFilter
+	}
 	// Handle NTH_PLAN
 	if forceNthPlanCnt != 0 {
 		if forceNthPlanCnt > 1 {
@@ -442,6 +514,7 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 		offs = append(offs, off)
 	}
 	offs = append(offs, setVarsOffs...)
+	offs = append(offs, runtimeFilterOffs...)
 	// let hint is always ordered, it is convenient to human compare and test.
 	sort.Ints(offs)
 	return
@@ -33,4 +33,11 @@ type PlanCacheMatchOpts struct {
 
 	// Below are some variables that can affect the plan
 	ForeignKeyChecks bool
+	// RuntimeFilterMode, RuntimeFilterTypes, RuntimeFilterMinFilterRatio and MultiColumnRuntimeFilter decide
+	// the runtime filters generated in the plan, they're stored as the underlying values of the variables
+	// to avoid import cycle.
+	RuntimeFilterMode           int64
+	RuntimeFilterTypes          []int64
+	RuntimeFilterMinFilterRatio float64
+	MultiColumnRuntimeFilter    bool
 }
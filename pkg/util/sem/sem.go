@@ -155,7 +155,9 @@ func IsInvisibleSysVar(varNameInLower string) bool {
 		variable.TiDBEnableCollectExecutionInfo,
 		variable.TiDBMemoryUsageAlarmRatio,
 		variable.TiDBRedactLog,
+		variable.TiDBRedactLogProfile,
 		variable.TiDBRestrictedReadOnly,
+		variable.TiDBRestrictedReadOnlySchemas,
 		variable.TiDBTopSQLMaxTimeSeriesCount,
 		variable.TiDBTopSQLMaxMetaCount,
 		tidbAuditRetractLog:
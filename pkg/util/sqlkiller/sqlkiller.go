@@ -33,6 +33,7 @@ const (
 	MaxExecTimeExceeded
 	QueryMemoryExceeded
 	ServerMemoryExceeded
+	SnapshotGCProtectionExceeded
 	// When you add a new signal, you should also modify store/driver/error/ToTidbErr,
 	// so that errors in client can be correctly converted to tidb errors.
 )
@@ -72,6 +73,8 @@ func (killer *SQLKiller) HandleSignal() error {
 		logutil.BgLogger().Warn("global memory controller, NeedKill signal is received successfully",
 			zap.Uint64("conn", killer.ConnID))
 		return exeerrors.ErrMemoryExceedForInstance.GenWithStackByArgs(killer.ConnID)
+	case SnapshotGCProtectionExceeded:
+		return exeerrors.ErrSnapshotGCProtectionExceeded.GenWithStackByArgs(killer.ConnID)
 	}
 	return nil
 }
@@ -17,11 +17,16 @@ package addindextest
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/ddl"
+	"github.com/pingcap/tidb/pkg/ddl/ingest"
 	"github.com/pingcap/tidb/pkg/ddl/util/callback"
 	"github.com/pingcap/tidb/pkg/disttask/framework/proto"
 	"github.com/pingcap/tidb/pkg/disttask/framework/scheduler"
@@ -223,6 +228,90 @@ func TestAddIndexDistPauseAndResume(t *testing.T) {
 	tk.MustExec(`set global tidb_enable_dist_task=0;`)
 }
 
+func TestAddIndexDistLocalPauseAndResume(t *testing.T) {
+	store := realtikvtest.CreateMockStoreAndSetup(t)
+	tk := testkit.NewTestKit(t, store)
+	tk1 := testkit.NewTestKit(t, store)
+	tk.MustExec("drop database if exists addindexlit;")
+	tk.MustExec("create database addindexlit;")
+	tk.MustExec("use addindexlit;")
+	t.Cleanup(func() {
+		tk.MustExec("set global tidb_enable_dist_task = off;")
+	})
+	tk.MustExec(`set global tidb_ddl_enable_fast_reorg=on;`)
+	tk.MustExec("set global tidb_enable_dist_task = on;")
+
+	tk.MustExec("create table t (a int primary key, b int);")
+	for i := 0; i < 20; i++ {
+		tk.MustExec("insert into t values (?, ?)", i, i)
+	}
+	// Each region is a table scan task of the subtask.
+	tk.MustExec("split table t between (0) and (20) regions 20;")
+
+	// Pause the job after most of the records are written to the local engines.
+	var written, rewritten atomic.Int32
+	var resumed atomic.Bool
+	ddl.OperatorCallBackForTest = func() {
+		if resumed.Load() {
+			rewritten.Add(1)
+			return
+		}
+		if written.Add(1) != 15 {
+			return
+		}
+		jobID := tk1.MustQuery("select job_id from mysql.tidb_ddl_job").Rows()[0][0].(string)
+		tk1.MustExec("admin pause ddl jobs " + jobID)
+		require.Eventually(t, func() bool {
+			rows := tk1.MustQuery("select state from mysql.tidb_global_task").Rows()
+			return len(rows) == 1 && rows[0][0].(string) == "paused"
+		}, 30*time.Second, 100*time.Millisecond)
+	}
+	var resumeOnce sync.Once
+	scheduler.MockDMLExecutionOnPausedState = func(task *proto.Task) {
+		resumeOnce.Do(func() {
+			jobID, err := strconv.ParseInt(tk1.MustQuery("select job_id from mysql.tidb_ddl_job").Rows()[0][0].(string), 10, 64)
+			require.NoError(t, err)
+			// The backend context and the checkpoints of the subtasks are kept while the job is paused.
+			require.Eventually(t, func() bool {
+				bc, ok := ingest.LitBackCtxMgr.Load(jobID)
+				if !ok {
+					return false
+				}
+				synced := 0
+				for _, row := range tk1.MustQuery("select id from mysql.tidb_background_subtask").Rows() {
+					subtaskID, err := strconv.ParseInt(row[0].(string), 10, 64)
+					require.NoError(t, err)
+					cnt, _ := bc.SubtaskCheckpoint(subtaskID).Status()
+					synced += cnt
+				}
+				return synced > 0
+			}, 30*time.Second, 100*time.Millisecond)
+			resumed.Store(true)
+			tk1.MustExec(fmt.Sprintf("admin resume ddl jobs %d", jobID))
+		})
+	}
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/ddl/writeLocalExec", "return(true)"))
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/disttask/framework/scheduler/mockDMLExecutionOnPausedState", "return(true)"))
+	t.Cleanup(func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/ddl/writeLocalExec"))
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/disttask/framework/scheduler/mockDMLExecutionOnPausedState"))
+		ddl.OperatorCallBackForTest = nil
+	})
+
+	tk.MustExec("alter table t add index idx(b);")
+	tk.MustExec("admin check table t;")
+	// The records before the checkpoint are not scanned again.
+	require.True(t, resumed.Load())
+	require.Less(t, int(rewritten.Load()), 20)
+	rows := tk.MustQuery("admin show ddl jobs 1;").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "20", rows[0][7])
+	jobID, err := strconv.ParseInt(rows[0][0].(string), 10, 64)
+	require.NoError(t, err)
+	_, ok := ingest.LitBackCtxMgr.Load(jobID)
+	require.False(t, ok)
+}
+
 func TestAddIndexInvalidDistTaskVariableSetting(t *testing.T) {
 	store := realtikvtest.CreateMockStoreAndSetup(t)
 	tk := testkit.NewTestKit(t, store)
@@ -94,7 +94,7 @@ func TestBackfillOperators(t *testing.T) {
 		ctx := context.Background()
 		opCtx := ddl.NewOperatorCtx(ctx, 1, 1)
 		src := newTestSource(opTasks...)
-		scanOp := ddl.NewTableScanOperator(opCtx, sessPool, copCtx, srcChkPool, 3)
+		scanOp := ddl.NewTableScanOperator(opCtx, sessPool, copCtx, srcChkPool, 3, nil)
 		sink := newTestSink[ddl.IndexRecordChunk]()
 
 		operator.Compose[ddl.TableScanTask](src, scanOp)
@@ -187,6 +187,7 @@ func TestBackfillOperatorPipeline(t *testing.T) {
 		sessPool,
 		mockBackendCtx,
 		[]ingest.Engine{mockEngine},
+		nil,
 		tk.Session(),
 		1, // job id
 		tbl.(table.PhysicalTable),
@@ -264,6 +265,7 @@ func TestBackfillOperatorPipelineException(t *testing.T) {
 			sessPool,
 			mockBackendCtx,
 			[]ingest.Engine{mockEngine},
+			nil,
 			tk.Session(),
 			1, // job id
 			tbl.(table.PhysicalTable),
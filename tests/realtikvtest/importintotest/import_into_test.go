@@ -342,6 +342,27 @@ func (s *mockGCSSuite) TestGeneratedColumnsAndTSVFile() {
 	s.tk.MustQuery(fmt.Sprintf(`IMPORT INTO load_csv.t_gen2(a)
 		FROM 'gcs://test-bucket/generated_columns.csv?endpoint=%s' WITH fields_terminated_by='\t'`, gcsEndpoint))
 	s.tk.MustQuery("select * from t_gen2").Check(testkit.Rows("<nil> <nil>", "<nil> <nil>"))
+
+	// value of generated column in data file is only a placeholder, it's not
+	// cast even in strict mode.
+	s.tk.MustExec("set @@sql_mode = 'STRICT_TRANS_TABLES'")
+	s.tk.MustExec(`delete from t_gen1`)
+	s.server.CreateObject(fakestorage.Object{
+		ObjectAttrs: fakestorage.ObjectAttrs{
+			BucketName: "test-bucket",
+			Name:       "generated_columns_placeholder.csv",
+		},
+		Content: []byte("1\tx\n2\t"),
+	})
+	s.tk.MustQuery(fmt.Sprintf(`IMPORT INTO load_csv.t_gen1
+		FROM 'gcs://test-bucket/generated_columns_placeholder.csv?endpoint=%s' WITH fields_terminated_by='\t'`, gcsEndpoint))
+	s.tk.MustQuery("select * from t_gen1").Check(testkit.Rows("1 2", "2 3"))
+
+	// non-constant default value together with SET clause.
+	s.tk.MustExec(`create table t_def (a int, b varchar(40) default (uuid()), c int);`)
+	s.tk.MustQuery(fmt.Sprintf(`IMPORT INTO load_csv.t_def(a, @1) set c=@1+1
+		FROM 'gcs://test-bucket/generated_columns.csv?endpoint=%s' WITH fields_terminated_by='\t'`, gcsEndpoint))
+	s.tk.MustQuery("select a, length(b), c from t_def").Check(testkit.Rows("1 36 3", "2 36 4"))
 }
 
 func (s *mockGCSSuite) TestInputCountMisMatchAndDefault() {
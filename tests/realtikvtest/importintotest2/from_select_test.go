@@ -154,3 +154,28 @@ func (s *mockGCSSuite) TestImportFromSelectStaleRead() {
 	s.tk.MustExec("import into dst from " + staleReadSQL)
 	s.tk.MustQuery("select * from dst").Check(testkit.Rows("1 a", "2 b"))
 }
+
+func (s *mockGCSSuite) TestCreateTableAsSelect() {
+	s.prepareAndUseDB("from_select")
+	s.tk.MustExec("create table src(id int primary key, v varchar(64), d decimal(10, 2))")
+	s.tk.MustExec("insert into src values(1, 'a', 1.5), (2, 'b', 2.5), (3, 'c', null)")
+
+	s.tk.MustExec("create table dst select id, v, d * 2 as d2 from src where id > 1")
+	s.Equal(uint64(2), s.tk.Session().GetSessionVars().StmtCtx.AffectedRows())
+	s.tk.MustQuery("select * from dst order by id").Check(testkit.Rows("2 b 5.00", "3 c <nil>"))
+	s.tk.MustQuery("select column_name from information_schema.columns where table_schema = 'from_select' " +
+		"and table_name = 'dst' order by ordinal_position").Check(testkit.Rows("id", "v", "d2"))
+
+	// the select fields are imported into the columns defined explicitly with the same names.
+	s.tk.MustExec("create table dst2 (id int primary key, x int default 10) select * from src")
+	s.tk.MustQuery("select id, x, v, d from dst2 order by id").Check(testkit.Rows("1 10 a 1.50", "2 10 b 2.50", "3 10 c <nil>"))
+
+	s.ErrorIs(s.tk.ExecToErr("create table dst select * from src"), infoschema.ErrTableExists)
+	s.tk.MustExec("create table if not exists dst select * from src")
+	s.tk.MustQuery("select count(*) from dst").Check(testkit.Rows("2"))
+
+	// the table isn't visible if the import fails.
+	s.Error(s.tk.ExecToErr("create table dst3 (primary key (v)) select v from src union all select v from src"))
+	s.tk.MustQuery("select table_name from information_schema.tables where table_schema = 'from_select' " +
+		"and table_name not in ('src', 'dst', 'dst2')").Check(testkit.Rows())
+}